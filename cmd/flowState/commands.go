@@ -0,0 +1,1032 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/agenda"
+	"github.com/Jericoz-JC/flowState-CLI/internal/bundle"
+	"github.com/Jericoz-JC/flowState-CLI/internal/capture"
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/csvimport"
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
+	"github.com/Jericoz-JC/flowState-CLI/internal/digest"
+	"github.com/Jericoz-JC/flowState-CLI/internal/goals"
+	"github.com/Jericoz-JC/flowState-CLI/internal/graph"
+	"github.com/Jericoz-JC/flowState-CLI/internal/instance"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/org"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
+)
+
+// runCommand executes a non-interactive subcommand and returns the process
+// exit code. Subcommands print plain text to stdout and never start the TUI.
+func runCommand(args []string) int {
+	switch args[0] {
+	case "today":
+		return runToday()
+	case "digest":
+		return runDigest(args[1:])
+	case "placeholders":
+		return runPlaceholders(args[1:])
+	case "graph":
+		return runGraph(args[1:])
+	case "todos", "todo":
+		return runTodos(args[1:])
+	case "maintenance":
+		return runMaintenance(args[1:])
+	case "restore":
+		return runRestore(args[1:])
+	case "bundle":
+		return runBundle(args[1:])
+	case "capture":
+		return runCapture(args[1:])
+	case "goals", "goal":
+		return runGoals(args[1:])
+	case "help", "-h", "--help":
+		printUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "flowState: unknown command %q\n\n", args[0])
+		printUsage()
+		return 2
+	}
+}
+
+// printUsage lists the available subcommands.
+func printUsage() {
+	fmt.Println(`Usage:
+  flowState           Run the interactive application
+  flowState today     Print today's agenda as plain text
+  flowState digest [--yesterday | --date YYYY-MM-DD] [--template NAME]
+                      Summarize a day's completed todos, focus time and notes
+  flowState placeholders [--delete]
+                      List wikilink placeholder notes nothing links to any more
+  flowState graph export [--format dot|mermaid]
+                      Print the notes and links graph as Graphviz DOT or Mermaid
+  flowState todos export [--format org]
+                      Print all todos as an Emacs Org-mode file
+  flowState todos import FILE.csv [--map FIELD=COLUMN]... [--dry-run]
+                      Create todos from the rows of a CSV file
+  flowState maintenance
+                      Check the database, prune orphan links and placeholders,
+                      and compact it
+  flowState restore --merge FILE
+                      Merge a backup database into this one, keeping changes
+                      made since; reports added, updated and conflicting items
+  flowState bundle export (--tag TAG | --notebook NAME) -o FILE
+                      Write one tag's or notebook's notes and todos to a
+                      password-protected file for another flowState
+  flowState bundle import FILE
+                      Add the notes and todos of a bundle file
+  flowState capture --stdin [--title "TITLE"] [--tag TAG]...
+                      Save text piped to flowState as a #readlater note
+  flowState goals     Show each goal's progress this week or month
+  flowState goals add (--notes N | --todos N | --focus-hours N) [--tag TAG]
+                      [--week | --month] ["TITLE"]
+                      Add a goal, counted from notes created, todos completed
+                      or focus time
+  flowState goals rm ID
+                      Delete a goal
+  flowState open LINK Open a note or todo, e.g. note/42 or flowstate://todo/7
+  flowState help      Show this help
+
+Options:
+  --no-color          Render without colors (NO_COLOR is honored too)
+  --plain             Render for screen readers: text labels instead of
+                      box-drawing art and emoji
+  --safe-mode         Start without semantic indexing, plugins, hooks or the
+                      goals and pin counts on Home, to reach your data when
+                      one of them misbehaves`)
+}
+
+// globalFlags are the options any command accepts.
+type globalFlags struct {
+	noColor  bool
+	plain    bool
+	safeMode bool
+}
+
+// parseGlobalFlags removes the global flags from args.
+func parseGlobalFlags(args []string) ([]string, globalFlags) {
+	rest := make([]string, 0, len(args))
+	var flags globalFlags
+	for _, arg := range args {
+		switch arg {
+		case "--no-color":
+			flags.noColor = true
+		case "--plain":
+			flags.plain = true
+		case "--safe-mode":
+			flags.safeMode = true
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	return rest, flags
+}
+
+// parseOpenArgs parses the link given to "flowState open".
+func parseOpenArgs(args []string) (deeplink.Target, error) {
+	if len(args) != 1 {
+		return deeplink.Target{}, fmt.Errorf("usage: flowState open note/ID | todo/ID")
+	}
+	return deeplink.Parse(args[0])
+}
+
+// openStore loads configuration and opens the SQLite store.
+func openStore() (*config.Config, *sqlite.Store, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, store, nil
+}
+
+// openStoreForWrite is openStore for commands that change the database.
+// It takes the single-instance lock first and refuses while flowState is
+// running, whose screens would not see the changes and could save over
+// them. Release the returned lock after closing the store.
+func openStoreForWrite() (*config.Config, *sqlite.Store, *instance.Lock, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	lock, err := instance.Acquire(instance.PathFor(cfg.DbPath))
+	var running *instance.RunningError
+	if errors.As(err, &running) {
+		return nil, nil, nil, fmt.Errorf("%v; quit it first, as this command changes the database", running)
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to lock database: %w", err)
+	}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		lock.Release()
+		return nil, nil, nil, err
+	}
+	return cfg, store, lock, nil
+}
+
+// runToday prints the agenda for today: due/overdue todos, planned effort
+// against configured work hours, focus progress, goals and starred notes.
+func runToday() int {
+	cfg, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	datefmt.Set(datefmt.New(cfg.DateFormat, cfg.ClockFormat, cfg.WeekStart))
+	a, err := agenda.Build(store, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	a.CapacityMinutes = cfg.DailyCapacityMinutes()
+	if err := a.Render(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// digestOptions are the flags of "flowState digest".
+type digestOptions struct {
+	day      time.Time
+	template string // Template name: "" for digest.tmpl, else digest-NAME.tmpl
+}
+
+// parseDigestArgs parses the flags of "flowState digest". The digest
+// covers today unless --yesterday or --date picks another day.
+func parseDigestArgs(args []string, now time.Time) (digestOptions, error) {
+	fs := flag.NewFlagSet("digest", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	yesterday := fs.Bool("yesterday", false, "")
+	date := fs.String("date", "", "")
+	name := fs.String("template", "", "")
+	if err := fs.Parse(args); err != nil {
+		return digestOptions{}, err
+	}
+	if fs.NArg() > 0 {
+		return digestOptions{}, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	opts := digestOptions{day: now, template: *name}
+	switch {
+	case *yesterday && *date != "":
+		return digestOptions{}, fmt.Errorf("use either --yesterday or --date")
+	case *yesterday:
+		opts.day = now.AddDate(0, 0, -1)
+	case *date != "":
+		day, err := time.ParseInLocation("2006-01-02", *date, now.Location())
+		if err != nil {
+			return digestOptions{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD)", *date)
+		}
+		opts.day = day
+	}
+	return opts, nil
+}
+
+// runDigest prints the summary of a day, formatted with the digest
+// template from the config directory (or the built-in one).
+func runDigest(args []string) int {
+	opts, err := parseDigestArgs(args, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState digest: %v\n", err)
+		return 2
+	}
+
+	cfg, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	datefmt.Set(datefmt.New(cfg.DateFormat, cfg.ClockFormat, cfg.WeekStart))
+	tmpl, err := digest.LoadTemplate(cfg.DataDir, opts.template)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	d, err := digest.Build(store, opts.day)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if err := d.Render(os.Stdout, tmpl); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runPlaceholders lists the orphan wikilink placeholders: notes created for
+// a [[wikilink]] that nothing links to any more. With --delete it removes
+// them as well.
+func runPlaceholders(args []string) int {
+	fs := flag.NewFlagSet("placeholders", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	del := fs.Bool("delete", false, "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState placeholders: %v\n", err)
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "flowState placeholders: unexpected argument %q\n", fs.Arg(0))
+		return 2
+	}
+
+	// Listing only reads; deleting must not happen under a running app
+	var store *sqlite.Store
+	var lock *instance.Lock
+	var err error
+	if *del {
+		_, store, lock, err = openStoreForWrite()
+	} else {
+		_, store, err = openStore()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	orphans, err := store.ListOrphanPlaceholders()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if len(orphans) == 0 {
+		fmt.Println("No orphan placeholders.")
+		return 0
+	}
+	for _, note := range orphans {
+		fmt.Printf("  %-6s %s\n", fmt.Sprintf("#%d", note.ID), note.Title)
+	}
+	if !*del {
+		fmt.Printf("%d orphan placeholder(s). Run \"flowState placeholders --delete\" to remove them.\n", len(orphans))
+		return 0
+	}
+	for _, note := range orphans {
+		if err := store.DeleteNote(note.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+			return 1
+		}
+	}
+	fmt.Printf("Deleted %d orphan placeholder(s).\n", len(orphans))
+	return 0
+}
+
+// runGraph runs "flowState graph export": it prints the mind map graph
+// (linked notes and todos, clustered by tag) as Graphviz DOT or Mermaid
+// for rendering outside the terminal.
+func runGraph(args []string) int {
+	if len(args) == 0 || args[0] != "export" {
+		fmt.Fprintln(os.Stderr, "usage: flowState graph export [--format dot|mermaid]")
+		return 2
+	}
+	fs := flag.NewFlagSet("graph export", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	format := fs.String("format", graph.FormatDOT, "")
+	if err := fs.Parse(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState graph export: %v\n", err)
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "flowState graph export: unexpected argument %q\n", fs.Arg(0))
+		return 2
+	}
+	if *format != graph.FormatDOT && *format != graph.FormatMermaid {
+		fmt.Fprintf(os.Stderr, "flowState graph export: unknown format %q (use dot or mermaid)\n", *format)
+		return 2
+	}
+
+	_, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	g, meta, err := loadGraph(store)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if err := graph.Export(os.Stdout, *format, g, meta); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// loadGraph builds the mind map graph from the store, with the labels and
+// metadata of its notes and todos.
+func loadGraph(store *sqlite.Store) (graph.Graph, map[string]graph.NodeMeta, error) {
+	links, err := store.ListLinks()
+	if err != nil {
+		return graph.Graph{}, nil, err
+	}
+	notes, err := store.ListNotes()
+	if err != nil {
+		return graph.Graph{}, nil, err
+	}
+	todos, err := store.ListTodos()
+	if err != nil {
+		return graph.Graph{}, nil, err
+	}
+
+	nodeTags := make(map[string][]string, len(notes))
+	meta := make(map[string]graph.NodeMeta, len(notes)+len(todos))
+	for _, n := range notes {
+		key := graph.NodeKey("note", n.ID)
+		nodeTags[key] = n.Tags
+		meta[key] = graph.NodeMeta{
+			Label:       n.Title,
+			Placeholder: n.IsPlaceholder(),
+			Created:     n.CreatedAt,
+			Updated:     n.UpdatedAt,
+		}
+	}
+	for _, t := range todos {
+		meta[graph.NodeKey("todo", t.ID)] = graph.NodeMeta{
+			Label:   t.Title,
+			Status:  string(t.Status),
+			Created: t.CreatedAt,
+			Updated: t.UpdatedAt,
+		}
+	}
+	return graph.BuildGraphFromLinks(links, nodeTags), meta, nil
+}
+
+// runTodos runs the "flowState todos" subcommands: export and import.
+// "todo" is accepted for "todos".
+func runTodos(args []string) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "export":
+			return runTodosExport(args[1:])
+		case "import":
+			return runTodosImport(args[1:])
+		}
+	}
+	fmt.Fprintln(os.Stderr, "usage: flowState todos export [--format org]")
+	fmt.Fprintln(os.Stderr, "       flowState todos import FILE.csv [--map FIELD=COLUMN]... [--dry-run]")
+	return 2
+}
+
+// runTodosExport prints every todo as an Org-mode file for Emacs' agenda.
+func runTodosExport(args []string) int {
+	fs := flag.NewFlagSet("todos export", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	format := fs.String("format", "org", "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState todos export: %v\n", err)
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "flowState todos export: unexpected argument %q\n", fs.Arg(0))
+		return 2
+	}
+	if *format != "org" {
+		fmt.Fprintf(os.Stderr, "flowState todos export: unknown format %q (use org)\n", *format)
+		return 2
+	}
+
+	_, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	todos, err := store.ListTodos()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if err := org.WriteTodos(os.Stdout, todos); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runTodosImport creates todos from a CSV file. It prints how columns were
+// mapped to fields and why any row is skipped, then a summary. The valid
+// rows are created in one transaction, so an error creates none of them;
+// with --dry-run it checks every row without creating anything.
+func runTodosImport(args []string) int {
+	fs := flag.NewFlagSet("todos import", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	dryRun := fs.Bool("dry-run", false, "")
+	var mapSpecs []string
+	fs.Func("map", "", func(s string) error {
+		mapSpecs = append(mapSpecs, s)
+		return nil
+	})
+	// Flags may come before or after the file name.
+	var files []string
+	for rest := args; ; {
+		if err := fs.Parse(rest); err != nil {
+			fmt.Fprintf(os.Stderr, "flowState todos import: %v\n", err)
+			return 2
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		files = append(files, fs.Arg(0))
+		rest = fs.Args()[1:]
+	}
+	if len(files) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: flowState todos import FILE.csv [--map FIELD=COLUMN]... [--dry-run]")
+		return 2
+	}
+
+	f, err := os.Open(files[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	header, records, err := csvimport.Read(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %s: %v\n", files[0], err)
+		return 1
+	}
+
+	mapping := csvimport.DetectMapping(header)
+	for _, spec := range mapSpecs {
+		field, column, ok := strings.Cut(spec, "=")
+		if !ok {
+			fmt.Fprintf(os.Stderr, "flowState todos import: invalid --map %q (use FIELD=COLUMN)\n", spec)
+			return 2
+		}
+		if err := mapping.Set(field, column, header); err != nil {
+			fmt.Fprintf(os.Stderr, "flowState todos import: %v\n", err)
+			return 2
+		}
+	}
+
+	fmt.Println("Columns:")
+	for _, field := range csvimport.Fields {
+		if i, ok := mapping[field]; ok {
+			fmt.Printf("  %-12s ← %q (column %d)\n", field, header[i], i+1)
+		} else {
+			fmt.Printf("  %-12s   (not mapped)\n", field)
+		}
+	}
+	if _, ok := mapping[csvimport.FieldTitle]; !ok {
+		fmt.Fprintln(os.Stderr, "flowState todos import: no title column; name one with --map title=COLUMN")
+		return 2
+	}
+	fmt.Println()
+
+	// Statuses may name any status in the configured workflow
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: failed to load config: %v\n", err)
+		return 1
+	}
+	todoWorkflow, _ := workflow.New(cfg.TodoStatuses)
+	workflow.Set(todoWorkflow)
+	rows := csvimport.Convert(records, mapping, time.Now())
+
+	var store *sqlite.Store
+	if !*dryRun {
+		var lock *instance.Lock
+		_, store, lock, err = openStoreForWrite()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+			return 1
+		}
+		defer lock.Release()
+		defer store.Close()
+	}
+
+	var valid []csvimport.Row
+	skipped := 0
+	for _, row := range rows {
+		if row.Err != nil {
+			fmt.Printf("  line %-4d skipped: %v\n", row.Line, row.Err)
+			skipped++
+			continue
+		}
+		if *dryRun {
+			fmt.Printf("  line %-4d ok: %s\n", row.Line, row.Todo.Title)
+		}
+		valid = append(valid, row)
+	}
+	created := len(valid)
+
+	if !*dryRun {
+		// All or nothing, so a failed import can simply be run again
+		err = store.WithTx(func(tx *sqlite.Tx) error {
+			for _, row := range valid {
+				todo := row.Todo
+				if err := tx.CreateTodo(&todo); err != nil {
+					return fmt.Errorf("line %d: %w", row.Line, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+			fmt.Println("No todos were created.")
+			return 1
+		}
+	}
+
+	if *dryRun {
+		fmt.Printf("Dry run: %d todo(s) would be created, %d row(s) skipped.\n", created, skipped)
+		return 0
+	}
+	fmt.Printf("Created %d todo(s), skipped %d row(s).\n", created, skipped)
+	return 0
+}
+
+// runMaintenance runs the database housekeeping and reports what it did.
+func runMaintenance(args []string) int {
+	if len(args) > 0 {
+		fmt.Fprintf(os.Stderr, "flowState maintenance: unexpected argument %q\n", args[0])
+		return 2
+	}
+
+	_, store, lock, err := openStoreForWrite()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	report, err := store.Maintain()
+	if errors.Is(err, sqlite.ErrIntegrity) {
+		fmt.Println("Integrity check: FAILED")
+		for _, problem := range report.Problems {
+			fmt.Printf("  %s\n", problem)
+		}
+		fmt.Println("Nothing was changed. Restore a backup or export your data before editing further.")
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Println("Integrity check: ok")
+	fmt.Printf("Orphan links removed: %d\n", report.OrphanLinks)
+	fmt.Printf("Orphan placeholders removed: %d\n", report.Placeholders)
+	fmt.Printf("Analyzed and vacuumed: %s → %s\n", sqlite.FormatSize(report.SizeBefore), sqlite.FormatSize(report.SizeAfter))
+	fmt.Printf("Done in %s.\n", report.Duration.Round(time.Millisecond))
+	return 0
+}
+
+// runRestore merges a backup database into the live one. A full replace
+// is left to copying the file while flowState is closed, so it cannot
+// happen by accident.
+func runRestore(args []string) int {
+	fs := flag.NewFlagSet("restore", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	merge := fs.Bool("merge", false, "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState restore: %v\n", err)
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: flowState restore --merge FILE")
+		return 2
+	}
+	path := fs.Arg(0)
+
+	cfg, store, lock, err := openStoreForWrite()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	if !*merge {
+		fmt.Fprintf(os.Stderr, "flowState restore: only --merge is supported; to replace the database instead, quit flowState and copy %s over %s\n", path, cfg.DbPath)
+		return 2
+	}
+	result, err := store.MergeBackup(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %s: %v\n", path, err)
+		return 1
+	}
+	fmt.Printf("Merged %s: %d added, %d updated, %d unchanged, %d conflict(s), %d link(s) added.\n",
+		path, result.Added, result.Updated, result.Unchanged, len(result.Conflicts), result.Links)
+	if len(result.Conflicts) > 0 {
+		fmt.Println("Changed here since the backup, kept as they are:")
+		for _, c := range result.Conflicts {
+			fmt.Printf("  %s/%d  %s\n", c.Type, c.ID, c.Title)
+		}
+	}
+	return 0
+}
+
+// bundlePasswordEnv names the environment variable a bundle password may
+// be given in, for scripts; otherwise it is asked for on the terminal.
+const bundlePasswordEnv = "FLOWSTATE_BUNDLE_PASSWORD"
+
+// runBundle runs the "flowState bundle" subcommands: export and import.
+func runBundle(args []string) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "export":
+			return runBundleExport(args[1:])
+		case "import":
+			return runBundleImport(args[1:])
+		}
+	}
+	fmt.Fprintln(os.Stderr, "usage: flowState bundle export (--tag TAG | --notebook NAME) -o FILE")
+	fmt.Fprintln(os.Stderr, "       flowState bundle import FILE")
+	return 2
+}
+
+// runBundleExport writes the notes of one tag or notebook, with their
+// todos and links, to a password-protected bundle file.
+func runBundleExport(args []string) int {
+	fs := flag.NewFlagSet("bundle export", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var filter bundle.Filter
+	fs.StringVar(&filter.Tag, "tag", "", "")
+	fs.StringVar(&filter.Notebook, "notebook", "", "")
+	output := fs.String("o", "", "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState bundle export: %v\n", err)
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "flowState bundle export: unexpected argument %q\n", fs.Arg(0))
+		return 2
+	}
+	if (filter.Tag == "") == (filter.Notebook == "") || *output == "" {
+		fmt.Fprintln(os.Stderr, "usage: flowState bundle export (--tag TAG | --notebook NAME) -o FILE")
+		return 2
+	}
+
+	_, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	b, err := bundle.Collect(store, filter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	password, err := bundlePassword(true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+
+	f, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if err := b.Seal(f, password); err != nil {
+		f.Close()
+		os.Remove(*output)
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if err := f.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Exported %s: %d note(s), %d todo(s), %d link(s) to %s.\n",
+		b.Describe(), len(b.Notes), len(b.Todos), len(b.Links), *output)
+	return 0
+}
+
+// runBundleImport adds the content of a bundle file to the database.
+func runBundleImport(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: flowState bundle import FILE")
+		return 2
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer f.Close()
+	password, err := bundlePassword(false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	b, err := bundle.Open(f, password)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %s: %v\n", args[0], err)
+		return 1
+	}
+
+	_, store, lock, err := openStoreForWrite()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	result, err := bundle.Import(store, b)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Imported %s: %d note(s), %d todo(s), %d link(s)", b.Describe(), result.Notes, result.Todos, result.Links)
+	if result.Notebook != "" {
+		fmt.Printf(" into notebook %q", result.Notebook)
+	}
+	fmt.Println(".")
+	if result.Skipped > 0 {
+		fmt.Printf("Skipped %d item(s) already in this database.\n", result.Skipped)
+	}
+	return 0
+}
+
+// bundlePassword returns the password from FLOWSTATE_BUNDLE_PASSWORD or
+// asks for it on the terminal without echoing it; confirm asks twice.
+func bundlePassword(confirm bool) (string, error) {
+	if password := os.Getenv(bundlePasswordEnv); password != "" {
+		return password, nil
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return "", fmt.Errorf("no terminal to ask for the password; set %s", bundlePasswordEnv)
+	}
+	read := func(prompt string) (string, error) {
+		fmt.Fprint(os.Stderr, prompt)
+		password, err := term.ReadPassword(os.Stdin.Fd())
+		fmt.Fprintln(os.Stderr)
+		return string(password), err
+	}
+	password, err := read("Bundle password: ")
+	if err != nil || !confirm {
+		return password, err
+	}
+	again, err := read("Repeat password: ")
+	if err != nil {
+		return "", err
+	}
+	if again != password {
+		return "", errors.New("passwords do not match")
+	}
+	return password, nil
+}
+
+// runCapture creates a #readlater note from text piped to standard input.
+func runCapture(args []string) int {
+	fs := flag.NewFlagSet("capture", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	stdin := fs.Bool("stdin", false, "")
+	title := fs.String("title", "", "")
+	var tags []string
+	fs.Func("tag", "", func(s string) error {
+		tags = append(tags, s)
+		return nil
+	})
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState capture: %v\n", err)
+		return 2
+	}
+	if !*stdin || fs.NArg() > 0 {
+		fmt.Fprintln(os.Stderr, `usage: some-command | flowState capture --stdin [--title "TITLE"] [--tag TAG]...`)
+		return 2
+	}
+
+	text, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	note, err := capture.Note(string(text), *title, tags)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState capture: %v\n", err)
+		return 1
+	}
+
+	// No instance lock: capture only adds a note, which a running app
+	// picks up on its next reload, and scripts must be able to capture
+	// while it is open
+	_, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+
+	if err := store.CreateNote(note); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Captured %q (%s)\n", note.Title, deeplink.URI(deeplink.KindNote, note.ID))
+	return 0
+}
+
+// runGoals dispatches "goals", "goals add" and "goals rm".
+func runGoals(args []string) int {
+	if len(args) == 0 || args[0] == "list" {
+		return runGoalsList()
+	}
+	switch args[0] {
+	case "add":
+		return runGoalsAdd(args[1:])
+	case "rm", "delete":
+		return runGoalsRemove(args[1:])
+	}
+	fmt.Fprintln(os.Stderr, "usage: flowState goals [list]")
+	fmt.Fprintln(os.Stderr, `       flowState goals add (--notes N | --todos N | --focus-hours N) [--tag TAG] [--week | --month] ["TITLE"]`)
+	fmt.Fprintln(os.Stderr, "       flowState goals rm ID")
+	return 2
+}
+
+// runGoalsList prints every goal with a text progress bar.
+func runGoalsList() int {
+	cfg, store, err := openStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer store.Close()
+	datefmt.Set(datefmt.New(cfg.DateFormat, cfg.ClockFormat, cfg.WeekStart))
+
+	now := time.Now()
+	progress, err := goals.MeasureAll(store, now)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	if len(progress) == 0 {
+		fmt.Println("No goals yet. Add one with: flowState goals add --notes 20 --tag thesis")
+		return 0
+	}
+	for _, p := range progress {
+		const width = 20
+		filled := int(p.Fraction() * width)
+		bar := "[" + strings.Repeat("#", filled) + strings.Repeat(".", width-filled) + "]"
+		left := fmt.Sprintf("%d days left", p.DaysLeft(now))
+		switch {
+		case p.Done():
+			left = "done"
+		case p.DaysLeft(now) == 1:
+			left = "last day"
+		}
+		fmt.Printf("%3d  %s %-14s %s (%s)\n", p.Goal.ID, bar, p.Count(), p.Goal.Title, left)
+	}
+	return 0
+}
+
+// runGoalsAdd stores a new goal.
+func runGoalsAdd(args []string) int {
+	fs := flag.NewFlagSet("goals add", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	notes := fs.Int("notes", 0, "")
+	todos := fs.Int("todos", 0, "")
+	focusHours := fs.Float64("focus-hours", 0, "")
+	tag := fs.String("tag", "", "")
+	week := fs.Bool("week", false, "")
+	month := fs.Bool("month", false, "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState goals add: %v\n", err)
+		return 2
+	}
+
+	goal := &models.Goal{Tag: *tag, Title: strings.Join(fs.Args(), " "), Period: models.GoalMonth}
+	kinds := 0
+	if *notes != 0 {
+		goal.Kind, goal.Target = models.GoalNotes, *notes
+		kinds++
+	}
+	if *todos != 0 {
+		goal.Kind, goal.Target = models.GoalTodos, *todos
+		kinds++
+	}
+	if *focusHours != 0 {
+		goal.Kind, goal.Target = models.GoalFocus, int(*focusHours*60+0.5)
+		kinds++
+	}
+	if kinds != 1 || (*week && *month) {
+		fmt.Fprintln(os.Stderr, "flowState goals add: give one of --notes, --todos or --focus-hours, and at most one of --week and --month")
+		return 2
+	}
+	if *week {
+		goal.Period = models.GoalWeek
+	}
+	if err := goals.Validate(goal); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState goals add: %v\n", err)
+		return 2
+	}
+
+	_, store, lock, err := openStoreForWrite()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	if err := store.CreateGoal(goal); err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Added goal %d: %s\n", goal.ID, goal.Title)
+	return 0
+}
+
+// runGoalsRemove deletes a goal by ID.
+func runGoalsRemove(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: flowState goals rm ID")
+		return 2
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || id <= 0 {
+		fmt.Fprintf(os.Stderr, "flowState goals rm: invalid goal ID %q\n", args[0])
+		return 2
+	}
+
+	_, store, lock, err := openStoreForWrite()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	defer lock.Release()
+	defer store.Close()
+
+	if err := store.DeleteGoal(id); errors.Is(err, sql.ErrNoRows) {
+		fmt.Fprintf(os.Stderr, "flowState goals rm: no goal %d\n", id)
+		return 1
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Deleted goal %d\n", id)
+	return 0
+}
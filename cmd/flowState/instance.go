@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/instance"
+)
+
+// acquireInstanceLock takes the single-instance lock for cfg's database.
+// When another instance is running, the user chooses between attaching
+// read-only (cfg.ReadOnly is set and the returned lock is nil) and
+// quitting (ok is false).
+func acquireInstanceLock(cfg *config.Config) (lock *instance.Lock, ok bool, err error) {
+	lock, err = instance.Acquire(instance.PathFor(cfg.DbPath))
+	var running *instance.RunningError
+	if errors.As(err, &running) {
+		if !promptAttach(os.Stdin, os.Stdout, running) {
+			return nil, false, nil
+		}
+		cfg.ReadOnly = true
+		return nil, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return lock, true, nil
+}
+
+// promptAttach explains that another instance owns the database and asks
+// whether to open it read-only. Anything but "r" quits.
+func promptAttach(in io.Reader, out io.Writer, running *instance.RunningError) bool {
+	where := fmt.Sprintf("Switch to the terminal running PID %d to keep working there, or:", running.PID)
+	if running.PID == 0 {
+		where = "If another instance is starting, switch to it, or:"
+	}
+	fmt.Fprintf(out, `%v.
+Two instances writing to the same database can interleave changes.
+%s
+
+  [r] Open read-only (browse only; changes are disabled)
+  [q] Quit
+
+Choice [q]: `, running, where)
+
+	line, _ := bufio.NewReader(in).ReadString('\n')
+	return strings.EqualFold(strings.TrimSpace(line), "r")
+}
@@ -17,6 +17,12 @@
 // Usage:
 //
 //	./flowState           # Run the application
+//	./flowState --no-color  # Run without colors (as does NO_COLOR=1)
+//	./flowState --plain   # Run with screen-reader friendly output
+//	./flowState --safe-mode  # Run without indexing, plugins and hooks
+//	./flowState today     # Print today's agenda to stdout
+//	./flowState digest --yesterday  # Summarize yesterday for mail or Slack
+//	./flowState open note/42  # Launch the TUI on a note or todo
 //	./flowState.exe       # Windows executable
 package main
 
@@ -28,10 +34,33 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
 	app "github.com/Jericoz-JC/flowState-CLI/internal/tui"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
 )
 
 func main() {
+	// Phase 4: Robustness - Pick the color profile (truecolor, 256, 16 or
+	// none) before anything renders
+	args, flags := parseGlobalFlags(os.Args[1:])
+	profile := styles.DetectColorProfile(os.Stdout, flags.noColor)
+	styles.SetColorProfile(profile)
+
+	// Non-interactive subcommands print to stdout and skip the TUI entirely;
+	// "open" launches the TUI on a linked note or todo
+	var target *deeplink.Target
+	if len(args) > 0 {
+		if args[0] != "open" {
+			os.Exit(runCommand(args))
+		}
+		t, err := parseOpenArgs(args[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+			os.Exit(2)
+		}
+		target = &t
+	}
+
 	// Phase 4: Robustness - File logging
 	f, err := tea.LogToFile("debug.log", "debug")
 	if err != nil {
@@ -39,6 +68,7 @@ func main() {
 		os.Exit(1)
 	}
 	defer f.Close()
+	log.Printf("color profile: %s", styles.ColorProfileName(profile))
 
 	// Phase 4: Robustness - Global Panic Recovery
 	defer func() {
@@ -55,6 +85,21 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if flags.plain {
+		cfg.PlainOutput = true
+	}
+	cfg.SafeMode = flags.safeMode
+
+	// Phase 4: Robustness - Single-instance lock. A second instance may
+	// only attach read-only so the two never interleave writes.
+	lock, ok, err := acquireInstanceLock(cfg)
+	if err != nil {
+		log.Fatalf("Failed to lock database: %v", err)
+	}
+	if !ok {
+		return
+	}
+	defer lock.Release()
 
 	// Phase 1: Initialize TUI application with storage connections
 	app, err := app.New(cfg)
@@ -63,9 +108,24 @@ func main() {
 	}
 	defer app.Close()
 
+	if target != nil {
+		if err := app.Open(*target); err != nil {
+			fmt.Fprintf(os.Stderr, "flowState: %v\n", err)
+			app.Close()
+			lock.Release()
+			os.Exit(1)
+		}
+	}
+
 	// Phase 1: Start Bubble Tea event loop with alternate screen
 	p := tea.NewProgram(app, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	_, err = p.Run()
+	if cfg.TerminalTitle() {
+		// Phase 10: leave no stale countdown in the terminal title (an
+		// OSC 2 sequence with an empty title)
+		fmt.Print("\x1b]2;\a")
+	}
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running app: %v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,278 @@
+// Package agenda builds a plain-text daily agenda for flowState-cli.
+//
+// The agenda is printed by `flowState today` so it can be piped into a
+// morning terminal, MOTD, or any other plain-text destination. It has no
+// TUI dependencies and writes nothing but plain text.
+//
+// Sections:
+//   - Overdue: pending todos whose due date is before today
+//   - Due Today: pending todos due today
+//   - Upcoming: pending todos due within the next 7 days
+//   - In Progress: in-progress todos without a due date
+//   - Focus: today's completed focus sessions and current streak, then
+//     the focus blocks planned for today (x when done)
+//   - Planned: summed estimates for today vs. daily capacity, with a
+//     warning when the day is over-planned
+//   - Goals: each weekly or monthly goal's progress and days left
+//   - Starred: the most recently updated starred notes
+//
+// Usage:
+//
+//	a, err := agenda.Build(store, time.Now())
+//	if err != nil { ... }
+//	a.Render(os.Stdout)
+package agenda
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/goals"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// UpcomingDays is how far ahead the Upcoming section looks.
+const UpcomingDays = 7
+
+// StarredLimit is how many starred notes the Starred section lists.
+const StarredLimit = 10
+
+// Agenda is a snapshot of everything relevant for a single day.
+type Agenda struct {
+	Date         time.Time
+	Overdue      []models.Todo
+	DueToday     []models.Todo
+	Upcoming     []models.Todo
+	InProgress   []models.Todo
+	FocusCount   int                     // Completed focus sessions today
+	FocusMinutes int                     // Completed focus minutes today
+	Streak       int                     // Consecutive days with a completed session
+	FocusBlocks  []models.PlannedSession // Focus blocks planned for today
+	Goals        []goals.Progress        // Every goal, in its current period
+	Starred      []models.Note           // Starred notes, recently updated first
+
+	// CapacityMinutes is the day's work capacity; set by the caller from
+	// config. Zero disables the over-planned warning.
+	CapacityMinutes int
+}
+
+// PlannedMinutes sums the estimates of everything on today's plate
+// (overdue plus due today).
+func (a *Agenda) PlannedMinutes() int {
+	total := 0
+	for _, list := range [][]models.Todo{a.Overdue, a.DueToday} {
+		for _, todo := range list {
+			total += todo.EstimateMinutes
+		}
+	}
+	return total
+}
+
+// OverPlanned reports whether today's estimates exceed capacity.
+func (a *Agenda) OverPlanned() bool {
+	return a.CapacityMinutes > 0 && a.PlannedMinutes() > a.CapacityMinutes
+}
+
+// Build collects the agenda for the day containing now.
+func Build(store *sqlite.Store, now time.Time) (*Agenda, error) {
+	todos, err := store.ListTodos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	a := &Agenda{Date: now}
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	startOfTomorrow := startOfToday.AddDate(0, 0, 1)
+	horizon := startOfToday.AddDate(0, 0, UpcomingDays+1)
+
+	for _, todo := range todos {
+		if todo.Status == models.TodoStatusCompleted {
+			continue
+		}
+		if todo.DueDate == nil {
+			if todo.Status == models.TodoStatusInProgress {
+				a.InProgress = append(a.InProgress, todo)
+			}
+			continue
+		}
+		due := todo.DueDate.In(now.Location())
+		switch {
+		case due.Before(startOfToday):
+			a.Overdue = append(a.Overdue, todo)
+		case due.Before(startOfTomorrow):
+			a.DueToday = append(a.DueToday, todo)
+		case due.Before(horizon):
+			a.Upcoming = append(a.Upcoming, todo)
+		}
+	}
+
+	for _, list := range [][]models.Todo{a.Overdue, a.DueToday, a.Upcoming} {
+		sortByDue(list)
+	}
+
+	sessions, err := store.GetSessionsForDate(now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	for _, session := range sessions {
+		if session.Status != models.SessionStatusCompleted {
+			continue
+		}
+		a.FocusCount++
+		a.FocusMinutes += session.Duration / 60
+	}
+
+	a.FocusBlocks, err = store.ListPlannedSessions(startOfToday, startOfTomorrow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list planned focus blocks: %w", err)
+	}
+
+	streak, err := store.GetCurrentStreak()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute streak: %w", err)
+	}
+	a.Streak = streak
+
+	a.Goals, err = goals.MeasureAll(store, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure goals: %w", err)
+	}
+	a.Starred, err = store.QueryNotes(sqlite.NoteQuery{Starred: true, Limit: StarredLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list starred notes: %w", err)
+	}
+
+	return a, nil
+}
+
+// sortByDue orders todos by due date (see models.CompareDue), then by
+// priority (high first).
+func sortByDue(todos []models.Todo) {
+	sort.SliceStable(todos, func(i, j int) bool {
+		if c := models.CompareDue(*todos[i].DueDate, *todos[j].DueDate); c != 0 {
+			return c < 0
+		}
+		return todos[i].Priority > todos[j].Priority
+	})
+}
+
+// Render writes the agenda as plain text.
+func (a *Agenda) Render(w io.Writer) error {
+	var b strings.Builder
+
+	header := "flowState — " + a.Date.Format("Monday, ") + datefmt.Date(a.Date)
+	b.WriteString(header + "\n")
+	b.WriteString(strings.Repeat("=", len([]rune(header))) + "\n")
+
+	writeSection(&b, "Overdue", a.Overdue, func(t models.Todo) string {
+		return "due " + datefmt.Short(*t.DueDate)
+	})
+	writeSection(&b, "Due Today", a.DueToday, func(t models.Todo) string {
+		if models.DueHasTime(*t.DueDate) {
+			return "by " + datefmt.Clock(*t.DueDate)
+		}
+		return ""
+	})
+	writeSection(&b, "Upcoming", a.Upcoming, func(t models.Todo) string {
+		if models.DueHasTime(*t.DueDate) {
+			return datefmt.DayTime(*t.DueDate)
+		}
+		return datefmt.Day(*t.DueDate)
+	})
+	writeSection(&b, "In Progress", a.InProgress, nil)
+
+	if planned := a.PlannedMinutes(); planned > 0 {
+		b.WriteString("\nPlanned\n")
+		if a.CapacityMinutes > 0 {
+			fmt.Fprintf(&b, "  %s of %s today\n", models.FormatMinutes(planned), models.FormatMinutes(a.CapacityMinutes))
+		} else {
+			fmt.Fprintf(&b, "  %s today\n", models.FormatMinutes(planned))
+		}
+		if a.OverPlanned() {
+			fmt.Fprintf(&b, "  ! Over-planned by %s\n", models.FormatMinutes(planned-a.CapacityMinutes))
+		}
+	}
+
+	b.WriteString("\nFocus\n")
+	fmt.Fprintf(&b, "  %d session(s), %d min today · streak %d day(s)\n", a.FocusCount, a.FocusMinutes, a.Streak)
+	for _, block := range a.FocusBlocks {
+		box := "[ ]"
+		if block.SessionID != nil {
+			box = "[x]"
+		}
+		line := fmt.Sprintf("  %s %s %s", box, datefmt.Clock(block.Start), models.FormatMinutes(block.Minutes))
+		if block.Label != "" {
+			line += " on " + block.Label
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if len(a.Goals) > 0 {
+		b.WriteString("\nGoals\n")
+		for _, p := range a.Goals {
+			box, left := "[ ]", fmt.Sprintf("%d days left", p.DaysLeft(a.Date))
+			switch {
+			case p.Done():
+				box, left = "[x]", "done"
+			case p.DaysLeft(a.Date) == 1:
+				left = "last day"
+			}
+			fmt.Fprintf(&b, "  %s %s %s (%s)\n", box, p.Goal.Title, p.Count(), left)
+		}
+	}
+
+	if len(a.Starred) > 0 {
+		fmt.Fprintf(&b, "\nStarred (%d)\n", len(a.Starred))
+		for _, note := range a.Starred {
+			b.WriteString("  * " + note.Title + "\n")
+		}
+	}
+
+	if a.Empty() {
+		b.WriteString("\nNothing due. Enjoy the clear runway.\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Empty reports whether there are no todos in any section.
+func (a *Agenda) Empty() bool {
+	return len(a.Overdue)+len(a.DueToday)+len(a.Upcoming)+len(a.InProgress) == 0
+}
+
+// writeSection renders one todo section; empty sections are omitted.
+// The estimate and detail (when non-nil) are added as a parenthesised suffix.
+func writeSection(b *strings.Builder, title string, todos []models.Todo, detail func(models.Todo) string) {
+	if len(todos) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s (%d)\n", title, len(todos))
+	for _, todo := range todos {
+		line := "  [ ] " + todo.Title
+		if todo.Status == models.TodoStatusInProgress {
+			line = "  [~] " + todo.Title
+		}
+		if todo.Priority == models.TodoPriorityHigh {
+			line += " !"
+		}
+		var details []string
+		if est := models.FormatMinutes(todo.EstimateMinutes); est != "" {
+			details = append(details, est)
+		}
+		if detail != nil {
+			if d := detail(todo); d != "" {
+				details = append(details, d)
+			}
+		}
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, ", ") + ")"
+		}
+		b.WriteString(line + "\n")
+	}
+}
@@ -0,0 +1,215 @@
+package agenda
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func newTestStore(t *testing.T) *sqlite.Store {
+	t.Helper()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestBuildBucketsTodosByDueDate(t *testing.T) {
+	store := newTestStore(t)
+
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local)
+	at := func(days int) *time.Time {
+		d := now.AddDate(0, 0, days)
+		return &d
+	}
+
+	todos := []*models.Todo{
+		{Title: "Late report", Status: models.TodoStatusPending, DueDate: at(-2)},
+		{Title: "Call dentist", Status: models.TodoStatusPending, DueDate: at(0), Priority: models.TodoPriorityHigh},
+		{Title: "Plan sprint", Status: models.TodoStatusPending, DueDate: at(3)},
+		{Title: "Far away", Status: models.TodoStatusPending, DueDate: at(30)},
+		{Title: "Already done", Status: models.TodoStatusCompleted, DueDate: at(0)},
+		{Title: "Refactor store", Status: models.TodoStatusInProgress},
+	}
+	for _, todo := range todos {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	a, err := Build(store, now)
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+
+	if len(a.Overdue) != 1 || a.Overdue[0].Title != "Late report" {
+		t.Errorf("Overdue = %+v", a.Overdue)
+	}
+	if len(a.DueToday) != 1 || a.DueToday[0].Title != "Call dentist" {
+		t.Errorf("DueToday = %+v", a.DueToday)
+	}
+	if len(a.Upcoming) != 1 || a.Upcoming[0].Title != "Plan sprint" {
+		t.Errorf("Upcoming = %+v", a.Upcoming)
+	}
+	if len(a.InProgress) != 1 || a.InProgress[0].Title != "Refactor store" {
+		t.Errorf("InProgress = %+v", a.InProgress)
+	}
+
+	var out strings.Builder
+	if err := a.Render(&out); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	text := out.String()
+	for _, want := range []string{"Tuesday, Mar 10, 2026", "Overdue (1)", "Call dentist !", "Upcoming (1)", "Focus"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("rendered agenda missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Far away") || strings.Contains(text, "Already done") {
+		t.Errorf("rendered agenda contains todos outside its window:\n%s", text)
+	}
+}
+
+func TestRenderEmptyAgenda(t *testing.T) {
+	store := newTestStore(t)
+
+	a, err := Build(store, time.Now())
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	if !a.Empty() {
+		t.Fatalf("expected empty agenda")
+	}
+
+	var out strings.Builder
+	if err := a.Render(&out); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	if !strings.Contains(out.String(), "Nothing due") {
+		t.Errorf("expected empty-state message, got:\n%s", out.String())
+	}
+}
+
+func TestRenderWarnsWhenOverPlanned(t *testing.T) {
+	store := newTestStore(t)
+
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local)
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	for _, todo := range []*models.Todo{
+		{Title: "Deep work", Status: models.TodoStatusPending, DueDate: &today, EstimateMinutes: 300},
+		{Title: "Reviews", Status: models.TodoStatusPending, DueDate: &today, EstimateMinutes: 240},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	a, err := Build(store, now)
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	a.CapacityMinutes = 8 * 60
+
+	if got := a.PlannedMinutes(); got != 540 {
+		t.Fatalf("PlannedMinutes() = %d, want 540", got)
+	}
+	if !a.OverPlanned() {
+		t.Fatalf("expected agenda to be over-planned")
+	}
+
+	var out strings.Builder
+	if err := a.Render(&out); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	for _, want := range []string{"Deep work (5h)", "9h of 8h", "Over-planned by 1h"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("rendered agenda missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestRenderPlannedFocusBlocks(t *testing.T) {
+	store := newTestStore(t)
+
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local)
+	for _, plan := range []*models.PlannedSession{
+		{Start: now.Add(6 * time.Hour), Minutes: 45, Label: "thesis"},
+		{Start: now.Add(time.Hour), Minutes: 25},
+		{Start: now.AddDate(0, 0, 1), Minutes: 60, Label: "tomorrow"},
+	} {
+		if err := store.CreatePlannedSession(plan); err != nil {
+			t.Fatalf("CreatePlannedSession() err = %v", err)
+		}
+	}
+
+	a, err := Build(store, now)
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	if len(a.FocusBlocks) != 2 {
+		t.Fatalf("FocusBlocks = %+v, want today's two", a.FocusBlocks)
+	}
+
+	var out strings.Builder
+	if err := a.Render(&out); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	got := out.String()
+	first := strings.Index(got, "[ ] "+datefmt.Clock(now.Add(time.Hour))+" 25m")
+	second := strings.Index(got, "[ ] "+datefmt.Clock(now.Add(6*time.Hour))+" 45m on thesis")
+	if first < 0 || second < first || strings.Contains(got, "tomorrow") {
+		t.Errorf("expected today's blocks in order under Focus, got:\n%s", got)
+	}
+}
+
+func TestRenderGoalsAndStarredNotes(t *testing.T) {
+	store := newTestStore(t)
+
+	for _, goal := range []*models.Goal{
+		{Title: "Write often", Kind: models.GoalNotes, Target: 3, Period: models.GoalMonth},
+		{Title: "Any note", Kind: models.GoalNotes, Target: 1, Period: models.GoalMonth},
+	} {
+		if err := store.CreateGoal(goal); err != nil {
+			t.Fatalf("CreateGoal() err = %v", err)
+		}
+	}
+	for _, note := range []*models.Note{
+		{Title: "Reading list", Starred: true},
+		{Title: "Scratch"},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+
+	a, err := Build(store, time.Now())
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	if len(a.Goals) != 2 || len(a.Starred) != 1 {
+		t.Fatalf("Goals = %+v, Starred = %+v; want 2 goals and 1 starred note", a.Goals, a.Starred)
+	}
+
+	var out strings.Builder
+	if err := a.Render(&out); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	text := out.String()
+	for _, want := range []string{"Goals", "[ ] Write often 2/3 notes", "[x] Any note 2/1 notes (done)", "Starred (1)", "  * Reading list"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("rendered agenda missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Scratch") {
+		t.Errorf("rendered agenda lists an unstarred note:\n%s", text)
+	}
+}
@@ -0,0 +1,274 @@
+// Package bundle shares a slice of flowState-cli data between instances.
+//
+// A share bundle holds the notes carrying one tag, or filed in one
+// notebook, together with the todos that mention the tag or are attached
+// to those notes and the links between them. It backs `flowState bundle
+// export` and `flowState bundle import`, so a project's notes can be
+// handed to a colleague without sharing the whole database.
+//
+// Bundles are password-protected: the JSON is compressed, then sealed
+// with AES-256-GCM under a key derived from the password with
+// PBKDF2-HMAC-SHA256 (see Seal). A wrong password and a damaged file both
+// fail with ErrPassword; nothing is imported from a bundle that does not
+// open.
+//
+// Importing creates new notes and todos, so IDs never clash with the
+// receiving database; links are remapped to the new IDs. Notes from a
+// notebook bundle are filed in a notebook of the same name. Items the
+// receiver already has (a note with the same title and body, a todo with
+// the same title and description) are skipped, so importing a bundle
+// twice does not duplicate it.
+//
+// Usage:
+//
+//	b, err := bundle.Collect(store, bundle.Filter{Tag: "acme"})
+//	if err != nil { ... }
+//	err = b.Seal(f, password)
+//
+//	b, err := bundle.Open(f, password)
+//	if err != nil { ... }
+//	result, err := bundle.Import(store, b)
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Version is the bundle format version written by Seal.
+const Version = 1
+
+// Filter selects what goes into a bundle: the notes tagged Tag, or the
+// notes filed in the notebook named Notebook. Exactly one must be set.
+type Filter struct {
+	Tag      string
+	Notebook string
+}
+
+// Bundle is the content of a share bundle. IDs are those of the sending
+// database; links refer to them.
+type Bundle struct {
+	Version  int           `json:"version"`
+	Created  time.Time     `json:"created"`
+	Tag      string        `json:"tag,omitempty"`
+	Notebook string        `json:"notebook,omitempty"`
+	Notes    []models.Note `json:"notes"`
+	Todos    []models.Todo `json:"todos"`
+	Links    []models.Link `json:"links"`
+}
+
+// Describe names what the bundle holds, e.g. `tag #acme` or
+// `notebook "Thesis"`.
+func (b *Bundle) Describe() string {
+	if b.Notebook != "" {
+		return fmt.Sprintf("notebook %q", b.Notebook)
+	}
+	return "tag #" + b.Tag
+}
+
+// Collect gathers the notes, todos and links selected by f.
+func Collect(store *sqlite.Store, f Filter) (*Bundle, error) {
+	f.Tag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(f.Tag), "#"))
+	f.Notebook = strings.TrimSpace(f.Notebook)
+	if (f.Tag == "") == (f.Notebook == "") {
+		return nil, errors.New("choose either a tag or a notebook")
+	}
+
+	b := &Bundle{Version: Version, Created: time.Now(), Tag: f.Tag}
+	query := sqlite.NoteQuery{Sort: sqlite.NoteSortUpdatedAsc}
+	if f.Notebook != "" {
+		notebook, err := findNotebook(store, f.Notebook)
+		if err != nil {
+			return nil, err
+		}
+		b.Tag, b.Notebook = "", notebook.Name
+		query.Notebook = notebook.ID
+	} else {
+		query.Tags = []string{f.Tag}
+	}
+
+	notes, err := store.QueryNotes(query)
+	if err != nil {
+		return nil, err
+	}
+	included := map[string]bool{}
+	for _, note := range notes {
+		note.NotebookID = 0 // Meaningless in another database
+		b.Notes = append(b.Notes, note)
+		included[key("note", note.ID)] = true
+	}
+
+	todos, err := store.ListTodos()
+	if err != nil {
+		return nil, err
+	}
+	for _, todo := range todos {
+		attached := todo.NoteID != nil && included[key("note", *todo.NoteID)]
+		if !attached && (b.Tag == "" || !mentionsTag(todo, b.Tag)) {
+			continue
+		}
+		if !attached {
+			todo.NoteID = nil
+		}
+		b.Todos = append(b.Todos, todo)
+		included[key("todo", todo.ID)] = true
+	}
+
+	links, err := store.ListLinks()
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if included[key(link.SourceType, link.SourceID)] && included[key(link.TargetType, link.TargetID)] {
+			b.Links = append(b.Links, link)
+		}
+	}
+
+	if len(b.Notes) == 0 && len(b.Todos) == 0 {
+		return nil, fmt.Errorf("nothing to export for %s", b.Describe())
+	}
+	return b, nil
+}
+
+// ImportResult says what Import created.
+type ImportResult struct {
+	Notes    int
+	Todos    int
+	Links    int
+	Skipped  int    // Notes and todos the database already had
+	Notebook string // Notebook the notes were filed in, if any
+}
+
+// Import adds the bundle's content to store in one transaction, giving
+// every item a new ID.
+func Import(store *sqlite.Store, b *Bundle) (*ImportResult, error) {
+	if store.ReadOnly() {
+		return nil, errors.New("database is open read-only")
+	}
+	result := &ImportResult{}
+
+	existingNotes, err := store.ListNotes()
+	if err != nil {
+		return nil, err
+	}
+	noteIDs := map[string]int64{}
+	for _, note := range existingNotes {
+		noteIDs[note.Title+"\x00"+note.Body] = note.ID
+	}
+	existingTodos, err := store.ListTodos()
+	if err != nil {
+		return nil, err
+	}
+	todoIDs := map[string]int64{}
+	for _, todo := range existingTodos {
+		todoIDs[todo.Title+"\x00"+todo.Description] = todo.ID
+	}
+
+	// ids maps an item of the bundle to its ID in store.
+	ids := map[string]int64{}
+	err = store.WithTx(func(tx *sqlite.Tx) error {
+		var notebookID int64
+		if b.Notebook != "" {
+			notebook, err := tx.CreateNotebook(b.Notebook)
+			if err != nil {
+				return err
+			}
+			notebookID, result.Notebook = notebook.ID, notebook.Name
+		}
+
+		for _, note := range b.Notes {
+			if id, ok := noteIDs[note.Title+"\x00"+note.Body]; ok {
+				ids[key("note", note.ID)] = id
+				result.Skipped++
+				continue
+			}
+			created := note
+			created.ID, created.NotebookID = 0, notebookID
+			if err := tx.CreateNote(&created); err != nil {
+				return fmt.Errorf("note %q: %w", note.Title, err)
+			}
+			ids[key("note", note.ID)] = created.ID
+			result.Notes++
+		}
+
+		for _, todo := range b.Todos {
+			if id, ok := todoIDs[todo.Title+"\x00"+todo.Description]; ok {
+				ids[key("todo", todo.ID)] = id
+				result.Skipped++
+				continue
+			}
+			created := todo
+			created.ID, created.NoteID = 0, nil
+			if todo.NoteID != nil {
+				if id, ok := ids[key("note", *todo.NoteID)]; ok {
+					created.NoteID = &id
+				}
+			}
+			if err := tx.CreateTodo(&created); err != nil {
+				return fmt.Errorf("todo %q: %w", todo.Title, err)
+			}
+			ids[key("todo", todo.ID)] = created.ID
+			result.Todos++
+		}
+
+		var links []models.Link
+		for _, link := range b.Links {
+			source, ok := ids[key(link.SourceType, link.SourceID)]
+			if !ok {
+				continue
+			}
+			target, ok := ids[key(link.TargetType, link.TargetID)]
+			if !ok {
+				continue
+			}
+			links = append(links, models.Link{
+				SourceType: link.SourceType,
+				SourceID:   source,
+				TargetType: link.TargetType,
+				TargetID:   target,
+				LinkType:   link.LinkType,
+			})
+		}
+		result.Links = len(links)
+		return tx.CreateLinks(links)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// findNotebook returns the notebook called name, ignoring case.
+func findNotebook(store *sqlite.Store, name string) (*models.Notebook, error) {
+	notebooks, err := store.ListNotebooks()
+	if err != nil {
+		return nil, err
+	}
+	for _, nb := range notebooks {
+		if strings.EqualFold(nb.Name, name) {
+			return &nb.Notebook, nil
+		}
+	}
+	return nil, fmt.Errorf("no notebook called %q", name)
+}
+
+// mentionsTag reports whether the todo's title or description carries
+// #tag.
+func mentionsTag(todo models.Todo, tag string) bool {
+	for _, t := range models.ExtractHashtags(todo.Title + " " + todo.Description) {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// key identifies an item of the bundle across notes and todos.
+func key(itemType string, id int64) string {
+	return fmt.Sprintf("%s/%d", itemType, id)
+}
@@ -0,0 +1,225 @@
+package bundle
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func init() {
+	iterations = 1000 // Keep the tests fast; the format records the count
+}
+
+func newTestStore(t *testing.T) *sqlite.Store {
+	t.Helper()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestSealOpen(t *testing.T) {
+	t.Parallel()
+
+	b := &Bundle{Version: Version, Tag: "acme", Notes: []models.Note{{ID: 3, Title: "Plan", Body: "secret body"}}}
+	var buf bytes.Buffer
+	if err := b.Seal(&buf, "short"); err == nil {
+		t.Error("expected a short password to be refused")
+	}
+	if err := b.Seal(&buf, "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("secret body")) {
+		t.Error("bundle content is readable without the password")
+	}
+
+	if _, err := Open(bytes.NewReader(buf.Bytes()), "wrong horse"); !errors.Is(err, ErrPassword) {
+		t.Errorf("wrong password: err = %v, want ErrPassword", err)
+	}
+	damaged := append([]byte(nil), buf.Bytes()...)
+	damaged[len(damaged)-1] ^= 1
+	if _, err := Open(bytes.NewReader(damaged), "correct horse"); !errors.Is(err, ErrPassword) {
+		t.Errorf("damaged file: err = %v, want ErrPassword", err)
+	}
+	if _, err := Open(bytes.NewReader([]byte("title,due\n")), "correct horse"); err == nil || errors.Is(err, ErrPassword) {
+		t.Errorf("not a bundle: err = %v", err)
+	}
+
+	opened, err := Open(&buf, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened.Tag != "acme" || len(opened.Notes) != 1 || opened.Notes[0].Body != "secret body" {
+		t.Errorf("Open() = %+v", opened)
+	}
+}
+
+func TestCollectAndImport(t *testing.T) {
+	t.Parallel()
+
+	src := newTestStore(t)
+	plan := &models.Note{Title: "Acme plan", Body: "Kickoff", Tags: []string{"acme"}}
+	notes := &models.Note{Title: "Acme notes", Body: "Meeting", Tags: []string{"acme", "meetings"}}
+	private := &models.Note{Title: "Diary", Body: "Private", Tags: []string{"personal"}}
+	for _, n := range []*models.Note{plan, notes, private} {
+		if err := src.CreateNote(n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	attached := &models.Todo{Title: "Send proposal", NoteID: &plan.ID}
+	tagged := &models.Todo{Title: "Invoice #acme"}
+	other := &models.Todo{Title: "Buy milk"}
+	for _, todo := range []*models.Todo{attached, tagged, other} {
+		if err := src.CreateTodo(todo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := src.CreateLinks([]models.Link{
+		{SourceType: "note", SourceID: plan.ID, TargetType: "note", TargetID: notes.ID, LinkType: models.LinkTypeRelated},
+		{SourceType: "note", SourceID: plan.ID, TargetType: "note", TargetID: private.ID, LinkType: models.LinkTypeRelated},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Collect(src, Filter{}); err == nil {
+		t.Error("expected an error without a tag or notebook")
+	}
+	b, err := Collect(src, Filter{Tag: "#Acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Notes) != 2 || len(b.Todos) != 2 || len(b.Links) != 1 {
+		t.Fatalf("Collect() = %d notes, %d todos, %d links; want 2, 2, 1", len(b.Notes), len(b.Todos), len(b.Links))
+	}
+
+	var buf bytes.Buffer
+	if err := b.Seal(&buf, "hand over"); err != nil {
+		t.Fatal(err)
+	}
+	opened, err := Open(&buf, "hand over")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := newTestStore(t)
+	if err := dst.CreateNote(&models.Note{Title: "Existing", Body: "Already here"}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := Import(dst, opened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Notes != 2 || result.Todos != 2 || result.Links != 1 || result.Skipped != 0 {
+		t.Errorf("Import() = %+v", result)
+	}
+
+	imported, err := dst.GetNoteByTitle("Acme plan")
+	if err != nil || imported == nil {
+		t.Fatalf("imported note missing: %v", err)
+	}
+	todos, err := dst.ListTodosForNote(imported.ID)
+	if err != nil || len(todos) != 1 || todos[0].Title != "Send proposal" {
+		t.Errorf("attached todo not remapped: %v, %v", todos, err)
+	}
+	links, err := dst.GetLinksForItem("note", imported.ID)
+	if err != nil || len(links) != 1 {
+		t.Fatalf("links = %v, err %v", links, err)
+	}
+	if target, _ := dst.GetNote(links[0].TargetID); target == nil || target.Title != "Acme notes" {
+		t.Errorf("link points at %+v", target)
+	}
+
+	again, err := Import(dst, opened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Notes != 0 || again.Todos != 0 || again.Skipped != 4 {
+		t.Errorf("second Import() = %+v, want everything skipped", again)
+	}
+}
+
+func TestCollectNotebook(t *testing.T) {
+	t.Parallel()
+
+	src := newTestStore(t)
+	nb, err := src.CreateNotebook("Thesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []*models.Note{{Title: "Chapter 1", NotebookID: nb.ID}, {Title: "Loose"}} {
+		if err := src.CreateNote(n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := Collect(src, Filter{Notebook: "Missing"}); err == nil {
+		t.Error("expected an error for an unknown notebook")
+	}
+	b, err := Collect(src, Filter{Notebook: "thesis"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Notebook != "Thesis" || len(b.Notes) != 1 || b.Notes[0].NotebookID != 0 {
+		t.Fatalf("Collect() = %+v", b)
+	}
+
+	dst := newTestStore(t)
+	result, err := Import(dst, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Notebook != "Thesis" {
+		t.Errorf("Import() notebook = %q", result.Notebook)
+	}
+	note, err := dst.GetNoteByTitle("Chapter 1")
+	if err != nil || note == nil || note.NotebookID == 0 {
+		t.Errorf("imported note not filed: %+v, %v", note, err)
+	}
+}
+
+func TestImportRollsBackNotebook(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	dst, err := sqlite.New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = dst.Close() })
+
+	// Make the import fail after the notebook and note are created
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TRIGGER no_todos BEFORE INSERT ON todos BEGIN SELECT RAISE(ABORT, 'no todos'); END"); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Bundle{
+		Version:  Version,
+		Notebook: "Thesis",
+		Notes:    []models.Note{{ID: 1, Title: "Chapter 1"}},
+		Todos:    []models.Todo{{ID: 2, Title: "Write it", Status: models.TodoStatusPending}},
+	}
+	if _, err := Import(dst, b); err == nil {
+		t.Fatal("expected Import() to fail")
+	}
+	notebooks, err := dst.ListNotebooks()
+	if err != nil || len(notebooks) != 0 {
+		t.Errorf("notebooks after a failed import = %+v (err %v), want none", notebooks, err)
+	}
+	if notes, err := dst.ListNotes(); err != nil || len(notes) != 0 {
+		t.Errorf("notes after a failed import = %d (err %v), want none", len(notes), err)
+	}
+}
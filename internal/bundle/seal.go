@@ -0,0 +1,139 @@
+package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// File format
+//
+// A sealed bundle is a fixed header followed by the ciphertext:
+//
+//	magic      8 bytes  "FSBUNDLE"
+//	version    1 byte
+//	iterations 4 bytes  PBKDF2 rounds, big-endian
+//	salt       16 bytes
+//	nonce      12 bytes
+//	ciphertext AES-256-GCM of the gzipped JSON, header as additional data
+//
+// The round count travels with the file so it can be raised later
+// without breaking older bundles.
+
+const (
+	magic     = "FSBUNDLE"
+	saltSize  = 16
+	keySize   = 32
+	headerLen = len(magic) + 1 + 4 + saltSize + 12
+
+	// minPasswordLength is the shortest password Seal accepts.
+	minPasswordLength = 8
+)
+
+// iterations is the PBKDF2 round count Seal uses; Open refuses files
+// asking for more than maxIterations rather than stall on them.
+var iterations uint32 = 600_000
+
+const maxIterations = 10_000_000
+
+// ErrPassword is returned by Open when the password is wrong or the file
+// was altered.
+var ErrPassword = errors.New("wrong password, or the bundle is damaged")
+
+// Seal writes the bundle to w, encrypted with password.
+func (b *Bundle) Seal(w io.Writer, password string) error {
+	if len(password) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+
+	var plain bytes.Buffer
+	zw := gzip.NewWriter(&plain)
+	if err := json.NewEncoder(zw).Encode(b); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	header := make([]byte, headerLen)
+	copy(header, magic)
+	header[len(magic)] = Version
+	binary.BigEndian.PutUint32(header[len(magic)+1:], iterations)
+	salt := header[len(magic)+5 : len(magic)+5+saltSize]
+	nonce := header[len(magic)+5+saltSize:]
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(password, salt, iterations)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = w.Write(gcm.Seal(nil, nonce, plain.Bytes(), header))
+	return err
+}
+
+// Open reads a bundle written by Seal.
+func Open(r io.Reader, password string) (*Bundle, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < headerLen || string(data[:len(magic)]) != magic {
+		return nil, errors.New("not a flowState bundle")
+	}
+	if v := data[len(magic)]; v > Version {
+		return nil, fmt.Errorf("bundle version %d is newer than this flowState supports (%d)", v, Version)
+	}
+	header := data[:headerLen]
+	rounds := binary.BigEndian.Uint32(header[len(magic)+1:])
+	if rounds == 0 || rounds > maxIterations {
+		return nil, errors.New("not a flowState bundle")
+	}
+	salt := header[len(magic)+5 : len(magic)+5+saltSize]
+	nonce := header[len(magic)+5+saltSize:]
+
+	gcm, err := newGCM(password, salt, rounds)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, nonce, data[headerLen:], header)
+	if err != nil {
+		return nil, ErrPassword
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return nil, err
+	}
+	var b Bundle
+	if err := json.NewDecoder(zr).Decode(&b); err != nil {
+		return nil, fmt.Errorf("invalid bundle contents: %w", err)
+	}
+	return &b, nil
+}
+
+// newGCM derives the key for password and salt and returns the cipher.
+func newGCM(password string, salt []byte, rounds uint32) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(pbkdf2.Key([]byte(password), salt, int(rounds), keySize, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
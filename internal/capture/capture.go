@@ -0,0 +1,92 @@
+// Package capture turns piped text into a note for flowState-cli.
+//
+// It backs `flowState capture --stdin`, so shell, browser and reader
+// pipelines can feed a reading queue:
+//
+//	pbpaste | flowState capture --stdin --title "Article X"
+//
+// Every capture is tagged #readlater (ReadLaterTag) plus any tags given
+// with --tag. Hashtags inside the text are deliberately not turned into
+// tags: pasted articles and highlights are full of URL fragments and
+// "#1"-style numbering that would litter the tag list.
+//
+// Without a title the first non-empty line becomes the title, and the
+// rest the body. A first line longer than models.MaxTitleLength is cut
+// short with an ellipsis and kept whole in the body.
+//
+// Usage:
+//
+//	note, err := capture.Note(text, title, []string{"work"})
+//	if err != nil { ... }
+//	store.CreateNote(note)
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// ReadLaterTag is the tag every captured note gets.
+const ReadLaterTag = "readlater"
+
+// Note builds a note from captured text. title may be empty; tags are
+// added after ReadLaterTag, with or without their #.
+func Note(text, title string, tags []string) (*models.Note, error) {
+	text = strings.TrimPrefix(text, "\ufeff")
+	text = strings.ToValidUTF8(text, "\ufffd")
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	title = oneLine(title)
+	if text == "" && title == "" {
+		return nil, errors.New("nothing to capture: the input is empty")
+	}
+
+	body := text
+	if title == "" {
+		first, rest, _ := strings.Cut(text, "\n")
+		title = oneLine(first)
+		body = strings.TrimSpace(rest)
+		if utf8.RuneCountInString(title) > models.MaxTitleLength {
+			title = string([]rune(title)[:models.MaxTitleLength-1]) + "…"
+			body = text
+		}
+	}
+	if n := utf8.RuneCountInString(title); n > models.MaxTitleLength {
+		return nil, fmt.Errorf("title is too long (%d of %d characters)", n, models.MaxTitleLength)
+	}
+	if n := utf8.RuneCountInString(body); n > models.MaxBodyLength {
+		return nil, fmt.Errorf("text is too long for a note (%d of %d characters)", n, models.MaxBodyLength)
+	}
+
+	noteTags := []string{ReadLaterTag}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(tag), "#"))
+		if tag == "" {
+			continue
+		}
+		if models.HashtagPattern.FindString("#"+tag) != "#"+tag {
+			return nil, fmt.Errorf("invalid tag %q (use letters, digits and _)", tag)
+		}
+		if !containsString(noteTags, tag) {
+			noteTags = append(noteTags, tag)
+		}
+	}
+	return &models.Note{Title: title, Body: body, Tags: noteTags}, nil
+}
+
+// oneLine collapses runs of whitespace, newlines included, to one space.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
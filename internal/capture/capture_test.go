@@ -0,0 +1,93 @@
+package capture
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNote(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		text      string
+		title     string
+		tags      []string
+		wantTitle string
+		wantBody  string
+		wantTags  []string
+	}{
+		{
+			name:      "first line is the title",
+			text:      "\ufeffArticle  X\r\n\r\nFirst paragraph, see #2.\r\n",
+			wantTitle: "Article X",
+			wantBody:  "First paragraph, see #2.",
+			wantTags:  []string{"readlater"},
+		},
+		{
+			name:      "title given",
+			text:      "Highlight one\nHighlight two",
+			title:     " Article\nX ",
+			tags:      []string{"#Work", "work", "", "readlater"},
+			wantTitle: "Article X",
+			wantBody:  "Highlight one\nHighlight two",
+			wantTags:  []string{"readlater", "work"},
+		},
+		{
+			name:      "title only",
+			title:     "Read later",
+			wantTitle: "Read later",
+			wantTags:  []string{"readlater"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			note, err := Note(tt.text, tt.title, tt.tags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if note.Title != tt.wantTitle || note.Body != tt.wantBody {
+				t.Errorf("Note() = %q / %q, want %q / %q", note.Title, note.Body, tt.wantTitle, tt.wantBody)
+			}
+			if !reflect.DeepEqual(note.Tags, tt.wantTags) {
+				t.Errorf("tags = %v, want %v", note.Tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+func TestNoteLongFirstLine(t *testing.T) {
+	t.Parallel()
+
+	text := strings.Repeat("é", models.MaxTitleLength+10) + "\nmore"
+	note, err := Note(text, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := utf8.RuneCountInString(note.Title); n != models.MaxTitleLength || !strings.HasSuffix(note.Title, "…") {
+		t.Errorf("title has %d characters: %q", n, note.Title)
+	}
+	if note.Body != text {
+		t.Error("expected the whole text in the body when the title is cut")
+	}
+}
+
+func TestNoteErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Note(" \n\t", "", nil); err == nil {
+		t.Error("expected an error for empty input")
+	}
+	if _, err := Note("text", "", []string{"not-a-tag"}); err == nil {
+		t.Error("expected an error for an invalid tag")
+	}
+	if _, err := Note(strings.Repeat("x", models.MaxBodyLength+1), "Title", nil); err == nil || !strings.Contains(err.Error(), "too long") {
+		t.Errorf("expected a too-long error, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+// Package clipboard copies text to the system clipboard through the
+// terminal.
+//
+// Phase 10: Sharing
+//   - Uses the OSC 52 escape sequence, which most modern terminals
+//     (iTerm2, kitty, WezTerm, Windows Terminal, tmux with set-clipboard)
+//     understand, so no platform helper (pbcopy, xclip) is needed and it
+//     also works over SSH
+//   - Terminals without OSC 52 support ignore the sequence
+//
+// Usage:
+//
+//	_ = clipboard.Copy(url)
+package clipboard
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Output is where Copy writes the escape sequence. Tests replace it.
+var Output io.Writer = os.Stdout
+
+// Sequence returns the OSC 52 sequence that sets the clipboard to text.
+func Sequence(text string) string {
+	return "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+}
+
+// Copy asks the terminal to put text on the clipboard.
+func Copy(text string) error {
+	_, err := fmt.Fprint(Output, Sequence(text))
+	return err
+}
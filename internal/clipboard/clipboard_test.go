@@ -0,0 +1,21 @@
+package clipboard
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCopyWritesOSC52(t *testing.T) {
+	var buf bytes.Buffer
+	old := Output
+	Output = &buf
+	defer func() { Output = old }()
+
+	if err := Copy("https://gist.github.com/x"); err != nil {
+		t.Fatalf("Copy() err = %v", err)
+	}
+	want := "\x1b]52;c;aHR0cHM6Ly9naXN0LmdpdGh1Yi5jb20veA==\a"
+	if buf.String() != want {
+		t.Errorf("Copy() wrote %q, want %q", buf.String(), want)
+	}
+}
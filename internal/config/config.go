@@ -1,7 +1,7 @@
 // Package config provides configuration management for flowState-cli.
 //
 // Phase 1: Core Infrastructure
-// - Loads configuration from ~/.config/flowState/config.yaml
+// - Loads configuration from ~/.config/flowState/config.json (optional)
 // - Creates data directory if it doesn't exist
 // - Provides sensible defaults for all configuration options
 // - Stores: data directory, database path, Qdrant URL, model path
@@ -10,8 +10,75 @@
 //   - DataDir: Base directory for all application data (~/.config/flowState)
 //   - DbPath: SQLite database file path
 //   - QdrantUrl: Vector database URL for semantic search
+//   - VectorBackend: Where semantic search keeps and searches vectors:
+//     "memory" (the default; in the database, compared in process) or
+//     "qdrant" (the server at QdrantUrl)
+//   - QdrantCollection / QdrantAPIKey: The Qdrant collection holding the
+//     vectors and the key sent with each request
 //   - ModelPath: Path to store embedding models
 //   - EmbeddingsEnabled: Toggle semantic search features
+//   - EmbeddingModel / EmbeddingDims: The embedding model notes are
+//     indexed with and, for a model the embeddings package does not know,
+//     the length of its vectors; changing them asks to rebuild the index
+//   - ANNSearch: Search large vector stores approximately (HNSW) instead
+//     of comparing the query with every vector
+//   - ModelURL / ModelSHA256: Where the embedding model is downloaded
+//     from (a mirror URL, or a local path for offline machines) and the
+//     checksum it must match
+//   - WorkHoursPerDay: Daily capacity used by the planner and agenda
+//   - SpellCheck: Underline misspelled words in the note body editor
+//   - DictionaryPath: User word list merged with the bundled dictionary
+//   - EditorLineNumbers: Number the lines of the note body editor
+//   - FocusBlockCommands / FocusUnblockCommands: Shell commands run when a
+//     focus work session starts and when it ends or is cancelled
+//   - FocusHookTimeoutSeconds: Time limit for each of those commands
+//   - BreakActivities: Checklist shown during focus breaks
+//   - AutoStartBreak / AutoStartWork: Whether a break starts when a work
+//     session completes, and the next work session when a break ends
+//   - FocusChime: Ring the terminal bell when a focus phase ends
+//   - ListDensity: Default row density per list ("notes", "todos",
+//     "focus_history"): "compact" or "comfortable"
+//   - DateFormat / ClockFormat / WeekStart: How dates and times are shown
+//     ("iso", "us" or "eu"; "12h" or "24h") and the first day of the week
+//     (see the datefmt package)
+//   - RelativeDatesEnabled: Show when list items changed as "2h ago" or
+//     "yesterday" (on unless "relative_dates" is false)
+//   - ShareCommand: Shell command a note's markdown is piped to by S in
+//     the notes screen; it prints the shared URL (e.g. "gh gist create -")
+//   - SummarizeCommand: Shell command or http(s) URL that A in the note
+//     preview sends a note to; its reply becomes the note's summary
+//   - SuggestTagsOnSave: Offer existing tags for notes saved without any
+//     (on unless "suggest_tags" is false)
+//   - WebhookURL / WebhookEvents: Slack or Discord webhook that focus
+//     milestones are posted to, and which kinds ("session", "daily_goal",
+//     "streak"; all when empty)
+//   - DailyFocusGoalMinutes: Focus time per day that counts as the goal
+//   - FocusJournal: Append a line for each completed focus work session to
+//     the day's daily note, e.g. "- 🍅 25m on [[Thesis outline]] (14:05–14:30)"
+//   - EnergyPrompt: Ask for a 1-5 energy rating when a focus work session
+//     ends; the history stats average the ratings by time of day
+//   - DailyNoteTitle: Title of the daily note, with YYYY, MM and DD
+//     standing for the date ("YYYY-MM-DD" by default)
+//   - StaleNoteDays / StaleTodoDays: Age at which a note left untouched, or
+//     an open todo left unchanged, is marked stale (90 and 30 by default)
+//   - TerminalTitleEnabled: Show the current screen and the remaining focus
+//     time in the terminal's title (on unless "terminal_title" is false)
+//   - PreviewAfterCreate: Open the preview of a note or todo once it is
+//     created; the new item is selected in the list either way
+//   - MaintenanceDays: Run housekeeping (integrity check, orphan pruning,
+//     VACUUM) on startup when it last ran this many days ago or more;
+//     0 (the default) leaves it to `flowState maintenance`
+//   - HighContrast: Use the high-contrast palette instead of ARCHWAVE
+//   - ReducedMotion: Turn off blinking cursors, spinners, gradients and
+//     timed feedback; toasts stay until the next key
+//   - PlainOutput: Render for screen readers, with text labels in place of
+//     box-drawing art and emoji
+//   - TodoStatuses: Todo statuses beyond pending, in_progress and
+//     completed, in workflow order (see the workflow package)
+//
+// Any field may be overridden in config.json using its json key, e.g.
+//
+//	{"work_hours_per_day": 6}
 //
 // Usage:
 //
@@ -21,16 +88,214 @@
 package config
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultWorkHoursPerDay is the daily capacity used when none is configured.
+const DefaultWorkHoursPerDay = 8
+
+// Default staleness thresholds, in days, for StaleNoteDays and StaleTodoDays.
+const (
+	DefaultStaleNoteDays = 90
+	DefaultStaleTodoDays = 30
 )
 
+// DefaultDailyNoteTitle is the daily note title used when none is
+// configured.
+const DefaultDailyNoteTitle = "YYYY-MM-DD"
+
+// List densities accepted by ListDensity.
+const (
+	DensityCompact     = "compact"
+	DensityComfortable = "comfortable"
+)
+
+// DefaultBreakActivities is the break checklist used when none is configured.
+var DefaultBreakActivities = []string{
+	"Stand up and stretch",
+	"Drink some water",
+	"Take a quick walk",
+	"Rest your eyes",
+}
+
+// TodoStatus is one entry of todo_statuses, e.g.
+//
+//	{"name": "blocked", "icon": "⛔", "color": "#ff5f87", "cycle": false}
+type TodoStatus struct {
+	Name  string `mapstructure:"name" json:"name"`
+	Label string `mapstructure:"label" json:"label"` // Defaults to the name, capitalized
+	Icon  string `mapstructure:"icon" json:"icon"`
+	Color string `mapstructure:"color" json:"color"` // Hex ("#ff5f87") or ANSI ("203")
+	Cycle *bool  `mapstructure:"cycle" json:"cycle"` // Reached by x; true when unset
+}
+
 type Config struct {
-	DataDir           string `mapstructure:"data_dir"`
-	DbPath            string `mapstructure:"db_path"`
-	QdrantUrl         string `mapstructure:"qdrant_url"`
-	ModelPath         string `mapstructure:"model_path"`
-	EmbeddingsEnabled bool   `mapstructure:"embeddings_enabled"`
+	DataDir           string  `mapstructure:"data_dir" json:"data_dir"`
+	DbPath            string  `mapstructure:"db_path" json:"db_path"`
+	QdrantUrl         string  `mapstructure:"qdrant_url" json:"qdrant_url"`
+	VectorBackend     string  `mapstructure:"vector_backend" json:"vector_backend"`
+	QdrantCollection  string  `mapstructure:"qdrant_collection" json:"qdrant_collection"`
+	QdrantAPIKey      string  `mapstructure:"qdrant_api_key" json:"qdrant_api_key"`
+	ModelPath         string  `mapstructure:"model_path" json:"model_path"`
+	EmbeddingsEnabled bool    `mapstructure:"embeddings_enabled" json:"embeddings_enabled"`
+	EmbeddingModel    string  `mapstructure:"embedding_model" json:"embedding_model"`
+	EmbeddingDims     int     `mapstructure:"embedding_dimensions" json:"embedding_dimensions"`
+	ANNSearch         bool    `mapstructure:"ann_search" json:"ann_search"`
+	ModelURL          string  `mapstructure:"model_url" json:"model_url"`
+	ModelSHA256       string  `mapstructure:"model_sha256" json:"model_sha256"`
+	WorkHoursPerDay   float64 `mapstructure:"work_hours_per_day" json:"work_hours_per_day"`
+	SpellCheck        bool    `mapstructure:"spell_check" json:"spell_check"`
+	DictionaryPath    string  `mapstructure:"dictionary_path" json:"dictionary_path"`
+	EditorLineNumbers bool    `mapstructure:"editor_line_numbers" json:"editor_line_numbers"`
+
+	FocusBlockCommands      []string `mapstructure:"focus_block_commands" json:"focus_block_commands"`
+	FocusUnblockCommands    []string `mapstructure:"focus_unblock_commands" json:"focus_unblock_commands"`
+	FocusHookTimeoutSeconds int      `mapstructure:"focus_hook_timeout_seconds" json:"focus_hook_timeout_seconds"`
+	BreakActivities         []string `mapstructure:"break_activities" json:"break_activities"`
+	AutoStartBreak          *bool    `mapstructure:"auto_start_break" json:"auto_start_break"`
+	AutoStartWork           bool     `mapstructure:"auto_start_work" json:"auto_start_work"`
+	FocusChime              bool     `mapstructure:"focus_chime" json:"focus_chime"`
+
+	ListDensity map[string]string `mapstructure:"list_density" json:"list_density"`
+
+	DateFormat  string `mapstructure:"date_format" json:"date_format"`
+	ClockFormat string `mapstructure:"clock_format" json:"clock_format"`
+	WeekStart   string `mapstructure:"week_start" json:"week_start"`
+
+	RelativeDatesEnabled *bool `mapstructure:"relative_dates" json:"relative_dates"`
+
+	ShareCommand     string `mapstructure:"share_command" json:"share_command"`
+	SummarizeCommand string `mapstructure:"summarize_command" json:"summarize_command"`
+
+	SuggestTagsOnSave *bool `mapstructure:"suggest_tags" json:"suggest_tags"`
+
+	WebhookURL            string   `mapstructure:"webhook_url" json:"webhook_url"`
+	WebhookEvents         []string `mapstructure:"webhook_events" json:"webhook_events"`
+	DailyFocusGoalMinutes int      `mapstructure:"daily_focus_goal_minutes" json:"daily_focus_goal_minutes"`
+
+	FocusJournal   bool   `mapstructure:"focus_journal" json:"focus_journal"`
+	EnergyPrompt   bool   `mapstructure:"energy_prompt" json:"energy_prompt"`
+	DailyNoteTitle string `mapstructure:"daily_note_title" json:"daily_note_title"`
+
+	StaleNoteDays int `mapstructure:"stale_note_days" json:"stale_note_days"`
+	StaleTodoDays int `mapstructure:"stale_todo_days" json:"stale_todo_days"`
+
+	TerminalTitleEnabled *bool `mapstructure:"terminal_title" json:"terminal_title"`
+
+	PreviewAfterCreate bool `mapstructure:"preview_after_create" json:"preview_after_create"`
+
+	MaintenanceDays int `mapstructure:"maintenance_days" json:"maintenance_days"`
+
+	HighContrast  bool `mapstructure:"high_contrast" json:"high_contrast"`
+	ReducedMotion bool `mapstructure:"reduced_motion" json:"reduced_motion"`
+	PlainOutput   bool `mapstructure:"plain_output" json:"plain_output"`
+
+	TodoStatuses []TodoStatus `mapstructure:"todo_statuses" json:"todo_statuses"`
+
+	// ReadOnly opens the database without write access. It is set for a
+	// single run (another instance holds the lock) and never read from
+	// config.json.
+	ReadOnly bool `json:"-"`
+
+	// SafeMode starts the app without semantic indexing, plugins, hooks
+	// or the heavier home screen queries (--safe-mode), so none of them
+	// can keep the data out of reach. Like ReadOnly it lasts one run.
+	SafeMode bool `json:"-"`
+}
+
+// DailyCapacityMinutes returns the configured work hours in minutes,
+// falling back to DefaultWorkHoursPerDay when unset.
+func (c *Config) DailyCapacityMinutes() int {
+	if c == nil || c.WorkHoursPerDay <= 0 {
+		return DefaultWorkHoursPerDay * 60
+	}
+	return int(c.WorkHoursPerDay * 60)
+}
+
+// BreakAutoStarts reports whether a break starts as soon as a work session
+// completes. It defaults to true when auto_start_break is unset.
+func (c *Config) BreakAutoStarts() bool {
+	return c == nil || c.AutoStartBreak == nil || *c.AutoStartBreak
+}
+
+// SuggestTags reports whether saving an untagged note offers tag
+// suggestions. It defaults to true when suggest_tags is unset.
+func (c *Config) SuggestTags() bool {
+	return c == nil || c.SuggestTagsOnSave == nil || *c.SuggestTagsOnSave
+}
+
+// TerminalTitle reports whether the app sets the terminal's title. It
+// defaults to true when terminal_title is unset.
+func (c *Config) TerminalTitle() bool {
+	return c == nil || c.TerminalTitleEnabled == nil || *c.TerminalTitleEnabled
+}
+
+// RelativeDates reports whether lists show how long ago items changed
+// rather than the date. It defaults to true when relative_dates is unset.
+func (c *Config) RelativeDates() bool {
+	return c == nil || c.RelativeDatesEnabled == nil || *c.RelativeDatesEnabled
+}
+
+// CompactList reports whether the list on screen ("notes", "todos",
+// "focus_history") defaults to compact rows. Lists are comfortable unless
+// configured otherwise.
+func (c *Config) CompactList(screen string) bool {
+	return c != nil && c.ListDensity[screen] == DensityCompact
+}
+
+// FocusHookTimeout returns the per-command limit for focus hooks, or zero
+// to use the hooks package default.
+func (c *Config) FocusHookTimeout() time.Duration {
+	if c == nil || c.FocusHookTimeoutSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(c.FocusHookTimeoutSeconds) * time.Second
+}
+
+// StaleNoteAge returns how long a note goes untouched before it is
+// marked stale, falling back to DefaultStaleNoteDays when unset.
+func (c *Config) StaleNoteAge() time.Duration {
+	days := DefaultStaleNoteDays
+	if c != nil && c.StaleNoteDays > 0 {
+		days = c.StaleNoteDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// StaleTodoAge returns how long an open todo goes unchanged before it is
+// marked stale, falling back to DefaultStaleTodoDays when unset.
+func (c *Config) StaleTodoAge() time.Duration {
+	days := DefaultStaleTodoDays
+	if c != nil && c.StaleTodoDays > 0 {
+		days = c.StaleTodoDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// DailyNoteTitleFor returns the title of the daily note for date,
+// replacing YYYY, MM and DD in DailyNoteTitle (or DefaultDailyNoteTitle)
+// with the year, month and day.
+func (c *Config) DailyNoteTitleFor(date time.Time) string {
+	title := DefaultDailyNoteTitle
+	if c != nil && strings.TrimSpace(c.DailyNoteTitle) != "" {
+		title = strings.TrimSpace(c.DailyNoteTitle)
+	}
+	return strings.NewReplacer(
+		"YYYY", date.Format("2006"),
+		"MM", date.Format("01"),
+		"DD", date.Format("02"),
+	).Replace(title)
+}
+
+// PluginDir returns the directory plugin executables are discovered in.
+func (c *Config) PluginDir() string {
+	return filepath.Join(c.DataDir, "plugins")
 }
 
 var cfg *Config
@@ -60,11 +325,34 @@ func Load() (*Config, error) {
 		QdrantUrl:         "localhost:6333",
 		ModelPath:         filepath.Join(dataDir, "models"),
 		EmbeddingsEnabled: true,
+		WorkHoursPerDay:   DefaultWorkHoursPerDay,
+		DictionaryPath:    filepath.Join(dataDir, "dictionary.txt"),
+		BreakActivities:   append([]string(nil), DefaultBreakActivities...),
+	}
+
+	if err := loadFile(filepath.Join(dataDir, "config.json"), cfg); err != nil {
+		return nil, err
 	}
 
 	return cfg, nil
 }
 
+// loadFile overlays settings from a JSON file onto c. A missing file is
+// not an error; defaults are kept for any key the file omits.
+func loadFile(path string, c *Config) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("invalid %s: %w", path, err)
+	}
+	return nil
+}
+
 // Get returns the cached configuration instance.
 func Get() *Config {
 	return cfg
@@ -0,0 +1,315 @@
+// Package csvimport reads todos from a CSV file for flowState-cli.
+//
+// It backs `flowState todos import FILE.csv`. The first row of the file
+// names the columns; each field a todo can take (title, description, due,
+// priority, tags, status) is matched to a column by name, including
+// common aliases such as "Task" or "Deadline", and any match can be
+// overridden with --map. Every data row is converted on its own, so one
+// bad row is reported and skipped without stopping the rest.
+//
+// Values:
+//   - title: required, at most models.MaxTitleLength characters
+//   - due: YYYY-MM-DD, or YYYY-MM-DD HH:MM for a time of day
+//   - priority: high/h, medium/med/m or low/l (empty means medium)
+//   - tags: separated by commas, semicolons or spaces, with or without
+//     the #; added to the description as #hashtags, since that is where
+//     todo tags live
+//   - status: todo/pending/open, doing/in progress/started,
+//     done/completed/x, or the name or label of any status in the
+//     configured workflow, e.g. "waiting" (empty means pending)
+//
+// Usage:
+//
+//	header, records, err := csvimport.Read(f)
+//	if err != nil { ... }
+//	m := csvimport.DetectMapping(header)
+//	for _, row := range csvimport.Convert(records, m, time.Now()) { ... }
+package csvimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
+)
+
+// Fields a column can be mapped to.
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldDue         = "due"
+	FieldPriority    = "priority"
+	FieldTags        = "tags"
+	FieldStatus      = "status"
+)
+
+// Fields lists every field in display order.
+var Fields = []string{FieldTitle, FieldDescription, FieldDue, FieldPriority, FieldTags, FieldStatus}
+
+// aliases are the column names each field is detected by, lowercased
+// with spaces, dashes and underscores removed.
+var aliases = map[string][]string{
+	FieldTitle:       {"title", "name", "task", "todo", "summary", "subject"},
+	FieldDescription: {"description", "desc", "notes", "note", "details", "body"},
+	FieldDue:         {"due", "duedate", "deadline", "dueon", "date"},
+	FieldPriority:    {"priority", "prio", "importance"},
+	FieldTags:        {"tags", "tag", "labels", "label", "contexts"},
+	FieldStatus:      {"status", "state", "done", "completed"},
+}
+
+// Mapping maps a field to the index of its column. Unmapped fields are
+// absent.
+type Mapping map[string]int
+
+// Record is one data row of a CSV file with the line it starts on.
+type Record struct {
+	Line  int
+	Cells []string
+}
+
+// Read parses a CSV file into its header row and data rows. Rows may
+// have fewer or more cells than the header.
+func Read(r io.Reader) ([]string, []Record, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff") // Byte order mark from spreadsheet exports
+
+	var records []Record
+	for {
+		cells, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		records = append(records, Record{Line: line, Cells: cells})
+	}
+	return header, records, nil
+}
+
+// DetectMapping matches header names to fields. Names are compared
+// case-insensitively, ignoring spaces, dashes and underscores; the first
+// matching column wins.
+func DetectMapping(header []string) Mapping {
+	m := Mapping{}
+	for i, name := range header {
+		name = normalize(name)
+		for _, field := range Fields {
+			if _, taken := m[field]; taken {
+				continue
+			}
+			if containsString(aliases[field], name) {
+				m[field] = i
+				break
+			}
+		}
+	}
+	return m
+}
+
+// Set maps field to column, given by header name or 1-based number. An
+// empty column unmaps the field.
+func (m Mapping) Set(field, column string, header []string) error {
+	field = strings.ToLower(strings.TrimSpace(field))
+	if !containsString(Fields, field) {
+		return fmt.Errorf("unknown field %q (use %s)", field, strings.Join(Fields, ", "))
+	}
+	column = strings.TrimSpace(column)
+	if column == "" {
+		delete(m, field)
+		return nil
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			m[field] = i
+			return nil
+		}
+	}
+	if n, err := strconv.Atoi(column); err == nil && n >= 1 && n <= len(header) {
+		m[field] = n - 1
+		return nil
+	}
+	return fmt.Errorf("no column %q for %s", column, field)
+}
+
+// Row is one converted data row. Line is its line in the file, counting
+// the header as line 1. Err says why the row is skipped; Todo is only
+// meaningful without it.
+type Row struct {
+	Line int
+	Todo models.Todo
+	Err  error
+}
+
+// Convert turns data rows into todos using m. Rows with only empty
+// cells are left out.
+func Convert(records []Record, m Mapping, now time.Time) []Row {
+	rows := make([]Row, 0, len(records))
+	for _, record := range records {
+		if blank(record.Cells) {
+			continue
+		}
+		todo, err := convertRow(record.Cells, m, now)
+		rows = append(rows, Row{Line: record.Line, Todo: todo, Err: err})
+	}
+	return rows
+}
+
+// convertRow builds the todo of one data row.
+func convertRow(record []string, m Mapping, now time.Time) (models.Todo, error) {
+	cell := func(field string) string {
+		i, ok := m[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	todo := models.Todo{
+		Title:       strings.Join(strings.Fields(cell(FieldTitle)), " "),
+		Description: cell(FieldDescription),
+	}
+	if todo.Title == "" {
+		return todo, errors.New("title is empty")
+	}
+	if n := utf8.RuneCountInString(todo.Title); n > models.MaxTitleLength {
+		return todo, fmt.Errorf("title is too long (%d of %d characters)", n, models.MaxTitleLength)
+	}
+
+	var err error
+	if todo.DueDate, err = parseDue(cell(FieldDue), now.Location()); err != nil {
+		return todo, err
+	}
+	if todo.Priority, err = parsePriority(cell(FieldPriority)); err != nil {
+		return todo, err
+	}
+	if todo.Status, err = parseStatus(cell(FieldStatus)); err != nil {
+		return todo, err
+	}
+	if tags := parseTags(cell(FieldTags)); tags != "" {
+		if todo.Description != "" {
+			todo.Description += "\n\n"
+		}
+		todo.Description += tags
+	}
+	if n := utf8.RuneCountInString(todo.Description); n > models.MaxDescriptionLength {
+		return todo, fmt.Errorf("description is too long (%d of %d characters)", n, models.MaxDescriptionLength)
+	}
+	return todo, nil
+}
+
+// parseDue parses a due date, with or without a time of day.
+func parseDue(s string, loc *time.Location) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid due date %q (use YYYY-MM-DD or YYYY-MM-DD HH:MM)", s)
+}
+
+// parsePriority parses a priority name.
+func parsePriority(s string) (models.TodoPriority, error) {
+	switch strings.ToLower(s) {
+	case "high", "h":
+		return models.TodoPriorityHigh, nil
+	case "", "medium", "med", "m", "normal":
+		return models.TodoPriorityMedium, nil
+	case "low", "l":
+		return models.TodoPriorityLow, nil
+	}
+	return models.TodoPriorityMedium, fmt.Errorf("invalid priority %q (use high, medium or low)", s)
+}
+
+// parseStatus parses a status name: one of the built-in aliases, or the
+// name or label of a status in the configured workflow.
+func parseStatus(s string) (models.TodoStatus, error) {
+	key := normalize(s)
+	switch key {
+	case "", "todo", "pending", "open", "no", "false":
+		return models.TodoStatusPending, nil
+	case "doing", "inprogress", "started", "active":
+		return models.TodoStatusInProgress, nil
+	case "done", "completed", "complete", "x", "yes", "true":
+		return models.TodoStatusCompleted, nil
+	}
+	names := []string{"todo", "doing", "done"}
+	for _, status := range workflow.Statuses() {
+		if key == normalize(string(status.Name)) || key == normalize(status.Label) {
+			return status.Name, nil
+		}
+		switch status.Name {
+		case models.TodoStatusPending, models.TodoStatusInProgress, models.TodoStatusCompleted:
+		default:
+			names = append(names, string(status.Name))
+		}
+	}
+	return models.TodoStatusPending, fmt.Errorf("invalid status %q (use %s or %s)", s,
+		strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+}
+
+// parseTags turns a tags cell into #hashtags separated by spaces. Words
+// that are not valid hashtags are dropped.
+func parseTags(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t'
+	})
+	var tags []string
+	for _, w := range words {
+		tag := "#" + strings.TrimLeft(w, "#")
+		if models.HashtagPattern.FindString(tag) == tag {
+			tags = append(tags, tag)
+		}
+	}
+	return strings.Join(tags, " ")
+}
+
+// normalize lowercases s and drops spaces, dashes and underscores.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
+
+// blank reports whether every cell of record is empty.
+func blank(record []string) bool {
+	for _, c := range record {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package csvimport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
+)
+
+func TestDetectMapping(t *testing.T) {
+	t.Parallel()
+
+	m := DetectMapping([]string{"Task", "Notes", "Due Date", "Prio", "Labels", "State", "Extra"})
+	want := Mapping{FieldTitle: 0, FieldDescription: 1, FieldDue: 2, FieldPriority: 3, FieldTags: 4, FieldStatus: 5}
+	if len(m) != len(want) {
+		t.Fatalf("DetectMapping() = %v, want %v", m, want)
+	}
+	for field, i := range want {
+		if m[field] != i {
+			t.Errorf("%s mapped to column %d, want %d", field, m[field], i)
+		}
+	}
+}
+
+func TestMappingSet(t *testing.T) {
+	t.Parallel()
+
+	header := []string{"What", "Notes", "When"}
+	m := DetectMapping(header)
+	if err := m.Set("title", "what", header); err != nil || m[FieldTitle] != 0 {
+		t.Errorf("Set by name: %v, title = %d", err, m[FieldTitle])
+	}
+	if err := m.Set("due", "3", header); err != nil || m[FieldDue] != 2 {
+		t.Errorf("Set by number: %v, due = %d", err, m[FieldDue])
+	}
+	if err := m.Set("description", "", header); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m[FieldDescription]; ok {
+		t.Error("expected an empty column to unmap the field")
+	}
+	if err := m.Set("owner", "What", header); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if err := m.Set("title", "Missing", header); err == nil {
+		t.Error("expected an error for a missing column")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	data := "\ufeffTitle,Description,Due,Priority,Tags,Status\n" +
+		"Write report,First draft,2026-03-09,high,\"work, #q1\",doing\n" +
+		",no title,,,,\n" +
+		"Call bank,,2026-03-10 14:30,l,,done\n" +
+		"\n" +
+		"Bad date,,09/03/2026,,,\n" +
+		"Bad priority,,,urgent,,\n" +
+		"Plain\n"
+	header, records, err := Read(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header[0] != "Title" {
+		t.Errorf("byte order mark not stripped: %q", header[0])
+	}
+
+	rows := Convert(records, DetectMapping(header), time.Now())
+	if len(rows) != 6 {
+		t.Fatalf("expected 6 rows (blank lines left out), got %d", len(rows))
+	}
+
+	first := rows[0]
+	if first.Err != nil || first.Line != 2 {
+		t.Fatalf("row 1: line %d, err %v", first.Line, first.Err)
+	}
+	if first.Todo.Title != "Write report" || first.Todo.Description != "First draft\n\n#work #q1" {
+		t.Errorf("row 1 todo = %+v", first.Todo)
+	}
+	if first.Todo.Priority != models.TodoPriorityHigh || first.Todo.Status != models.TodoStatusInProgress {
+		t.Errorf("row 1 priority/status = %v/%v", first.Todo.Priority, first.Todo.Status)
+	}
+	if first.Todo.DueDate == nil || first.Todo.DueDate.Format("2006-01-02 15:04") != "2026-03-09 00:00" {
+		t.Errorf("row 1 due = %v", first.Todo.DueDate)
+	}
+
+	if rows[1].Err == nil || !strings.Contains(rows[1].Err.Error(), "title") {
+		t.Errorf("row without title: err %v", rows[1].Err)
+	}
+
+	call := rows[2]
+	if call.Err != nil || call.Todo.Status != models.TodoStatusCompleted || call.Todo.Priority != models.TodoPriorityLow {
+		t.Errorf("row 3 = %+v, err %v", call.Todo, call.Err)
+	}
+	if call.Todo.DueDate == nil || call.Todo.DueDate.Format("15:04") != "14:30" {
+		t.Errorf("row 3 due = %v", call.Todo.DueDate)
+	}
+
+	if rows[3].Line != 6 || rows[3].Err == nil || !strings.Contains(rows[3].Err.Error(), "due date") {
+		t.Errorf("bad date row: line %d, err %v", rows[3].Line, rows[3].Err)
+	}
+	if rows[4].Err == nil || !strings.Contains(rows[4].Err.Error(), "priority") {
+		t.Errorf("bad priority row: err %v", rows[4].Err)
+	}
+	if plain := rows[5]; plain.Err != nil || plain.Todo.Title != "Plain" || plain.Todo.Status != models.TodoStatusPending {
+		t.Errorf("short row = %+v, err %v", plain.Todo, plain.Err)
+	}
+}
+
+func TestConvertTooLong(t *testing.T) {
+	t.Parallel()
+
+	records := []Record{{Line: 2, Cells: []string{strings.Repeat("x", models.MaxTitleLength+1)}}}
+	rows := Convert(records, Mapping{FieldTitle: 0}, time.Now())
+	if len(rows) != 1 || rows[0].Err == nil || !strings.Contains(rows[0].Err.Error(), "too long") {
+		t.Errorf("expected a too-long error, got %+v", rows)
+	}
+}
+
+func TestConvertWorkflowStatus(t *testing.T) {
+	w, err := workflow.New([]config.TodoStatus{{Name: "waiting"}, {Name: "needs_review", Label: "In review"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	workflow.Set(w)
+	defer func() {
+		w, _ := workflow.New(nil)
+		workflow.Set(w)
+	}()
+
+	records := []Record{
+		{Line: 2, Cells: []string{"Reply to Sam", "Waiting"}},
+		{Line: 3, Cells: []string{"Ship docs", "in review"}},
+		{Line: 4, Cells: []string{"Fix bug", "needs_review"}},
+		{Line: 5, Cells: []string{"Plan trip", "someday"}},
+	}
+	rows := Convert(records, Mapping{FieldTitle: 0, FieldStatus: 1}, time.Now())
+	want := []models.TodoStatus{"waiting", "needs_review", "needs_review"}
+	for i, status := range want {
+		if rows[i].Err != nil || rows[i].Todo.Status != status {
+			t.Errorf("row %d status = %q, err %v; want %q", i+1, rows[i].Todo.Status, rows[i].Err, status)
+		}
+	}
+	if err := rows[3].Err; err == nil || !strings.Contains(err.Error(), "waiting") {
+		t.Errorf("unknown status err = %v, want one listing the workflow's statuses", err)
+	}
+}
@@ -0,0 +1,172 @@
+// Package datefmt formats dates and times the way the user configured them.
+//
+// Phase 10: Localization
+//   - DateFormat picks how dates are written: "us" (Jan 2, 2006, the
+//     default), "iso" (2006-01-02) or "eu" (2 Jan 2006)
+//   - ClockFormat picks "12h" (3:04 PM, the default) or "24h" (15:04)
+//   - WeekStart is the first day of the week ("monday" by default); it
+//     decides when "next week" begins and where the planner and focus
+//     stats start a week
+//   - Lists show how long ago items changed ("2h ago", "yesterday")
+//     unless relative dates are turned off; previews keep the full date
+//
+// The app calls Set once at startup with the configured Formatter; every
+// screen formats through the package functions so lists, previews, the
+// planner and stats agree.
+//
+// Usage:
+//
+//	datefmt.Set(datefmt.New(cfg.DateFormat, cfg.ClockFormat, cfg.WeekStart))
+//	label := "Due " + datefmt.Day(due) // "Due Mon Jan 2"
+package datefmt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date formats accepted by New.
+const (
+	FormatISO = "iso"
+	FormatUS  = "us"
+	FormatEU  = "eu"
+)
+
+// Clock formats accepted by New.
+const (
+	Clock12 = "12h"
+	Clock24 = "24h"
+)
+
+// Formatter holds the layouts for one date format, clock and week start.
+type Formatter struct {
+	date      string       // Full date, e.g. "Jan 2, 2006"
+	short     string       // Month and day, e.g. "Jan 2"
+	month     string       // Month and year, e.g. "January 2006"
+	clock     string       // Time of day, e.g. "3:04 PM"
+	weekStart time.Weekday // First day of the week
+	relative  bool         // Lists show "2h ago" rather than the date
+}
+
+// New returns the Formatter for the given settings. Empty or unknown
+// values fall back to the defaults: us dates, a 12-hour clock, weeks
+// starting on Monday and relative dates in lists.
+func New(dateFormat, clockFormat, weekStart string) Formatter {
+	f := Formatter{date: "Jan 2, 2006", short: "Jan 2", month: "January 2006", clock: "3:04 PM", weekStart: time.Monday, relative: true}
+	switch strings.ToLower(dateFormat) {
+	case FormatISO:
+		f.date, f.month = "2006-01-02", "2006-01"
+	case FormatEU:
+		f.date, f.short = "2 Jan 2006", "2 Jan"
+	}
+	if strings.ToLower(clockFormat) == Clock24 {
+		f.clock = "15:04"
+	}
+	if day, ok := ParseWeekday(weekStart); ok {
+		f.weekStart = day
+	}
+	return f
+}
+
+// WithRelative returns f with relative dates in lists on or off.
+func (f Formatter) WithRelative(on bool) Formatter {
+	f.relative = on
+	return f
+}
+
+// ParseWeekday parses an English weekday name or its three-letter
+// abbreviation, ignoring case.
+func ParseWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) < 3 {
+		return 0, false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if s == name || s == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+var current = New("", "", "")
+
+// Set makes f the formatter used by the package functions.
+func Set(f Formatter) {
+	current = f
+}
+
+// Date formats t as a full date, e.g. "Jan 2, 2006" or "2006-01-02".
+func Date(t time.Time) string { return t.Format(current.date) }
+
+// Short formats t as month and day, e.g. "Jan 2" or "2 Jan".
+func Short(t time.Time) string { return t.Format(current.short) }
+
+// Month formats t as month and year, e.g. "January 2006" or "2006-01".
+func Month(t time.Time) string { return t.Format(current.month) }
+
+// Day formats t as weekday, month and day, e.g. "Mon Jan 2".
+func Day(t time.Time) string { return t.Format("Mon ") + Short(t) }
+
+// WeekdayDate formats t as weekday and full date, e.g. "Mon Jan 2, 2006".
+func WeekdayDate(t time.Time) string { return t.Format("Mon ") + Date(t) }
+
+// Clock formats the time of day, e.g. "3:04 PM" or "15:04".
+func Clock(t time.Time) string { return t.Format(current.clock) }
+
+// DateTime formats t as full date and time, e.g. "Jan 2, 2006 3:04 PM".
+func DateTime(t time.Time) string { return Date(t) + " " + Clock(t) }
+
+// ShortDateTime formats t as month, day and time, e.g. "Jan 2, 3:04 PM".
+func ShortDateTime(t time.Time) string { return Short(t) + ", " + Clock(t) }
+
+// DayTime formats t as weekday, month, day and time, e.g.
+// "Mon Jan 2, 3:04 PM".
+func DayTime(t time.Time) string { return Day(t) + ", " + Clock(t) }
+
+// Age formats when a list item last changed: how long ago, e.g. "2h ago",
+// or the full date when relative dates are off.
+func Age(t time.Time) string {
+	if !current.relative {
+		return Date(t)
+	}
+	return Ago(t, time.Now())
+}
+
+// Ago formats how long before now t was: "just now", "5m ago", "2h ago",
+// "yesterday", "3d ago", "3w ago", "4mo ago", and the full date from a
+// year back.
+func Ago(t, now time.Time) string {
+	d := now.Sub(t)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	days := int(today.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())).Hours()+12) / 24
+	switch {
+	case d < time.Minute:
+		return "just now" // Or a little in the future, from clock skew
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d/time.Minute))
+	case days == 0:
+		return fmt.Sprintf("%dh ago", int(d/time.Hour))
+	case days == 1:
+		return "yesterday"
+	case days < 7:
+		return fmt.Sprintf("%dd ago", days)
+	case days < 30:
+		return fmt.Sprintf("%dw ago", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo ago", max(days/30, 1))
+	}
+	return Date(t)
+}
+
+// WeekStart returns the configured first day of the week.
+func WeekStart() time.Weekday { return current.weekStart }
+
+// StartOfWeek returns midnight on the first day of the week containing t.
+func StartOfWeek(t time.Time) time.Time {
+	back := (int(t.Weekday()) - int(current.weekStart) + 7) % 7
+	day := t.AddDate(0, 0, -back)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,128 @@
+package datefmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormats(t *testing.T) {
+	ts := time.Date(2026, 3, 9, 14, 5, 0, 0, time.UTC) // Monday
+
+	tests := []struct {
+		date, clock string
+		wantDate    string
+		wantDay     string
+		wantMonth   string
+		wantTime    string
+		wantShortDT string
+	}{
+		{"", "", "Mar 9, 2026", "Mon Mar 9", "March 2026", "Mar 9, 2026 2:05 PM", "Mar 9, 2:05 PM"},
+		{"us", "12h", "Mar 9, 2026", "Mon Mar 9", "March 2026", "Mar 9, 2026 2:05 PM", "Mar 9, 2:05 PM"},
+		{"iso", "24h", "2026-03-09", "Mon Mar 9", "2026-03", "2026-03-09 14:05", "Mar 9, 14:05"},
+		{"EU", "24H", "9 Mar 2026", "Mon 9 Mar", "March 2026", "9 Mar 2026 14:05", "9 Mar, 14:05"},
+		{"klingon", "25h", "Mar 9, 2026", "Mon Mar 9", "March 2026", "Mar 9, 2026 2:05 PM", "Mar 9, 2:05 PM"},
+	}
+
+	defer Set(New("", "", ""))
+	for _, tt := range tests {
+		Set(New(tt.date, tt.clock, ""))
+		if got := Date(ts); got != tt.wantDate {
+			t.Errorf("%s/%s: Date() = %q, want %q", tt.date, tt.clock, got, tt.wantDate)
+		}
+		if got := Day(ts); got != tt.wantDay {
+			t.Errorf("%s/%s: Day() = %q, want %q", tt.date, tt.clock, got, tt.wantDay)
+		}
+		if got := Month(ts); got != tt.wantMonth {
+			t.Errorf("%s/%s: Month() = %q, want %q", tt.date, tt.clock, got, tt.wantMonth)
+		}
+		if got := DateTime(ts); got != tt.wantTime {
+			t.Errorf("%s/%s: DateTime() = %q, want %q", tt.date, tt.clock, got, tt.wantTime)
+		}
+		if got := ShortDateTime(ts); got != tt.wantShortDT {
+			t.Errorf("%s/%s: ShortDateTime() = %q, want %q", tt.date, tt.clock, got, tt.wantShortDT)
+		}
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Weekday
+		ok   bool
+	}{
+		{"monday", time.Monday, true},
+		{"Sunday", time.Sunday, true},
+		{" sat ", time.Saturday, true},
+		{"SUN", time.Sunday, true},
+		{"su", 0, false},
+		{"someday", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseWeekday(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseWeekday(%q) = %v, %v; want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	wed := time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		weekStart string
+		want      time.Time
+	}{
+		{"", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{"sunday", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"wednesday", time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{"thursday", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	defer Set(New("", "", ""))
+	for _, tt := range tests {
+		Set(New("", "", tt.weekStart))
+		if got := StartOfWeek(wed); !got.Equal(tt.want) {
+			t.Errorf("week start %q: StartOfWeek() = %v, want %v", tt.weekStart, got, tt.want)
+		}
+	}
+}
+
+func TestAgo(t *testing.T) {
+	now := time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{now.Add(-20 * time.Second), "just now"},
+		{now.Add(time.Minute), "just now"},
+		{now.Add(-5 * time.Minute), "5m ago"},
+		{now.Add(-2 * time.Hour), "2h ago"},
+		{time.Date(2026, 3, 11, 0, 5, 0, 0, time.UTC), "15h ago"},
+		{time.Date(2026, 3, 10, 23, 0, 0, 0, time.UTC), "yesterday"},
+		{time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC), "3d ago"},
+		{time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC), "3w ago"},
+		{time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC), "4mo ago"},
+		{time.Date(2025, 1, 5, 9, 0, 0, 0, time.UTC), "Jan 5, 2025"},
+	}
+	for _, tt := range tests {
+		if got := Ago(tt.t, now); got != tt.want {
+			t.Errorf("Ago(%v) = %q, want %q", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestAgeRelativeToggle(t *testing.T) {
+	defer Set(New("", "", ""))
+	then := time.Now().Add(-10 * time.Minute)
+
+	Set(New("iso", "", ""))
+	if got := Age(then); got != "10m ago" {
+		t.Errorf("Age() = %q, want relative by default", got)
+	}
+	Set(New("iso", "", "").WithRelative(false))
+	if got, want := Age(then), then.Format("2006-01-02"); got != want {
+		t.Errorf("Age() with relative dates off = %q, want %q", got, want)
+	}
+}
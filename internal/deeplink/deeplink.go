@@ -0,0 +1,72 @@
+// Package deeplink formats and parses stable links to notes and todos.
+//
+// A link names an item by kind and database ID, either as a URI
+// ("flowstate://note/42") or in the short form accepted on the command
+// line ("note/42"). External tools and scripts can use links to refer to
+// items, and `flowState open note/42` launches the TUI on that item.
+package deeplink
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Scheme is the URI scheme of flowState links.
+const Scheme = "flowstate"
+
+// Kind is the type of item a link points at.
+type Kind string
+
+const (
+	KindNote Kind = "note"
+	KindTodo Kind = "todo"
+)
+
+// Target is a parsed link.
+type Target struct {
+	Kind Kind
+	ID   int64
+}
+
+// URI returns the canonical link, e.g. "flowstate://note/42".
+func (t Target) URI() string {
+	return URI(t.Kind, t.ID)
+}
+
+// String returns the short form, e.g. "note/42".
+func (t Target) String() string {
+	return fmt.Sprintf("%s/%d", t.Kind, t.ID)
+}
+
+// URI returns the canonical link to the item of kind with id.
+func URI(kind Kind, id int64) string {
+	return fmt.Sprintf("%s://%s/%d", Scheme, kind, id)
+}
+
+// Parse accepts "flowstate://note/42" or "note/42" (and the todo
+// equivalents). Kinds are case-insensitive; IDs must be positive.
+func Parse(s string) (Target, error) {
+	rest := strings.TrimSpace(s)
+	if scheme, after, ok := strings.Cut(rest, "://"); ok {
+		if !strings.EqualFold(scheme, Scheme) {
+			return Target{}, fmt.Errorf("unsupported link %q (want %s://note/ID or %s://todo/ID)", s, Scheme, Scheme)
+		}
+		rest = after
+	}
+
+	kind, idStr, ok := strings.Cut(strings.Trim(rest, "/"), "/")
+	if !ok {
+		return Target{}, fmt.Errorf("invalid link %q (want note/ID or todo/ID)", s)
+	}
+	t := Target{Kind: Kind(strings.ToLower(kind))}
+	if t.Kind != KindNote && t.Kind != KindTodo {
+		return Target{}, fmt.Errorf("unknown item type %q (want note or todo)", kind)
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return Target{}, fmt.Errorf("invalid %s ID %q", t.Kind, idStr)
+	}
+	t.ID = id
+	return t, nil
+}
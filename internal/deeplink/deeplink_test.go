@@ -0,0 +1,48 @@
+package deeplink
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Target
+		wantErr bool
+	}{
+		{"short note", "note/42", Target{KindNote, 42}, false},
+		{"short todo", "todo/7", Target{KindTodo, 7}, false},
+		{"uri", "flowstate://note/42", Target{KindNote, 42}, false},
+		{"uri mixed case", "FlowState://Todo/3", Target{KindTodo, 3}, false},
+		{"trailing slash", "note/42/", Target{KindNote, 42}, false},
+		{"other scheme", "https://note/42", Target{}, true},
+		{"unknown kind", "project/1", Target{}, true},
+		{"missing id", "note", Target{}, true},
+		{"bad id", "note/abc", Target{}, true},
+		{"zero id", "note/0", Target{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) err = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURIRoundTrip(t *testing.T) {
+	target := Target{Kind: KindTodo, ID: 12}
+	if got := target.URI(); got != "flowstate://todo/12" {
+		t.Fatalf("URI() = %q", got)
+	}
+	if got := target.String(); got != "todo/12" {
+		t.Fatalf("String() = %q", got)
+	}
+	parsed, err := Parse(target.URI())
+	if err != nil || parsed != target {
+		t.Fatalf("Parse(URI()) = %+v, %v", parsed, err)
+	}
+}
@@ -0,0 +1,198 @@
+// Package digest builds a plain-text summary of one day's work for
+// flowState-cli.
+//
+// The digest is printed by `flowState digest` so it can be piped into
+// mail, posted to Slack or saved to a journal. Like the agenda it has no
+// TUI dependencies.
+//
+// Sections:
+//   - Completed: todos marked done that day, by their completion time
+//   - Focus: completed focus sessions, total minutes and time per label
+//   - Notes: notes created that day
+//
+// The text comes from a Go text/template. Without a template file the
+// built-in DefaultTemplate is used; a digest.tmpl in the config directory
+// replaces it, and `--template NAME` picks digest-NAME.tmpl instead, so
+// one setup can keep e.g. an email and a Slack layout side by side.
+// Templates get the Digest as dot and the functions date, day, clock and
+// minutes (see Funcs).
+//
+// Usage:
+//
+//	d, err := digest.Build(store, yesterday)
+//	if err != nil { ... }
+//	tmpl, err := digest.LoadTemplate(cfg.DataDir, "")
+//	if err != nil { ... }
+//	d.Render(os.Stdout, tmpl)
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Digest summarizes a single day.
+type Digest struct {
+	Date          time.Time
+	Completed     []models.Todo
+	FocusSessions int
+	FocusMinutes  int
+	Labels        []LabelMinutes // Focus time per session label, most first
+	NotesCreated  []models.Note
+}
+
+// LabelMinutes is the focus time spent on one session label.
+type LabelMinutes struct {
+	Label   string
+	Minutes int
+}
+
+// Empty reports whether nothing happened on the day.
+func (d *Digest) Empty() bool {
+	return len(d.Completed) == 0 && d.FocusSessions == 0 && len(d.NotesCreated) == 0
+}
+
+// Build collects the digest for the day containing day.
+func Build(store *sqlite.Store, day time.Time) (*Digest, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	within := func(t time.Time) bool {
+		t = t.In(day.Location())
+		return !t.Before(start) && t.Before(end)
+	}
+	d := &Digest{Date: start}
+
+	todos, err := store.QueryTodos(sqlite.TodoQuery{CompletedSince: start, Sort: sqlite.TodoSortCompletedDesc})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+	for _, todo := range todos {
+		if todo.CompletedAt != nil && within(*todo.CompletedAt) {
+			d.Completed = append(d.Completed, todo)
+		}
+	}
+	sort.SliceStable(d.Completed, func(i, j int) bool {
+		return d.Completed[i].CompletedAt.Before(*d.Completed[j].CompletedAt)
+	})
+
+	sessions, err := store.GetSessionsForDate(start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	labels := map[string]int{}
+	for _, session := range sessions {
+		if session.Status != models.SessionStatusCompleted {
+			continue
+		}
+		d.FocusSessions++
+		d.FocusMinutes += session.Duration / 60
+		if session.Label != "" {
+			labels[session.Label] += session.Duration / 60
+		}
+	}
+	for label, minutes := range labels {
+		d.Labels = append(d.Labels, LabelMinutes{Label: label, Minutes: minutes})
+	}
+	sort.Slice(d.Labels, func(i, j int) bool {
+		if d.Labels[i].Minutes != d.Labels[j].Minutes {
+			return d.Labels[i].Minutes > d.Labels[j].Minutes
+		}
+		return d.Labels[i].Label < d.Labels[j].Label
+	})
+
+	notes, err := store.ListNotes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notes: %w", err)
+	}
+	for _, note := range notes {
+		if within(note.CreatedAt) {
+			d.NotesCreated = append(d.NotesCreated, note)
+		}
+	}
+	sort.SliceStable(d.NotesCreated, func(i, j int) bool {
+		return d.NotesCreated[i].CreatedAt.Before(d.NotesCreated[j].CreatedAt)
+	})
+
+	return d, nil
+}
+
+// DefaultTemplate is the digest layout used when no template file exists.
+const DefaultTemplate = `flowState digest — {{day .Date}}
+
+Completed ({{len .Completed}})
+{{range .Completed}}  [x] {{.Title}}{{with .Project}} ({{.}}){{end}}
+{{else}}  nothing completed
+{{end}}
+Focus
+  {{.FocusSessions}} session(s), {{minutes .FocusMinutes}}
+{{range .Labels}}  - {{.Label}}: {{minutes .Minutes}}
+{{end}}
+Notes ({{len .NotesCreated}})
+{{range .NotesCreated}}  - {{.Title}}
+{{else}}  no new notes
+{{end}}`
+
+// Funcs are the functions available to digest templates:
+//   - date, day, clock: format a time as configured (see datefmt)
+//   - minutes: format a number of minutes as "1h30m", or "0m"
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"date":  datefmt.Date,
+		"day":   datefmt.Day,
+		"clock": datefmt.Clock,
+		"minutes": func(minutes int) string {
+			if minutes <= 0 {
+				return "0m"
+			}
+			return models.FormatMinutes(minutes)
+		},
+	}
+}
+
+// Parse parses a digest template.
+func Parse(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(Funcs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// TemplatePath returns where the template called name lives in dir:
+// digest.tmpl for "", digest-NAME.tmpl otherwise.
+func TemplatePath(dir, name string) string {
+	if name == "" {
+		return filepath.Join(dir, "digest.tmpl")
+	}
+	return filepath.Join(dir, "digest-"+name+".tmpl")
+}
+
+// LoadTemplate loads the template called name from dir. A missing
+// digest.tmpl falls back to DefaultTemplate; a missing named template is
+// an error.
+func LoadTemplate(dir, name string) (*template.Template, error) {
+	path := TemplatePath(dir, name)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && name == "" {
+		return Parse("default", DefaultTemplate)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Parse(filepath.Base(path), string(data))
+}
+
+// Render writes the digest using tmpl.
+func (d *Digest) Render(w io.Writer, tmpl *template.Template) error {
+	return tmpl.Execute(w, d)
+}
@@ -0,0 +1,119 @@
+package digest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func newTestStore(t *testing.T) *sqlite.Store {
+	t.Helper()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestBuildAndRenderDefault(t *testing.T) {
+	store := newTestStore(t)
+	now := time.Now()
+
+	done := &models.Todo{Title: "Ship release", Status: models.TodoStatusCompleted, Project: "work"}
+	for _, todo := range []*models.Todo{done, {Title: "Still open", Status: models.TodoStatusPending}} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	if err := store.CreateNote(&models.Note{Title: "Retro notes", Body: "went well"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	for _, s := range []models.FocusSession{
+		{StartTime: now, Duration: 25 * 60, Status: models.SessionStatusCompleted, Label: "writing"},
+		{StartTime: now, Duration: 50 * 60, Status: models.SessionStatusCompleted, Label: "coding"},
+		{StartTime: now, Duration: 10 * 60, Status: models.SessionStatusCancelled, Label: "coding"},
+	} {
+		session := s
+		if err := store.CreateSession(&session); err != nil {
+			t.Fatalf("CreateSession() err = %v", err)
+		}
+	}
+
+	d, err := Build(store, now)
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	if len(d.Completed) != 1 || d.Completed[0].Title != "Ship release" {
+		t.Errorf("Completed = %+v", d.Completed)
+	}
+	if d.FocusSessions != 2 || d.FocusMinutes != 75 {
+		t.Errorf("focus = %d sessions, %d min; want 2, 75", d.FocusSessions, d.FocusMinutes)
+	}
+	if len(d.Labels) != 2 || d.Labels[0] != (LabelMinutes{"coding", 50}) {
+		t.Errorf("Labels = %+v, want coding first", d.Labels)
+	}
+
+	tmpl, err := LoadTemplate(t.TempDir(), "")
+	if err != nil {
+		t.Fatalf("LoadTemplate() err = %v", err)
+	}
+	var b strings.Builder
+	if err := d.Render(&b, tmpl); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{"Completed (1)", "[x] Ship release (work)", "2 session(s), 1h15m", "- coding: 50m", "Notes (1)", "- Retro notes"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("digest missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Still open") {
+		t.Errorf("digest lists an open todo:\n%s", out)
+	}
+
+	// Nothing happened yesterday
+	d, err = Build(store, now.AddDate(0, 0, -1))
+	if err != nil {
+		t.Fatalf("Build() err = %v", err)
+	}
+	if !d.Empty() {
+		t.Errorf("expected an empty digest for yesterday, got %+v", d)
+	}
+}
+
+func TestLoadTemplateFromConfigDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(TemplatePath(dir, "slack"), []byte(`*{{len .Completed}} done*, focus {{minutes .FocusMinutes}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := LoadTemplate(dir, "slack")
+	if err != nil {
+		t.Fatalf("LoadTemplate() err = %v", err)
+	}
+	var b strings.Builder
+	if err := (&Digest{FocusMinutes: 90, Completed: make([]models.Todo, 3)}).Render(&b, tmpl); err != nil {
+		t.Fatalf("Render() err = %v", err)
+	}
+	if b.String() != "*3 done*, focus 1h30m" {
+		t.Errorf("Render() = %q", b.String())
+	}
+
+	if _, err := LoadTemplate(dir, "email"); err == nil {
+		t.Error("expected an error for a missing named template")
+	}
+	if err := os.WriteFile(TemplatePath(dir, ""), []byte(`{{.Nope`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTemplate(dir, ""); err == nil || !strings.Contains(err.Error(), "digest.tmpl") {
+		t.Errorf("LoadTemplate() err = %v, want a parse error naming the file", err)
+	}
+}
@@ -0,0 +1,238 @@
+package embedder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Model downloads (Phase 5: Semantic Search)
+//
+// Download fetches model.onnx into the model directory through a
+// model.onnx.part file. An interrupted download leaves the part file
+// behind and the next one resumes it with an HTTP Range request; a server
+// that ignores the range starts it over. When an expected SHA-256 is
+// given, the finished file must match it or it is thrown away. For
+// offline machines the source may be a local path or file:// URL, e.g.
+// a copy on a USB stick or network share, as well as an http(s) mirror.
+
+// ErrChecksum is returned by Download when the downloaded file does not
+// match the expected SHA-256.
+var ErrChecksum = errors.New("model checksum mismatch")
+
+// DownloadOptions configures Download.
+type DownloadOptions struct {
+	// URL is a direct URL to model.onnx, a HuggingFace repository URL, or
+	// a local path or file:// URL. Empty means the default repository.
+	URL string
+	// SHA256 is the expected checksum in hex; empty skips the check.
+	SHA256 string
+	// Progress, when set, is called as bytes arrive with the bytes
+	// written so far, including a resumed part, and the total size (-1
+	// when the server does not say).
+	Progress func(done, total int64)
+}
+
+// HasModel reports whether model.onnx has been downloaded.
+func (e *Embedder) HasModel() bool {
+	_, err := os.Stat(e.ModelFilePath())
+	return err == nil
+}
+
+// PartialSize returns the size of an interrupted download that Download
+// would resume, or 0 when there is none.
+func (e *Embedder) PartialSize() int64 {
+	info, err := os.Stat(e.partPath())
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// ModelURL returns where Download fetches the model from for source, as
+// for DownloadOptions.URL.
+func (e *Embedder) ModelURL(source string) string {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		source = e.GetModelInfo().DownloadURL
+	}
+	if _, ok := localSource(source); ok {
+		return source
+	}
+	url := strings.TrimRight(source, "/")
+	if !strings.Contains(url, "resolve/") && !strings.HasSuffix(strings.ToLower(url), ".onnx") {
+		url += "/resolve/main/model.onnx"
+	}
+	return url
+}
+
+// ModelChecksum returns the SHA-256 of the downloaded model in hex.
+func (e *Embedder) ModelChecksum() (string, error) {
+	return fileSHA256(e.ModelFilePath())
+}
+
+// Download fetches the model as configured by opts, replacing any model
+// already downloaded once the new one is complete and verified.
+func (e *Embedder) Download(ctx context.Context, opts DownloadOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	source := e.ModelURL(opts.URL)
+	report := opts.Progress
+	if report == nil {
+		report = func(int64, int64) {}
+	}
+
+	var err error
+	if path, ok := localSource(source); ok {
+		err = e.copyModel(path, report)
+	} else {
+		err = e.fetchModel(ctx, source, report)
+	}
+	if err != nil {
+		return err
+	}
+
+	part := e.partPath()
+	if want := strings.TrimSpace(opts.SHA256); want != "" {
+		got, err := fileSHA256(part)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(got, want) {
+			// Resuming a corrupt file would only repeat the mismatch
+			_ = os.Remove(part)
+			return fmt.Errorf("%w: got %s, want %s", ErrChecksum, got, want)
+		}
+	}
+	if err := os.Rename(part, e.ModelFilePath()); err != nil {
+		return fmt.Errorf("failed to finalize model file: %w", err)
+	}
+	return nil
+}
+
+// fetchModel downloads url into the part file, resuming it if present.
+func (e *Embedder) fetchModel(ctx context.Context, url string, report func(done, total int64)) error {
+	offset := e.PartialSize()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build model download request: %w", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := e.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download model: %w", err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_WRONLY | os.O_CREATE
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)):
+		flags |= os.O_APPEND
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The part file already holds the whole model
+		report(offset, offset)
+		return nil
+	case resp.StatusCode >= 200 && resp.StatusCode < 300 && resp.StatusCode != http.StatusPartialContent:
+		offset = 0
+		flags |= os.O_TRUNC
+	default:
+		return fmt.Errorf("model download failed: status=%s", resp.Status)
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+	f, err := os.OpenFile(e.partPath(), flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create model file: %w", err)
+	}
+	report(offset, total)
+	_, err = io.Copy(&progressWriter{w: f, done: offset, total: total, report: report}, resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// The part file is kept for the next attempt to resume
+		return fmt.Errorf("failed to write model: %w", err)
+	}
+	return nil
+}
+
+// copyModel copies a local model file into the part file.
+func (e *Embedder) copyModel(path string, report func(done, total int64)) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open model: %w", err)
+	}
+	defer src.Close()
+	total := int64(-1)
+	if info, err := src.Stat(); err == nil {
+		total = info.Size()
+	}
+
+	f, err := os.Create(e.partPath())
+	if err != nil {
+		return fmt.Errorf("failed to create model file: %w", err)
+	}
+	report(0, total)
+	_, err = io.Copy(&progressWriter{w: f, total: total, report: report}, src)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to copy model: %w", err)
+	}
+	return nil
+}
+
+// partPath is where a download in progress is written.
+func (e *Embedder) partPath() string {
+	return e.ModelFilePath() + ".part"
+}
+
+// localSource returns the file path of a local path or file:// URL.
+func localSource(source string) (string, bool) {
+	if path, ok := strings.CutPrefix(source, "file://"); ok {
+		return path, true
+	}
+	return source, !strings.Contains(source, "://")
+}
+
+// fileSHA256 returns the SHA-256 of the file at path in hex.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// progressWriter reports the bytes written through it.
+type progressWriter struct {
+	w           io.Writer
+	done, total int64
+	report      func(done, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.done += int64(n)
+	p.report(p.done, p.total)
+	return n, err
+}
@@ -0,0 +1,121 @@
+package embedder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+func newTestEmbedder(t *testing.T, client *http.Client) *Embedder {
+	t.Helper()
+	e, err := NewWithHTTPClient(&config.Config{ModelPath: t.TempDir()}, client)
+	if err != nil {
+		t.Fatalf("NewWithHTTPClient() err = %v", err)
+	}
+	return e
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownloadResumes(t *testing.T) {
+	t.Parallel()
+
+	const model = "0123456789abcdefghij"
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "model.onnx", time.Time{}, strings.NewReader(model))
+	}))
+	t.Cleanup(srv.Close)
+	e := newTestEmbedder(t, srv.Client())
+
+	// An earlier attempt stopped after 8 bytes
+	if err := os.WriteFile(e.partPath(), []byte(model[:8]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.PartialSize(); got != 8 {
+		t.Fatalf("PartialSize() = %d, want 8", got)
+	}
+
+	var lastDone, lastTotal int64
+	err := e.Download(context.Background(), DownloadOptions{
+		URL:      srv.URL + "/model.onnx",
+		SHA256:   strings.ToUpper(sha256Hex(model)),
+		Progress: func(done, total int64) { lastDone, lastTotal = done, total },
+	})
+	if err != nil {
+		t.Fatalf("Download() err = %v", err)
+	}
+	if len(ranges) != 1 || ranges[0] != "bytes=8-" {
+		t.Errorf("requests had ranges %q, want one resuming at 8", ranges)
+	}
+	if lastDone != int64(len(model)) || lastTotal != int64(len(model)) {
+		t.Errorf("last progress = %d/%d, want %d/%d", lastDone, lastTotal, len(model), len(model))
+	}
+	got, err := os.ReadFile(e.ModelFilePath())
+	if err != nil || string(got) != model {
+		t.Fatalf("model = %q, err = %v; want %q", got, err, model)
+	}
+	if !e.HasModel() || e.PartialSize() != 0 {
+		t.Errorf("HasModel() = %v, PartialSize() = %d after the download", e.HasModel(), e.PartialSize())
+	}
+	if sum, _ := e.ModelChecksum(); sum != sha256Hex(model) {
+		t.Errorf("ModelChecksum() = %s, want %s", sum, sha256Hex(model))
+	}
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tampered"))
+	}))
+	t.Cleanup(srv.Close)
+	e := newTestEmbedder(t, srv.Client())
+
+	err := e.Download(context.Background(), DownloadOptions{URL: srv.URL + "/model.onnx", SHA256: sha256Hex("genuine")})
+	if !errors.Is(err, ErrChecksum) {
+		t.Fatalf("Download() err = %v, want ErrChecksum", err)
+	}
+	if e.HasModel() || e.PartialSize() != 0 {
+		t.Errorf("a mismatching download was kept")
+	}
+}
+
+func TestDownloadFromLocalMirror(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmbedder(t, nil)
+	mirror := filepath.Join(t.TempDir(), "model.onnx")
+	if err := os.WriteFile(mirror, []byte("offline-model"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, source := range []string{mirror, "file://" + mirror} {
+		if got := e.ModelURL(source); got != source {
+			t.Errorf("ModelURL(%q) = %q, want it unchanged", source, got)
+		}
+		if err := e.Download(context.Background(), DownloadOptions{URL: source, SHA256: sha256Hex("offline-model")}); err != nil {
+			t.Fatalf("Download(%q) err = %v", source, err)
+		}
+		if got, _ := os.ReadFile(e.ModelFilePath()); string(got) != "offline-model" {
+			t.Errorf("model from %q = %q", source, got)
+		}
+	}
+	if got := e.ModelURL("https://huggingface.co/org/repo/"); got != "https://huggingface.co/org/repo/resolve/main/model.onnx" {
+		t.Errorf("ModelURL(repo) = %q", got)
+	}
+}
@@ -2,7 +2,8 @@
 //
 // Phase 1: Core Infrastructure
 //   - Text-to-vector embedding conversion
-//   - 384-dimensional vectors (MiniLM-L6 architecture)
+//   - 384-dimensional vectors (MiniLM-L6 architecture) by default; the
+//     model and its dimensions are configurable (see models.go)
 //   - Ready for ONNX model integration
 //
 // Phase 5: Semantic Search (upcoming)
@@ -25,7 +26,6 @@ package embedder
 import (
 	"context"
 	"fmt"
-	"io"
 	"math"
 	"net/http"
 	"os"
@@ -48,14 +48,16 @@ import (
 //   - Similar texts have similar vectors
 //   - Enables semantic similarity search
 type Embedder struct {
+	info      ModelInfo
 	modelPath string
 	http      *http.Client
 }
 
 // New creates a new Embedder instance.
 //
-// Phase 1: Creates model directory at ~/.config/flowState/models/
+// Phase 1: Creates model directory at ~/.config/flowState/models/<model>/
 //   - Ready for model file storage
+//   - Fails for an unknown embedding_model without embedding_dimensions
 //   - Future: Download ONNX model automatically
 func New(cfg *config.Config) (*Embedder, error) {
 	return NewWithHTTPClient(cfg, http.DefaultClient)
@@ -67,13 +69,20 @@ func NewWithHTTPClient(cfg *config.Config, client *http.Client) (*Embedder, erro
 		client = http.DefaultClient
 	}
 
-	modelPath := filepath.Join(cfg.ModelPath, "all-MiniLM-L6-v2")
+	info, err := resolveModel(cfg.EmbeddingModel, cfg.EmbeddingDims)
+	if err != nil {
+		return nil, err
+	}
+	// A HuggingFace repository name keeps one directory per model
+	modelPath := filepath.Join(cfg.ModelPath, strings.ReplaceAll(info.Name, "/", "--"))
+	info.ModelPath = modelPath
 
 	if err := os.MkdirAll(modelPath, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create model directory: %w", err)
 	}
 
 	return &Embedder{
+		info:      info,
 		modelPath: modelPath,
 		http:      client,
 	}, nil
@@ -82,7 +91,7 @@ func NewWithHTTPClient(cfg *config.Config, client *http.Client) (*Embedder, erro
 // Embed generates embeddings for multiple texts.
 //
 // Phase 1: Returns 2D slice of float32 vectors
-//   - Each input text gets one 384-dimensional vector
+//   - Each input text gets one vector of the model's dimensions
 //   - Vectors are normalized (unit length)
 //   - Ready for cosine similarity comparison
 func (e *Embedder) Embed(texts []string) ([][]float32, error) {
@@ -119,9 +128,9 @@ func (e *Embedder) embedSimple(texts []string) ([][]float32, error) {
 	return embeddings, nil
 }
 
-// simpleHashEmbedding creates a 384-dimensional vector from text.
+// simpleHashEmbedding creates a vector of the model's dimensions from text.
 func (e *Embedder) simpleHashEmbedding(text string) []float32 {
-	dim := 384
+	dim := e.info.Dimensions
 	embedding := make([]float32, dim)
 
 	for i, ch := range text {
@@ -148,15 +157,9 @@ func (e *Embedder) simpleHashEmbedding(text string) []float32 {
 // Phase 1: Core Infrastructure
 //   - Model name and dimensions
 //   - Download URL for ONNX model
-//   - Expected model size (~90MB)
+//   - Expected model size (~90MB for the default model)
 func (e *Embedder) GetModelInfo() ModelInfo {
-	return ModelInfo{
-		Name:        "all-MiniLM-L6-v2",
-		Dimensions:  384,
-		ModelPath:   e.modelPath,
-		DownloadURL: "https://huggingface.co/sentence-transformers/all-MiniLM-L6-v2-onnx",
-		ModelSize:   "90MB",
-	}
+	return e.info
 }
 
 // ModelFilePath returns the expected path of the ONNX model file.
@@ -169,62 +172,13 @@ func (e *Embedder) ModelFilePath() string {
 // downloadURL can be either:
 // - a direct file URL to model.onnx, or
 // - a HuggingFace repo URL, in which case we append /resolve/main/model.onnx
+//
+// See Download for progress, checksums and resuming.
 func (e *Embedder) EnsureModel(ctx context.Context, downloadURL string) error {
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
-	modelPath := e.ModelFilePath()
-	if _, err := os.Stat(modelPath); err == nil {
+	if e.HasModel() {
 		return nil
 	}
-
-	if strings.TrimSpace(downloadURL) == "" {
-		downloadURL = e.GetModelInfo().DownloadURL
-	}
-
-	url := strings.TrimRight(downloadURL, "/")
-	if !strings.Contains(url, "resolve/") && !strings.HasSuffix(strings.ToLower(url), ".onnx") {
-		url = url + "/resolve/main/model.onnx"
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to build model download request: %w", err)
-	}
-
-	resp, err := e.http.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to download model: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("model download failed: status=%s", resp.Status)
-	}
-
-	tmpPath := modelPath + ".tmp"
-	f, err := os.Create(tmpPath)
-	if err != nil {
-		return fmt.Errorf("failed to create temp model file: %w", err)
-	}
-
-	if _, err := io.Copy(f, resp.Body); err != nil {
-		f.Close()
-		_ = os.Remove(tmpPath)
-		return fmt.Errorf("failed to write model: %w", err)
-	}
-	if err := f.Close(); err != nil {
-		_ = os.Remove(tmpPath)
-		return fmt.Errorf("failed to close model file: %w", err)
-	}
-
-	if err := os.Rename(tmpPath, modelPath); err != nil {
-		_ = os.Remove(tmpPath)
-		return fmt.Errorf("failed to finalize model file: %w", err)
-	}
-
-	return nil
+	return e.Download(ctx, DownloadOptions{URL: downloadURL})
 }
 
 // IsModelLoaded always returns true for current implementation.
@@ -96,3 +96,37 @@ func TestEmbedSingleText(t *testing.T) {
 		t.Fatalf("modelPath mismatch: got=%s want=%s", e.modelPath, wantDir)
 	}
 }
+
+func TestEmbeddingModelSelection(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	e, err := New(&config.Config{ModelPath: tmpDir, EmbeddingModel: "bge-base-en-v1.5"})
+	if err != nil {
+		t.Fatalf("New(bge-base-en-v1.5) err = %v", err)
+	}
+	if info := e.GetModelInfo(); info.Name != "bge-base-en-v1.5" || info.Dimensions != 768 {
+		t.Errorf("GetModelInfo() = %+v, want bge-base-en-v1.5 with 768 dimensions", info)
+	}
+	if v, _ := e.EmbedSingle("hello"); len(v) != 768 {
+		t.Errorf("EmbedSingle() has %d dimensions, want 768", len(v))
+	}
+
+	// Any HuggingFace repository, given its dimensions
+	if _, err := New(&config.Config{ModelPath: tmpDir, EmbeddingModel: "intfloat/e5-large-v2"}); err == nil {
+		t.Fatal("New() of an unknown model without embedding_dimensions err = nil")
+	}
+	e, err = New(&config.Config{ModelPath: tmpDir, EmbeddingModel: "intfloat/e5-large-v2", EmbeddingDims: 1024})
+	if err != nil {
+		t.Fatalf("New(intfloat/e5-large-v2) err = %v", err)
+	}
+	if v, _ := e.EmbedSingle("hello"); len(v) != 1024 {
+		t.Errorf("EmbedSingle() has %d dimensions, want 1024", len(v))
+	}
+	if want := filepath.Join(tmpDir, "intfloat--e5-large-v2"); e.modelPath != want {
+		t.Errorf("modelPath = %s, want %s", e.modelPath, want)
+	}
+	if got := e.ModelURL(""); got != "https://huggingface.co/intfloat/e5-large-v2/resolve/main/model.onnx" {
+		t.Errorf("ModelURL() = %q", got)
+	}
+}
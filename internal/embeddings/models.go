@@ -0,0 +1,82 @@
+package embedder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Embedding models (Phase 5: Semantic Search)
+//
+// embedding_model picks the model notes are embedded with. The models
+// below are known by name; any other HuggingFace repository ("org/name")
+// works too when embedding_dimensions says how long its vectors are.
+// embedding_dimensions also overrides a known model's size, for models
+// whose vectors may be truncated. Vectors of different models cannot be
+// compared, so changing either setting means rebuilding the search index
+// (see search.SemanticSearch.RebuildIndex).
+
+// DefaultModel is the embedding model used when none is configured.
+const DefaultModel = "all-MiniLM-L6-v2"
+
+// knownModels are the embedding models that need no embedding_dimensions.
+var knownModels = map[string]ModelInfo{
+	DefaultModel: {
+		Dimensions:  384,
+		DownloadURL: "https://huggingface.co/sentence-transformers/all-MiniLM-L6-v2-onnx",
+		ModelSize:   "90MB",
+	},
+	"all-mpnet-base-v2": {
+		Dimensions:  768,
+		DownloadURL: "https://huggingface.co/sentence-transformers/all-mpnet-base-v2/resolve/main/onnx/model.onnx",
+		ModelSize:   "420MB",
+	},
+	"bge-small-en-v1.5": {
+		Dimensions:  384,
+		DownloadURL: "https://huggingface.co/BAAI/bge-small-en-v1.5/resolve/main/onnx/model.onnx",
+		ModelSize:   "130MB",
+	},
+	"bge-base-en-v1.5": {
+		Dimensions:  768,
+		DownloadURL: "https://huggingface.co/BAAI/bge-base-en-v1.5/resolve/main/onnx/model.onnx",
+		ModelSize:   "440MB",
+	},
+	"nomic-embed-text-v1.5": {
+		Dimensions:  768,
+		DownloadURL: "https://huggingface.co/nomic-ai/nomic-embed-text-v1.5/resolve/main/onnx/model.onnx",
+		ModelSize:   "550MB",
+	},
+}
+
+// KnownModels returns the names of the models embedding_model accepts
+// without embedding_dimensions, sorted.
+func KnownModels() []string {
+	names := make([]string, 0, len(knownModels))
+	for name := range knownModels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveModel returns the model info for name (DefaultModel when empty),
+// with dimensions overriding its vector size when positive.
+func resolveModel(name string, dimensions int) (ModelInfo, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultModel
+	}
+	info, known := knownModels[name]
+	if !known {
+		if dimensions <= 0 {
+			return ModelInfo{}, fmt.Errorf("unknown embedding model %q: set embedding_dimensions, or use one of %s",
+				name, strings.Join(KnownModels(), ", "))
+		}
+		info = ModelInfo{DownloadURL: "https://huggingface.co/" + name, ModelSize: "unknown size"}
+	}
+	if dimensions > 0 {
+		info.Dimensions = dimensions
+	}
+	info.Name = name
+	return info, nil
+}
@@ -0,0 +1,176 @@
+// Package goals defines weekly and monthly targets and measures how far
+// along they are for flowState-cli.
+//
+// A goal counts one of:
+//   - notes: notes created in the period, with the goal's tag if set
+//   - todos: todos completed in the period, mentioning the tag if set
+//   - focus: minutes of completed focus sessions, for one label if set
+//
+// Progress is always counted from the notes, todos and sessions already
+// in the database, so there is nothing to tick off by hand. Weeks start
+// on the configured week_start day; months on the 1st. Like digest, the
+// package has no TUI dependencies: `flowState goals` prints the same
+// progress the home screen shows as bars.
+//
+// Usage:
+//
+//	goal := &models.Goal{Kind: models.GoalNotes, Tag: "thesis", Target: 20, Period: models.GoalMonth}
+//	if err := goals.Validate(goal); err != nil { ... }
+//	store.CreateGoal(goal)
+//	progress, err := goals.MeasureAll(store, time.Now())
+package goals
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Progress is how far a goal has come in its current period.
+type Progress struct {
+	Goal    models.Goal
+	Current int       // Count so far, or minutes for focus goals
+	Start   time.Time // Start of the current period
+	End     time.Time // Start of the next period
+}
+
+// Fraction is the share of the target reached, at most 1.
+func (p Progress) Fraction() float64 {
+	if p.Goal.Target <= 0 {
+		return 0
+	}
+	return min(float64(p.Current)/float64(p.Goal.Target), 1)
+}
+
+// Done reports whether the target has been reached.
+func (p Progress) Done() bool {
+	return p.Current >= p.Goal.Target
+}
+
+// Count renders the progress against the target, e.g. "12/20 notes" or
+// "31h/40h".
+func (p Progress) Count() string {
+	if p.Goal.Kind == models.GoalFocus {
+		current := models.FormatMinutes(p.Current)
+		if current == "" {
+			current = "0m"
+		}
+		return current + "/" + models.FormatMinutes(p.Goal.Target)
+	}
+	return fmt.Sprintf("%d/%d %s", p.Current, p.Goal.Target, p.Goal.Kind)
+}
+
+// DaysLeft is how many days of the period remain, today included.
+func (p Progress) DaysLeft(now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return int(p.End.Sub(today).Hours()/24 + 0.5)
+}
+
+// Period returns the start of the period containing now and the start of
+// the next one.
+func Period(period models.GoalPeriod, now time.Time) (start, end time.Time) {
+	if period == models.GoalWeek {
+		start = datefmt.StartOfWeek(now)
+		return start, start.AddDate(0, 0, 7)
+	}
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// Validate normalizes goal's tag and fills in a title when it has none,
+// and reports a goal that cannot be measured.
+func Validate(goal *models.Goal) error {
+	switch goal.Kind {
+	case models.GoalNotes, models.GoalTodos, models.GoalFocus:
+	default:
+		return fmt.Errorf("unknown goal kind %q (use notes, todos or focus)", goal.Kind)
+	}
+	switch goal.Period {
+	case models.GoalWeek, models.GoalMonth:
+	default:
+		return fmt.Errorf("unknown goal period %q (use week or month)", goal.Period)
+	}
+	if goal.Target <= 0 {
+		return errors.New("the target must be more than zero")
+	}
+
+	goal.Tag = strings.TrimSpace(goal.Tag)
+	if goal.Kind != models.GoalFocus {
+		goal.Tag = strings.ToLower(strings.TrimLeft(goal.Tag, "#"))
+		if goal.Tag != "" && models.HashtagPattern.FindString("#"+goal.Tag) != "#"+goal.Tag {
+			return fmt.Errorf("invalid tag %q (use letters, digits and _)", goal.Tag)
+		}
+	}
+	goal.Title = strings.Join(strings.Fields(goal.Title), " ")
+	if goal.Title == "" {
+		goal.Title = Describe(*goal)
+	}
+	return nil
+}
+
+// Describe words a goal, e.g. "20 notes tagged #thesis this month" or
+// "40h focus on writing this week".
+func Describe(goal models.Goal) string {
+	var s string
+	switch goal.Kind {
+	case models.GoalFocus:
+		s = models.FormatMinutes(goal.Target) + " focus"
+		if goal.Tag != "" {
+			s += " on " + goal.Tag
+		}
+	case models.GoalTodos:
+		s = fmt.Sprintf("%d todos done", goal.Target)
+		if goal.Tag != "" {
+			s += " tagged #" + goal.Tag
+		}
+	default:
+		s = fmt.Sprintf("%d notes", goal.Target)
+		if goal.Tag != "" {
+			s += " tagged #" + goal.Tag
+		}
+	}
+	return s + " this " + string(goal.Period)
+}
+
+// Measure counts goal's progress in the period containing now.
+func Measure(store *sqlite.Store, goal models.Goal, now time.Time) (Progress, error) {
+	p := Progress{Goal: goal}
+	p.Start, p.End = Period(goal.Period, now)
+
+	var tags []string
+	if goal.Tag != "" {
+		tags = []string{goal.Tag}
+	}
+	var err error
+	switch goal.Kind {
+	case models.GoalNotes:
+		p.Current, err = store.CountNotes(sqlite.NoteQuery{Tags: tags, CreatedSince: p.Start})
+	case models.GoalTodos:
+		p.Current, err = store.CountTodos(sqlite.TodoQuery{Tags: tags, CompletedSince: p.Start})
+	case models.GoalFocus:
+		p.Current, err = store.FocusMinutes(p.Start, p.End, goal.Tag)
+	}
+	return p, err
+}
+
+// MeasureAll measures every goal, oldest first.
+func MeasureAll(store *sqlite.Store, now time.Time) ([]Progress, error) {
+	list, err := store.ListGoals()
+	if err != nil {
+		return nil, err
+	}
+	progress := make([]Progress, 0, len(list))
+	for _, goal := range list {
+		p, err := Measure(store, goal, now)
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, p)
+	}
+	return progress, nil
+}
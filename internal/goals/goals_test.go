@@ -0,0 +1,131 @@
+package goals
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func newTestStore(t *testing.T) *sqlite.Store {
+	t.Helper()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestValidate(t *testing.T) {
+	goal := &models.Goal{Kind: models.GoalNotes, Tag: "#Thesis", Target: 20, Period: models.GoalMonth}
+	if err := Validate(goal); err != nil {
+		t.Fatalf("Validate() err = %v", err)
+	}
+	if goal.Tag != "thesis" || goal.Title != "20 notes tagged #thesis this month" {
+		t.Errorf("Validate() = %q / %q", goal.Tag, goal.Title)
+	}
+
+	focus := &models.Goal{Kind: models.GoalFocus, Target: 40 * 60, Period: models.GoalWeek}
+	if err := Validate(focus); err != nil || focus.Title != "40h focus this week" {
+		t.Errorf("Validate(focus) = %q, %v", focus.Title, err)
+	}
+
+	for _, bad := range []models.Goal{
+		{Kind: "pages", Target: 1, Period: models.GoalWeek},
+		{Kind: models.GoalNotes, Target: 1, Period: "year"},
+		{Kind: models.GoalNotes, Target: 0, Period: models.GoalWeek},
+		{Kind: models.GoalTodos, Tag: "not-a-tag", Target: 1, Period: models.GoalWeek},
+	} {
+		if err := Validate(&bad); err == nil {
+			t.Errorf("Validate(%+v) expected an error", bad)
+		}
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	now := time.Date(2026, 3, 11, 15, 0, 0, 0, time.Local)
+	start, end := Period(models.GoalMonth, now)
+	if !start.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)) || !end.Equal(time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("month = %v .. %v", start, end)
+	}
+	start, end = Period(models.GoalWeek, now)
+	if end.Sub(start) != 7*24*time.Hour || start.After(now) || !end.After(now) {
+		t.Errorf("week = %v .. %v", start, end)
+	}
+}
+
+func TestMeasureAll(t *testing.T) {
+	store := newTestStore(t)
+	now := time.Now()
+
+	for _, note := range []*models.Note{
+		{Title: "Chapter 1", Tags: []string{"thesis"}},
+		{Title: "Chapter 2", Tags: []string{"thesis"}},
+		{Title: "Groceries"},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	for _, todo := range []*models.Todo{
+		{Title: "Send draft #thesis", Status: models.TodoStatusCompleted},
+		{Title: "Read paper #thesis", Status: models.TodoStatusPending},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	lastMonth := now.AddDate(0, -1, -7)
+	for _, s := range []models.FocusSession{
+		{StartTime: now, Duration: 50 * 60, Status: models.SessionStatusCompleted, Label: "Writing"},
+		{StartTime: now, Duration: 25 * 60, Status: models.SessionStatusCompleted, Label: "email"},
+		{StartTime: lastMonth, Duration: 25 * 60, Status: models.SessionStatusCompleted, Label: "writing"},
+	} {
+		s := s
+		if err := store.CreateSession(&s); err != nil {
+			t.Fatalf("CreateSession() err = %v", err)
+		}
+	}
+
+	for _, goal := range []*models.Goal{
+		{Kind: models.GoalNotes, Tag: "thesis", Target: 20, Period: models.GoalMonth},
+		{Kind: models.GoalTodos, Tag: "thesis", Target: 1, Period: models.GoalWeek},
+		{Kind: models.GoalFocus, Tag: "writing", Target: 40 * 60, Period: models.GoalMonth},
+	} {
+		if err := Validate(goal); err != nil {
+			t.Fatalf("Validate() err = %v", err)
+		}
+		if err := store.CreateGoal(goal); err != nil {
+			t.Fatalf("CreateGoal() err = %v", err)
+		}
+	}
+
+	progress, err := MeasureAll(store, now)
+	if err != nil {
+		t.Fatalf("MeasureAll() err = %v", err)
+	}
+	if len(progress) != 3 {
+		t.Fatalf("MeasureAll() returned %d goals, want 3", len(progress))
+	}
+	if got := progress[0].Count(); got != "2/20 notes" || progress[0].Fraction() != 0.1 {
+		t.Errorf("notes goal = %q (%v)", got, progress[0].Fraction())
+	}
+	if got := progress[1].Count(); got != "1/1 todos" || !progress[1].Done() {
+		t.Errorf("todos goal = %q, done %v", got, progress[1].Done())
+	}
+	if got := progress[2].Count(); got != "50m/40h" {
+		t.Errorf("focus goal = %q", got)
+	}
+
+	if err := store.DeleteGoal(progress[0].Goal.ID); err != nil {
+		t.Fatalf("DeleteGoal() err = %v", err)
+	}
+	if err := store.DeleteGoal(progress[0].Goal.ID); err == nil {
+		t.Error("DeleteGoal() of a deleted goal: expected an error")
+	}
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -44,9 +45,14 @@ func RenderGraphASCII(g Graph, labels map[string]string, positions map[string]Po
 		}
 	}
 
-	// Draw nodes
+	// Draw nodes in key order so overlapping boxes render the same every time
 	const boxW = 18
-	for key := range g.Nodes {
+	keys := make([]string, 0, len(g.Nodes))
+	for k := range g.Nodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		p, ok := positions[key]
 		if !ok {
 			continue
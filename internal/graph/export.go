@@ -0,0 +1,293 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Graph export (Phase 7: Visualization).
+//
+// The mind map can be written out as Graphviz DOT or as a Mermaid
+// flowchart, for rendering outside the terminal. Each link becomes one
+// undirected edge labeled with its link type. Nodes are grouped in a
+// cluster per tag; a node with several tags sits in the cluster of the
+// first in alphabetical order, and all of its tags are kept in its
+// metadata. Output is sorted by key, so exporting the same graph twice
+// gives the same text.
+
+// Export formats.
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+)
+
+// NodeMeta describes a node for export.
+type NodeMeta struct {
+	Label       string
+	Status      string // Todo status; empty for notes
+	Placeholder bool   // Unfilled wikilink placeholder note
+	Created     time.Time
+	Updated     time.Time
+}
+
+// Export writes g in the given format. meta supplies labels and metadata
+// by node key; nodes without an entry are labeled with their key.
+func Export(w io.Writer, format string, g Graph, meta map[string]NodeMeta) error {
+	switch format {
+	case FormatDOT:
+		return ExportDOT(w, g, meta)
+	case FormatMermaid:
+		return ExportMermaid(w, g, meta)
+	default:
+		return fmt.Errorf("unknown format %q (use %s or %s)", format, FormatDOT, FormatMermaid)
+	}
+}
+
+// ExportDOT writes g as an undirected Graphviz graph. Node metadata is
+// written as node attributes (type, tags, status, created, updated) and
+// repeated in the tooltip.
+func ExportDOT(w io.Writer, g Graph, meta map[string]NodeMeta) error {
+	var b strings.Builder
+	b.WriteString("graph flowstate {\n")
+	b.WriteString("  graph [rankdir=LR];\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+
+	clusters, loose := tagClusters(g)
+	for _, c := range clusters {
+		fmt.Fprintf(&b, "\n  subgraph %s {\n", dotQuote("cluster_"+c.tag))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote("#"+c.tag))
+		for _, key := range c.keys {
+			b.WriteString("    " + dotNode(g.Nodes[key], meta[key]) + "\n")
+		}
+		b.WriteString("  }\n")
+	}
+	if len(loose) > 0 {
+		b.WriteString("\n")
+	}
+	for _, key := range loose {
+		b.WriteString("  " + dotNode(g.Nodes[key], meta[key]) + "\n")
+	}
+
+	edges := undirectedEdges(g)
+	if len(edges) > 0 {
+		b.WriteString("\n")
+	}
+	for _, e := range edges {
+		fmt.Fprintf(&b, "  %s -- %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(string(e.LinkType)))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotNode renders one node statement.
+func dotNode(n *Node, m NodeMeta) string {
+	attrs := [][2]string{{"label", nodeLabel(n, m)}}
+	if n.ItemType == "todo" {
+		attrs = append(attrs, [2]string{"shape", "ellipse"})
+	}
+	if m.Placeholder {
+		attrs = append(attrs, [2]string{"style", "dashed"})
+	}
+	details := nodeDetails(n, m)
+	attrs = append(attrs, details...)
+	attrs = append(attrs, [2]string{"tooltip", detailsText(details)})
+
+	parts := make([]string, len(attrs))
+	for i, a := range attrs {
+		parts[i] = a[0] + "=" + dotQuote(a[1])
+	}
+	return dotQuote(n.Key) + " [" + strings.Join(parts, ", ") + "];"
+}
+
+// dotQuote returns s as a double-quoted DOT ID.
+func dotQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "").Replace(s)
+	return `"` + s + `"`
+}
+
+// ExportMermaid writes g as a Mermaid flowchart. Notes are drawn as
+// boxes, todos as rounded boxes and placeholders with a dashed border;
+// the tags and dates of each node are shown under its label.
+func ExportMermaid(w io.Writer, g Graph, meta map[string]NodeMeta) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	clusters, loose := tagClusters(g)
+	for _, c := range clusters {
+		fmt.Fprintf(&b, "  subgraph %s[%s]\n", mermaidID("tag:"+c.tag), mermaidQuote("#"+c.tag))
+		for _, key := range c.keys {
+			b.WriteString("    " + mermaidNode(g.Nodes[key], meta[key]) + "\n")
+		}
+		b.WriteString("  end\n")
+	}
+	for _, key := range loose {
+		b.WriteString("  " + mermaidNode(g.Nodes[key], meta[key]) + "\n")
+	}
+
+	for _, e := range undirectedEdges(g) {
+		fmt.Fprintf(&b, "  %s ---|%s| %s\n", mermaidID(e.From), mermaidQuote(string(e.LinkType)), mermaidID(e.To))
+	}
+
+	var placeholders []string
+	for _, key := range sortedKeys(g) {
+		if meta[key].Placeholder {
+			placeholders = append(placeholders, mermaidID(key))
+		}
+	}
+	if len(placeholders) > 0 {
+		b.WriteString("  classDef placeholder stroke-dasharray: 5 5\n")
+		b.WriteString("  class " + strings.Join(placeholders, ",") + " placeholder\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidNode renders one node statement.
+func mermaidNode(n *Node, m NodeMeta) string {
+	label := mermaidEscape(nodeLabel(n, m))
+	if details := nodeDetails(n, m); len(details) > 1 {
+		label += "<br/><small>" + mermaidEscape(detailsText(details[1:])) + "</small>"
+	}
+	if n.ItemType == "todo" {
+		return mermaidID(n.Key) + `("` + label + `")`
+	}
+	return mermaidID(n.Key) + `["` + label + `"]`
+}
+
+// mermaidID turns a node key into a Mermaid node ID: "note:12" becomes
+// "note_12". Characters Mermaid does not allow in IDs become underscores.
+func mermaidID(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// mermaidQuote returns s as a double-quoted Mermaid label.
+func mermaidQuote(s string) string {
+	return `"` + mermaidEscape(s) + `"`
+}
+
+// mermaidEscape replaces the characters that end or break a quoted
+// Mermaid label with entity codes.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", " ", "<", "#lt;", ">", "#gt;").Replace(s)
+}
+
+// nodeLabel returns the display label of a node.
+func nodeLabel(n *Node, m NodeMeta) string {
+	if m.Label != "" {
+		return m.Label
+	}
+	return n.Key
+}
+
+// nodeDetails returns the metadata of a node as name/value pairs, starting
+// with its type. Empty values are left out.
+func nodeDetails(n *Node, m NodeMeta) [][2]string {
+	details := [][2]string{{"type", n.ItemType}}
+	if len(n.Tags) > 0 {
+		tags := append([]string(nil), n.Tags...)
+		sort.Strings(tags)
+		details = append(details, [2]string{"tags", strings.Join(tags, ",")})
+	}
+	if m.Status != "" {
+		details = append(details, [2]string{"status", m.Status})
+	}
+	if !m.Created.IsZero() {
+		details = append(details, [2]string{"created", m.Created.Format("2006-01-02")})
+	}
+	if !m.Updated.IsZero() {
+		details = append(details, [2]string{"updated", m.Updated.Format("2006-01-02")})
+	}
+	return details
+}
+
+// detailsText joins metadata pairs as "name: value · name: value".
+func detailsText(details [][2]string) string {
+	parts := make([]string, len(details))
+	for i, d := range details {
+		parts[i] = d[0] + ": " + d[1]
+	}
+	return strings.Join(parts, " · ")
+}
+
+// tagCluster is the nodes exported under one tag.
+type tagCluster struct {
+	tag  string
+	keys []string
+}
+
+// tagClusters groups the nodes of g by their first tag in alphabetical
+// order. It returns the clusters sorted by tag, and the untagged nodes.
+func tagClusters(g Graph) ([]tagCluster, []string) {
+	byTag := map[string][]string{}
+	var loose []string
+	for _, key := range sortedKeys(g) {
+		tag := firstTag(g.Nodes[key].Tags)
+		if tag == "" {
+			loose = append(loose, key)
+			continue
+		}
+		byTag[tag] = append(byTag[tag], key)
+	}
+	tags := make([]string, 0, len(byTag))
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	clusters := make([]tagCluster, len(tags))
+	for i, tag := range tags {
+		clusters[i] = tagCluster{tag: tag, keys: byTag[tag]}
+	}
+	return clusters, loose
+}
+
+// firstTag returns the alphabetically first non-empty tag.
+func firstTag(tags []string) string {
+	first := ""
+	for _, t := range tags {
+		if t != "" && (first == "" || t < first) {
+			first = t
+		}
+	}
+	return first
+}
+
+// undirectedEdges returns each link of g once, with From before To in
+// key order, sorted.
+func undirectedEdges(g Graph) []Edge {
+	var edges []Edge
+	for _, from := range sortedKeys(g) {
+		for to, e := range g.Adj[from] {
+			if from <= to {
+				edges = append(edges, Edge{From: from, To: to, LinkType: e.LinkType})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+// sortedKeys returns the node keys of g in order.
+func sortedKeys(g Graph) []string {
+	keys := make([]string, 0, len(g.Nodes))
+	for k := range g.Nodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
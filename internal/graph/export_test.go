@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func exportFixture() (Graph, map[string]NodeMeta) {
+	links := []models.Link{
+		{SourceType: "note", SourceID: 2, TargetType: "note", TargetID: 1, LinkType: models.LinkTypeRelated},
+		{SourceType: "note", SourceID: 2, TargetType: "todo", TargetID: 9, LinkType: models.LinkTypeReferences},
+		{SourceType: "note", SourceID: 3, TargetType: "note", TargetID: 1, LinkType: models.LinkTypeContains},
+	}
+	nodeTags := map[string][]string{
+		NodeKey("note", 1): {"work", "project"},
+		NodeKey("note", 2): {"project"},
+		NodeKey("note", 3): {models.PlaceholderTag},
+	}
+	day := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	meta := map[string]NodeMeta{
+		NodeKey("note", 1): {Label: `Say "hi"`, Created: day, Updated: day.AddDate(0, 0, 1)},
+		NodeKey("note", 2): {Label: "Plan", Created: day},
+		NodeKey("note", 3): {Label: "Stub", Placeholder: true},
+		NodeKey("todo", 9): {Label: "Ship <it>", Status: "pending"},
+	}
+	return BuildGraphFromLinks(links, nodeTags), meta
+}
+
+func TestExportDOT(t *testing.T) {
+	t.Parallel()
+
+	g, meta := exportFixture()
+	var b strings.Builder
+	if err := ExportDOT(&b, g, meta); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"graph flowstate {",
+		`subgraph "cluster_project" {`,
+		`label="#project";`,
+		`"note:1" [label="Say \"hi\"", type="note", tags="project,work", created="2026-03-09", updated="2026-03-10"`,
+		`"todo:9" [label="Ship <it>", shape="ellipse", type="todo", status="pending"`,
+		`"note:3" [label="Stub", style="dashed"`,
+		`"note:1" -- "note:2" [label="related"];`,
+		`"note:2" -- "todo:9" [label="references"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, " -- "); n != 3 {
+		t.Errorf("expected 3 edges, got %d:\n%s", n, out)
+	}
+	// The untagged todo sits outside every cluster.
+	if i, j := strings.Index(out, `"todo:9" [`), strings.LastIndex(out, "  }\n"); i < j {
+		t.Errorf("todo exported inside a cluster:\n%s", out)
+	}
+}
+
+func TestExportMermaid(t *testing.T) {
+	t.Parallel()
+
+	g, meta := exportFixture()
+	var b strings.Builder
+	if err := ExportMermaid(&b, g, meta); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"flowchart LR\n",
+		`  subgraph tag_project["#project"]`,
+		`    note_1["Say #quot;hi#quot;<br/><small>tags: project,work · created: 2026-03-09 · updated: 2026-03-10</small>"]`,
+		`  todo_9("Ship #lt;it#gt;<br/><small>status: pending</small>")`,
+		`  note_1 ---|"related"| note_2`,
+		"  class note_3 placeholder\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Mermaid output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "subgraph") != strings.Count(out, "  end\n") {
+		t.Errorf("unbalanced subgraphs:\n%s", out)
+	}
+}
+
+func TestExportIsStable(t *testing.T) {
+	t.Parallel()
+
+	g, meta := exportFixture()
+	for _, format := range []string{FormatDOT, FormatMermaid} {
+		var first, second strings.Builder
+		if err := Export(&first, format, g, meta); err != nil {
+			t.Fatal(err)
+		}
+		if err := Export(&second, format, g, meta); err != nil {
+			t.Fatal(err)
+		}
+		if first.String() != second.String() {
+			t.Errorf("%s export differs between runs", format)
+		}
+	}
+	if err := Export(&strings.Builder{}, "svg", g, meta); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,111 @@
+package graph
+
+import "sort"
+
+// Graph insights (Phase 7: Visualization).
+//
+// Reports for gardening a knowledge base: orphans (nodes with no links),
+// hubs (the most linked nodes) and clusters (groups of linked nodes cut
+// off from the main body of the graph).
+
+// NodeDegree is a node with its number of distinct neighbors.
+type NodeDegree struct {
+	Key    string
+	Degree int
+}
+
+// Insights summarizes the shape of a graph.
+type Insights struct {
+	Orphans  []string     // Nodes without any link, in the order given
+	Hubs     []NodeDegree // Most linked nodes, highest degree first
+	Clusters [][]string   // Components apart from the largest, biggest first
+}
+
+// Degree returns how many distinct nodes key is linked to. A link from a
+// node to itself does not count.
+func Degree(g Graph, key string) int {
+	n := len(g.Adj[key])
+	if _, self := g.Adj[key][key]; self {
+		n--
+	}
+	return n
+}
+
+// Neighbors returns the nodes linked to key, sorted.
+func Neighbors(g Graph, key string) []string {
+	out := make([]string, 0, len(g.Adj[key]))
+	for nb := range g.Adj[key] {
+		if nb != key {
+			out = append(out, nb)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Reachable returns every node reachable from start, start included, in
+// breadth-first order. Neighbors are visited in key order, so the result
+// is stable.
+func Reachable(g Graph, start string) []string {
+	if _, ok := g.Nodes[start]; !ok {
+		return nil
+	}
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+	for i := 0; i < len(queue); i++ {
+		for _, nb := range Neighbors(g, queue[i]) {
+			if !visited[nb] {
+				visited[nb] = true
+				queue = append(queue, nb)
+			}
+		}
+	}
+	return queue
+}
+
+// TopHubs returns up to n nodes with the most links, highest degree
+// first and ties in key order. Nodes without links are left out.
+func TopHubs(g Graph, n int) []NodeDegree {
+	var hubs []NodeDegree
+	for key := range g.Nodes {
+		if d := Degree(g, key); d > 0 {
+			hubs = append(hubs, NodeDegree{Key: key, Degree: d})
+		}
+	}
+	sort.Slice(hubs, func(i, j int) bool {
+		if hubs[i].Degree != hubs[j].Degree {
+			return hubs[i].Degree > hubs[j].Degree
+		}
+		return hubs[i].Key < hubs[j].Key
+	})
+	if n >= 0 && len(hubs) > n {
+		hubs = hubs[:n]
+	}
+	return hubs
+}
+
+// Analyze reports on g. keys lists every node that could be linked, such
+// as all notes, so nodes missing from g count as orphans; the top
+// topHubs hubs are included.
+func Analyze(g Graph, keys []string, topHubs int) Insights {
+	var in Insights
+	for _, key := range keys {
+		if Degree(g, key) == 0 {
+			in.Orphans = append(in.Orphans, key)
+		}
+	}
+	in.Hubs = TopHubs(g, topHubs)
+
+	var comps [][]string
+	for _, comp := range ConnectedComponents(g) {
+		if len(comp) > 1 {
+			sort.Strings(comp)
+			comps = append(comps, comp)
+		}
+	}
+	sort.SliceStable(comps, func(i, j int) bool { return len(comps[i]) > len(comps[j]) })
+	if len(comps) > 1 {
+		in.Clusters = comps[1:]
+	}
+	return in
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func insightsTestGraph() Graph {
+	link := func(a, b int64) models.Link {
+		return models.Link{SourceType: "note", SourceID: a, TargetType: "note", TargetID: b, LinkType: models.LinkTypeRelated}
+	}
+	// Main body: 1 is a hub for 2, 3 and 4; 4 also links 5.
+	// Island: 6 <-> 7. Self link on 8 only.
+	return BuildGraphFromLinks([]models.Link{
+		link(1, 2), link(1, 3), link(1, 4), link(4, 5),
+		link(6, 7), link(8, 8),
+	}, nil)
+}
+
+func TestTraversalHelpers(t *testing.T) {
+	t.Parallel()
+
+	g := insightsTestGraph()
+	if got := Degree(g, NodeKey("note", 1)); got != 3 {
+		t.Fatalf("Degree(note:1) = %d, want 3", got)
+	}
+	if got := Degree(g, NodeKey("note", 8)); got != 0 {
+		t.Fatalf("self link should not count, Degree(note:8) = %d", got)
+	}
+	want := []string{"note:1", "note:5"}
+	if got := Neighbors(g, NodeKey("note", 4)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Neighbors(note:4) = %v, want %v", got, want)
+	}
+	want = []string{"note:2", "note:1", "note:3", "note:4", "note:5"}
+	if got := Reachable(g, NodeKey("note", 2)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Reachable(note:2) = %v, want %v", got, want)
+	}
+	if got := Reachable(g, "note:99"); got != nil {
+		t.Fatalf("Reachable(missing) = %v, want nil", got)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	g := insightsTestGraph()
+	keys := []string{"note:1", "note:8", "note:9", "note:6"}
+	in := Analyze(g, keys, 2)
+
+	if want := []string{"note:8", "note:9"}; !reflect.DeepEqual(in.Orphans, want) {
+		t.Fatalf("Orphans = %v, want %v", in.Orphans, want)
+	}
+	wantHubs := []NodeDegree{{Key: "note:1", Degree: 3}, {Key: "note:4", Degree: 2}}
+	if !reflect.DeepEqual(in.Hubs, wantHubs) {
+		t.Fatalf("Hubs = %v, want %v", in.Hubs, wantHubs)
+	}
+	wantClusters := [][]string{{"note:6", "note:7"}}
+	if !reflect.DeepEqual(in.Clusters, wantClusters) {
+		t.Fatalf("Clusters = %v, want %v", in.Clusters, wantClusters)
+	}
+}
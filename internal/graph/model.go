@@ -87,20 +87,9 @@ func ConnectedComponents(g Graph) [][]string {
 		if visited[start] {
 			continue
 		}
-		// BFS
-		queue := []string{start}
-		visited[start] = true
-		var comp []string
-		for len(queue) > 0 {
-			cur := queue[0]
-			queue = queue[1:]
-			comp = append(comp, cur)
-			for nb := range g.Adj[cur] {
-				if !visited[nb] {
-					visited[nb] = true
-					queue = append(queue, nb)
-				}
-			}
+		comp := Reachable(g, start)
+		for _, key := range comp {
+			visited[key] = true
 		}
 		comps = append(comps, comp)
 	}
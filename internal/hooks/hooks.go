@@ -0,0 +1,98 @@
+// Package hooks runs user-configured shell commands on app events.
+//
+// Phase 5: Focus Sessions - Distraction blocker hooks
+//   - Commands run through the platform shell (sh -c, or cmd /C on
+//     Windows) so users can call helpers, pipe and use env vars
+//   - Every command gets a timeout, so a hanging helper cannot freeze a
+//     focus session; on timeout the process is killed
+//   - All commands run even if one fails; errors are joined
+//
+// Phase 10: Sharing
+//   - Pipe feeds text to a command's stdin and returns its stdout, e.g.
+//     to publish a note with "gh gist create -"
+//
+// Usage:
+//
+//	err := hooks.Run(ctx, cfg.FocusBlockCommands, cfg.FocusHookTimeout())
+//	out, err := hooks.Pipe(ctx, cfg.ShareCommand, markdown, 30*time.Second)
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds each command when no timeout is configured.
+const DefaultTimeout = 10 * time.Second
+
+// Run runs commands in order, each bounded by timeout (DefaultTimeout
+// when zero or negative). It returns every failure joined together.
+func Run(ctx context.Context, commands []string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	var errs []error
+	for _, command := range commands {
+		if strings.TrimSpace(command) == "" {
+			continue
+		}
+		if err := runOne(ctx, command, timeout); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func runOne(ctx context.Context, command string, timeout time.Duration) error {
+	_, err := pipe(ctx, command, nil, timeout)
+	return err
+}
+
+// Pipe runs command with input on its stdin, bounded by timeout
+// (DefaultTimeout when zero or negative), and returns its stdout.
+func Pipe(ctx context.Context, command, input string, timeout time.Duration) (string, error) {
+	if strings.TrimSpace(command) == "" {
+		return "", errors.New("no command configured")
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return pipe(ctx, command, strings.NewReader(input), timeout)
+}
+
+func pipe(ctx context.Context, command string, stdin io.Reader, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	// Don't wait on grandchildren holding the output pipe after a kill
+	cmd.WaitDelay = time.Second
+	cmd.Stdin = stdin
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return "", fmt.Errorf("%q timed out after %s", command, timeout)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%q failed: %w: %s", command, err, msg)
+		}
+		return "", fmt.Errorf("%q failed: %w", command, err)
+	}
+	return stdout.String(), nil
+}
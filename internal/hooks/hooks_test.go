@@ -0,0 +1,59 @@
+//go:build !windows
+
+package hooks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunRunsEveryCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.txt")
+
+	err := Run(context.Background(), []string{
+		"echo block >> " + out,
+		"echo oops >&2; exit 3",
+		"",
+		"echo again >> " + out,
+	}, time.Second)
+	if err == nil || !strings.Contains(err.Error(), "oops") {
+		t.Fatalf("Run() err = %v, want the failing command's stderr", err)
+	}
+
+	data, _ := os.ReadFile(out)
+	if string(data) != "block\nagain\n" {
+		t.Errorf("output = %q, want both commands to have run", data)
+	}
+}
+
+func TestRunTimesOut(t *testing.T) {
+	start := time.Now()
+	err := Run(context.Background(), []string{"sleep 5"}, 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("Run() err = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Run() took %s, want it killed near the timeout", elapsed)
+	}
+}
+
+func TestPipeFeedsStdinAndReturnsStdout(t *testing.T) {
+	out, err := Pipe(context.Background(), "tr a-z A-Z", "# hello\n", time.Second)
+	if err != nil {
+		t.Fatalf("Pipe() err = %v", err)
+	}
+	if out != "# HELLO\n" {
+		t.Errorf("Pipe() = %q, want the command's stdout", out)
+	}
+
+	if _, err := Pipe(context.Background(), "  ", "x", time.Second); err == nil {
+		t.Error("Pipe() with an empty command should fail")
+	}
+	if _, err := Pipe(context.Background(), "echo denied >&2; exit 1", "x", time.Second); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("Pipe() err = %v, want the command's stderr", err)
+	}
+}
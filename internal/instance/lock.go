@@ -0,0 +1,159 @@
+// Package instance keeps two flowState TUIs from writing to the same
+// database at once.
+//
+// Phase 4: Robustness - Single-instance lock
+//   - Acquire takes an OS-level exclusive lock (flock, or LockFileEx on
+//     Windows) on a lock file next to the database and writes its PID
+//   - The OS drops the lock when its holder exits or crashes, so a lock
+//     file left behind is simply locked again; no takeover is needed and
+//     two instances cannot both win a race for it
+//   - A locked file is held even before its PID is written; Acquire waits
+//     briefly for the PID so the error can name the process
+//   - A lock held by a live process returns *RunningError so the caller
+//     can offer a read-only attach or abort
+//
+// Usage:
+//
+//	lock, err := instance.Acquire(instance.PathFor(cfg.DbPath))
+//	var running *instance.RunningError
+//	if errors.As(err, &running) { ... }
+//	defer lock.Release()
+package instance
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pidWait is how long Acquire waits for a new lock file's PID to appear.
+var pidWait = 500 * time.Millisecond
+
+// errLocked is returned by lockFile when another process holds the lock.
+var errLocked = errors.New("lock file is locked")
+
+// RunningError reports that another live process holds the lock. PID is
+// 0 when the lock file names no process.
+type RunningError struct {
+	PID  int
+	Path string
+}
+
+func (e *RunningError) Error() string {
+	if e.PID == 0 {
+		return fmt.Sprintf("flowState is starting (%s is locked but names no PID yet)", e.Path)
+	}
+	return fmt.Sprintf("flowState is already running (PID %d)", e.PID)
+}
+
+// Lock is a held instance lock. The lock lasts as long as f is open.
+type Lock struct {
+	path string
+	f    *os.File
+}
+
+// PathFor returns the lock file path for a database file.
+func PathFor(dbPath string) string {
+	return dbPath + ".lock"
+}
+
+// Acquire locks the lock file at path, creating it if needed, and writes
+// this process's PID to it. It returns *RunningError when a live process
+// already holds it.
+func Acquire(path string) (*Lock, error) {
+	// Retried when the holder releases and deletes the file between our
+	// open and lock, which leaves us locking a file no longer at path.
+	for attempt := 0; attempt < 3; attempt++ {
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("open lock file: %w", err)
+		}
+		err = lockFile(f)
+		if errors.Is(err, errLocked) {
+			f.Close()
+			pid, err := waitPID(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue // Released meanwhile
+			}
+			if err != nil {
+				return nil, &RunningError{Path: path}
+			}
+			return nil, &RunningError{PID: pid, Path: path}
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("lock file: %w", err)
+		}
+		if !isFileAt(f, path) {
+			f.Close()
+			continue
+		}
+
+		// Whatever a previous holder left in the file is replaced
+		if err := writePID(f); err != nil {
+			removeLocked(f, path)
+			return nil, fmt.Errorf("write lock file: %w", err)
+		}
+		return &Lock{path: path, f: f}, nil
+	}
+	return nil, fmt.Errorf("could not acquire lock file %s", path)
+}
+
+// Release unlocks and removes the lock file. It is safe to call on a nil
+// Lock.
+func (l *Lock) Release() error {
+	if l == nil || l.f == nil {
+		return nil
+	}
+	f := l.f
+	l.f = nil
+	return removeLocked(f, l.path)
+}
+
+// isFileAt reports whether f is still the file at path.
+func isFileAt(f *os.File, path string) bool {
+	open, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	return err == nil && os.SameFile(open, current)
+}
+
+// writePID replaces the contents of f with this process's PID.
+func writePID(f *os.File) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	_, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	return err
+}
+
+// waitPID reads the PID in a lock file, waiting up to pidWait for one
+// that was just created to have it written.
+func waitPID(path string) (int, error) {
+	deadline := time.Now().Add(pidWait)
+	for {
+		pid, err := readPID(path)
+		if err == nil || errors.Is(err, os.ErrNotExist) || time.Now().After(deadline) {
+			return pid, err
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// readPID parses the PID stored in a lock file.
+func readPID(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("invalid lock file %s", path)
+	}
+	return pid, nil
+}
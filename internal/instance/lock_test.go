@@ -0,0 +1,159 @@
+package instance
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAcquireAndRelease(t *testing.T) {
+	path := PathFor(filepath.Join(t.TempDir(), "flowState.db"))
+
+	lock, err := Acquire(path)
+	if err != nil {
+		t.Fatalf("Acquire() err = %v", err)
+	}
+	if pid, err := readPID(path); err != nil || pid != os.Getpid() {
+		t.Fatalf("lock file PID = %d, %v; want %d", pid, err, os.Getpid())
+	}
+
+	// A second instance sees the live holder
+	_, err = Acquire(path)
+	var running *RunningError
+	if !errors.As(err, &running) || running.PID != os.Getpid() {
+		t.Fatalf("second Acquire() err = %v, want RunningError for PID %d", err, os.Getpid())
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("Release() err = %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected lock file to be removed, stat err = %v", err)
+	}
+
+	lock, err = Acquire(path)
+	if err != nil {
+		t.Fatalf("Acquire() after release err = %v", err)
+	}
+	lock.Release()
+}
+
+func TestAcquireTakesOverStaleLock(t *testing.T) {
+	path := PathFor(filepath.Join(t.TempDir(), "flowState.db"))
+
+	if err := os.WriteFile(path, []byte("999999999\n"), 0644); err != nil {
+		t.Fatalf("write stale lock: %v", err)
+	}
+	lock, err := Acquire(path)
+	if err != nil {
+		t.Fatalf("Acquire() over a dead PID err = %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if got, want := string(data), strconv.Itoa(os.Getpid())+"\n"; got != want {
+		t.Errorf("lock file = %q, want %q", got, want)
+	}
+	lock.Release()
+}
+
+// holdLock locks the file at path with contents data, as another
+// instance would, until the test ends.
+func holdLock(t *testing.T, path string, data string) *os.File {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("open lock: %v", err)
+	}
+	t.Cleanup(func() { f.Close() })
+	if err := lockFile(f); err != nil {
+		t.Fatalf("lockFile() err = %v", err)
+	}
+	return f
+}
+
+func TestAcquireWaitsForNewLockPID(t *testing.T) {
+	path := PathFor(filepath.Join(t.TempDir(), "flowState.db"))
+
+	// Another instance has locked the file but not yet written its PID
+	f := holdLock(t, path, "")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}()
+	_, err := Acquire(path)
+	var running *RunningError
+	if !errors.As(err, &running) || running.PID != os.Getpid() {
+		t.Fatalf("Acquire() err = %v, want RunningError for the PID written late", err)
+	}
+
+	// A locked file that never names a PID is held, not taken over
+	if _, err := f.WriteAt([]byte("garbage"), 0); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+	_, err = Acquire(path)
+	if !errors.As(err, &running) || running.PID != 0 {
+		t.Fatalf("Acquire() over an unreadable lock err = %v, want RunningError without a PID", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "garbage" {
+		t.Errorf("lock file = %q, want it left alone", data)
+	}
+}
+
+func TestAcquireUnlockedFileWithoutPID(t *testing.T) {
+	path := PathFor(filepath.Join(t.TempDir(), "flowState.db"))
+
+	// Its owner crashed before writing the PID; nobody holds the lock
+	if err := os.WriteFile(path, []byte("garbage"), 0644); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+	lock, err := Acquire(path)
+	if err != nil {
+		t.Fatalf("Acquire() err = %v", err)
+	}
+	if pid, err := readPID(path); err != nil || pid != os.Getpid() {
+		t.Fatalf("lock file PID = %d, %v; want %d", pid, err, os.Getpid())
+	}
+	lock.Release()
+}
+
+func TestAcquireConcurrentTakeover(t *testing.T) {
+	path := PathFor(filepath.Join(t.TempDir(), "flowState.db"))
+
+	// Many instances race for a lock left behind by a crashed one
+	if err := os.WriteFile(path, []byte("999999999\n"), 0644); err != nil {
+		t.Fatalf("write stale lock: %v", err)
+	}
+	const racers = 16
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		locks []*Lock
+	)
+	for i := 0; i < racers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lock, err := Acquire(path)
+			var running *RunningError
+			if err != nil && !errors.As(err, &running) {
+				t.Errorf("Acquire() err = %v", err)
+			}
+			if lock != nil {
+				mu.Lock()
+				locks = append(locks, lock)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(locks) != 1 {
+		t.Fatalf("%d instances acquired the lock, want 1", len(locks))
+	}
+	locks[0].Release()
+}
@@ -0,0 +1,30 @@
+//go:build !windows
+
+package instance
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive flock on f without blocking. It returns
+// errLocked when another open file holds it. The kernel drops the lock
+// when its holder's file is closed, including when the process dies.
+func lockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return errLocked
+	}
+	return err
+}
+
+// removeLocked deletes the held lock file and then releases the lock, so
+// nobody can lock the file on its way out.
+func removeLocked(f *os.File, path string) error {
+	err := os.Remove(path)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+//go:build windows
+
+package instance
+
+import (
+	"errors"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockOffset is where the locked byte sits. Windows locks are mandatory,
+// so it is well past the PID to keep that readable by other processes.
+const lockOffset = 1 << 32
+
+// lockFile takes an exclusive LockFileEx lock on f without blocking. It
+// returns errLocked when another handle holds it. Windows drops the lock
+// when its holder's handle is closed, including when the process dies.
+func lockFile(f *os.File) error {
+	ol := &windows.Overlapped{Offset: lockOffset & 0xffffffff, OffsetHigh: lockOffset >> 32}
+	err := windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, ol)
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return errLocked
+	}
+	return err
+}
+
+// removeLocked releases the lock and deletes the lock file. Windows
+// cannot delete a file that is open, so it is closed first; if another
+// instance has opened it meanwhile, the delete fails and the file stays
+// for that instance.
+func removeLocked(f *os.File, path string) error {
+	if err := f.Close(); err != nil {
+		return err
+	}
+	_ = os.Remove(path)
+	return nil
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Due dates may carry a time of day. A due date at local midnight has
+// none: the todo is due some time that day, so it sorts after the
+// day's timed todos (see CompareDue).
+
+// dueTimeLayouts are the accepted ways of writing a due time.
+var dueTimeLayouts = []string{"15:04", "3pm", "3:04pm"}
+
+// ParseDue parses a due date with an optional time of day:
+// "2026-03-09", "2026-03-09 17:00", "today 5pm", "tomorrow 9:30",
+// "+3" or "+2w" (days or weeks from today), or a bare time ("17:00",
+// "5pm") for today. An empty string means no due date.
+func ParseDue(s string, now time.Time) (*time.Time, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if len(fields) > 2 {
+		return nil, fmt.Errorf("invalid due date %q (try 2026-03-09 17:00, tomorrow 9am or +3)", s)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	day, ok := parseDueDay(fields[0], today)
+	clock := fields[1:]
+	if !ok {
+		if len(fields) > 1 {
+			return nil, fmt.Errorf("invalid due date %q (use YYYY-MM-DD, today, tomorrow or +N)", fields[0])
+		}
+		// A bare time is due today
+		day, clock = today, fields
+	}
+
+	due := day
+	if len(clock) > 0 {
+		t, err := parseDueTime(clock[0])
+		if err != nil {
+			return nil, err
+		}
+		due = time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location())
+	}
+	return &due, nil
+}
+
+// parseDueDay parses the day part of a due date.
+func parseDueDay(s string, today time.Time) (time.Time, bool) {
+	switch s {
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+	if day, err := time.ParseInLocation("2006-01-02", s, today.Location()); err == nil {
+		return day, true
+	}
+	if rest, ok := strings.CutPrefix(s, "+"); ok {
+		unit := 1
+		if n, ok := strings.CutSuffix(rest, "w"); ok {
+			rest, unit = n, 7
+		} else {
+			rest = strings.TrimSuffix(rest, "d")
+		}
+		if n, err := strconv.Atoi(rest); err == nil && n >= 0 {
+			return today.AddDate(0, 0, n*unit), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseDueTime parses the time part of a due date.
+func parseDueTime(s string) (time.Time, error) {
+	for _, layout := range dueTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (try 17:00, 5pm or 5:30pm)", s)
+}
+
+// DueHasTime reports whether due carries a time of day.
+func DueHasTime(due time.Time) bool {
+	return due.Hour() != 0 || due.Minute() != 0
+}
+
+// DaysUntil returns the calendar days from now's date to due's, both in
+// now's location: 1 for any time tomorrow, even a minute away, and -1
+// for any time yesterday. Whole days are counted on dates rather than
+// hours, so DST changes do not shift them.
+func DaysUntil(due, now time.Time) int {
+	y, m, d := due.In(now.Location()).Date()
+	ny, nm, nd := now.Date()
+	days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Sub(time.Date(ny, nm, nd, 0, 0, 0, 0, time.UTC))
+	return int(days.Hours() / 24)
+}
+
+// FormatDueInput writes due the way ParseDue reads it back, e.g.
+// "2026-03-09" or "2026-03-09 17:00".
+func FormatDueInput(due time.Time) string {
+	if DueHasTime(due) {
+		return due.Format("2006-01-02 15:04")
+	}
+	return due.Format("2006-01-02")
+}
+
+// CompareDue orders due dates by day, then timed before untimed (which
+// are due by the end of the day), then by time. It returns -1, 0 or +1.
+func CompareDue(a, b time.Time) int {
+	ya, ma, da := a.Date()
+	yb, mb, db := b.Date()
+	if c := time.Date(ya, ma, da, 0, 0, 0, 0, time.UTC).Compare(time.Date(yb, mb, db, 0, 0, 0, 0, time.UTC)); c != 0 {
+		return c
+	}
+	switch ta, tb := DueHasTime(a), DueHasTime(b); {
+	case ta && !tb:
+		return -1
+	case !ta && tb:
+		return 1
+	}
+	return a.Compare(b)
+}
+
+// FormatCountdown renders the time from now until due in its two
+// largest units: "due in 3h 20m", "due in 2d 4h" or "overdue by 45m".
+func FormatCountdown(due, now time.Time) string {
+	d := due.Sub(now)
+	prefix := "due in "
+	if d < 0 {
+		d, prefix = -d, "overdue by "
+	}
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes == 0 {
+		return prefix + "<1m"
+	}
+
+	units := []struct {
+		size int
+		name string
+	}{{24 * 60, "d"}, {60, "h"}, {1, "m"}}
+	var parts []string
+	for _, u := range units {
+		n := minutes / u.size
+		minutes %= u.size
+		switch {
+		case n > 0:
+			parts = append(parts, strconv.Itoa(n)+u.name)
+		case len(parts) > 0:
+			// "2d 5m" reads as more precise than it is
+			return prefix + parts[0]
+		}
+		if len(parts) == 2 {
+			break
+		}
+	}
+	return prefix + strings.Join(parts, " ")
+}
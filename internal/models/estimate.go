@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseEstimate parses a human effort estimate into minutes.
+// Accepts plain minutes ("30"), Go-style durations ("45m", "1h30m")
+// and fractional hours ("1.5h"). An empty string means no estimate.
+func ParseEstimate(s string) (int, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if s == "" {
+		return 0, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("estimate must not be negative")
+		}
+		return n, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid estimate %q (try 30, 45m or 1h30m)", s)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("estimate must not be negative")
+	}
+	return int(d.Round(time.Minute) / time.Minute), nil
+}
+
+// FormatMinutes renders minutes compactly: "45m", "2h", "1h30m".
+// Returns an empty string for zero.
+func FormatMinutes(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%02dm", h, m)
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+)
+
+// HashtagPattern matches #hashtags in todo titles and descriptions.
+var HashtagPattern = regexp.MustCompile(`#(\w+)`)
+
+// ExtractHashtags returns the unique, lowercased #hashtags in text, in
+// order of first appearance.
+func ExtractHashtags(text string) []string {
+	matches := HashtagPattern.FindAllStringSubmatch(text, -1)
+	seen := make(map[string]bool)
+	var tags []string
+	for _, match := range matches {
+		if len(match) > 1 {
+			tag := strings.ToLower(match[1])
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
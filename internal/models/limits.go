@@ -0,0 +1,9 @@
+package models
+
+// Field limits (Phase 4: Robustness). Forms refuse to save longer values
+// and say why under the field; lengths count characters, not bytes.
+const (
+	MaxTitleLength       = 200   // Note and todo titles
+	MaxBodyLength        = 20000 // Note bodies
+	MaxDescriptionLength = 5000  // Todo descriptions
+)
@@ -31,6 +31,10 @@ import (
 // Phase 2: Notes
 //   - Tags are automatically extracted when note is saved
 //   - Supports filtering by tags in the UI
+//
+// Phase 6: Organization
+//   - NotebookID: Optional notebook the note is filed in (0 = unfiled)
+//   - Starred: Marked as a favorite for quick access
 type Note struct {
 	ID        int64     `json:"id"`
 	Title     string    `json:"title"`
@@ -38,6 +42,142 @@ type Note struct {
 	Tags      []string  `json:"tags"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	NotebookID int64 `json:"notebook_id,omitempty"`
+	Starred    bool  `json:"starred,omitempty"`
+}
+
+// PlaceholderTag marks a note created for a [[wikilink]] to a title that
+// had no note yet. Filling the note in drops the tag, since tags are
+// re-extracted from the text on save.
+const PlaceholderTag = "placeholder"
+
+// PlaceholderBody is the body a wikilink placeholder note is created with.
+const PlaceholderBody = "(Created from wikilink)"
+
+// IsPlaceholder reports whether the note is an unfilled wikilink
+// placeholder.
+func (n *Note) IsPlaceholder() bool {
+	for _, tag := range n.Tags {
+		if tag == PlaceholderTag {
+			return true
+		}
+	}
+	return false
+}
+
+// Notebook is a named folder of notes, for grouping notes without tags.
+//
+// Phase 6: Organization
+//   - Name: Unique, case-insensitively
+//   - A note belongs to at most one notebook; deleting a notebook leaves
+//     its notes unfiled
+type Notebook struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Pin is a notes or todos filter pinned to the home screen, where 1-9
+// open it.
+//
+// Phase 10: Home
+//   - Screen: "notes" or "todos"
+//   - Label: The filter as the status bar shows it, e.g. "#work stale"
+//   - Filter: Stored as JSON, so new filter fields need no migration
+type Pin struct {
+	ID        int64     `json:"id"`
+	Screen    string    `json:"screen"`
+	Label     string    `json:"label"`
+	Filter    PinFilter `json:"filter"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PinFilter is the filter state a pin restores. Fields that do not apply
+// to the pin's screen are left empty.
+type PinFilter struct {
+	Text     string        `json:"text,omitempty"`
+	Tags     []string      `json:"tags,omitempty"`
+	Notebook int64         `json:"notebook,omitempty"` // Notes: 0 = all, -1 = unfiled
+	Status   TodoStatus    `json:"status,omitempty"`
+	Priority *TodoPriority `json:"priority,omitempty"`
+	Project  string        `json:"project,omitempty"`
+	Stale    bool          `json:"stale,omitempty"`
+}
+
+// MaxPins is how many pins the home screen's 1-9 keys reach.
+const MaxPins = 9
+
+// Field is a custom key-value field on a note or todo, such as
+// client: acme or severity: high.
+//
+// Phase 6: Organization
+//   - Key: Lowercase letters, digits, _ and -; unique per item
+//   - Value: Free text; filtered on with key:value in the / filters
+type Field struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TagStyle is how a tag looks and what it implies, set on the Tags
+// screen.
+//
+// Phase 6: Organization
+//   - Color: Hex or ANSI color for the tag; "" for the theme's
+//   - Icon: Shown before the tag, e.g. "🔥"
+//   - Priority: Todos carrying the tag are raised to at least this
+//     priority when saved; nil for no default
+type TagStyle struct {
+	Tag      string        `json:"tag"`
+	Color    string        `json:"color,omitempty"`
+	Icon     string        `json:"icon,omitempty"`
+	Priority *TodoPriority `json:"priority,omitempty"`
+}
+
+// GoalKind is what a goal counts.
+type GoalKind string
+
+const (
+	GoalNotes GoalKind = "notes" // Notes created, with the goal's tag if set
+	GoalTodos GoalKind = "todos" // Todos completed, mentioning the tag if set
+	GoalFocus GoalKind = "focus" // Focus minutes, for the session label if set
+)
+
+// GoalPeriod is how often a goal starts over.
+type GoalPeriod string
+
+const (
+	GoalWeek  GoalPeriod = "week"
+	GoalMonth GoalPeriod = "month"
+)
+
+// Goal is a target for a week or month, such as "20 notes tagged
+// #thesis this month". Progress is counted from existing notes, todos
+// and focus sessions, never entered by hand.
+//
+// Phase 6: Planning
+//   - Tag: The tag notes and todos must carry, or the focus session
+//     label; "" counts everything
+//   - Target: A count, or minutes for GoalFocus
+type Goal struct {
+	ID        int64      `json:"id"`
+	Title     string     `json:"title"`
+	Kind      GoalKind   `json:"kind"`
+	Tag       string     `json:"tag,omitempty"`
+	Target    int        `json:"target"`
+	Period    GoalPeriod `json:"period"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Habit is something to do every day, checked off one day at a time.
+//
+// Phase 5: Habits
+//   - Check-offs are stored per calendar day, separately from the habit
+//   - Streaks are counted from the check-offs, like focus streaks
+type Habit struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // TodoStatus represents the status of a todo item.
@@ -46,6 +186,8 @@ type Note struct {
 //   - Pending: Task not started
 //   - InProgress: Task currently being worked on
 //   - Completed: Task finished
+//   - Any name from todo_statuses, e.g. "waiting" (see the workflow
+//     package); these are open, like pending
 type TodoStatus string
 
 const (
@@ -84,6 +226,13 @@ const (
 //   - Press SPACE to toggle status between pending/completed
 //   - Visual indicators: [ ] pending, [~] in progress, [x] completed
 //   - Priority shown as 🔴 (high), 🟢 (low), nothing (medium)
+//
+// Phase 6: Planning
+//   - EstimateMinutes: Optional effort estimate (0 = no estimate)
+//   - Project: Optional project name, distinct from #tags (contexts/topics)
+//   - DeferredUntil: Optional snooze; the todo is hidden from the default
+//     list until this time
+//   - Starred: Marked as a favorite for quick access
 type Todo struct {
 	ID          int64        `json:"id"`
 	Title       string       `json:"title"`
@@ -94,6 +243,12 @@ type Todo struct {
 	NoteID      *int64       `json:"note_id,omitempty"`
 	CreatedAt   time.Time    `json:"created_at"`
 	UpdatedAt   time.Time    `json:"updated_at"`
+
+	EstimateMinutes int        `json:"estimate_minutes,omitempty"`
+	Project         string     `json:"project,omitempty"`
+	DeferredUntil   *time.Time `json:"deferred_until,omitempty"`
+	Starred         bool       `json:"starred,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
 }
 
 // SessionStatus represents the status of a focus session.
@@ -121,15 +276,41 @@ const (
 //   - Pomodoro-style timer (25 min work, 5 min break)
 //   - Session history tracking
 //   - Daily/weekly statistics
+//
+// Phase 5: Focus Sessions
+//   - Label: Optional label typed when starting ("writing", "clientA");
+//     history can be filtered by it and stats aggregate per label
+//   - Energy: Optional 1-5 rating given when the session ended; 0 = not
+//     rated
 type FocusSession struct {
 	ID        int64         `json:"id"`
 	StartTime time.Time     `json:"start_time"`
 	EndTime   *time.Time    `json:"end_time,omitempty"`
 	Duration  int           `json:"duration"`
 	Status    SessionStatus `json:"status"`
+	Label     string        `json:"label,omitempty"`
+	Energy    int           `json:"energy,omitempty"`
 	CreatedAt time.Time     `json:"created_at"`
 }
 
+// PlannedSession is a focus block scheduled in advance, e.g. 45 minutes
+// on #thesis at 3pm.
+//
+// Phase 5: Focus Sessions
+//   - Start: When the block should begin; the app offers to start it then
+//   - Minutes: The block's length, used instead of the work duration
+//   - Label: The label the session gets, as with l on the focus screen
+//   - SessionID: The completed session started from the block; nil until
+//     then, so planned and actual focus can be compared
+type PlannedSession struct {
+	ID        int64     `json:"id"`
+	Start     time.Time `json:"start"`
+	Minutes   int       `json:"minutes"`
+	Label     string    `json:"label,omitempty"`
+	SessionID *int64    `json:"session_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 // LinkType represents the type of relationship between items.
 //
 // Phase 3: Linking System (upcoming)
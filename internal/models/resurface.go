@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseResurfaceDate parses the day a note should resurface in the inbox:
+// a date ("2006-01-02") or a count of days or weeks from today ("+3",
+// "+2w"). It returns the start of that day, which must be after today
+// and no more than MaxSnoozeYears away.
+func ParseResurfaceDate(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var day time.Time
+	if rest, ok := strings.CutPrefix(s, "+"); ok {
+		days := 1
+		if weeks, ok := strings.CutSuffix(rest, "w"); ok {
+			rest, days = weeks, 7
+		} else {
+			rest = strings.TrimSuffix(rest, "d")
+		}
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < 1 {
+			return time.Time{}, fmt.Errorf("invalid offset %q (try +3 or +2w)", s)
+		}
+		day = today.AddDate(0, 0, n*days)
+	} else {
+		parsed, err := time.ParseInLocation("2006-01-02", s, now.Location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD, +3 or +2w)", s)
+		}
+		day = parsed
+	}
+
+	if !day.After(today) {
+		return time.Time{}, fmt.Errorf("resurface date must be after today")
+	}
+	if day.After(today.AddDate(MaxSnoozeYears, 0, 0)) {
+		return time.Time{}, fmt.Errorf("resurface date must be within %d years", MaxSnoozeYears)
+	}
+	return day, nil
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SnoozeHour is the time of day snoozed todos wake up at when a preset
+// targets a whole day (tomorrow, next week, a picked date).
+const SnoozeHour = 9
+
+// MaxSnoozeYears is how far ahead a picked snooze date may be; anything
+// later is more likely a typo in the year than a plan.
+const MaxSnoozeYears = 5
+
+// SnoozeLaterToday returns the wake-up time for "later today": three
+// hours from now, rounded up to the hour.
+func SnoozeLaterToday(now time.Time) time.Time {
+	t := now.Add(3 * time.Hour).Truncate(time.Hour)
+	if t.Before(now.Add(3 * time.Hour)) {
+		t = t.Add(time.Hour)
+	}
+	return t
+}
+
+// SnoozeTomorrow returns the start of tomorrow's work day.
+func SnoozeTomorrow(now time.Time) time.Time {
+	return snoozeDay(now.AddDate(0, 0, 1))
+}
+
+// SnoozeNextWeek returns the start of the work day on which next week
+// begins, for weeks starting on weekStart.
+func SnoozeNextWeek(now time.Time, weekStart time.Weekday) time.Time {
+	days := (int(weekStart) - int(now.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return snoozeDay(now.AddDate(0, 0, days))
+}
+
+// ParseSnoozeDate parses a picked snooze date ("2006-01-02") and returns
+// the start of that work day. The result must be in the future, and no
+// more than MaxSnoozeYears away.
+func ParseSnoozeDate(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	day, err := time.ParseInLocation("2006-01-02", s, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD)", s)
+	}
+	until := snoozeDay(day)
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("snooze date must be in the future")
+	}
+	if until.After(now.AddDate(MaxSnoozeYears, 0, 0)) {
+		return time.Time{}, fmt.Errorf("snooze date must be within %d years", MaxSnoozeYears)
+	}
+	return until, nil
+}
+
+func snoozeDay(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), SnoozeHour, 0, 0, 0, day.Location())
+}
+
+// IsSnoozed reports whether the todo is deferred past now.
+func (t *Todo) IsSnoozed(now time.Time) bool {
+	return t.DeferredUntil != nil && t.DeferredUntil.After(now)
+}
@@ -0,0 +1,259 @@
+// Package notify posts milestone messages to a Slack or Discord webhook.
+//
+// Phase 10: Integrations
+//   - Milestones (a focus session completed, the daily focus goal hit, a
+//     streak milestone) are queued and posted by a background goroutine,
+//     so the TUI never waits on the network
+//   - Failed posts are retried with a growing delay; a 429 response's
+//     Retry-After is honoured
+//   - Posts are spaced at least MinInterval apart, and events arriving
+//     while the queue is full are dropped rather than blocking
+//   - Discord webhook URLs get a {"content": ...} payload, everything else
+//     the Slack-style {"text": ...}
+//
+// Usage:
+//
+//	n := notify.New(cfg.WebhookURL, cfg.WebhookEvents)
+//	defer n.Close(3 * time.Second)
+//	n.Notify(notify.Event{Kind: notify.EventSession, Text: "🍅 25 min focus session done"})
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event kinds, as listed in webhook_events.
+const (
+	EventSession   = "session"    // A focus work session completed
+	EventDailyGoal = "daily_goal" // Today's focus minutes reached the goal
+	EventStreak    = "streak"     // The focus streak reached a milestone
+)
+
+// Event is one milestone message.
+type Event struct {
+	Kind string
+	Text string
+}
+
+// Defaults for a Notifier created by New.
+const (
+	MinInterval = 2 * time.Second // Spacing between posts
+	MaxRetries  = 3               // Retries after the first failed post
+	QueueSize   = 16              // Events waiting to be posted
+)
+
+// maxRetryAfter caps how long a 429 response can make the notifier wait.
+const maxRetryAfter = time.Minute
+
+// Notifier posts events to one webhook. A nil *Notifier is valid and
+// discards every event, so callers need not check whether a webhook is
+// configured.
+type Notifier struct {
+	url    string
+	events map[string]bool // Kinds to post; nil posts every kind
+	client *http.Client
+	queue  chan Event
+
+	minInterval time.Duration
+	retries     int
+	backoff     time.Duration // Delay before the first retry, doubled after each
+
+	ctx       context.Context // Cancelled when Close gives up waiting
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex // Guards closed against Notify racing Close
+	closed    bool
+}
+
+// New starts a notifier for webhookURL that posts the given event kinds
+// (every kind when events is empty). It returns nil when webhookURL is
+// empty.
+func New(webhookURL string, events []string) *Notifier {
+	webhookURL = strings.TrimSpace(webhookURL)
+	if webhookURL == "" {
+		return nil
+	}
+	n := &Notifier{
+		url:         webhookURL,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		queue:       make(chan Event, QueueSize),
+		minInterval: MinInterval,
+		retries:     MaxRetries,
+		backoff:     time.Second,
+		done:        make(chan struct{}),
+	}
+	for _, kind := range events {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			if n.events == nil {
+				n.events = make(map[string]bool)
+			}
+			n.events[kind] = true
+		}
+	}
+	n.ctx, n.cancel = context.WithCancel(context.Background())
+	go n.run()
+	return n
+}
+
+// Enabled reports whether events of kind are posted.
+func (n *Notifier) Enabled(kind string) bool {
+	return n != nil && (n.events == nil || n.events[kind])
+}
+
+// Notify queues e for posting and returns at once. It reports whether the
+// event was queued; events of a disabled kind, or arriving while the queue
+// is full or after Close, are dropped.
+func (n *Notifier) Notify(e Event) bool {
+	if !n.Enabled(e.Kind) {
+		return false
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return false
+	}
+	select {
+	case n.queue <- e:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops accepting events and waits up to timeout for queued ones to
+// be posted; any still pending after that are abandoned. It is safe to
+// call more than once.
+func (n *Notifier) Close(timeout time.Duration) {
+	if n == nil {
+		return
+	}
+	n.closeOnce.Do(func() {
+		n.mu.Lock()
+		n.closed = true
+		close(n.queue)
+		n.mu.Unlock()
+
+		select {
+		case <-n.done:
+		case <-time.After(timeout):
+			n.cancel()
+			<-n.done
+		}
+		n.cancel()
+	})
+}
+
+// run posts queued events one at a time until the queue is closed.
+func (n *Notifier) run() {
+	defer close(n.done)
+	var last time.Time
+	for e := range n.queue {
+		if n.ctx.Err() != nil {
+			continue // Close gave up; drain without posting
+		}
+		if wait := n.minInterval - time.Since(last); !last.IsZero() && wait > 0 {
+			if !n.sleep(wait) {
+				continue
+			}
+		}
+		_ = n.post(e)
+		last = time.Now()
+	}
+}
+
+// post sends e, retrying failures. It returns the last error.
+func (n *Notifier) post(e Event) error {
+	body, err := json.Marshal(payload(n.url, e.Text))
+	if err != nil {
+		return err
+	}
+
+	delay := n.backoff
+	for attempt := 0; ; attempt++ {
+		wait, err := n.postOnce(body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= n.retries || wait < 0 {
+			return err
+		}
+		if wait == 0 {
+			wait = delay
+			delay *= 2
+		}
+		if !n.sleep(wait) {
+			return err
+		}
+	}
+}
+
+// postOnce makes one request. On failure it returns how long to wait
+// before retrying: 0 for the usual backoff, or -1 when retrying is
+// pointless (the webhook rejected the request).
+func (n *Notifier) postOnce(body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(n.ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return -1, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return 0, nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return retryAfter(resp.Header.Get("Retry-After")), fmt.Errorf("webhook rate limited: %s", resp.Status)
+	case resp.StatusCode >= 500:
+		return 0, fmt.Errorf("webhook failed: %s", resp.Status)
+	default:
+		return -1, fmt.Errorf("webhook rejected the message: %s", resp.Status)
+	}
+}
+
+// sleep waits for d, returning false if the notifier is cancelled first.
+func (n *Notifier) sleep(d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-n.ctx.Done():
+		return false
+	}
+}
+
+// retryAfter parses a Retry-After header given in seconds, capped at
+// maxRetryAfter. It returns 0 (use the usual backoff) when absent.
+func retryAfter(header string) time.Duration {
+	secs, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return min(time.Duration(secs)*time.Second, maxRetryAfter)
+}
+
+// payload builds the JSON body for the webhook at webhookURL.
+func payload(webhookURL, text string) map[string]string {
+	if u, err := url.Parse(webhookURL); err == nil {
+		host := strings.ToLower(u.Hostname())
+		if host == "discord.com" || host == "discordapp.com" || strings.HasSuffix(host, ".discord.com") {
+			return map[string]string{"content": text}
+		}
+	}
+	return map[string]string{"text": text}
+}
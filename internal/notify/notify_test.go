@@ -0,0 +1,128 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhook records the payloads posted to it, failing the first failures
+// requests with status.
+type webhook struct {
+	mu       sync.Mutex
+	payloads []map[string]string
+	times    []time.Time
+	failures int
+	status   int
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.failures > 0 {
+		w.failures--
+		rw.WriteHeader(w.status)
+		return
+	}
+	var p map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&p)
+	w.payloads = append(w.payloads, p)
+	w.times = append(w.times, time.Now())
+}
+
+func newTestNotifier(t *testing.T, w *webhook, events ...string) *Notifier {
+	t.Helper()
+	srv := httptest.NewServer(w)
+	t.Cleanup(srv.Close)
+	n := New(srv.URL, events)
+	n.minInterval = 50 * time.Millisecond
+	n.backoff = 10 * time.Millisecond
+	return n
+}
+
+func TestNotifyPostsAndFilters(t *testing.T) {
+	w := &webhook{}
+	n := newTestNotifier(t, w, EventSession, EventStreak)
+
+	if !n.Notify(Event{Kind: EventSession, Text: "one"}) {
+		t.Fatal("session event not queued")
+	}
+	if n.Notify(Event{Kind: EventDailyGoal, Text: "goal"}) {
+		t.Error("daily_goal event queued although not enabled")
+	}
+	n.Notify(Event{Kind: EventStreak, Text: "two"})
+	n.Close(2 * time.Second)
+
+	if len(w.payloads) != 2 || w.payloads[0]["text"] != "one" || w.payloads[1]["text"] != "two" {
+		t.Fatalf("payloads = %v, want one then two", w.payloads)
+	}
+	if gap := w.times[1].Sub(w.times[0]); gap < 50*time.Millisecond {
+		t.Errorf("posts %v apart, want at least the minimum interval", gap)
+	}
+	if n.Notify(Event{Kind: EventSession, Text: "late"}) {
+		t.Error("event queued after Close")
+	}
+	n.Close(time.Second) // Closing twice is fine
+}
+
+func TestNotifyRetries(t *testing.T) {
+	w := &webhook{failures: 2, status: http.StatusBadGateway}
+	n := newTestNotifier(t, w)
+	n.Notify(Event{Kind: EventSession, Text: "retried"})
+	n.Close(2 * time.Second)
+	if len(w.payloads) != 1 {
+		t.Fatalf("payloads = %v, want the message after two failures", w.payloads)
+	}
+
+	// A rejected message is not retried
+	w = &webhook{failures: 1, status: http.StatusBadRequest}
+	n = newTestNotifier(t, w)
+	n.Notify(Event{Kind: EventSession, Text: "bad"})
+	n.Notify(Event{Kind: EventSession, Text: "next"})
+	n.Close(2 * time.Second)
+	if len(w.payloads) != 1 || w.payloads[0]["text"] != "next" {
+		t.Fatalf("payloads = %v, want only the next message", w.payloads)
+	}
+}
+
+func TestNilNotifier(t *testing.T) {
+	var n *Notifier
+	if New("  ", nil) != nil {
+		t.Error("New() with no URL should return nil")
+	}
+	if n.Notify(Event{Kind: EventSession}) {
+		t.Error("nil notifier queued an event")
+	}
+	n.Close(time.Second)
+}
+
+func TestPayload(t *testing.T) {
+	tests := []struct {
+		url, key string
+	}{
+		{"https://hooks.slack.com/services/T0/B0/xyz", "text"},
+		{"https://discord.com/api/webhooks/1/abc", "content"},
+		{"https://ptb.discord.com/api/webhooks/1/abc", "content"},
+		{"https://example.com/hook?discord.com", "text"},
+	}
+	for _, tt := range tests {
+		if p := payload(tt.url, "hi"); p[tt.key] != "hi" {
+			t.Errorf("payload(%q) = %v, want key %q", tt.url, p, tt.key)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if got := retryAfter("3"); got != 3*time.Second {
+		t.Errorf("retryAfter(3) = %v", got)
+	}
+	if got := retryAfter("3600"); got != maxRetryAfter {
+		t.Errorf("retryAfter(3600) = %v, want cap", got)
+	}
+	if got := retryAfter("Wed, 21 Oct 2015 07:28:00 GMT"); got != 0 {
+		t.Errorf("retryAfter(date) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,151 @@
+// Package org writes flowState todos as an Emacs Org-mode file.
+//
+// The file is printed by `flowState todos export` so Emacs users can add
+// it to org-agenda-files and see flowState todos in their agenda. Like
+// the agenda and digest it has no TUI dependencies.
+//
+// Each todo becomes a top-level heading:
+//   - Keyword: TODO (pending), DOING (in progress) or DONE (completed),
+//     declared by a #+TODO line at the top of the file
+//   - Priority: [#A] for high and [#C] for low; medium is Org's default
+//     and gets no cookie
+//   - Tags: the todo's #hashtags, as :tag1:tag2:
+//   - DEADLINE: the due date; SCHEDULED: the snooze date, when set
+//   - Properties: the flowState link, project (as CATEGORY), estimate
+//     (as Effort) and creation time
+//   - Body: the description, indented so no line reads as a heading
+//
+// Usage:
+//
+//	todos, err := store.ListTodos()
+//	if err != nil { ... }
+//	org.WriteTodos(os.Stdout, todos)
+package org
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Header starts every exported file.
+const Header = "#+TITLE: flowState todos\n#+TODO: TODO DOING | DONE\n"
+
+// WriteTodos writes todos as Org headings, in the order given.
+func WriteTodos(w io.Writer, todos []models.Todo) error {
+	var b strings.Builder
+	b.WriteString(Header)
+	for i := range todos {
+		b.WriteString("\n")
+		writeTodo(&b, &todos[i])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeTodo writes one heading with its planning line, properties and body.
+func writeTodo(b *strings.Builder, todo *models.Todo) {
+	b.WriteString("* " + Keyword(todo.Status) + " ")
+	switch todo.Priority {
+	case models.TodoPriorityHigh:
+		b.WriteString("[#A] ")
+	case models.TodoPriorityLow:
+		b.WriteString("[#C] ")
+	}
+	b.WriteString(strings.Join(strings.Fields(todo.Title), " "))
+	if tags := Tags(models.ExtractHashtags(todo.Title + " " + todo.Description)); tags != "" {
+		b.WriteString(" " + tags)
+	}
+	b.WriteString("\n")
+
+	var planning []string
+	if todo.DueDate != nil {
+		planning = append(planning, "DEADLINE: "+Timestamp(*todo.DueDate, true))
+	}
+	if todo.DeferredUntil != nil {
+		planning = append(planning, "SCHEDULED: "+Timestamp(*todo.DeferredUntil, true))
+	}
+	if len(planning) > 0 {
+		b.WriteString("  " + strings.Join(planning, " ") + "\n")
+	}
+
+	b.WriteString("  :PROPERTIES:\n")
+	property(b, "FLOWSTATE", deeplink.URI(deeplink.KindTodo, todo.ID))
+	if todo.Project != "" {
+		property(b, "CATEGORY", todo.Project)
+	}
+	if todo.EstimateMinutes > 0 {
+		property(b, "Effort", fmt.Sprintf("%d:%02d", todo.EstimateMinutes/60, todo.EstimateMinutes%60))
+	}
+	if !todo.CreatedAt.IsZero() {
+		property(b, "CREATED", Timestamp(todo.CreatedAt, false))
+	}
+	b.WriteString("  :END:\n")
+
+	if desc := strings.TrimSpace(todo.Description); desc != "" {
+		for _, line := range strings.Split(desc, "\n") {
+			line = strings.TrimRight(line, " \t\r")
+			if line == "" {
+				b.WriteString("\n")
+				continue
+			}
+			b.WriteString("  " + line + "\n")
+		}
+	}
+}
+
+// property writes one line of a property drawer, values aligned.
+func property(b *strings.Builder, name, value string) {
+	fmt.Fprintf(b, "  %-12s %s\n", ":"+name+":", value)
+}
+
+// Keyword returns the Org TODO keyword for a status.
+func Keyword(status models.TodoStatus) string {
+	switch status {
+	case models.TodoStatusInProgress:
+		return "DOING"
+	case models.TodoStatusCompleted:
+		return "DONE"
+	default:
+		return "TODO"
+	}
+}
+
+// Tags formats tags as an Org tag string, e.g. ":work:home:". Characters
+// Org does not allow in tags become underscores. It returns "" for no tags.
+func Tags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	out := make([]string, len(tags))
+	for i, tag := range tags {
+		out[i] = strings.Map(func(r rune) rune {
+			switch {
+			case r == '_' || r == '@' || r == '#' || r == '%':
+				return r
+			case r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+				return r
+			}
+			return '_'
+		}, tag)
+	}
+	return ":" + strings.Join(out, ":") + ":"
+}
+
+// Timestamp formats t as an Org timestamp: active (<2026-03-09 Mon>) or
+// inactive ([2026-03-09 Mon]). The time of day is added unless t is at
+// midnight, which flowState uses for dates without a time.
+func Timestamp(t time.Time, active bool) string {
+	s := t.Format("2006-01-02 Mon")
+	if t.Hour() != 0 || t.Minute() != 0 {
+		s += t.Format(" 15:04")
+	}
+	if active {
+		return "<" + s + ">"
+	}
+	return "[" + s + "]"
+}
@@ -0,0 +1,93 @@
+package org
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestWriteTodos(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local)
+	snooze := time.Date(2026, 3, 6, 9, 30, 0, 0, time.Local)
+	created := time.Date(2026, 3, 1, 14, 5, 0, 0, time.Local)
+	todos := []models.Todo{
+		{
+			ID:              7,
+			Title:           "Ship  release #work",
+			Description:     "Checklist:\n* tag build\n\n#launch-day notes",
+			Status:          models.TodoStatusInProgress,
+			Priority:        models.TodoPriorityHigh,
+			DueDate:         &due,
+			DeferredUntil:   &snooze,
+			CreatedAt:       created,
+			EstimateMinutes: 90,
+			Project:         "Launch",
+		},
+		{ID: 8, Title: "Water plants", Status: models.TodoStatusCompleted, Priority: models.TodoPriorityMedium},
+		{ID: 9, Title: "Someday", Status: models.TodoStatusPending, Priority: models.TodoPriorityLow},
+	}
+
+	var b strings.Builder
+	if err := WriteTodos(&b, todos); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Header + `
+* DOING [#A] Ship release #work :work:launch:
+  DEADLINE: <2026-03-09 Mon> SCHEDULED: <2026-03-06 Fri 09:30>
+  :PROPERTIES:
+  :FLOWSTATE:  flowstate://todo/7
+  :CATEGORY:   Launch
+  :Effort:     1:30
+  :CREATED:    [2026-03-01 Sun 14:05]
+  :END:
+  Checklist:
+  * tag build
+
+  #launch-day notes
+
+* DONE Water plants
+  :PROPERTIES:
+  :FLOWSTATE:  flowstate://todo/8
+  :END:
+
+* TODO [#C] Someday
+  :PROPERTIES:
+  :FLOWSTATE:  flowstate://todo/9
+  :END:
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteTodos() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestKeyword(t *testing.T) {
+	t.Parallel()
+
+	tests := map[models.TodoStatus]string{
+		models.TodoStatusPending:    "TODO",
+		models.TodoStatusInProgress: "DOING",
+		models.TodoStatusCompleted:  "DONE",
+		"":                          "TODO",
+	}
+	for status, want := range tests {
+		if got := Keyword(status); got != want {
+			t.Errorf("Keyword(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestTags(t *testing.T) {
+	t.Parallel()
+
+	if got := Tags(nil); got != "" {
+		t.Errorf("Tags(nil) = %q, want empty", got)
+	}
+	if got, want := Tags([]string{"work", "q3-plan", "a.b"}), ":work:q3_plan:a_b:"; got != want {
+		t.Errorf("Tags() = %q, want %q", got, want)
+	}
+}
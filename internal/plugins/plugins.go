@@ -0,0 +1,323 @@
+// Package plugins runs external executables that extend flowState-cli.
+//
+// Phase 10: Integrations
+//   - Every executable file in ~/.config/flowState/plugins is a plugin,
+//     written in any language; plugins are discovered at startup by
+//     asking each one to describe itself
+//   - Each call runs the plugin once, writes one JSON request to its stdin
+//     and reads one JSON reply from its stdout, bounded by a timeout; a
+//     plugin keeps no connection to the app
+//   - A plugin registers actions, which the Plugins screen lists and runs,
+//     and subscribes to events, which are queued and delivered by a
+//     background goroutine so the TUI never waits on a plugin
+//
+// Protocol (one request per run, "protocol" is Protocol):
+//
+//	{"type":"describe","protocol":1}
+//	  -> {"name":"Gist","actions":[{"id":"sync","title":"Sync gists"}],"events":["session.completed"]}
+//	{"type":"action","protocol":1,"action":"sync"}
+//	  -> {"message":"3 gists synced"}    or    {"error":"not logged in"}
+//	{"type":"event","protocol":1,"event":"session.completed","data":{...}}
+//	  -> reply ignored
+//
+// Usage:
+//
+//	host := plugins.Discover(ctx, cfg.PluginDir(), 0)
+//	defer host.Close(3 * time.Second)
+//	reply, err := host.RunAction(ctx, host.Plugins[0], "sync")
+//	host.Emit(plugins.EventSessionCompleted, session)
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Protocol is the version of the request format, sent with every request.
+const Protocol = 1
+
+// Events a plugin can subscribe to.
+const (
+	EventStartup          = "startup"           // The TUI started; data has the database path
+	EventSessionCompleted = "session.completed" // A focus work session completed; data is the session
+)
+
+// Defaults for a Host created by Discover.
+const (
+	DefaultTimeout = 10 * time.Second // Bounds each plugin run
+	QueueSize      = 16               // Events waiting to be delivered
+)
+
+// Action is an action a plugin offers.
+type Action struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// Plugin is one discovered plugin executable.
+type Plugin struct {
+	Path    string   // Executable
+	Name    string   // From the description; the file name when empty
+	Actions []Action // Actions with an ID, titled by ID when untitled
+	Events  []string // Events it subscribes to
+}
+
+// Reply is a plugin's answer to an action.
+type Reply struct {
+	Message string `json:"message,omitempty"` // Shown in a toast
+	Error   string `json:"error,omitempty"`   // Reported as a failure
+}
+
+// request is the JSON written to a plugin's stdin.
+type request struct {
+	Type     string `json:"type"`
+	Protocol int    `json:"protocol"`
+	Action   string `json:"action,omitempty"`
+	Event    string `json:"event,omitempty"`
+	Data     any    `json:"data,omitempty"`
+}
+
+// description is a plugin's reply to a describe request.
+type description struct {
+	Name    string   `json:"name"`
+	Actions []Action `json:"actions"`
+	Events  []string `json:"events"`
+}
+
+// event is one queued event.
+type event struct {
+	name string
+	data any
+}
+
+// Host holds the plugins discovered in a directory and delivers events to
+// them. A nil *Host is valid and has no plugins, so callers need not check
+// whether plugins were loaded.
+type Host struct {
+	Dir     string
+	Plugins []*Plugin // Sorted by file name
+	Errors  []error   // Files that could not be described
+
+	timeout time.Duration
+	queue   chan event
+
+	ctx       context.Context // Cancelled when Close gives up waiting
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex // Guards closed against Emit racing Close
+	closed    bool
+}
+
+// Discover describes every executable in dir, concurrently, each run
+// bounded by timeout (DefaultTimeout when zero or negative). A missing
+// dir just has no plugins; a plugin that fails to describe itself is
+// left out and reported in Errors.
+func Discover(ctx context.Context, dir string, timeout time.Duration) *Host {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	h := &Host{Dir: dir, timeout: timeout, queue: make(chan event, QueueSize), done: make(chan struct{})}
+	h.ctx, h.cancel = context.WithCancel(context.Background())
+	go h.run()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			h.Errors = append(h.Errors, err)
+		}
+		return h
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || !isExecutable(info) {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(paths)
+
+	plugins := make([]*Plugin, len(paths))
+	errs := make([]error, len(paths))
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			plugins[i], errs[i] = h.describe(ctx, path)
+		}()
+	}
+	wg.Wait()
+
+	for i := range paths {
+		if errs[i] != nil {
+			h.Errors = append(h.Errors, errs[i])
+			continue
+		}
+		h.Plugins = append(h.Plugins, plugins[i])
+	}
+	return h
+}
+
+// isExecutable reports whether a file can be run as a plugin: any execute
+// bit, or on Windows an executable extension.
+func isExecutable(info os.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(info.Name())) {
+		case ".exe", ".bat", ".cmd":
+			return true
+		}
+		return false
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// describe asks the plugin at path for its name, actions and events.
+func (h *Host) describe(ctx context.Context, path string) (*Plugin, error) {
+	var d description
+	if err := h.call(ctx, path, request{Type: "describe"}, &d); err != nil {
+		return nil, err
+	}
+	p := &Plugin{Path: path, Name: strings.TrimSpace(d.Name), Events: d.Events}
+	if p.Name == "" {
+		p.Name = filepath.Base(path)
+	}
+	for _, a := range d.Actions {
+		if a.ID == "" {
+			continue
+		}
+		if a.Title == "" {
+			a.Title = a.ID
+		}
+		p.Actions = append(p.Actions, a)
+	}
+	return p, nil
+}
+
+// Subscribes reports whether the plugin wants the named event.
+func (p *Plugin) Subscribes(name string) bool {
+	return slices.Contains(p.Events, name)
+}
+
+// RunAction runs one of p's actions and returns its reply; no output is
+// an empty reply. A reply with an error is returned as an error.
+func (h *Host) RunAction(ctx context.Context, p *Plugin, action string) (Reply, error) {
+	var reply Reply
+	if err := h.call(ctx, p.Path, request{Type: "action", Action: action}, &reply); err != nil {
+		return Reply{}, err
+	}
+	if reply.Error != "" {
+		return reply, fmt.Errorf("%s: %s", p.Name, reply.Error)
+	}
+	return reply, nil
+}
+
+// Emit queues the named event for the plugins subscribed to it. It never
+// blocks: the event is dropped when the queue is full or the host closed.
+func (h *Host) Emit(name string, data any) {
+	if h == nil || !slices.ContainsFunc(h.Plugins, func(p *Plugin) bool { return p.Subscribes(name) }) {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return
+	}
+	select {
+	case h.queue <- event{name: name, data: data}:
+	default:
+	}
+}
+
+// Close stops accepting events and waits up to wait for the queued ones
+// to be delivered, then kills any plugin still running.
+func (h *Host) Close(wait time.Duration) {
+	if h == nil {
+		return
+	}
+	h.closeOnce.Do(func() {
+		h.mu.Lock()
+		h.closed = true
+		close(h.queue)
+		h.mu.Unlock()
+	})
+	select {
+	case <-h.done:
+	case <-time.After(wait):
+		h.cancel()
+		<-h.done
+	}
+	h.cancel()
+}
+
+// run delivers queued events, one plugin at a time, until Close. Event
+// failures are dropped: there is nobody to report them to.
+func (h *Host) run() {
+	defer close(h.done)
+	for e := range h.queue {
+		for _, p := range h.Plugins {
+			if p.Subscribes(e.name) && h.ctx.Err() == nil {
+				_ = h.call(h.ctx, p.Path, request{Type: "event", Event: e.name, Data: e.data}, nil)
+			}
+		}
+	}
+}
+
+// call runs the plugin at path with req on its stdin and decodes its
+// stdout into reply (ignored when nil).
+func (h *Host) call(ctx context.Context, path string, req request, reply any) error {
+	req.Protocol = Protocol
+	input, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Dir = filepath.Dir(path)
+	// Don't wait on grandchildren holding the output pipe after a kill
+	cmd.WaitDelay = time.Second
+	cmd.Stdin = bytes.NewReader(append(input, '\n'))
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	name := filepath.Base(path)
+	err = cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s timed out after %s", name, h.timeout)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s failed: %w: %s", name, err, msg)
+		}
+		return fmt.Errorf("%s failed: %w", name, err)
+	}
+	out := bytes.TrimSpace(stdout.Bytes())
+	if reply == nil || len(out) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(out, reply); err != nil {
+		return fmt.Errorf("%s replied with invalid JSON: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writePlugin writes an executable shell script plugin to dir.
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatalf("WriteFile() err = %v", err)
+	}
+	return path
+}
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+}
+
+// echoPlugin describes itself, replies to actions with the request it
+// got, and appends events to a log file.
+const echoPlugin = `read req
+case "$req" in
+*'"describe"'*) echo '{"name":"Echo","actions":[{"id":"say","title":"Say hi","description":"Replies"},{"title":"no id"}],"events":["session.completed"]}' ;;
+*'"action"'*) printf '{"message":"%s"}' "$(echo "$req" | tr -d '"')" ;;
+*'"event"'*) echo "$req" >> events.log ;;
+esac
+`
+
+func TestDiscover(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	writePlugin(t, dir, "b-echo", echoPlugin)
+	writePlugin(t, dir, "a-quiet", "cat >/dev/null\n")
+	writePlugin(t, dir, "c-broken", "echo not json\n")
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a plugin"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	writePlugin(t, dir, ".hidden", echoPlugin)
+
+	host := Discover(context.Background(), dir, 0)
+	defer host.Close(time.Second)
+
+	if len(host.Plugins) != 2 {
+		t.Fatalf("Plugins = %+v, want a-quiet and b-echo", host.Plugins)
+	}
+	quiet, echo := host.Plugins[0], host.Plugins[1]
+	if quiet.Name != "a-quiet" || len(quiet.Actions) != 0 {
+		t.Errorf("quiet plugin = %+v, want the file name and no actions", quiet)
+	}
+	if echo.Name != "Echo" || len(echo.Actions) != 1 || echo.Actions[0].Title != "Say hi" {
+		t.Errorf("echo plugin = %+v, want one titled action", echo)
+	}
+	if !echo.Subscribes(EventSessionCompleted) || echo.Subscribes(EventStartup) {
+		t.Errorf("echo events = %v", echo.Events)
+	}
+	if len(host.Errors) != 1 || !strings.Contains(host.Errors[0].Error(), "c-broken replied with invalid JSON") {
+		t.Errorf("Errors = %v, want the broken plugin", host.Errors)
+	}
+
+	missing := Discover(context.Background(), filepath.Join(dir, "missing"), 0)
+	defer missing.Close(time.Second)
+	if len(missing.Plugins) != 0 || len(missing.Errors) != 0 {
+		t.Errorf("a missing directory should have no plugins and no errors, got %+v", missing)
+	}
+}
+
+func TestRunAction(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	writePlugin(t, dir, "echo", echoPlugin)
+	writePlugin(t, dir, "fail", `read req
+case "$req" in
+*describe*) echo '{"actions":[{"id":"go"}]}' ;;
+*) echo '{"error":"not logged in"}' ;;
+esac
+`)
+	writePlugin(t, dir, "slow", `read req
+case "$req" in
+*describe*) echo '{"actions":[{"id":"wait"}]}' ;;
+*) sleep 5 ;;
+esac
+`)
+	host := Discover(context.Background(), dir, 0)
+	defer host.Close(time.Second)
+	if len(host.Plugins) != 3 {
+		t.Fatalf("Plugins = %+v, Errors = %v", host.Plugins, host.Errors)
+	}
+
+	reply, err := host.RunAction(context.Background(), host.Plugins[0], "say")
+	if err != nil {
+		t.Fatalf("RunAction() err = %v", err)
+	}
+	if want := "{type:action,protocol:1,action:say}"; reply.Message != want {
+		t.Errorf("Message = %q, want %q", reply.Message, want)
+	}
+
+	if _, err := host.RunAction(context.Background(), host.Plugins[1], "go"); err == nil || err.Error() != "fail: not logged in" {
+		t.Errorf("RunAction() err = %v, want the plugin's error", err)
+	}
+
+	host.timeout = 200 * time.Millisecond
+	if _, err := host.RunAction(context.Background(), host.Plugins[2], "wait"); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("RunAction() err = %v, want a timeout", err)
+	}
+}
+
+func TestEmit(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	writePlugin(t, dir, "echo", echoPlugin)
+	host := Discover(context.Background(), dir, 0)
+
+	host.Emit(EventStartup, nil) // not subscribed
+	host.Emit(EventSessionCompleted, map[string]int{"duration": 1500})
+	host.Close(5 * time.Second)
+	host.Emit(EventSessionCompleted, nil) // after Close: dropped
+
+	data, err := os.ReadFile(filepath.Join(dir, "events.log"))
+	if err != nil {
+		t.Fatalf("expected the event to be delivered: %v", err)
+	}
+	want := `{"type":"event","protocol":1,"event":"session.completed","data":{"duration":1500}}` + "\n"
+	if string(data) != want {
+		t.Errorf("events.log = %q, want %q", data, want)
+	}
+
+	var none *Host
+	none.Emit(EventStartup, nil)
+	none.Close(time.Second)
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/qdrant"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Vector backends (Phase 5: Semantic Search)
+//
+// Without a backend the vectors live in the database and a search
+// compares the query with each of them. A VectorBackend set with
+// SetVectorBackend, such as the app's persisted qdrant.VectorStore or a
+// Qdrant server, takes the searching over: it is sent every vector as
+// notes are indexed, while the database keeps its copy as the record the
+// index's model is checked against. IndexAllNotes sends only the vectors
+// that changed; when the backend holds a different number of vectors than
+// the database (a new collection, or notes deleted meanwhile) it is
+// emptied and sent all of them.
+
+// VectorBackend stores and searches note vectors outside the database.
+type VectorBackend interface {
+	AddEmbedding(noteID int64, embedding []float32, text string) error
+	DeleteEmbedding(noteID int64) error
+	Reset() error
+	SearchContext(ctx context.Context, query []float32, limit int) ([]qdrant.SearchResult, error)
+	GetStats() (int, error)
+	Close() error
+}
+
+var (
+	_ VectorBackend = (*qdrant.VectorStore)(nil)
+	_ VectorBackend = (*qdrant.Client)(nil)
+)
+
+// SetVectorBackend makes backend hold and search the vectors; nil goes
+// back to searching the database.
+func (s *SemanticSearch) SetVectorBackend(backend VectorBackend) {
+	s.backend = backend
+}
+
+// Close closes the vector backend, if any.
+func (s *SemanticSearch) Close() error {
+	if s.backend == nil {
+		return nil
+	}
+	return s.backend.Close()
+}
+
+// nearest returns up to limit notes whose vectors are most similar to
+// embedding, from the backend when there is one.
+func (s *SemanticSearch) nearest(ctx context.Context, embedding []float32, limit int) ([]sqlite.NoteVectorSearchResult, error) {
+	if s.backend == nil {
+		return s.store.SearchNoteEmbeddingsContext(ctx, embedding, limit)
+	}
+	found, err := s.backend.SearchContext(ctx, embedding, limit)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]sqlite.NoteVectorSearchResult, len(found))
+	for i, r := range found {
+		results[i] = sqlite.NoteVectorSearchResult{NoteID: r.NoteID, Score: r.Score}
+	}
+	return results, nil
+}
+
+// syncBackend prepares the backend for IndexAllNotes and reports whether
+// every vector must be sent to it: when its count differs from the
+// database's, it is emptied first.
+func (s *SemanticSearch) syncBackend(stored int) (bool, error) {
+	if s.backend == nil {
+		return false, nil
+	}
+	held, err := s.backend.GetStats()
+	if err != nil {
+		return false, err
+	}
+	if held == stored {
+		return false, nil
+	}
+	return true, s.backend.Reset()
+}
+
+// equalVectors reports whether a and b hold the same values.
+func equalVectors(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -10,6 +10,10 @@
 //   - VectorStore: Stores and searches vectors
 //   - SemanticSearch: Orchestrates the search pipeline
 //
+// The index records the embedding model it was built with. Once the
+// configured model differs, searching and indexing return ErrModelChanged
+// until RebuildIndex replaces the old vectors.
+//
 // Usage:
 //
 // Phase 5: Enable semantic search in your notes
@@ -22,13 +26,23 @@
 package search
 
 import (
+	"context"
+	"errors"
+	"strings"
+
 	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 )
 
+// ErrModelChanged is returned while the index holds the vectors of an
+// embedding model other than the configured one.
+var ErrModelChanged = errors.New("the embedding model changed; rebuild the search index (r on the Model screen)")
+
 type SemanticSearch struct {
 	embedder *embeddings.Embedder
 	store    *sqlite.Store
+	backend  VectorBackend // Searches the vectors instead of the store; may be nil
 }
 
 func New(embedder *embeddings.Embedder, store *sqlite.Store) *SemanticSearch {
@@ -53,23 +67,38 @@ func New(embedder *embeddings.Embedder, store *sqlite.Store) *SemanticSearch {
 //	    fmt.Printf("Match: %s (score: %.2f)\n", r.NoteText, r.Score)
 //	}
 func (s *SemanticSearch) Search(query string, limit int) ([]SearchResult, error) {
+	return s.SearchContext(context.Background(), query, limit)
+}
+
+// SearchContext is Search with cancellation. The search stops between
+// steps once ctx is done (e.g. the user typed a newer query) and returns
+// ctx.Err().
+func (s *SemanticSearch) SearchContext(ctx context.Context, query string, limit int) ([]SearchResult, error) {
 	if len(query) == 0 {
 		return []SearchResult{}, nil
 	}
+	if _, stale, err := s.NeedsRebuild(); err != nil {
+		return nil, err
+	} else if stale {
+		return nil, ErrModelChanged
+	}
 
 	queryEmbedding, err := s.embedder.EmbedSingle(query)
 	if err != nil {
 		return nil, err
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	results, err := s.store.SearchNoteEmbeddings(queryEmbedding, limit)
+	results, err := s.nearest(ctx, queryEmbedding, limit)
 	if err != nil {
 		return nil, err
 	}
 
 	searchResults := make([]SearchResult, 0, len(results))
 	for _, r := range results {
-		note, err := s.store.GetNote(r.NoteID)
+		note, err := s.store.GetNoteContext(ctx, r.NoteID)
 		if err != nil {
 			return nil, err
 		}
@@ -102,27 +131,89 @@ func (s *SemanticSearch) Search(query string, limit int) ([]SearchResult, error)
 //   - Stores in vector database
 //   - Available for search immediately
 func (s *SemanticSearch) IndexNote(noteID int64, text string) error {
+	if _, stale, err := s.NeedsRebuild(); err != nil {
+		return err
+	} else if stale {
+		return ErrModelChanged
+	}
+	return s.indexNote(noteID, text, true)
+}
+
+// indexNote is IndexNote without the model check. The vector goes to the
+// backend too when send is set or it changed.
+func (s *SemanticSearch) indexNote(noteID int64, text string, send bool) error {
 	embeddings, err := s.embedder.Embed([]string{text})
 	if err != nil {
 		return err
 	}
+	if s.backend != nil && !send {
+		old, ok, err := s.store.GetNoteEmbedding(noteID)
+		if err != nil {
+			return err
+		}
+		send = !ok || !equalVectors(old, embeddings[0])
+	}
 
-	return s.store.UpsertNoteEmbedding(noteID, embeddings[0])
+	if err := s.store.UpsertNoteEmbedding(noteID, embeddings[0]); err != nil {
+		return err
+	}
+	if s.backend != nil && send {
+		return s.backend.AddEmbedding(noteID, embeddings[0], "")
+	}
+	return nil
 }
 
 // RemoveNote removes a note from the search index.
 func (s *SemanticSearch) RemoveNote(noteID int64) error {
-	return s.store.DeleteNoteEmbedding(noteID)
+	if err := s.store.DeleteNoteEmbedding(noteID); err != nil {
+		return err
+	}
+	if s.backend != nil {
+		return s.backend.DeleteEmbedding(noteID)
+	}
+	return nil
 }
 
-// IndexAllNotes bulk-indexes all notes currently in the database.
+// IndexAllNotes bulk-indexes all notes currently in the database. The
+// first run records the embedding model the index is built with. Notes
+// not edited since their vector was stored keep it rather than being
+// embedded again; the backend is sent the stored vector when it needs
+// every one.
 func (s *SemanticSearch) IndexAllNotes() error {
+	index, stale, err := s.NeedsRebuild()
+	if err != nil {
+		return err
+	}
+	if stale {
+		return ErrModelChanged
+	}
+	if index.Model == "" {
+		info := s.embedder.GetModelInfo()
+		if err := s.store.ResetEmbeddingIndex(info.Name, info.Dimensions); err != nil {
+			return err
+		}
+	}
+	sendAll, err := s.syncBackend(index.Vectors)
+	if err != nil {
+		return err
+	}
+
 	notes, err := s.store.ListNotes()
 	if err != nil {
 		return err
 	}
+	embedded, err := s.store.NoteEmbeddingTimes()
+	if err != nil {
+		return err
+	}
 
 	for _, n := range notes {
+		if at, ok := embedded[n.ID]; ok && !at.Before(n.UpdatedAt) {
+			if err := s.resend(n.ID, sendAll); err != nil {
+				return err
+			}
+			continue
+		}
 		// ListNotes truncates body for performance; embed the full note.
 		full, err := s.store.GetNote(n.ID)
 		if err != nil {
@@ -135,13 +226,53 @@ func (s *SemanticSearch) IndexAllNotes() error {
 		if full.Body != "" {
 			text += "\n" + full.Body
 		}
-		if err := s.IndexNote(full.ID, text); err != nil {
+		if err := s.indexNote(full.ID, text, sendAll); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// resend sends the backend a note's stored vector when send is set.
+func (s *SemanticSearch) resend(noteID int64, send bool) error {
+	if s.backend == nil || !send {
+		return nil
+	}
+	vector, ok, err := s.store.GetNoteEmbedding(noteID)
+	if err != nil || !ok {
+		return err
+	}
+	return s.backend.AddEmbedding(noteID, vector, "")
+}
+
+// NeedsRebuild returns the stored index and whether it was built with an
+// embedding model other than the configured one. An index that was never
+// built needs no rebuild.
+func (s *SemanticSearch) NeedsRebuild() (sqlite.EmbeddingIndex, bool, error) {
+	index, err := s.store.EmbeddingIndex()
+	if err != nil {
+		return sqlite.EmbeddingIndex{}, false, err
+	}
+	info := s.embedder.GetModelInfo()
+	stale := index.Model != "" && (index.Model != info.Name || index.Dimensions != info.Dimensions)
+	return index, stale, nil
+}
+
+// RebuildIndex deletes the stored vectors and indexes every note with the
+// configured embedding model.
+func (s *SemanticSearch) RebuildIndex() error {
+	info := s.embedder.GetModelInfo()
+	if err := s.store.ResetEmbeddingIndex(info.Name, info.Dimensions); err != nil {
+		return err
+	}
+	if s.backend != nil {
+		if err := s.backend.Reset(); err != nil {
+			return err
+		}
+	}
+	return s.IndexAllNotes()
+}
+
 // SearchWithTagFilter searches and filters results to notes containing all requiredTags.
 func (s *SemanticSearch) SearchWithTagFilter(query string, limit int, requiredTags []string) ([]SearchResult, error) {
 	results, err := s.Search(query, limit)
@@ -168,6 +299,50 @@ func (s *SemanticSearch) SearchWithTagFilter(query string, limit int, requiredTa
 	return out, nil
 }
 
+// SearchWithFields searches and keeps the notes whose custom fields
+// match fields, ignoring case. More candidates than limit are ranked, so
+// filtering still leaves up to limit results.
+func (s *SemanticSearch) SearchWithFields(ctx context.Context, query string, limit int, fields map[string]string) ([]SearchResult, error) {
+	if len(fields) == 0 {
+		return s.SearchContext(ctx, query, limit)
+	}
+	results, err := s.SearchContext(ctx, query, limit*5)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]SearchResult, 0, limit)
+	for _, r := range results {
+		noteFields, err := s.store.GetFields("note", r.NoteID)
+		if err != nil {
+			return nil, err
+		}
+		if hasFields(noteFields, fields) {
+			out = append(out, r)
+		}
+		if len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func hasFields(noteFields []models.Field, required map[string]string) bool {
+	for key, value := range required {
+		found := false
+		for _, f := range noteFields {
+			if f.Key == key && strings.EqualFold(f.Value, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func hasAllTags(noteTags []string, required []string) bool {
 	if len(required) == 0 {
 		return true
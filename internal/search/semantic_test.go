@@ -1,12 +1,16 @@
 package search
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
 	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
 	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/qdrant"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 )
 
@@ -128,6 +132,37 @@ func TestSearchWithTagFilter(t *testing.T) {
 	}
 }
 
+func TestSearchWithFields(t *testing.T) {
+	t.Parallel()
+
+	store, searcher := newTestStoreAndSearcher(t)
+
+	n1 := &models.Note{Title: "A", Body: "kickoff meeting"}
+	n2 := &models.Note{Title: "B", Body: "kickoff meeting"}
+	for _, n := range []*models.Note{n1, n2} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := store.SetField("note", n1.ID, "client", "Acme"); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+	if err := store.SetField("note", n2.ID, "client", "globex"); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+
+	results, err := searcher.SearchWithFields(context.Background(), "kickoff meeting", 10, map[string]string{"client": "acme"})
+	if err != nil {
+		t.Fatalf("SearchWithFields() err = %v", err)
+	}
+	if len(results) != 1 || results[0].NoteID != n1.ID {
+		t.Fatalf("expected only n1 to match client:acme, got %+v", results)
+	}
+}
+
 func TestSearchEmptyQuery(t *testing.T) {
 	t.Parallel()
 
@@ -141,3 +176,184 @@ func TestSearchEmptyQuery(t *testing.T) {
 		t.Fatalf("expected 0 results for empty query, got %d", len(results))
 	}
 }
+
+func TestSearchContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	store, searcher := newTestStoreAndSearcher(t)
+
+	n := &models.Note{Title: "A", Body: "hello world"}
+	if err := store.CreateNote(n); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := searcher.SearchContext(ctx, "hello", 10); !errors.Is(err, context.Canceled) {
+		t.Fatalf("SearchContext() err = %v, want context.Canceled", err)
+	}
+}
+
+func TestRebuildIndexAfterModelChange(t *testing.T) {
+	t.Parallel()
+
+	store, searcher := newTestStoreAndSearcher(t)
+	note := &models.Note{Title: "Garden", Body: "plant tomatoes"}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+	if index, stale, err := searcher.NeedsRebuild(); err != nil || stale || index.Model != embeddings.DefaultModel {
+		t.Fatalf("NeedsRebuild() = %+v, %v, %v; want the default model, current", index, stale, err)
+	}
+
+	// The same database opened with a 768-dim model
+	emb, err := embeddings.New(&config.Config{ModelPath: t.TempDir(), EmbeddingModel: "bge-base-en-v1.5"})
+	if err != nil {
+		t.Fatalf("embeddings.New() err = %v", err)
+	}
+	changed := New(emb, store)
+	if _, stale, _ := changed.NeedsRebuild(); !stale {
+		t.Fatal("NeedsRebuild() = false after the model changed")
+	}
+	if _, err := changed.Search("tomatoes", 5); !errors.Is(err, ErrModelChanged) {
+		t.Errorf("Search() err = %v, want ErrModelChanged", err)
+	}
+	if err := changed.IndexAllNotes(); !errors.Is(err, ErrModelChanged) {
+		t.Errorf("IndexAllNotes() err = %v, want ErrModelChanged", err)
+	}
+
+	if err := changed.RebuildIndex(); err != nil {
+		t.Fatalf("RebuildIndex() err = %v", err)
+	}
+	index, stale, err := changed.NeedsRebuild()
+	if err != nil || stale || index.Model != "bge-base-en-v1.5" || index.Dimensions != 768 || index.Vectors != 1 {
+		t.Fatalf("NeedsRebuild() after the rebuild = %+v, %v, %v", index, stale, err)
+	}
+	if results, err := changed.Search("tomatoes", 5); err != nil || len(results) != 1 || results[0].NoteID != note.ID {
+		t.Errorf("Search() after the rebuild = %+v, %v", results, err)
+	}
+	// Now the old model's searcher is the stale one
+	if _, err := searcher.Search("tomatoes", 5); !errors.Is(err, ErrModelChanged) {
+		t.Errorf("old model Search() err = %v, want ErrModelChanged", err)
+	}
+}
+
+// countingBackend is an in-process VectorBackend that counts the vectors
+// sent to it.
+type countingBackend struct {
+	*qdrant.VectorStore
+	adds     int
+	searches int
+}
+
+func (b *countingBackend) AddEmbedding(noteID int64, embedding []float32, text string) error {
+	b.adds++
+	return b.VectorStore.AddEmbedding(noteID, embedding, text)
+}
+
+func (b *countingBackend) SearchContext(ctx context.Context, query []float32, limit int) ([]qdrant.SearchResult, error) {
+	b.searches++
+	return b.VectorStore.SearchContext(ctx, query, limit)
+}
+
+func TestSearchContextWithANN(t *testing.T) {
+	t.Parallel()
+
+	store, searcher := newTestStoreAndSearcher(t)
+	// Enough notes for the store to search through the HNSW graph
+	var notes []*models.Note
+	err := store.WithTx(func(tx *sqlite.Tx) error {
+		for i := 0; i < 1200; i++ {
+			n := &models.Note{Title: fmt.Sprintf("Note %d", i), Body: fmt.Sprintf("entry %d about topic %d", i, i%37)}
+			if err := tx.CreateNote(n); err != nil {
+				return err
+			}
+			notes = append(notes, n)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	backend, err := qdrant.New(&config.Config{ANNSearch: true})
+	if err != nil {
+		t.Fatalf("qdrant.New() err = %v", err)
+	}
+	searcher.SetVectorBackend(backend)
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+
+	for _, i := range []int{0, 417, 1199} {
+		want := notes[i]
+		results, err := searcher.SearchContext(context.Background(), want.Title+"\n"+want.Body, 5)
+		if err != nil || len(results) == 0 || results[0].NoteID != want.ID {
+			t.Errorf("SearchContext(%q) = %+v, %v; want note %d first", want.Title, results, err, want.ID)
+		}
+	}
+}
+
+func TestVectorBackend(t *testing.T) {
+	t.Parallel()
+
+	store, searcher := newTestStoreAndSearcher(t)
+	garden := &models.Note{Title: "Garden", Body: "plant tomatoes"}
+	taxes := &models.Note{Title: "Taxes", Body: "file the tax return"}
+	for _, n := range []*models.Note{garden, taxes} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	// Indexed before the backend was set up: the first sync sends all
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+	vectors, _ := qdrant.New(nil)
+	backend := &countingBackend{VectorStore: vectors}
+	searcher.SetVectorBackend(backend)
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+	if n, _ := backend.GetStats(); n != 2 || backend.adds != 2 {
+		t.Fatalf("backend holds %d vectors after %d adds, want 2 and 2", n, backend.adds)
+	}
+	// In sync, unchanged notes are not sent again
+	if err := searcher.IndexAllNotes(); err != nil || backend.adds != 2 {
+		t.Fatalf("IndexAllNotes() in sync err = %v, %d adds; want none more", err, backend.adds)
+	}
+
+	results, err := searcher.Search("tomatoes", 5)
+	if err != nil || len(results) == 0 || results[0].NoteID != garden.ID || backend.searches != 1 {
+		t.Fatalf("Search() = %+v, %v after %d backend searches; want the garden note from the backend", results, err, backend.searches)
+	}
+
+	if err := searcher.RemoveNote(taxes.ID); err != nil {
+		t.Fatalf("RemoveNote() err = %v", err)
+	}
+	if n, _ := backend.GetStats(); n != 1 {
+		t.Errorf("backend holds %d vectors after RemoveNote(), want 1", n)
+	}
+
+	// A backend that lost its vectors, such as a new collection, is sent
+	// them all again
+	if err := backend.Reset(); err != nil {
+		t.Fatalf("Reset() err = %v", err)
+	}
+	hike := &models.Note{Title: "Hike", Body: "walk the ridge"}
+	if err := store.CreateNote(hike); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+	if n, _ := backend.GetStats(); n != 3 {
+		t.Errorf("backend holds %d vectors after resyncing, want all 3 notes", n)
+	}
+}
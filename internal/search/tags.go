@@ -0,0 +1,104 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Tag suggestions (Phase 6: Organization)
+//   - Tags already in use whose words appear in the note score highest
+//   - The tags of the most similar notes (by embedding) add votes,
+//     weighted by how similar each note is
+//   - Only existing tags are suggested, so the vocabulary stays small
+//   - While the index waits for a rebuild (ErrModelChanged), only the
+//     words in the note count
+
+// suggestNeighbors is how many similar notes vote on tags.
+const suggestNeighbors = 5
+
+// minNeighborScore is the similarity a note needs to vote.
+const minNeighborScore = 0.5
+
+// minTagScore is the score a tag needs to be suggested: one mention in
+// the text, or votes from similar notes adding up to as much.
+const minTagScore = 0.5
+
+// SuggestTags proposes up to limit existing note tags for a note with the
+// given text, best first. noteID is the note itself, if already saved, so
+// it does not vote for its own tags.
+func (s *SemanticSearch) SuggestTags(ctx context.Context, noteID int64, text string, limit int) ([]string, error) {
+	if strings.TrimSpace(text) == "" || limit <= 0 {
+		return nil, nil
+	}
+	vocabulary, err := s.store.ListNoteTagsContext(ctx)
+	if err != nil || len(vocabulary) == 0 {
+		return nil, err
+	}
+
+	scores := make(map[string]float32)
+	words := " " + normalizeWords(text) + " "
+	for _, tag := range vocabulary {
+		if phrase := normalizeWords(tag); phrase != "" && strings.Contains(words, " "+phrase+" ") {
+			scores[tag] += 1
+		}
+	}
+
+	// Until a changed model's index is rebuilt, only the words count
+	var neighbors []sqlite.NoteVectorSearchResult
+	if _, stale, err := s.NeedsRebuild(); err != nil {
+		return nil, err
+	} else if !stale {
+		embedding, err := s.embedder.EmbedSingle(text)
+		if err != nil {
+			return nil, err
+		}
+		if neighbors, err = s.nearest(ctx, embedding, suggestNeighbors+1); err != nil {
+			return nil, err
+		}
+	}
+	for _, n := range neighbors {
+		if n.NoteID == noteID || n.Score < minNeighborScore {
+			continue
+		}
+		note, err := s.store.GetNoteContext(ctx, n.NoteID)
+		if err != nil {
+			return nil, err
+		}
+		if note == nil {
+			continue
+		}
+		for _, tag := range note.Tags {
+			scores[tag] += n.Score
+		}
+	}
+
+	tags := make([]string, 0, len(scores))
+	for tag, score := range scores {
+		if score >= minTagScore {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if scores[tags[i]] != scores[tags[j]] {
+			return scores[tags[i]] > scores[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags, nil
+}
+
+// normalizeWords lowercases text and turns everything but letters and
+// digits into single spaces, so "#Machine-Learning" matches "machine
+// learning".
+func normalizeWords(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
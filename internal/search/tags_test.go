@@ -0,0 +1,45 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestSuggestTags(t *testing.T) {
+	store, searcher := newTestStoreAndSearcher(t)
+
+	for _, n := range []*models.Note{
+		{Title: "Sprint review", Body: "Demo the new importer #work #meetings", Tags: []string{"work", "meetings"}},
+		{Title: "Sourdough", Body: "Feed the starter #baking", Tags: []string{"baking"}},
+		{Title: "Reading list", Body: "Books on machine learning #machine-learning", Tags: []string{"machine-learning"}},
+	} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := searcher.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+
+	// Words matching a tag suggest it; similar notes add votes for theirs
+	got, err := searcher.SuggestTags(context.Background(), 0, "Sprint review\nDemo the new importer #work #meetings\nmore on Machine Learning", 3)
+	if err != nil {
+		t.Fatalf("SuggestTags() err = %v", err)
+	}
+	if want := []string{"meetings", "work", "machine-learning"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTags() = %v, want %v", got, want)
+	}
+
+	if got, _ := searcher.SuggestTags(context.Background(), 0, "  ", 3); got != nil {
+		t.Errorf("SuggestTags(blank) = %v, want none", got)
+	}
+}
+
+func TestNormalizeWords(t *testing.T) {
+	if got := normalizeWords("#Machine-Learning, rocks!"); got != "machine learning rocks" {
+		t.Errorf("normalizeWords() = %q", got)
+	}
+}
@@ -0,0 +1,378 @@
+// Package spellcheck finds misspelled words in note text and suggests
+// corrections.
+//
+// Phase 3: Editor - Spell-check
+//   - A bundled English word list (words.txt, most common words first) is
+//     merged with an optional user dictionary, one word per line
+//   - Common inflections (-s, -es, -ed, -ing, -ly, -er, -est, 's) of a
+//     known word are accepted
+//   - Markdown that is not prose is skipped: fenced and inline code,
+//     #tags, @mentions, [[wikilinks]], URLs, words with digits and
+//     ALL-CAPS acronyms
+//   - Suggestions are known words within two edits, closest and most
+//     common first
+//
+// Usage:
+//
+//	checker, err := spellcheck.Load(cfg.DictionaryPath)
+//	bad := checker.Misspelled(note.Body)
+//	fixes := checker.Suggest("recieve", 5)
+package spellcheck
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed words.txt
+var bundledWords string
+
+// Checker checks words against the bundled and user dictionaries. It is
+// safe for concurrent use.
+type Checker struct {
+	mu       sync.RWMutex
+	rank     map[string]int // word -> position in the word lists (lower is more common)
+	userPath string
+}
+
+// Load returns a Checker with the bundled word list plus the words in
+// userPath. A missing user dictionary is not an error; userPath may be
+// empty to use the bundled list alone.
+func Load(userPath string) (*Checker, error) {
+	c := &Checker{rank: make(map[string]int), userPath: userPath}
+	if err := c.addWords(strings.NewReader(bundledWords)); err != nil {
+		return nil, fmt.Errorf("read bundled dictionary: %w", err)
+	}
+
+	if userPath == "" {
+		return c, nil
+	}
+	f, err := os.Open(userPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open dictionary: %w", err)
+	}
+	defer f.Close()
+	if err := c.addWords(f); err != nil {
+		return nil, fmt.Errorf("read dictionary %s: %w", userPath, err)
+	}
+	return c, nil
+}
+
+// addWords reads one word per line; blank lines and # comments are skipped.
+func (c *Checker) addWords(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		if _, ok := c.rank[word]; !ok {
+			c.rank[word] = len(c.rank)
+		}
+	}
+	return scanner.Err()
+}
+
+// Add accepts word from now on and appends it to the user dictionary.
+func (c *Checker) Add(word string) error {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.rank[word]; ok {
+		return nil
+	}
+	c.rank[word] = len(c.rank)
+
+	if c.userPath == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.userPath), 0755); err != nil {
+		return fmt.Errorf("create dictionary dir: %w", err)
+	}
+	f, err := os.OpenFile(c.userPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open dictionary: %w", err)
+	}
+	if _, err := f.WriteString(word + "\n"); err != nil {
+		f.Close()
+		return fmt.Errorf("write dictionary: %w", err)
+	}
+	return f.Close()
+}
+
+// Correct reports whether word is spelled correctly. Words the checker
+// does not judge (see Words) count as correct.
+func (c *Checker) Correct(word string) bool {
+	if !checkable(word) {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.known(strings.ReplaceAll(strings.ToLower(word), "’", "'"))
+}
+
+// known reports whether a lowercase word or a base form of it is in the
+// dictionary. Callers hold c.mu.
+func (c *Checker) known(word string) bool {
+	if _, ok := c.rank[word]; ok {
+		return true
+	}
+	for _, base := range baseForms(word) {
+		if _, ok := c.rank[base]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// baseForms strips common English inflections from a lowercase word.
+func baseForms(word string) []string {
+	var forms []string
+	add := func(suffix string, replacements ...string) {
+		if !strings.HasSuffix(word, suffix) || len(word)-len(suffix) < 2 {
+			return
+		}
+		stem := strings.TrimSuffix(word, suffix)
+		for _, r := range replacements {
+			forms = append(forms, stem+r)
+		}
+	}
+	add("'s", "")
+	add("s'", "")
+	add("s", "")
+	add("es", "")
+	add("ies", "y")
+	add("ied", "y")
+	add("ed", "", "e")
+	add("ing", "", "e")
+	add("ly", "", "le")
+	add("ily", "y")
+	add("er", "", "e")
+	add("est", "", "e")
+	// Doubled consonant: stopped, running, bigger
+	for _, suffix := range []string{"ed", "ing", "er", "est"} {
+		stem := strings.TrimSuffix(word, suffix)
+		if stem != word && len(stem) >= 3 && stem[len(stem)-1] == stem[len(stem)-2] {
+			forms = append(forms, stem[:len(stem)-1])
+		}
+	}
+	return forms
+}
+
+// Word is a checkable word in a line of text, with rune offsets.
+type Word struct {
+	Text       string
+	Start, End int
+}
+
+// Words returns the prose words of a single line: runs of letters with
+// inner apostrophes, skipping inline code, #tags, @mentions, [[wikilinks]]
+// and URLs. Fenced code blocks are handled by Misspelled.
+func Words(line string) []Word {
+	runes := []rune(line)
+	maskSpans(runes, "`", "`")
+	maskSpans(runes, "[[", "]]")
+
+	var words []Word
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+		token := string(runes[start:i])
+		if strings.HasPrefix(token, "#") || strings.HasPrefix(token, "@") ||
+			strings.Contains(token, "://") || strings.HasPrefix(token, "www.") || strings.Contains(token, "/") {
+			continue
+		}
+		for j := start; j < i; {
+			if !unicode.IsLetter(runes[j]) {
+				j++
+				continue
+			}
+			wordStart := j
+			for j < i && (isWordRune(runes[j]) || (isApostrophe(runes[j]) && j+1 < i && unicode.IsLetter(runes[j+1]))) {
+				j++
+			}
+			words = append(words, Word{Text: string(runes[wordStart:j]), Start: wordStart, End: j})
+		}
+	}
+	return words
+}
+
+// maskSpans blanks out open...close spans (inline code, wikilinks) so
+// they are not checked. An unclosed span runs to the end of the line.
+func maskSpans(runes []rune, open, close string) {
+	o, c := []rune(open), []rune(close)
+	for i := 0; i+len(o) <= len(runes); i++ {
+		if string(runes[i:i+len(o)]) != open {
+			continue
+		}
+		end := len(runes)
+		for j := i + len(o); j+len(c) <= len(runes); j++ {
+			if string(runes[j:j+len(c)]) == close {
+				end = j + len(c)
+				break
+			}
+		}
+		for k := i; k < end; k++ {
+			runes[k] = ' '
+		}
+		i = end - 1
+	}
+}
+
+// Misspelled returns the distinct misspelled words in text, in order of
+// first appearance. Lines inside ``` fences are skipped.
+func (c *Checker) Misspelled(text string) []string {
+	var bad []string
+	seen := make(map[string]bool)
+	inFence := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, w := range Words(line) {
+			if seen[w.Text] {
+				continue
+			}
+			seen[w.Text] = true
+			if !c.Correct(w.Text) {
+				bad = append(bad, w.Text)
+			}
+		}
+	}
+	return bad
+}
+
+// Suggest returns up to limit known words within two edits of word,
+// closest first and then most common first. The case of a capitalized
+// word is carried over.
+func (c *Checker) Suggest(word string, limit int) []string {
+	lower := strings.ToLower(word)
+	target := []rune(lower)
+
+	type candidate struct {
+		word       string
+		dist, rank int
+	}
+	var candidates []candidate
+
+	c.mu.RLock()
+	for w, rank := range c.rank {
+		if abs(len([]rune(w))-len(target)) > 2 || w == lower {
+			continue
+		}
+		if d := editDistance(target, []rune(w)); d <= 2 {
+			candidates = append(candidates, candidate{w, d, rank})
+		}
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].dist != candidates[j].dist {
+			return candidates[i].dist < candidates[j].dist
+		}
+		return candidates[i].rank < candidates[j].rank
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	capitalized := word != "" && unicode.IsUpper([]rune(word)[0])
+	out := make([]string, len(candidates))
+	for i, cand := range candidates {
+		out[i] = cand.word
+		if capitalized {
+			r := []rune(cand.word)
+			r[0] = unicode.ToUpper(r[0])
+			out[i] = string(r)
+		}
+	}
+	return out
+}
+
+// editDistance is the optimal string alignment distance: insertions,
+// deletions, substitutions and adjacent transpositions each cost one.
+func editDistance(a, b []rune) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// checkable reports whether word is prose the checker should judge:
+// at least two letters, no digits, and not an ALL-CAPS acronym.
+func checkable(word string) bool {
+	letters, upper := 0, 0
+	for _, r := range word {
+		switch {
+		case unicode.IsDigit(r):
+			return false
+		case unicode.IsLetter(r):
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters < 2 {
+		return false
+	}
+	return upper != letters
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,116 @@
+package spellcheck
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func newTestChecker(t *testing.T) *Checker {
+	t.Helper()
+	c, err := Load(filepath.Join(t.TempDir(), "dictionary.txt"))
+	if err != nil {
+		t.Fatalf("Load() err = %v", err)
+	}
+	return c
+}
+
+func TestCorrect(t *testing.T) {
+	c := newTestChecker(t)
+
+	for _, word := range []string{
+		"the", "The", "meeting", "meetings", "planned", "planning", "stopped",
+		"running", "tries", "carried", "quickly", "it's", "don’t", "Monday",
+		// Not judged: acronyms, digits, single letters
+		"API", "v2", "x",
+	} {
+		if !c.Correct(word) {
+			t.Errorf("Correct(%q) = false, want true", word)
+		}
+	}
+	for _, word := range []string{"recieve", "teh", "meetnig", "Wendesday"} {
+		if c.Correct(word) {
+			t.Errorf("Correct(%q) = true, want false", word)
+		}
+	}
+}
+
+func TestMisspelledSkipsMarkup(t *testing.T) {
+	c := newTestChecker(t)
+
+	text := "Teh meeting about #projx with @bobz\n" +
+		"See [[Qwzx notes]] and `fmtz.Printf` at https://exmple.com/pth\n" +
+		"```go\nfunc zzkq() {}\n```\n" +
+		"Shoud we recieve teh budget?"
+	got := c.Misspelled(text)
+	want := []string{"Teh", "Shoud", "recieve", "teh"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Misspelled() = %q, want %q", got, want)
+	}
+}
+
+func TestWordsOffsets(t *testing.T) {
+	got := Words("I can't go — #tag ok")
+	want := []Word{
+		{Text: "I", Start: 0, End: 1},
+		{Text: "can't", Start: 2, End: 7},
+		{Text: "go", Start: 8, End: 10},
+		{Text: "ok", Start: 18, End: 20},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Words() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	c := newTestChecker(t)
+
+	tests := []struct {
+		word string
+		want string // expected first suggestion
+	}{
+		{"teh", "the"},
+		{"recieve", "receive"},
+		{"meetnig", "meeting"},
+		{"Shoud", "Should"},
+	}
+	for _, tt := range tests {
+		got := c.Suggest(tt.word, 5)
+		if len(got) == 0 || got[0] != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q first", tt.word, got, tt.want)
+		}
+		if len(got) > 5 {
+			t.Errorf("Suggest(%q) returned %d suggestions, want at most 5", tt.word, len(got))
+		}
+	}
+}
+
+func TestAddPersistsToUserDictionary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "dictionary.txt")
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() err = %v", err)
+	}
+	if c.Correct("flowstate") {
+		t.Fatalf("expected flowstate to be unknown before Add")
+	}
+	if err := c.Add("flowState"); err != nil {
+		t.Fatalf("Add() err = %v", err)
+	}
+	if !c.Correct("flowState") {
+		t.Errorf("expected flowState to be correct after Add")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "flowstate\n" {
+		t.Fatalf("user dictionary = %q, %v; want %q", data, err, "flowstate\n")
+	}
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() err = %v", err)
+	}
+	if !reloaded.Correct("flowstates") {
+		t.Errorf("expected a reloaded checker to know flowstates")
+	}
+}
@@ -0,0 +1,284 @@
+package qdrant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+// Qdrant server backend (Phase 5: Semantic Search)
+//
+// Client stores and searches note vectors in a Qdrant server through its
+// REST API, for indexes too large to search in process. It is chosen with
+// vector_backend "qdrant" and talks to qdrant_url, sending
+// qdrant_api_key when set. The collection (qdrant_collection) is created
+// on the first write with the vectors' size and cosine distance. Points
+// are keyed by note ID and carry no payload: only vectors leave the
+// machine, never note text.
+
+// DefaultCollection is the Qdrant collection used when none is configured.
+const DefaultCollection = "flowstate_notes"
+
+// availableTimeout bounds IsAvailable, which runs at startup.
+const availableTimeout = 2 * time.Second
+
+// Client is a Qdrant server holding note vectors.
+type Client struct {
+	baseURL    string
+	collection string
+	apiKey     string
+	http       *http.Client
+
+	mu         sync.Mutex
+	dimensions int // Vector size of the collection, once known
+}
+
+// NewClient creates a client for the Qdrant server configured in cfg.
+func NewClient(cfg *config.Config) (*Client, error) {
+	return NewClientWithHTTPClient(cfg, &http.Client{Timeout: 30 * time.Second})
+}
+
+// NewClientWithHTTPClient is NewClient with a given HTTP client, e.g. a
+// test server's.
+func NewClientWithHTTPClient(cfg *config.Config, client *http.Client) (*Client, error) {
+	raw := strings.TrimSpace(cfg.QdrantUrl)
+	if raw == "" {
+		return nil, errors.New("qdrant_url is not set")
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	base, err := url.Parse(raw)
+	if err != nil || base.Host == "" {
+		return nil, fmt.Errorf("invalid qdrant_url %q", cfg.QdrantUrl)
+	}
+	collection := strings.TrimSpace(cfg.QdrantCollection)
+	if collection == "" {
+		collection = DefaultCollection
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(base.String(), "/"),
+		collection: collection,
+		apiKey:     cfg.QdrantAPIKey,
+		http:       client,
+	}, nil
+}
+
+// Close releases nothing; the server keeps the vectors.
+func (c *Client) Close() error {
+	return nil
+}
+
+// IsAvailable reports whether the server answers, waiting two seconds
+// at most.
+func (c *Client) IsAvailable() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), availableTimeout)
+	defer cancel()
+	return c.do(ctx, http.MethodGet, "/collections", nil, nil) == nil
+}
+
+// AddEmbedding stores or replaces a note's vector. text is not sent.
+func (c *Client) AddEmbedding(noteID int64, embedding []float32, text string) error {
+	if noteID < 0 {
+		return fmt.Errorf("note ID %d cannot be a Qdrant point ID", noteID)
+	}
+	ctx := context.Background()
+	if err := c.ensureCollection(ctx, len(embedding)); err != nil {
+		return err
+	}
+	body := map[string]any{
+		"points": []map[string]any{{"id": noteID, "vector": embedding}},
+	}
+	return c.do(ctx, http.MethodPut, c.collectionPath("/points?wait=true"), body, nil)
+}
+
+// DeleteEmbedding removes a note's vector.
+func (c *Client) DeleteEmbedding(noteID int64) error {
+	body := map[string]any{"points": []int64{noteID}}
+	err := c.do(context.Background(), http.MethodPost, c.collectionPath("/points/delete?wait=true"), body, nil)
+	if isNotFound(err) {
+		return nil // No collection, so nothing to delete
+	}
+	return err
+}
+
+// Reset deletes the collection with every vector in it; the next
+// AddEmbedding creates it again, sized for the new vectors.
+func (c *Client) Reset() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.do(context.Background(), http.MethodDelete, c.collectionPath(""), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	c.dimensions = 0
+	return nil
+}
+
+// SearchContext returns up to limit notes most similar to query, best
+// first. NoteText is empty since no text is stored.
+func (c *Client) SearchContext(ctx context.Context, query []float32, limit int) ([]SearchResult, error) {
+	if limit <= 0 {
+		return []SearchResult{}, nil
+	}
+	var resp struct {
+		Result []struct {
+			ID    int64   `json:"id"`
+			Score float32 `json:"score"`
+		} `json:"result"`
+	}
+	body := map[string]any{"vector": query, "limit": limit}
+	err := c.do(ctx, http.MethodPost, c.collectionPath("/points/search"), body, &resp)
+	if isNotFound(err) {
+		return []SearchResult{}, nil // Nothing indexed yet
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := make([]SearchResult, len(resp.Result))
+	for i, r := range resp.Result {
+		results[i] = SearchResult{NoteID: r.ID, Score: r.Score}
+	}
+	return results, nil
+}
+
+// GetStats returns the number of vectors in the collection.
+func (c *Client) GetStats() (int, error) {
+	var resp struct {
+		Result struct {
+			Count int `json:"count"`
+		} `json:"result"`
+	}
+	err := c.do(context.Background(), http.MethodPost, c.collectionPath("/points/count"), map[string]any{"exact": true}, &resp)
+	if isNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.Result.Count, nil
+}
+
+// ensureCollection creates the collection for vectors of size dims if it
+// does not exist, and checks that an existing one has that size.
+func (c *Client) ensureCollection(ctx context.Context, dims int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if dims == 0 {
+		return errors.New("embedding is empty")
+	}
+	if c.dimensions == 0 {
+		var resp struct {
+			Result struct {
+				Config struct {
+					Params struct {
+						Vectors struct {
+							Size int `json:"size"`
+						} `json:"vectors"`
+					} `json:"params"`
+				} `json:"config"`
+			} `json:"result"`
+		}
+		err := c.do(ctx, http.MethodGet, c.collectionPath(""), nil, &resp)
+		switch {
+		case isNotFound(err):
+			body := map[string]any{"vectors": map[string]any{"size": dims, "distance": "Cosine"}}
+			if err := c.do(ctx, http.MethodPut, c.collectionPath(""), body, nil); err != nil {
+				return fmt.Errorf("failed to create qdrant collection %s: %w", c.collection, err)
+			}
+			c.dimensions = dims
+		case err != nil:
+			return err
+		default:
+			c.dimensions = resp.Result.Config.Params.Vectors.Size
+		}
+	}
+	if dims != c.dimensions {
+		return fmt.Errorf("qdrant collection %s holds %d-dim vectors, got %d; rebuild the search index", c.collection, c.dimensions, dims)
+	}
+	return nil
+}
+
+// collectionPath returns the API path of the collection followed by rest.
+func (c *Client) collectionPath(rest string) string {
+	return "/collections/" + url.PathEscape(c.collection) + rest
+}
+
+// statusError is a Qdrant reply other than 2xx.
+type statusError struct {
+	status  int
+	message string
+}
+
+func (e *statusError) Error() string {
+	if e.message != "" {
+		return fmt.Sprintf("qdrant: %s (%d)", e.message, e.status)
+	}
+	return fmt.Sprintf("qdrant: status %d", e.status)
+}
+
+// isNotFound reports whether err is Qdrant's 404, e.g. for a collection
+// that was never created.
+func isNotFound(err error) bool {
+	var se *statusError
+	return errors.As(err, &se) && se.status == http.StatusNotFound
+}
+
+// do sends a request with body as JSON and decodes the reply into out.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("api-key", c.apiKey)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("qdrant: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var reply struct {
+			Status struct {
+				Error string `json:"error"`
+			} `json:"status"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&reply)
+		return &statusError{status: resp.StatusCode, message: reply.Status.Error}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("qdrant: invalid reply: %w", err)
+	}
+	return nil
+}
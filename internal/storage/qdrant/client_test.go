@@ -0,0 +1,224 @@
+package qdrant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+// fakeQdrant serves the part of Qdrant's REST API the client uses, one
+// collection at a time, searching with a VectorStore.
+type fakeQdrant struct {
+	mu         sync.Mutex
+	collection string
+	size       int
+	points     *VectorStore
+	apiKeys    []string
+}
+
+func (f *fakeQdrant) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apiKeys = append(f.apiKeys, r.Header.Get("api-key"))
+
+	reply := func(result any) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result, "status": "ok"})
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": map[string]string{"error": "Not found: collection"}})
+	}
+	if r.URL.Path == "/collections" {
+		reply(map[string]any{"collections": []any{}})
+		return
+	}
+	rest, ok := strings.CutPrefix(r.URL.Path, "/collections/"+f.collection)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var body struct {
+		Vectors struct {
+			Size int `json:"size"`
+		} `json:"vectors"`
+		Points json.RawMessage `json:"points"`
+		Vector []float32       `json:"vector"`
+		Limit  int             `json:"limit"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	if rest == "" && r.Method == http.MethodPut {
+		f.size = body.Vectors.Size
+		f.points, _ = New(nil)
+		reply(true)
+		return
+	}
+	if f.points == nil {
+		notFound()
+		return
+	}
+	switch {
+	case rest == "" && r.Method == http.MethodGet:
+		reply(map[string]any{"config": map[string]any{"params": map[string]any{"vectors": map[string]any{"size": f.size}}}})
+	case rest == "" && r.Method == http.MethodDelete:
+		f.points = nil
+		reply(true)
+	case rest == "/points" && r.Method == http.MethodPut:
+		var points []struct {
+			ID     int64     `json:"id"`
+			Vector []float32 `json:"vector"`
+		}
+		_ = json.Unmarshal(body.Points, &points)
+		for _, p := range points {
+			if len(p.Vector) != f.size {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = f.points.AddEmbedding(p.ID, p.Vector, "")
+		}
+		reply(map[string]string{"status": "completed"})
+	case rest == "/points/delete":
+		var ids []int64
+		_ = json.Unmarshal(body.Points, &ids)
+		for _, id := range ids {
+			_ = f.points.DeleteEmbedding(id)
+		}
+		reply(map[string]string{"status": "completed"})
+	case rest == "/points/search":
+		found := f.points.Search(body.Vector, body.Limit)
+		hits := make([]map[string]any, len(found))
+		for i, r := range found {
+			hits[i] = map[string]any{"id": r.NoteID, "version": 0, "score": r.Score}
+		}
+		reply(hits)
+	case rest == "/points/count":
+		n, _ := f.points.GetStats()
+		reply(map[string]int{"count": n})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newFakeQdrantClient(t *testing.T, cfg *config.Config) (*Client, *fakeQdrant) {
+	t.Helper()
+	fake := &fakeQdrant{collection: DefaultCollection}
+	if cfg.QdrantCollection != "" {
+		fake.collection = cfg.QdrantCollection
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	cfg.QdrantUrl = strings.TrimPrefix(server.URL, "http://")
+	client, err := NewClientWithHTTPClient(cfg, server.Client())
+	if err != nil {
+		t.Fatalf("NewClientWithHTTPClient() err = %v", err)
+	}
+	return client, fake
+}
+
+func TestClientStoresAndSearches(t *testing.T) {
+	t.Parallel()
+
+	client, fake := newFakeQdrantClient(t, &config.Config{QdrantCollection: "notes", QdrantAPIKey: "secret"})
+	if !client.IsAvailable() {
+		t.Fatal("IsAvailable() = false for a running server")
+	}
+	if n, err := client.GetStats(); err != nil || n != 0 {
+		t.Fatalf("GetStats() before any write = %d, %v; want 0", n, err)
+	}
+	if results, err := client.SearchContext(context.Background(), []float32{1, 0, 0}, 5); err != nil || len(results) != 0 {
+		t.Fatalf("SearchContext() before any write = %+v, %v; want none", results, err)
+	}
+
+	for id, emb := range map[int64][]float32{1: {1, 0, 0}, 2: {0, 1, 0}, 3: {0.7, 0.7, 0}} {
+		if err := client.AddEmbedding(id, emb, "private text"); err != nil {
+			t.Fatalf("AddEmbedding(%d) err = %v", id, err)
+		}
+	}
+	if fake.size != 3 {
+		t.Errorf("collection created with size %d, want 3", fake.size)
+	}
+	if err := client.AddEmbedding(4, []float32{1, 0}, ""); err == nil {
+		t.Error("AddEmbedding() of a 2-dim vector into a 3-dim collection err = nil")
+	}
+
+	results, err := client.SearchContext(context.Background(), []float32{1, 0.1, 0}, 2)
+	if err != nil || len(results) != 2 || results[0].NoteID != 1 || results[1].NoteID != 3 {
+		t.Fatalf("SearchContext() = %+v, %v; want notes 1 and 3", results, err)
+	}
+	if results[0].Score <= results[1].Score || results[0].NoteText != "" {
+		t.Errorf("SearchContext() = %+v; want best first and no text", results)
+	}
+
+	if err := client.DeleteEmbedding(1); err != nil {
+		t.Fatalf("DeleteEmbedding() err = %v", err)
+	}
+	if n, err := client.GetStats(); err != nil || n != 2 {
+		t.Errorf("GetStats() after a delete = %d, %v; want 2", n, err)
+	}
+
+	// A reset collection is created again at the next vectors' size
+	if err := client.Reset(); err != nil {
+		t.Fatalf("Reset() err = %v", err)
+	}
+	if err := client.DeleteEmbedding(2); err != nil {
+		t.Errorf("DeleteEmbedding() without a collection err = %v", err)
+	}
+	if err := client.AddEmbedding(5, []float32{1, 0}, ""); err != nil || fake.size != 2 {
+		t.Errorf("AddEmbedding() after Reset() err = %v, size %d; want a 2-dim collection", err, fake.size)
+	}
+
+	for _, key := range fake.apiKeys {
+		if key != "secret" {
+			t.Fatalf("request sent api-key %q, want the configured one", key)
+		}
+	}
+}
+
+func TestClientAdoptsExistingCollection(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	first, _ := newFakeQdrantClient(t, cfg)
+	if err := first.AddEmbedding(1, []float32{1, 0, 0}, ""); err != nil {
+		t.Fatalf("AddEmbedding() err = %v", err)
+	}
+	// A second client, as on the next start, learns the collection's size
+	second, err := NewClientWithHTTPClient(cfg, nil)
+	if err != nil {
+		t.Fatalf("NewClientWithHTTPClient() err = %v", err)
+	}
+	if err := second.AddEmbedding(2, []float32{1, 0}, ""); err == nil || !strings.Contains(err.Error(), "rebuild") {
+		t.Errorf("AddEmbedding() of the wrong size err = %v, want a rebuild hint", err)
+	}
+	if err := second.AddEmbedding(2, []float32{0, 1, 0}, ""); err != nil {
+		t.Errorf("AddEmbedding() err = %v", err)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(&config.Config{}); err == nil {
+		t.Error("NewClient() without qdrant_url err = nil")
+	}
+	client, err := NewClient(&config.Config{QdrantUrl: "localhost:6333/"})
+	if err != nil || client.baseURL != "http://localhost:6333" || client.collection != DefaultCollection {
+		t.Errorf("NewClient() = %+v, %v; want http://localhost:6333 and the default collection", client, err)
+	}
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	unreachable, err := NewClient(&config.Config{QdrantUrl: server.URL})
+	if err != nil {
+		t.Fatalf("NewClient() err = %v", err)
+	}
+	if unreachable.IsAvailable() {
+		t.Error("IsAvailable() = true for a stopped server")
+	}
+}
@@ -0,0 +1,317 @@
+package qdrant
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Approximate nearest neighbor search (Phase 5: Semantic Search)
+//
+// Comparing a query with every vector gets slow past tens of thousands of
+// notes. With ann_search on, the store also keeps an HNSW graph
+// (Hierarchical Navigable Small World, Malkov & Yashunin 2016): every
+// vector is linked to its nearest neighbors on layer 0, and to fewer,
+// farther-reaching ones on the sparser layers above. A search walks down
+// from the top layer greedily and then explores the best efSearch
+// candidates on layer 0, visiting a small part of the index.
+//
+// Results may miss a few of the exact top matches (recall below 1); their
+// scores are still exact cosine similarities. A stored vector that is
+// replaced or deleted stays in the graph as a tombstone, still walked but
+// never returned, until tombstones outnumber live vectors and the graph
+// is rebuilt. Stores smaller than annMinVectors are searched exactly.
+
+// HNSW parameters.
+const (
+	hnswM              = 16  // Links per vector on the upper layers
+	hnswM0             = 32  // Links per vector on layer 0
+	hnswEfConstruction = 200 // Candidates considered when linking a vector
+	hnswEfSearch       = 100 // Candidates explored by a search, at least
+)
+
+// annMinVectors is the store size below which Search stays exact.
+const annMinVectors = 1000
+
+// minTombstonesToRebuild keeps small graphs from rebuilding constantly.
+const minTombstonesToRebuild = 1000
+
+// hnswGraph is the HNSW index over a store's vectors.
+type hnswGraph struct {
+	nodes      []hnswNode
+	live       map[int64]int32 // Note ID to its live node
+	entry      int32           // -1 while empty
+	maxLevel   int
+	tombstones int
+	levelMult  float64
+	rng        *rand.Rand
+}
+
+// hnswNode is one vector in the graph.
+type hnswNode struct {
+	noteID  int64
+	vector  []float32 // Normalized, so similarity is a dot product
+	links   [][]int32 // Neighbors per layer, up to the node's level
+	deleted bool
+}
+
+// newHNSWGraph creates an empty graph. The level of each new vector is
+// random, from a fixed seed so the same inserts build the same graph.
+func newHNSWGraph() *hnswGraph {
+	return &hnswGraph{
+		live:      make(map[int64]int32),
+		entry:     -1,
+		levelMult: 1 / math.Log(hnswM),
+		rng:       rand.New(rand.NewSource(1)),
+	}
+}
+
+// buildHNSWGraph indexes vectors, in note ID order.
+func buildHNSWGraph(vectors map[int64][]float32) *hnswGraph {
+	ids := make([]int64, 0, len(vectors))
+	for id := range vectors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	g := newHNSWGraph()
+	for _, id := range ids {
+		g.insert(id, vectors[id])
+	}
+	return g
+}
+
+// needsRebuild reports whether tombstones have taken over the graph.
+func (g *hnswGraph) needsRebuild() bool {
+	return g.tombstones >= minTombstonesToRebuild && g.tombstones > len(g.live)
+}
+
+// remove turns a note's node into a tombstone.
+func (g *hnswGraph) remove(noteID int64) {
+	if id, ok := g.live[noteID]; ok {
+		g.nodes[id].deleted = true
+		delete(g.live, noteID)
+		g.tombstones++
+	}
+}
+
+// insert adds or replaces a note's vector.
+func (g *hnswGraph) insert(noteID int64, vector []float32) {
+	g.remove(noteID)
+	level := int(-math.Log(1-g.rng.Float64()) * g.levelMult)
+	id := int32(len(g.nodes))
+	g.nodes = append(g.nodes, hnswNode{
+		noteID: noteID,
+		vector: normalize(vector),
+		links:  make([][]int32, level+1),
+	})
+	g.live[noteID] = id
+	if g.entry < 0 {
+		g.entry, g.maxLevel = id, level
+		return
+	}
+
+	query := g.nodes[id].vector
+	entry := g.entry
+	for l := g.maxLevel; l > level; l-- {
+		entry = g.greedy(query, entry, l)
+	}
+	for l := min(level, g.maxLevel); l >= 0; l-- {
+		candidates := g.searchLayer(query, entry, hnswEfConstruction, l)
+		neighbors := g.selectNeighbors(candidates, hnswM)
+		g.nodes[id].links[l] = neighbors
+		for _, n := range neighbors {
+			g.link(n, id, l)
+		}
+		entry = candidates[0].id
+	}
+	if level > g.maxLevel {
+		g.entry, g.maxLevel = id, level
+	}
+}
+
+// link adds a link from node from to node to on layer l, pruning from's
+// links back to the layer's limit.
+func (g *hnswGraph) link(from, to int32, l int) {
+	node := &g.nodes[from]
+	node.links[l] = append(node.links[l], to)
+	limit := hnswM
+	if l == 0 {
+		limit = hnswM0
+	}
+	if len(node.links[l]) <= limit {
+		return
+	}
+	candidates := make([]scoredNode, len(node.links[l]))
+	for i, n := range node.links[l] {
+		candidates[i] = scoredNode{id: n, sim: dot(node.vector, g.nodes[n].vector)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].sim > candidates[j].sim })
+	node.links[l] = g.selectNeighbors(candidates, limit)
+}
+
+// selectNeighbors picks up to m of candidates (best first), preferring
+// ones in different directions: a candidate closer to an already picked
+// neighbor than to the query is reached through that neighbor anyway.
+// Skipped candidates fill any places left.
+func (g *hnswGraph) selectNeighbors(candidates []scoredNode, m int) []int32 {
+	picked := make([]int32, 0, m)
+	var skipped []int32
+	for _, c := range candidates {
+		if len(picked) == m {
+			break
+		}
+		diverse := true
+		for _, p := range picked {
+			if dot(g.nodes[c.id].vector, g.nodes[p].vector) > c.sim {
+				diverse = false
+				break
+			}
+		}
+		if diverse {
+			picked = append(picked, c.id)
+		} else {
+			skipped = append(skipped, c.id)
+		}
+	}
+	for _, id := range skipped {
+		if len(picked) == m {
+			break
+		}
+		picked = append(picked, id)
+	}
+	return picked
+}
+
+// greedy moves from entry to the most similar node reachable on layer l.
+func (g *hnswGraph) greedy(query []float32, entry int32, l int) int32 {
+	best, bestSim := entry, dot(query, g.nodes[entry].vector)
+	for changed := true; changed; {
+		changed = false
+		for _, n := range g.nodes[best].links[l] {
+			if sim := dot(query, g.nodes[n].vector); sim > bestSim {
+				best, bestSim, changed = n, sim, true
+			}
+		}
+	}
+	return best
+}
+
+// searchLayer returns up to ef nodes of layer l most similar to query,
+// best first, exploring from entry.
+func (g *hnswGraph) searchLayer(query []float32, entry int32, ef, l int) []scoredNode {
+	visited := make(map[int32]struct{}, ef*4)
+	visited[entry] = struct{}{}
+	start := scoredNode{id: entry, sim: dot(query, g.nodes[entry].vector)}
+	candidates := &maxHeap{start} // To explore, best first
+	results := &minHeap{start}    // Found so far, worst first
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(scoredNode)
+		if results.Len() >= ef && c.sim < (*results)[0].sim {
+			break
+		}
+		for _, n := range g.nodes[c.id].links[l] {
+			if _, seen := visited[n]; seen {
+				continue
+			}
+			visited[n] = struct{}{}
+			sim := dot(query, g.nodes[n].vector)
+			if results.Len() < ef || sim > (*results)[0].sim {
+				heap.Push(candidates, scoredNode{id: n, sim: sim})
+				heap.Push(results, scoredNode{id: n, sim: sim})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	found := []scoredNode(*results)
+	sort.Slice(found, func(i, j int) bool { return found[i].sim > found[j].sim })
+	return found
+}
+
+// search returns the note IDs of up to limit live vectors most similar to
+// query.
+func (g *hnswGraph) search(query []float32, limit int) []int64 {
+	if g.entry < 0 || limit <= 0 {
+		return nil
+	}
+	query = normalize(query)
+	entry := g.entry
+	for l := g.maxLevel; l > 0; l-- {
+		entry = g.greedy(query, entry, l)
+	}
+	ef := max(hnswEfSearch, limit)
+	ids := make([]int64, 0, limit)
+	for _, c := range g.searchLayer(query, entry, ef, 0) {
+		if node := g.nodes[c.id]; !node.deleted {
+			ids = append(ids, node.noteID)
+			if len(ids) == limit {
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// normalize returns a unit-length copy of v (zero stays zero).
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, f := range v {
+		norm += float64(f) * float64(f)
+	}
+	out := make([]float32, len(v))
+	if norm == 0 {
+		return out
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i, f := range v {
+		out[i] = f * scale
+	}
+	return out
+}
+
+// dot returns the dot product of two vectors of the same length.
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// scoredNode is a node and its similarity to the query.
+type scoredNode struct {
+	id  int32
+	sim float32
+}
+
+// maxHeap pops the most similar node first.
+type maxHeap []scoredNode
+
+func (h maxHeap) Len() int           { return len(h) }
+func (h maxHeap) Less(i, j int) bool { return h[i].sim > h[j].sim }
+func (h maxHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x any)        { *h = append(*h, x.(scoredNode)) }
+func (h *maxHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// minHeap pops the least similar node first.
+type minHeap []scoredNode
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i].sim < h[j].sim }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(scoredNode)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
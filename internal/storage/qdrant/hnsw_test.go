@@ -0,0 +1,168 @@
+package qdrant
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+// clusteredVectors returns n vectors of dims dimensions around a few
+// dozen centers, like embeddings of notes on a handful of topics.
+func clusteredVectors(rng *rand.Rand, n, dims int) [][]float32 {
+	centers := make([][]float32, 40)
+	for i := range centers {
+		centers[i] = make([]float32, dims)
+		for j := range centers[i] {
+			centers[i][j] = float32(rng.NormFloat64())
+		}
+	}
+	vectors := make([][]float32, n)
+	for i := range vectors {
+		center := centers[rng.Intn(len(centers))]
+		vectors[i] = make([]float32, dims)
+		for j := range vectors[i] {
+			vectors[i][j] = center[j] + 0.5*float32(rng.NormFloat64())
+		}
+	}
+	return vectors
+}
+
+// newANNStore returns an in-memory store holding vectors, with ANN on.
+func newANNStore(t testing.TB, vectors [][]float32) *VectorStore {
+	t.Helper()
+	v, err := New(&config.Config{ANNSearch: true})
+	if err != nil {
+		t.Fatalf("New() err = %v", err)
+	}
+	for i, vec := range vectors {
+		if err := v.AddEmbedding(int64(i), vec, ""); err != nil {
+			t.Fatalf("AddEmbedding(%d) err = %v", i, err)
+		}
+	}
+	return v
+}
+
+// exactSearch is Search with ANN off.
+func exactSearch(v *VectorStore, query []float32, limit int) []SearchResult {
+	graph := v.graph
+	v.graph = nil
+	defer func() { v.graph = graph }()
+	return v.Search(query, limit)
+}
+
+func TestANNRecall(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42))
+	vectors := clusteredVectors(rng, 5000, 48)
+	v := newANNStore(t, vectors)
+
+	const queries, k = 100, 10
+	found := 0
+	for q := 0; q < queries; q++ {
+		query := clusteredVectors(rng, 1, 48)[0]
+		want := make(map[int64]bool, k)
+		for _, r := range exactSearch(v, query, k) {
+			want[r.NoteID] = true
+		}
+		got := v.Search(query, k)
+		if len(got) != k {
+			t.Fatalf("Search() returned %d results, want %d", len(got), k)
+		}
+		for i, r := range got {
+			if want[r.NoteID] {
+				found++
+			}
+			if i > 0 && r.Score > got[i-1].Score {
+				t.Fatalf("results are not sorted by score: %+v", got)
+			}
+		}
+	}
+	recall := float64(found) / (queries * k)
+	t.Logf("recall@%d = %.3f", k, recall)
+	if recall < 0.95 {
+		t.Errorf("recall@%d = %.3f, want at least 0.95", k, recall)
+	}
+}
+
+func TestANNFollowsUpdatesAndDeletes(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(7))
+	vectors := clusteredVectors(rng, 2*annMinVectors, 16)
+	v := newANNStore(t, vectors)
+
+	// A deleted note is never returned, even for its own vector
+	for id := int64(0); id < 50; id++ {
+		if err := v.DeleteEmbedding(id); err != nil {
+			t.Fatalf("DeleteEmbedding() err = %v", err)
+		}
+	}
+	for id := 0; id < 50; id++ {
+		for _, r := range v.Search(vectors[id], 5) {
+			if r.NoteID < 50 {
+				t.Fatalf("Search() returned deleted note %d", r.NoteID)
+			}
+		}
+	}
+
+	// A replaced vector is found at its new place, with an exact score
+	moved := clusteredVectors(rng, 1, 16)[0]
+	if err := v.AddEmbedding(100, moved, "moved"); err != nil {
+		t.Fatalf("AddEmbedding() err = %v", err)
+	}
+	got := v.Search(moved, 1)
+	if len(got) != 1 || got[0].NoteID != 100 || got[0].NoteText != "moved" || got[0].Score < 0.9999 {
+		t.Errorf("Search(new vector) = %+v, want note 100 first", got)
+	}
+
+	// Enough tombstones rebuild the graph
+	for id := int64(50); id < int64(len(vectors)); id++ {
+		if id != 100 {
+			_ = v.DeleteEmbedding(id)
+		}
+	}
+	if v.graph.tombstones >= minTombstonesToRebuild {
+		t.Errorf("graph keeps %d tombstones for %d live vectors", v.graph.tombstones, len(v.graph.live))
+	}
+}
+
+func TestANNSmallStoreIsExact(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(3))
+	vectors := clusteredVectors(rng, annMinVectors/2, 8)
+	v := newANNStore(t, vectors)
+	for q := 0; q < 20; q++ {
+		query := clusteredVectors(rng, 1, 8)[0]
+		if got, want := v.Search(query, 10), exactSearch(v, query, 10); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Search() = %+v, want the exact %+v", got, want)
+		}
+	}
+
+	// Turning ANN on later indexes what is already stored
+	v.SetANN(false)
+	v.SetANN(true)
+	if len(v.graph.live) != len(vectors) {
+		t.Errorf("graph holds %d vectors, want %d", len(v.graph.live), len(vectors))
+	}
+}
+
+func benchmarkSearch(b *testing.B, ann bool) {
+	rng := rand.New(rand.NewSource(1))
+	v, _ := New(nil)
+	for i, vec := range clusteredVectors(rng, 20000, 384) {
+		_ = v.AddEmbedding(int64(i), vec, "")
+	}
+	v.SetANN(ann)
+	query := clusteredVectors(rng, 1, 384)[0]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.Search(query, 10)
+	}
+}
+
+func BenchmarkSearchExact20000(b *testing.B) { benchmarkSearch(b, false) }
+func BenchmarkSearchANN20000(b *testing.B)   { benchmarkSearch(b, true) }
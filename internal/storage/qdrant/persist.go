@@ -0,0 +1,284 @@
+package qdrant
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+// On-disk persistence (Phase 5: Semantic Search)
+//
+// A store opened with a path keeps its vectors in one file. Every change
+// is appended to it as a record, so saving costs one small write rather
+// than rewriting the index, and opening replays the records in order.
+// Records that a later one replaced pile up, so once they outnumber the
+// live vectors the file is rewritten with only those (compaction). A
+// record cut short by a crash is dropped, with anything after it, the
+// next time the file is opened.
+//
+// File format, little endian:
+//
+//	header:  "FSVECv1\n"
+//	record:  op (1 byte) | note ID (int64)
+//	add:     + dimensions (uint32) | float32 × dimensions
+//	         + text length (uint32) | text (UTF-8)
+//	delete:  no more fields
+
+// IndexFileName is the file a store persists to inside the data directory.
+const IndexFileName = "vectors.idx"
+
+// fileHeader starts every index file and names its format version.
+var fileHeader = []byte("FSVECv1\n")
+
+// Record operations.
+const (
+	opAdd    byte = 1
+	opDelete byte = 2
+)
+
+// compactMinRecords is how many records a file holds before compaction
+// is considered, so small indexes are not rewritten over and over.
+const compactMinRecords = 1024
+
+// Limits guarding against reading a corrupt length as a huge allocation.
+const (
+	maxDimensions = 1 << 16
+	maxTextBytes  = 1 << 26
+)
+
+// errTornRecord marks a record that ends before its fields do.
+var errTornRecord = errors.New("torn record")
+
+// IndexPath returns the file the store for cfg persists to.
+func IndexPath(cfg *config.Config) string {
+	return filepath.Join(cfg.DataDir, IndexFileName)
+}
+
+// Open opens the vector store persisted at path, creating the file if it
+// does not exist.
+func Open(path string) (*VectorStore, error) {
+	v := &VectorStore{
+		vectors:   make(map[int64][]float32),
+		noteTexts: make(map[int64]string),
+		path:      path,
+	}
+	if err := v.load(); err != nil {
+		return nil, err
+	}
+	if v.records >= compactMinRecords && v.records > 2*len(v.vectors) {
+		if err := v.compact(); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open vector index: %w", err)
+	}
+	v.file = f
+	return v, nil
+}
+
+// load replays the file into the maps, creating it when missing and
+// cutting off a torn record at its end.
+func (v *VectorStore) load() error {
+	data, err := os.ReadFile(v.path)
+	if errors.Is(err, os.ErrNotExist) || err == nil && len(data) == 0 {
+		if err := os.MkdirAll(filepath.Dir(v.path), 0o755); err != nil {
+			return fmt.Errorf("failed to create vector index directory: %w", err)
+		}
+		if err := os.WriteFile(v.path, fileHeader, 0o644); err != nil {
+			return fmt.Errorf("failed to create vector index: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read vector index: %w", err)
+	}
+	if !bytes.HasPrefix(data, fileHeader) {
+		return fmt.Errorf("%s is not a flowState vector index", v.path)
+	}
+
+	r := bytes.NewReader(data[len(fileHeader):])
+	good := int64(len(fileHeader))
+	for r.Len() > 0 {
+		err := v.replay(r)
+		if errors.Is(err, errTornRecord) {
+			// Keep what was written completely; the rest never finished
+			if err := os.Truncate(v.path, good); err != nil {
+				return fmt.Errorf("failed to repair vector index: %w", err)
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("vector index %s: %w", v.path, err)
+		}
+		v.records++
+		good = int64(len(data)) - int64(r.Len())
+	}
+	return nil
+}
+
+// replay reads one record from r and applies it.
+func (v *VectorStore) replay(r *bytes.Reader) error {
+	var head struct {
+		Op     byte
+		NoteID int64
+	}
+	if err := readFull(r, &head); err != nil {
+		return err
+	}
+	switch head.Op {
+	case opDelete:
+		delete(v.vectors, head.NoteID)
+		delete(v.noteTexts, head.NoteID)
+		return nil
+	case opAdd:
+	default:
+		return fmt.Errorf("unknown record type %d", head.Op)
+	}
+
+	var dims uint32
+	if err := readFull(r, &dims); err != nil {
+		return err
+	}
+	if dims == 0 || dims > maxDimensions {
+		return fmt.Errorf("invalid vector length %d", dims)
+	}
+	embedding := make([]float32, dims)
+	if err := readFull(r, embedding); err != nil {
+		return err
+	}
+	var textLen uint32
+	if err := readFull(r, &textLen); err != nil {
+		return err
+	}
+	if textLen > maxTextBytes {
+		return fmt.Errorf("invalid text length %d", textLen)
+	}
+	text := make([]byte, textLen)
+	if _, err := io.ReadFull(r, text); err != nil {
+		return errTornRecord
+	}
+
+	if v.dimensions == 0 {
+		v.dimensions = int(dims)
+	} else if int(dims) != v.dimensions {
+		return fmt.Errorf("vector of note %d has %d dimensions, the index %d", head.NoteID, dims, v.dimensions)
+	}
+	v.vectors[head.NoteID] = embedding
+	v.noteTexts[head.NoteID] = string(text)
+	return nil
+}
+
+// readFull decodes data from r, reporting a short read as a torn record.
+func readFull(r io.Reader, data any) error {
+	if err := binary.Read(r, binary.LittleEndian, data); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errTornRecord
+		}
+		return err
+	}
+	return nil
+}
+
+// appendRecord encodes one record onto buf.
+func appendRecord(buf []byte, op byte, noteID int64, embedding []float32, text string) []byte {
+	buf = append(buf, op)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(noteID))
+	if op != opAdd {
+		return buf
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(embedding)))
+	for _, f := range embedding {
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(text)))
+	return append(buf, text...)
+}
+
+// persist appends a record for a change about to be applied; a store
+// without a file keeps it in memory only. Callers hold v.mu.
+func (v *VectorStore) persist(op byte, noteID int64, embedding []float32, text string) error {
+	if v.file == nil {
+		return nil
+	}
+	// One write per record, so a crash tears at most the last one
+	if _, err := v.file.Write(appendRecord(nil, op, noteID, embedding, text)); err != nil {
+		return fmt.Errorf("failed to save vector index: %w", err)
+	}
+	v.records++
+	return nil
+}
+
+// maybeCompact rewrites the file once replaced records outnumber the
+// live vectors. Callers hold v.mu.
+func (v *VectorStore) maybeCompact() error {
+	if v.file == nil || v.records < compactMinRecords || v.records <= 2*len(v.vectors) {
+		return nil
+	}
+	return v.compact()
+}
+
+// compact rewrites the file with one record per stored vector and
+// reopens it for appending. Callers hold v.mu.
+func (v *VectorStore) compact() error {
+	tmp := v.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to compact vector index: %w", err)
+	}
+	ids := make([]int64, 0, len(v.vectors))
+	for id := range v.vectors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	w := bufio.NewWriter(f)
+	_, err = w.Write(fileHeader)
+	var buf []byte
+	for _, id := range ids {
+		if err != nil {
+			break
+		}
+		buf = appendRecord(buf[:0], opAdd, id, v.vectors[id], v.noteTexts[id])
+		_, err = w.Write(buf)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to compact vector index: %w", err)
+	}
+
+	if v.file != nil {
+		_ = v.file.Close()
+		v.file = nil
+	}
+	if err := os.Rename(tmp, v.path); err != nil {
+		return fmt.Errorf("failed to compact vector index: %w", err)
+	}
+	file, err := os.OpenFile(v.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open vector index: %w", err)
+	}
+	v.file = file
+	v.records = len(ids)
+	return nil
+}
@@ -1,7 +1,7 @@
-// Package qdrant provides in-memory vector storage for semantic search.
+// Package qdrant provides vector storage for semantic search.
 //
 // Phase 1: Core Infrastructure
-//   - In-memory vector store with cosine similarity search
+//   - Vector store with cosine similarity search, held in memory
 //   - Compatible interface with Qdrant for future migration
 //   - Thread-safe with RWMutex for concurrent access
 //
@@ -12,17 +12,22 @@
 //
 // Current Implementation:
 //   - Uses hash-based embeddings for development
+//   - Exact search, or approximate (HNSW) for large stores with
+//     ann_search on (see hnsw.go)
 //   - Ready for ONNX model integration
-//   - In-memory storage (data lost on restart)
+//   - Searched in memory; saved to an append-only file in the data
+//     directory and loaded on start (see persist.go)
 //
-// Future Migration:
-//   - Replace with actual Qdrant client
-//   - Persistent storage across restarts
-//   - GPU acceleration support
+// Qdrant:
+//   - Client stores and searches the vectors in a Qdrant server instead,
+//     with vector_backend "qdrant" (see client.go)
 package qdrant
 
 import (
+	"context"
+	"fmt"
 	"math"
+	"os"
 	"sort"
 	"sync"
 
@@ -35,31 +40,80 @@ import (
 //   - mu: RWMutex for thread-safe operations
 //   - vectors: Map of noteID to embedding vector
 //   - noteTexts: Map of noteID to original text for display
+//   - dimensions: Length of every stored vector, set by the first one
 //
 // Phase 5: Semantic Search
 //   - AddEmbedding: Store a new embedding
 //   - Search: Find similar notes by cosine similarity
 //   - DeleteEmbedding: Remove an embedding
 type VectorStore struct {
-	mu        sync.RWMutex
-	vectors   map[int64][]float32
-	noteTexts map[int64]string
+	mu         sync.RWMutex
+	vectors    map[int64][]float32
+	noteTexts  map[int64]string
+	dimensions int
+
+	// Persistence; file is nil for a store kept in memory only
+	path    string
+	file    *os.File
+	records int // Records in the file, live or replaced
+
+	graph *hnswGraph // Approximate search index; nil unless ANN is on
 }
 
-// New creates a new in-memory vector store.
+// New creates the vector store for cfg.
 //
 // Phase 1: Initializes empty storage maps
 //   - Ready for embedding additions
 //   - No external dependencies
+//
+// Phase 5: With a data directory configured, the store is loaded from and
+// saved to IndexPath(cfg); without one it lives in memory only. ann_search
+// turns on approximate search.
 func New(cfg *config.Config) (*VectorStore, error) {
-	return &VectorStore{
+	v := &VectorStore{
 		vectors:   make(map[int64][]float32),
 		noteTexts: make(map[int64]string),
-	}, nil
+	}
+	if cfg != nil && cfg.DataDir != "" {
+		var err error
+		if v, err = Open(IndexPath(cfg)); err != nil {
+			return nil, err
+		}
+	}
+	if cfg != nil {
+		v.SetANN(cfg.ANNSearch)
+	}
+	return v, nil
 }
 
+// SetANN turns approximate nearest neighbor search on or off. Turning it
+// on indexes the stored vectors.
+func (v *VectorStore) SetANN(enabled bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	switch {
+	case !enabled:
+		v.graph = nil
+	case v.graph == nil:
+		v.graph = buildHNSWGraph(v.vectors)
+	}
+}
+
+// Close flushes the index file to disk and closes it.
 func (v *VectorStore) Close() error {
-	return nil
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.file == nil {
+		return nil
+	}
+	err := v.file.Sync()
+	if closeErr := v.file.Close(); err == nil {
+		err = closeErr
+	}
+	v.file = nil
+	return err
 }
 
 // AddEmbedding stores an embedding for a note.
@@ -68,21 +122,86 @@ func (v *VectorStore) Close() error {
 //   - Thread-safe insertion
 //   - Stores both vector and original text
 //   - Ready for Phase 5 semantic search
-func (v *VectorStore) AddEmbedding(noteID int64, embedding []float32, text string) {
+//
+// Phase 5: Rejects a vector whose length differs from the stored ones;
+// Reset clears the store for another embedding model. The change is
+// saved before it is applied.
+func (v *VectorStore) AddEmbedding(noteID int64, embedding []float32, text string) error {
 	v.mu.Lock()
 	defer v.mu.Unlock()
 
+	if len(embedding) == 0 {
+		return fmt.Errorf("embedding is empty")
+	}
+	if v.dimensions != 0 && len(embedding) != v.dimensions {
+		return fmt.Errorf("embedding must be %d-dim, got %d", v.dimensions, len(embedding))
+	}
+	if err := v.persist(opAdd, noteID, embedding, text); err != nil {
+		return err
+	}
+	v.dimensions = len(embedding)
 	v.vectors[noteID] = embedding
 	v.noteTexts[noteID] = text
+	if v.graph != nil {
+		v.graph.insert(noteID, embedding)
+		v.rebuildGraphIfStale()
+	}
+	return v.maybeCompact()
+}
+
+// rebuildGraphIfStale rebuilds the ANN graph once replaced and deleted
+// vectors outnumber the live ones. Callers hold v.mu.
+func (v *VectorStore) rebuildGraphIfStale() {
+	if v.graph.needsRebuild() {
+		v.graph = buildHNSWGraph(v.vectors)
+	}
+}
+
+// Dimensions returns the length of the stored vectors, or 0 when empty
+// since the last Reset.
+func (v *VectorStore) Dimensions() int {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.dimensions
+}
+
+// Reset removes every embedding, e.g. before rebuilding the store with
+// another embedding model. A persisted store empties its file.
+func (v *VectorStore) Reset() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.vectors = make(map[int64][]float32)
+	v.noteTexts = make(map[int64]string)
+	v.dimensions = 0
+	if v.graph != nil {
+		v.graph = newHNSWGraph()
+	}
+	if v.file == nil {
+		return nil
+	}
+	return v.compact()
 }
 
 // DeleteEmbedding removes an embedding by note ID.
-func (v *VectorStore) DeleteEmbedding(noteID int64) {
+func (v *VectorStore) DeleteEmbedding(noteID int64) error {
 	v.mu.Lock()
 	defer v.mu.Unlock()
 
+	if _, ok := v.vectors[noteID]; !ok {
+		return nil
+	}
+	if err := v.persist(opDelete, noteID, nil, ""); err != nil {
+		return err
+	}
 	delete(v.vectors, noteID)
 	delete(v.noteTexts, noteID)
+	if v.graph != nil {
+		v.graph.remove(noteID)
+		v.rebuildGraphIfStale()
+	}
+	return v.maybeCompact()
 }
 
 // Search finds the most similar notes to the query embedding.
@@ -91,6 +210,8 @@ func (v *VectorStore) DeleteEmbedding(noteID int64) {
 //   - Computes cosine similarity with all stored vectors
 //   - Returns results sorted by score (highest first)
 //   - Limits results to specified count
+//   - With ANN on, large stores are searched through the HNSW graph
+//     instead of every vector (see hnsw.go)
 //
 // Cosine Similarity:
 //   - Measures angle between vectors
@@ -106,9 +227,17 @@ func (v *VectorStore) Search(queryEmbedding []float32, limit int) []SearchResult
 
 	results := make([]scoredResult, 0)
 
-	for noteID, emb := range v.vectors {
-		score := cosineSimilarity(queryEmbedding, emb)
-		results = append(results, scoredResult{noteID: noteID, score: score})
+	if v.graph != nil && len(v.vectors) >= annMinVectors && len(queryEmbedding) == v.dimensions {
+		// Phase 5: the graph finds the candidates, scored exactly
+		for _, noteID := range v.graph.search(queryEmbedding, limit) {
+			score := cosineSimilarity(queryEmbedding, v.vectors[noteID])
+			results = append(results, scoredResult{noteID: noteID, score: score})
+		}
+	} else {
+		for noteID, emb := range v.vectors {
+			score := cosineSimilarity(queryEmbedding, emb)
+			results = append(results, scoredResult{noteID: noteID, score: score})
+		}
 	}
 
 	sort.Slice(results, func(i, j int) bool {
@@ -131,6 +260,15 @@ func (v *VectorStore) Search(queryEmbedding []float32, limit int) []SearchResult
 	return searchResults
 }
 
+// SearchContext is Search with the error-returning signature the Qdrant
+// Client has, so either can back semantic search.
+func (v *VectorStore) SearchContext(ctx context.Context, queryEmbedding []float32, limit int) ([]SearchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return v.Search(queryEmbedding, limit), nil
+}
+
 // GetNoteIDs returns all stored note IDs.
 func (v *VectorStore) GetNoteIDs() []int64 {
 	v.mu.RLock()
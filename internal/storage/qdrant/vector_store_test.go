@@ -0,0 +1,137 @@
+package qdrant
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+func openTestStore(t *testing.T, path string) *VectorStore {
+	t.Helper()
+	v, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() err = %v", err)
+	}
+	t.Cleanup(func() { _ = v.Close() })
+	return v
+}
+
+func TestVectorStorePersists(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{DataDir: t.TempDir()}
+	memory, _ := New(nil)
+	disk, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() err = %v", err)
+	}
+	for _, v := range []*VectorStore{memory, disk} {
+		for id, emb := range map[int64][]float32{1: {1, 0, 0}, 2: {0, 1, 0}, 3: {0.7, 0.7, 0}} {
+			if err := v.AddEmbedding(id, emb, "note"); err != nil {
+				t.Fatalf("AddEmbedding(%d) err = %v", id, err)
+			}
+		}
+		if err := v.AddEmbedding(4, []float32{1, 2}, "short"); err == nil {
+			t.Fatal("AddEmbedding() of a 2-dim vector into a 3-dim store err = nil")
+		}
+		if err := v.AddEmbedding(1, []float32{0.9, 0.1, 0}, "first, edited"); err != nil {
+			t.Fatalf("AddEmbedding(1) again err = %v", err)
+		}
+		if err := v.DeleteEmbedding(2); err != nil {
+			t.Fatalf("DeleteEmbedding() err = %v", err)
+		}
+	}
+	if err := disk.Close(); err != nil {
+		t.Fatalf("Close() err = %v", err)
+	}
+
+	reopened := openTestStore(t, IndexPath(cfg))
+	query := []float32{1, 0.2, 0}
+	if got, want := reopened.Search(query, 10), memory.Search(query, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() after reopening = %+v, want %+v", got, want)
+	}
+	if n, _ := reopened.GetStats(); n != 2 || reopened.Dimensions() != 3 {
+		t.Errorf("reopened store has %d vectors of %d dimensions, want 2 of 3", n, reopened.Dimensions())
+	}
+}
+
+func TestVectorStoreDropsTornRecord(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "index", IndexFileName)
+	v := openTestStore(t, path)
+	_ = v.AddEmbedding(1, []float32{1, 0}, "one")
+	_ = v.AddEmbedding(2, []float32{0, 1}, "two")
+	_ = v.Close()
+	intact, _ := os.Stat(path)
+
+	// A crash in the middle of writing the third record
+	torn := appendRecord(nil, opAdd, 3, []float32{1, 1}, "three")
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	_, _ = f.Write(torn[:len(torn)-3])
+	_ = f.Close()
+
+	v = openTestStore(t, path)
+	if ids := v.GetNoteIDs(); len(ids) != 2 {
+		t.Fatalf("notes after a torn record = %v, want 1 and 2", ids)
+	}
+	if info, _ := os.Stat(path); info.Size() != intact.Size() {
+		t.Errorf("file is %d bytes, want the torn record cut off (%d)", info.Size(), intact.Size())
+	}
+	if err := v.AddEmbedding(3, []float32{1, 1}, "three"); err != nil {
+		t.Fatalf("AddEmbedding() after repair err = %v", err)
+	}
+	_ = v.Close()
+	if ids := openTestStore(t, path).GetNoteIDs(); len(ids) != 3 {
+		t.Errorf("notes after re-adding = %v, want 3", ids)
+	}
+}
+
+func TestVectorStoreCompacts(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), IndexFileName)
+	v := openTestStore(t, path)
+	for i := 0; i < 3*compactMinRecords; i++ {
+		if err := v.AddEmbedding(int64(i%2), []float32{float32(i), 1}, "note"); err != nil {
+			t.Fatalf("AddEmbedding() err = %v", err)
+		}
+	}
+	if v.records >= compactMinRecords {
+		t.Errorf("file holds %d records after rewriting 2 notes %d times", v.records, 3*compactMinRecords)
+	}
+	_ = v.Close()
+
+	last := float32(3*compactMinRecords - 1)
+	reopened := openTestStore(t, path)
+	if emb, ok := reopened.GetEmbedding(1); !ok || emb[0] != last {
+		t.Errorf("note 1 after compaction = %v, want the last write (%v)", emb, last)
+	}
+
+	// Reset empties the file, so another model's vectors fit
+	if err := reopened.Reset(); err != nil {
+		t.Fatalf("Reset() err = %v", err)
+	}
+	if err := reopened.AddEmbedding(7, []float32{1, 2, 3}, "wider"); err != nil {
+		t.Fatalf("AddEmbedding() after Reset err = %v", err)
+	}
+	_ = reopened.Close()
+	if after := openTestStore(t, path); after.Dimensions() != 3 || len(after.GetNoteIDs()) != 1 {
+		t.Errorf("after Reset: %d vectors of %d dimensions, want 1 of 3", len(after.GetNoteIDs()), after.Dimensions())
+	}
+}
+
+func TestOpenRejectsOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("not an index"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path); err == nil {
+		t.Error("Open() of a text file err = nil")
+	}
+}
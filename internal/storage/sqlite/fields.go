@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Custom Fields (Phase 6: Organization)
+//
+// Notes and todos carry free-form key-value fields such as client: acme,
+// severity: high or url: https://..., kept in the item_fields table
+// keyed by item type ("note" or "todo") and ID. Keys are lowercase and
+// unique per item; setting an empty value removes the field. The notes
+// and todos filters match fields with key:value words, see
+// ParseFieldFilters; values compare case-insensitively.
+
+// fieldKeyPattern is what a field key may look like, so key:value reads
+// unambiguously in filters.
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// NormalizeFieldKey lowercases and trims key and reports whether it is a
+// valid field key.
+func NormalizeFieldKey(key string) (string, bool) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	return key, fieldKeyPattern.MatchString(key)
+}
+
+// SetField sets a field on the note or todo itemType/id. An empty value
+// removes the field.
+func (s *Store) SetField(itemType string, id int64, key, value string) error {
+	key, ok := NormalizeFieldKey(key)
+	if !ok {
+		return fmt.Errorf("invalid field name %q (use letters, digits, _ and -)", key)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		_, err := s.db.Exec("DELETE FROM item_fields WHERE item_type = ? AND item_id = ? AND key = ?", itemType, id, key)
+		return err
+	}
+	_, err := s.db.Exec(
+		`INSERT INTO item_fields (item_type, item_id, key, value) VALUES (?, ?, ?, ?)
+		ON CONFLICT(item_type, item_id, key) DO UPDATE SET value = excluded.value`,
+		itemType, id, key, value,
+	)
+	return err
+}
+
+// GetFields returns the fields of the note or todo itemType/id, sorted
+// by key.
+func (s *Store) GetFields(itemType string, id int64) ([]models.Field, error) {
+	rows, err := s.db.Query("SELECT key, value FROM item_fields WHERE item_type = ? AND item_id = ? ORDER BY key", itemType, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []models.Field
+	for rows.Next() {
+		var f models.Field
+		if err := rows.Scan(&f.Key, &f.Value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, rows.Err()
+}
+
+// ListFieldKeys returns every field key in use, sorted.
+func (s *Store) ListFieldKeys() ([]string, error) {
+	rows, err := s.db.Query("SELECT DISTINCT key FROM item_fields ORDER BY key")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// deleteFields removes the fields of a deleted item.
+func deleteFields(db execer, itemType string, id int64) error {
+	_, err := db.Exec("DELETE FROM item_fields WHERE item_type = ? AND item_id = ?", itemType, id)
+	return err
+}
+
+// ParseFieldFilters splits key:value words out of a filter, e.g.
+// "invoice client:acme" gives "invoice" and {client: acme}. A word only
+// counts when its key is a valid field key and its value does not start
+// with "/", so URLs and times such as 10:30 stay part of the text.
+func ParseFieldFilters(text string) (string, map[string]string) {
+	var rest []string
+	var fields map[string]string
+	for _, word := range strings.Fields(text) {
+		key, value, found := strings.Cut(word, ":")
+		key, ok := NormalizeFieldKey(key)
+		if !found || !ok || value == "" || strings.HasPrefix(value, "/") {
+			rest = append(rest, word)
+			continue
+		}
+		if fields == nil {
+			fields = map[string]string{}
+		}
+		fields[key] = value
+	}
+	return strings.Join(rest, " "), fields
+}
+
+// fieldClauses returns the EXISTS clauses matching fields on the table
+// of itemType.
+func fieldClauses(itemType, table string, fields map[string]string, args []interface{}) ([]string, []interface{}) {
+	var clauses []string
+	for key, value := range fields {
+		clauses = append(clauses, "EXISTS (SELECT 1 FROM item_fields f WHERE f.item_type = ? AND f.item_id = "+table+".id AND f.key = ? AND f.value = ? COLLATE NOCASE)")
+		args = append(args, itemType, key, value)
+	}
+	return clauses, args
+}
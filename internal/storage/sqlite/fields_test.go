@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestFields(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	acme := &models.Note{Title: "kickoff notes"}
+	globex := &models.Note{Title: "kickoff agenda"}
+	for _, n := range []*models.Note{acme, globex} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	bug := &models.Todo{Title: "fix login", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(bug); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	for _, f := range []struct {
+		itemType   string
+		id         int64
+		key, value string
+	}{
+		{"note", acme.ID, "Client", "Acme"},
+		{"note", acme.ID, "url", "https://acme.example"},
+		{"note", globex.ID, "client", "globex"},
+		{"todo", bug.ID, "client", "acme"},
+		{"todo", bug.ID, "severity", "high"},
+	} {
+		if err := store.SetField(f.itemType, f.id, f.key, f.value); err != nil {
+			t.Fatalf("SetField(%q) err = %v", f.key, err)
+		}
+	}
+	if err := store.SetField("note", acme.ID, "bad key", "x"); err == nil {
+		t.Errorf("SetField() with a space in the key should fail")
+	}
+
+	fields, err := store.GetFields("note", acme.ID)
+	want := []models.Field{{Key: "client", Value: "Acme"}, {Key: "url", Value: "https://acme.example"}}
+	if err != nil || !reflect.DeepEqual(fields, want) {
+		t.Errorf("GetFields() = %v, %v; want %v", fields, err, want)
+	}
+	if keys, _ := store.ListFieldKeys(); !reflect.DeepEqual(keys, []string{"client", "severity", "url"}) {
+		t.Errorf("ListFieldKeys() = %v", keys)
+	}
+
+	notes, err := store.QueryNotes(NoteQuery{Text: "kickoff", Fields: map[string]string{"client": "acme"}})
+	if err != nil || !reflect.DeepEqual(noteTitles(notes), []string{"kickoff notes"}) {
+		t.Errorf("QueryNotes(client:acme) = %v, %v", noteTitles(notes), err)
+	}
+	todos, err := store.QueryTodos(TodoQuery{Fields: map[string]string{"client": "ACME", "severity": "high"}})
+	if err != nil || len(todos) != 1 || todos[0].ID != bug.ID {
+		t.Errorf("QueryTodos(client:ACME severity:high) = %v, %v", todos, err)
+	}
+	if n, _ := store.CountTodos(TodoQuery{Fields: map[string]string{"severity": "low"}}); n != 0 {
+		t.Errorf("CountTodos(severity:low) = %d, want 0", n)
+	}
+
+	// An empty value removes the field; deleting the item removes the rest
+	if err := store.SetField("todo", bug.ID, "severity", " "); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+	if fields, _ := store.GetFields("todo", bug.ID); len(fields) != 1 {
+		t.Errorf("GetFields() after clearing = %v, want only client", fields)
+	}
+	if err := store.DeleteNote(acme.ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+	if fields, _ := store.GetFields("note", acme.ID); len(fields) != 0 {
+		t.Errorf("GetFields() after DeleteNote = %v, want none", fields)
+	}
+}
+
+func TestParseFieldFilters(t *testing.T) {
+	for _, tt := range []struct {
+		in     string
+		text   string
+		fields map[string]string
+	}{
+		{"invoice client:acme", "invoice", map[string]string{"client": "acme"}},
+		{"Severity:High due", "due", map[string]string{"severity": "High"}},
+		{"see https://example.com at 10:30", "see https://example.com at 10:30", nil},
+		{"note: trailing", "note: trailing", nil},
+	} {
+		text, fields := ParseFieldFilters(tt.in)
+		if text != tt.text || !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("ParseFieldFilters(%q) = %q, %v; want %q, %v", tt.in, text, fields, tt.text, tt.fields)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Goals (Phase 6: Planning)
+//
+// Weekly and monthly targets shown with progress bars on the home
+// screen. Only the definition is stored; the goals package counts the
+// progress from notes, todos and focus sessions each time.
+
+// CreateGoal stores goal and sets its ID and CreatedAt.
+func (s *Store) CreateGoal(goal *models.Goal) error {
+	goal.CreatedAt = time.Now()
+	res, err := s.db.Exec(
+		"INSERT INTO goals (title, kind, tag, target, period, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		goal.Title, goal.Kind, goal.Tag, goal.Target, goal.Period, goal.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	goal.ID, err = res.LastInsertId()
+	return err
+}
+
+// ListGoals returns every goal, oldest first.
+func (s *Store) ListGoals() ([]models.Goal, error) {
+	rows, err := s.db.Query("SELECT id, title, kind, tag, target, period, created_at FROM goals ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var goals []models.Goal
+	for rows.Next() {
+		var g models.Goal
+		if err := rows.Scan(&g.ID, &g.Title, &g.Kind, &g.Tag, &g.Target, &g.Period, &g.CreatedAt); err != nil {
+			return nil, err
+		}
+		goals = append(goals, g)
+	}
+	return goals, rows.Err()
+}
+
+// DeleteGoal removes a goal. It returns sql.ErrNoRows when there is no
+// goal with that ID.
+func (s *Store) DeleteGoal(id int64) error {
+	res, err := s.db.Exec("DELETE FROM goals WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+	return err
+}
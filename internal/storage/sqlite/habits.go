@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Habits (Phase 5: Habits)
+//
+// Daily habits and their check-offs. A check-off is one row per habit per
+// calendar day, stored as the local date ("2006-01-02") rather than a
+// timestamp, so a habit checked at 23:59 stays on that day when the
+// timezone changes. Deleting a habit deletes its check-offs.
+
+// habitDayFormat is how check-off days are stored.
+const habitDayFormat = "2006-01-02"
+
+// habitDay returns the stored form of t's calendar day.
+func habitDay(t time.Time) string {
+	return t.Format(habitDayFormat)
+}
+
+// CreateHabit stores habit and sets its ID and CreatedAt.
+func (s *Store) CreateHabit(habit *models.Habit) error {
+	habit.CreatedAt = time.Now()
+	res, err := s.db.Exec("INSERT INTO habits (name, created_at) VALUES (?, ?)", habit.Name, habit.CreatedAt)
+	if err != nil {
+		return err
+	}
+	habit.ID, err = res.LastInsertId()
+	return err
+}
+
+// ListHabits returns every habit, oldest first.
+func (s *Store) ListHabits() ([]models.Habit, error) {
+	rows, err := s.db.Query("SELECT id, name, created_at FROM habits ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var habits []models.Habit
+	for rows.Next() {
+		var h models.Habit
+		if err := rows.Scan(&h.ID, &h.Name, &h.CreatedAt); err != nil {
+			return nil, err
+		}
+		habits = append(habits, h)
+	}
+	return habits, rows.Err()
+}
+
+// RenameHabit changes a habit's name.
+func (s *Store) RenameHabit(id int64, name string) error {
+	_, err := s.db.Exec("UPDATE habits SET name = ? WHERE id = ?", name, id)
+	return err
+}
+
+// DeleteHabit removes a habit and its check-offs.
+func (s *Store) DeleteHabit(id int64) error {
+	return s.WithTx(func(tx *Tx) error {
+		if _, err := tx.tx.Exec("DELETE FROM habit_checks WHERE habit_id = ?", id); err != nil {
+			return err
+		}
+		_, err := tx.tx.Exec("DELETE FROM habits WHERE id = ?", id)
+		return err
+	})
+}
+
+// ToggleHabitCheck checks a habit off for day, or unchecks it when it is
+// already checked, and reports whether it is checked now.
+func (s *Store) ToggleHabitCheck(id int64, day time.Time) (bool, error) {
+	res, err := s.db.Exec("DELETE FROM habit_checks WHERE habit_id = ? AND day = ?", id, habitDay(day))
+	if err != nil {
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return false, err
+	}
+	_, err = s.db.Exec("INSERT INTO habit_checks (habit_id, day) VALUES (?, ?)", id, habitDay(day))
+	return err == nil, err
+}
+
+// ListHabitChecks returns the days each habit was checked off, newest
+// first, as local midnights.
+func (s *Store) ListHabitChecks() (map[int64][]time.Time, error) {
+	rows, err := s.db.Query("SELECT habit_id, day FROM habit_checks ORDER BY habit_id, day DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checks := make(map[int64][]time.Time)
+	for rows.Next() {
+		var id int64
+		var day string
+		if err := rows.Scan(&id, &day); err != nil {
+			return nil, err
+		}
+		t, err := time.ParseInLocation(habitDayFormat, day, time.Local)
+		if err != nil {
+			continue // Not written by this package
+		}
+		checks[id] = append(checks[id], t)
+	}
+	return checks, rows.Err()
+}
+
+// CountHabitsChecked returns how many habits were checked off on day, and
+// how many habits there are.
+func (s *Store) CountHabitsChecked(day time.Time) (checked, total int, err error) {
+	err = s.db.QueryRow(
+		"SELECT (SELECT COUNT(*) FROM habit_checks WHERE day = ?), (SELECT COUNT(*) FROM habits)",
+		habitDay(day),
+	).Scan(&checked, &total)
+	return checked, total, err
+}
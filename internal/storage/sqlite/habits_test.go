@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestHabitChecks(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	read := &models.Habit{Name: "Read"}
+	run := &models.Habit{Name: "Run"}
+	for _, h := range []*models.Habit{read, run} {
+		if err := store.CreateHabit(h); err != nil {
+			t.Fatalf("CreateHabit() err = %v", err)
+		}
+	}
+
+	today := time.Date(2026, 3, 11, 23, 59, 0, 0, time.Local)
+	yesterday := today.AddDate(0, 0, -1)
+	for _, day := range []time.Time{today, yesterday} {
+		if checked, err := store.ToggleHabitCheck(read.ID, day); err != nil || !checked {
+			t.Fatalf("ToggleHabitCheck() = %v, %v; want checked", checked, err)
+		}
+	}
+	if checked, total, err := store.CountHabitsChecked(today); err != nil || checked != 1 || total != 2 {
+		t.Errorf("CountHabitsChecked() = %d of %d, %v; want 1 of 2", checked, total, err)
+	}
+
+	checks, err := store.ListHabitChecks()
+	if err != nil {
+		t.Fatalf("ListHabitChecks() err = %v", err)
+	}
+	days := checks[read.ID]
+	if len(days) != 2 || days[0].Day() != 11 || days[1].Day() != 10 || days[0].Hour() != 0 {
+		t.Errorf("ListHabitChecks()[read] = %v, want the 11th then the 10th at midnight", days)
+	}
+
+	// Toggling again unchecks; deleting the habit drops its check-offs.
+	if checked, err := store.ToggleHabitCheck(read.ID, today); err != nil || checked {
+		t.Fatalf("second ToggleHabitCheck() = %v, %v; want unchecked", checked, err)
+	}
+	if err := store.DeleteHabit(read.ID); err != nil {
+		t.Fatalf("DeleteHabit() err = %v", err)
+	}
+	checks, _ = store.ListHabitChecks()
+	habits, _ := store.ListHabits()
+	if len(checks) != 0 || len(habits) != 1 || habits[0].Name != "Run" {
+		t.Errorf("after delete: checks %v, habits %+v", checks, habits)
+	}
+}
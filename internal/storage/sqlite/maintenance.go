@@ -0,0 +1,200 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Maintenance (Phase 4: Robustness)
+//
+// Housekeeping run by `flowState maintenance`, and on startup every
+// maintenance_days days when configured. It checks the whole database
+// with PRAGMA integrity_check and stops there if anything is wrong, so a
+// damaged file is never rewritten. Otherwise it removes links whose
+// source or target was deleted and unfilled wikilink placeholders that
+// nothing links to, then runs ANALYZE and VACUUM. The time of the last
+// run is kept in the settings table.
+
+// LastMaintenanceKey is the settings key holding when maintenance last
+// ran, in RFC 3339.
+const LastMaintenanceKey = "maintenance.last_run"
+
+// ErrIntegrity is returned by Maintain when the integrity check finds
+// problems; the report lists them.
+var ErrIntegrity = errors.New("database integrity check failed")
+
+// MaintenanceReport says what Maintain did.
+type MaintenanceReport struct {
+	Problems     []string // Rows of the integrity check; empty when it passed
+	OrphanLinks  int      // Links removed because an end no longer exists
+	Placeholders int      // Orphan placeholder notes removed
+	SizeBefore   int64    // Database size in bytes before the run
+	SizeAfter    int64    // Database size in bytes after VACUUM
+	Duration     time.Duration
+}
+
+// Maintain runs the integrity check, prunes orphans and compacts the
+// database, recording the time of the run.
+func (s *Store) Maintain() (*MaintenanceReport, error) {
+	if s.readOnly {
+		return nil, errors.New("database is open read-only")
+	}
+	start := time.Now()
+	report := &MaintenanceReport{}
+
+	var err error
+	if report.SizeBefore, err = s.size(); err != nil {
+		return nil, err
+	}
+	if report.Problems, err = s.integrityProblems(); err != nil {
+		return nil, err
+	}
+	if len(report.Problems) > 0 {
+		report.Duration = time.Since(start)
+		return report, ErrIntegrity
+	}
+
+	orphans, err := s.ListOrphanPlaceholders()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.WithTx(func(tx *Tx) error {
+		for _, note := range orphans {
+			if _, err := tx.tx.Exec("DELETE FROM note_tags WHERE note_id = ?", note.ID); err != nil {
+				return err
+			}
+			if err := deleteFields(tx.tx, "note", note.ID); err != nil {
+				return err
+			}
+			if err := deleteNoteReminder(tx.tx, note.ID); err != nil {
+				return err
+			}
+			if _, err := tx.tx.Exec("DELETE FROM notes WHERE id = ?", note.ID); err != nil {
+				return err
+			}
+		}
+		report.Placeholders = len(orphans)
+
+		res, err := tx.tx.Exec(`
+			DELETE FROM links WHERE
+				(source_type = 'note' AND source_id NOT IN (SELECT id FROM notes))
+				OR (source_type = 'todo' AND source_id NOT IN (SELECT id FROM todos))
+				OR (target_type = 'note' AND target_id NOT IN (SELECT id FROM notes))
+				OR (target_type = 'todo' AND target_id NOT IN (SELECT id FROM todos))`)
+		if err != nil {
+			return err
+		}
+		n, _ := res.RowsAffected()
+		report.OrphanLinks = int(n)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to prune orphans: %w", err)
+	}
+
+	if _, err := s.db.Exec("ANALYZE"); err != nil {
+		return nil, fmt.Errorf("ANALYZE failed: %w", err)
+	}
+	if _, err := s.db.Exec("VACUUM"); err != nil {
+		return nil, fmt.Errorf("VACUUM failed: %w", err)
+	}
+	if report.SizeAfter, err = s.size(); err != nil {
+		return nil, err
+	}
+	if err := s.SetSetting(LastMaintenanceKey, start.Format(time.RFC3339)); err != nil {
+		return nil, err
+	}
+	report.Duration = time.Since(start)
+	return report, nil
+}
+
+// Summary describes the run in one line, e.g. "Removed 2 orphan links
+// and 1 placeholder; 96.0 KB → 80.0 KB".
+func (r *MaintenanceReport) Summary() string {
+	if len(r.Problems) > 0 {
+		return fmt.Sprintf("Integrity check found %d problem(s); nothing was changed", len(r.Problems))
+	}
+	removed := "Nothing to prune"
+	if r.OrphanLinks > 0 || r.Placeholders > 0 {
+		removed = fmt.Sprintf("Removed %d orphan link(s) and %d placeholder(s)", r.OrphanLinks, r.Placeholders)
+	}
+	return fmt.Sprintf("%s; %s → %s", removed, FormatSize(r.SizeBefore), FormatSize(r.SizeAfter))
+}
+
+// FormatSize formats a byte count as B, KB, MB or GB.
+func FormatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	value, suffix := float64(n)/unit, "KB"
+	for _, next := range []string{"MB", "GB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
+
+// LastMaintenance returns when maintenance last ran, or the zero time if
+// it never has.
+func (s *Store) LastMaintenance() (time.Time, error) {
+	value, ok, err := s.GetSetting(LastMaintenanceKey)
+	if err != nil || !ok {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, nil
+	}
+	return t, nil
+}
+
+// MaintenanceDue reports whether maintenance last ran more than days ago
+// (or never). It is never due when days is zero or less, or the database
+// is read-only.
+func (s *Store) MaintenanceDue(days int, now time.Time) (bool, error) {
+	if days <= 0 || s.readOnly {
+		return false, nil
+	}
+	last, err := s.LastMaintenance()
+	if err != nil {
+		return false, err
+	}
+	return last.IsZero() || now.Sub(last) >= time.Duration(days)*24*time.Hour, nil
+}
+
+// integrityProblems runs the full integrity check and returns its rows,
+// or nil when the database is sound.
+func (s *Store) integrityProblems() ([]string, error) {
+	rows, err := s.db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return nil, fmt.Errorf("integrity check failed to run: %w", err)
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return nil, err
+		}
+		if row != "ok" {
+			problems = append(problems, row)
+		}
+	}
+	return problems, rows.Err()
+}
+
+// size returns the size of the database file in bytes.
+func (s *Store) size() (int64, error) {
+	var pages, pageSize int64
+	if err := s.db.QueryRow("PRAGMA page_count").Scan(&pages); err != nil {
+		return 0, err
+	}
+	if err := s.db.QueryRow("PRAGMA page_size").Scan(&pageSize); err != nil {
+		return 0, err
+	}
+	return pages * pageSize, nil
+}
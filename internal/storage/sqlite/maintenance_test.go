@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestMaintain(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	source := &models.Note{Title: "Planning", Body: "[[Budget]]"}
+	kept := &models.Note{Title: "Budget", Body: models.PlaceholderBody, Tags: []string{models.PlaceholderTag}}
+	orphan := &models.Note{Title: "Roadmap", Body: models.PlaceholderBody, Tags: []string{models.PlaceholderTag}}
+	gone := &models.Note{Title: "Old draft"}
+	for _, note := range []*models.Note{source, kept, orphan, gone} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	todo := &models.Todo{Title: "Ship", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	for _, link := range []models.Link{
+		{SourceType: "note", SourceID: source.ID, TargetType: "note", TargetID: kept.ID, LinkType: "wikilink"},
+		{SourceType: "note", SourceID: gone.ID, TargetType: "note", TargetID: orphan.ID, LinkType: "wikilink"},
+		{SourceType: "todo", SourceID: todo.ID, TargetType: "note", TargetID: gone.ID, LinkType: models.LinkTypeRelated},
+	} {
+		link := link
+		if err := store.CreateLink(&link); err != nil {
+			t.Fatalf("CreateLink() err = %v", err)
+		}
+	}
+	if err := store.DeleteNote(gone.ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+
+	due, err := store.MaintenanceDue(7, time.Now())
+	if err != nil || !due {
+		t.Fatalf("MaintenanceDue() before any run = %v, %v; want true", due, err)
+	}
+
+	report, err := store.Maintain()
+	if err != nil {
+		t.Fatalf("Maintain() err = %v", err)
+	}
+	if report.Placeholders != 1 || report.OrphanLinks != 2 || len(report.Problems) != 0 {
+		t.Errorf("Maintain() = %+v, want 1 placeholder and 2 links removed", report)
+	}
+	if report.SizeAfter <= 0 {
+		t.Errorf("SizeAfter = %d, want the database size", report.SizeAfter)
+	}
+
+	if note, _ := store.GetNote(orphan.ID); note != nil {
+		t.Error("orphan placeholder was not removed")
+	}
+	if note, _ := store.GetNote(kept.ID); note == nil {
+		t.Error("linked placeholder was removed")
+	}
+	links, err := store.ListLinks()
+	if err != nil {
+		t.Fatalf("ListLinks() err = %v", err)
+	}
+	if len(links) != 1 || links[0].TargetID != kept.ID {
+		t.Errorf("links after Maintain() = %+v, want only the link to Budget", links)
+	}
+
+	if due, _ := store.MaintenanceDue(7, time.Now()); due {
+		t.Error("MaintenanceDue() right after a run = true")
+	}
+	if due, _ := store.MaintenanceDue(7, time.Now().AddDate(0, 0, 8)); !due {
+		t.Error("MaintenanceDue() a week later = false")
+	}
+	if due, _ := store.MaintenanceDue(0, time.Now().AddDate(1, 0, 0)); due {
+		t.Error("MaintenanceDue() with days = 0 should never be due")
+	}
+}
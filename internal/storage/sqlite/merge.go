@@ -0,0 +1,551 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Merging a backup (Phase 4: Robustness)
+//
+// `flowState restore --merge FILE` brings back what a backup has without
+// replacing the live database, so nothing written since the backup is
+// lost. Notes, todos and focus sessions are matched by identity: the same
+// ID and creation time, or failing that the same creation time alone
+// (an item added by an earlier merge keeps the backup's creation time
+// under a new ID). Then, for each item of the backup:
+//   - missing here: added with a new ID, keeping its timestamps
+//   - the same content (for notes, title, body and tags): unchanged
+//   - edited later in the backup: updated to the backup's version
+//   - edited later here: a conflict; the live version is kept and the
+//     item reported so it can be checked by hand
+//
+// Links between the backup's items, their custom fields and note
+// reminders are added against the matched IDs. An item that was added or
+// updated takes the backup's field values and reminder; any other keeps
+// its own and only gains what it lacks. Nothing is ever deleted, so an
+// item deleted since the backup comes back. Merging the same backup
+// twice changes nothing the second time.
+
+// MergeConflict is an item the live database changed more recently than
+// the backup; it was left as it is.
+type MergeConflict struct {
+	Type  string // "note" or "todo"
+	ID    int64  // ID in the live database
+	Title string
+}
+
+// MergeResult says what MergeBackup did with the backup's items.
+type MergeResult struct {
+	Added     int // Notes, todos and sessions only the backup had
+	Updated   int // Items replaced by the backup's newer version
+	Unchanged int // Items the same in both
+	Conflicts []MergeConflict
+	Links     int // Links added
+}
+
+// MergeBackup merges the database file at path into s. The file itself
+// is not modified: a copy is opened, so a backup from an older version
+// can be migrated first.
+func (s *Store) MergeBackup(path string) (*MergeResult, error) {
+	if s.readOnly {
+		return nil, errors.New("database is open read-only")
+	}
+	copyPath, err := copyToTemp(path)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(copyPath)
+
+	backup, err := New(&config.Config{DbPath: copyPath})
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	defer backup.Close()
+	return s.merge(backup)
+}
+
+// copyToTemp copies the file at path to a new temporary file and returns
+// its path.
+func copyToTemp(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp("", "flowState-merge-*"+filepath.Ext(path))
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
+
+// identities matches the backup's items to live ones of one kind.
+type identities struct {
+	byID      map[int64]time.Time // Live ID to creation time
+	byCreated map[int64][]int64   // Creation time (UnixNano) to live IDs
+	taken     map[int64]bool      // Live IDs already matched
+}
+
+func newIdentities() *identities {
+	return &identities{byID: map[int64]time.Time{}, byCreated: map[int64][]int64{}, taken: map[int64]bool{}}
+}
+
+func (ids *identities) add(id int64, created time.Time) {
+	ids.byID[id] = created
+	ids.byCreated[created.UnixNano()] = append(ids.byCreated[created.UnixNano()], id)
+}
+
+// match returns the live ID of the item created at created with ID id in
+// the backup, or false when there is none.
+func (ids *identities) match(id int64, created time.Time) (int64, bool) {
+	if c, ok := ids.byID[id]; ok && c.Equal(created) && !ids.taken[id] {
+		ids.taken[id] = true
+		return id, true
+	}
+	for _, live := range ids.byCreated[created.UnixNano()] {
+		if !ids.taken[live] {
+			ids.taken[live] = true
+			return live, true
+		}
+	}
+	return 0, false
+}
+
+// merge merges backup into s in one transaction.
+func (s *Store) merge(backup *Store) (*MergeResult, error) {
+	backupNotes, err := allNotes(backup.db)
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	backupTodos, err := backup.queryTodos(context.Background(), "SELECT "+todoColumns+" FROM todos ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	backupSessions, err := backup.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	backupLinks, err := backup.ListLinks()
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	backupFields, err := allFields(backup.db)
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	backupReminders, err := allReminders(backup.db)
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	notebooks, err := backup.ListNotebooks()
+	if err != nil {
+		return nil, fmt.Errorf("backup: %w", err)
+	}
+	notebookNames := map[int64]string{}
+	for _, nb := range notebooks {
+		notebookNames[nb.ID] = nb.Name
+	}
+
+	liveNotes, err := allNotes(s.db)
+	if err != nil {
+		return nil, err
+	}
+	liveTodos, err := s.queryTodos(context.Background(), "SELECT "+todoColumns+" FROM todos ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	liveSessions, err := s.ListSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{}
+	// ids maps a backup item ("note/3") to its ID in s; replaced marks the
+	// items added or updated, whose fields and reminder replace the live
+	// ones.
+	ids := map[string]int64{}
+	replaced := map[string]bool{}
+	err = s.WithTx(func(t *Tx) error {
+		notes := newIdentities()
+		liveNote := map[int64]models.Note{}
+		for _, note := range liveNotes {
+			notes.add(note.ID, note.CreatedAt)
+			liveNote[note.ID] = note
+		}
+		for _, note := range backupNotes {
+			backupID := note.ID
+			id, ok := notes.match(note.ID, note.CreatedAt)
+			if !ok {
+				if err := mergeAddNote(t.tx, &note, notebookNames[note.NotebookID]); err != nil {
+					return fmt.Errorf("note %q: %w", note.Title, err)
+				}
+				ids[mergeKey("note", backupID)] = note.ID
+				replaced[mergeKey("note", backupID)] = true
+				result.Added++
+				continue
+			}
+			ids[mergeKey("note", backupID)] = id
+			live := liveNote[id]
+			switch {
+			case live.Title == note.Title && live.Body == note.Body && sameTags(live.Tags, note.Tags):
+				result.Unchanged++
+			case note.UpdatedAt.After(live.UpdatedAt):
+				note.ID = id
+				if err := mergeUpdateNote(t.tx, &note); err != nil {
+					return fmt.Errorf("note %q: %w", note.Title, err)
+				}
+				replaced[mergeKey("note", backupID)] = true
+				result.Updated++
+			default:
+				result.Conflicts = append(result.Conflicts, MergeConflict{Type: "note", ID: id, Title: live.Title})
+			}
+		}
+
+		todos := newIdentities()
+		liveTodo := map[int64]models.Todo{}
+		for _, todo := range liveTodos {
+			todos.add(todo.ID, todo.CreatedAt)
+			liveTodo[todo.ID] = todo
+		}
+		for _, todo := range backupTodos {
+			if todo.NoteID != nil {
+				if id, ok := ids[mergeKey("note", *todo.NoteID)]; ok {
+					todo.NoteID = &id
+				} else {
+					todo.NoteID = nil
+				}
+			}
+			backupID := todo.ID
+			id, ok := todos.match(todo.ID, todo.CreatedAt)
+			if !ok {
+				if err := mergeAddTodo(t.tx, &todo); err != nil {
+					return fmt.Errorf("todo %q: %w", todo.Title, err)
+				}
+				ids[mergeKey("todo", backupID)] = todo.ID
+				replaced[mergeKey("todo", backupID)] = true
+				result.Added++
+				continue
+			}
+			ids[mergeKey("todo", backupID)] = id
+			live := liveTodo[id]
+			switch {
+			case sameTodoContent(live, todo):
+				result.Unchanged++
+			case todo.UpdatedAt.After(live.UpdatedAt):
+				todo.ID = id
+				if err := mergeUpdateTodo(t.tx, &todo); err != nil {
+					return fmt.Errorf("todo %q: %w", todo.Title, err)
+				}
+				replaced[mergeKey("todo", backupID)] = true
+				result.Updated++
+			default:
+				result.Conflicts = append(result.Conflicts, MergeConflict{Type: "todo", ID: id, Title: live.Title})
+			}
+		}
+
+		// Sessions are not edited after they end, so a match is unchanged
+		sessions := newIdentities()
+		for _, session := range liveSessions {
+			sessions.add(session.ID, session.CreatedAt)
+		}
+		for _, session := range backupSessions {
+			if _, ok := sessions.match(session.ID, session.CreatedAt); ok {
+				result.Unchanged++
+				continue
+			}
+			if _, err := t.tx.Exec(
+				"INSERT INTO sessions (start_time, end_time, duration, status, label, energy, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+				session.StartTime, session.EndTime, session.Duration, session.Status, session.Label, nullIfZero(session.Energy), session.CreatedAt,
+			); err != nil {
+				return fmt.Errorf("session of %s: %w", session.StartTime.Format(time.DateTime), err)
+			}
+			result.Added++
+		}
+
+		for _, link := range backupLinks {
+			source, ok := ids[mergeKey(link.SourceType, link.SourceID)]
+			if !ok {
+				continue
+			}
+			target, ok := ids[mergeKey(link.TargetType, link.TargetID)]
+			if !ok {
+				continue
+			}
+			res, err := t.tx.Exec(
+				"INSERT OR IGNORE INTO links (source_type, source_id, target_type, target_id, link_type, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+				link.SourceType, source, link.TargetType, target, link.LinkType, link.CreatedAt,
+			)
+			if err != nil {
+				return err
+			}
+			if n, _ := res.RowsAffected(); n > 0 {
+				result.Links++
+			}
+		}
+
+		for _, f := range backupFields {
+			key := mergeKey(f.itemType, f.itemID)
+			id, ok := ids[key]
+			if !ok {
+				continue
+			}
+			conflict := "DO NOTHING"
+			if replaced[key] {
+				conflict = "DO UPDATE SET value = excluded.value"
+			}
+			if _, err := t.tx.Exec(
+				"INSERT INTO item_fields (item_type, item_id, key, value) VALUES (?, ?, ?, ?) ON CONFLICT(item_type, item_id, key) "+conflict,
+				f.itemType, id, f.key, f.value,
+			); err != nil {
+				return err
+			}
+		}
+
+		for noteID, day := range backupReminders {
+			key := mergeKey("note", noteID)
+			id, ok := ids[key]
+			if !ok {
+				continue
+			}
+			conflict := "DO NOTHING"
+			if replaced[key] {
+				conflict = "DO UPDATE SET day = excluded.day"
+			}
+			if _, err := t.tx.Exec(
+				"INSERT INTO note_reminders (note_id, day) VALUES (?, ?) ON CONFLICT(note_id) "+conflict,
+				id, day,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// allNotes returns every note with its full body, oldest first.
+func allNotes(q querier) ([]models.Note, error) {
+	rows, err := q.Query("SELECT id, title, body, tags, created_at, updated_at, notebook_id, starred FROM notes ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []models.Note
+	for rows.Next() {
+		var note models.Note
+		var body sql.NullString
+		var tagsStr sql.NullString
+		var notebookID sql.NullInt64
+		if err := rows.Scan(&note.ID, &note.Title, &body, &tagsStr, &note.CreatedAt, &note.UpdatedAt, &notebookID, &note.Starred); err != nil {
+			return nil, err
+		}
+		note.Body = body.String
+		json.Unmarshal([]byte(tagsStr.String), &note.Tags)
+		note.NotebookID = notebookID.Int64
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
+
+// itemField is a custom field row of any note or todo.
+type itemField struct {
+	itemType   string
+	itemID     int64
+	key, value string
+}
+
+// allFields returns every custom field of every item.
+func allFields(q querier) ([]itemField, error) {
+	rows, err := q.Query("SELECT item_type, item_id, key, value FROM item_fields ORDER BY item_type, item_id, key")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []itemField
+	for rows.Next() {
+		var f itemField
+		if err := rows.Scan(&f.itemType, &f.itemID, &f.key, &f.value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, rows.Err()
+}
+
+// allReminders returns the reminder day ("2006-01-02") of every note
+// that has one, by note ID.
+func allReminders(q querier) (map[int64]string, error) {
+	rows, err := q.Query("SELECT note_id, day FROM note_reminders")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := map[int64]string{}
+	for rows.Next() {
+		var noteID int64
+		var day string
+		if err := rows.Scan(&noteID, &day); err != nil {
+			return nil, err
+		}
+		days[noteID] = day
+	}
+	return days, rows.Err()
+}
+
+// mergeAddNote inserts a note of the backup with its timestamps, filed in
+// the notebook called notebook (created if needed), and sets its new ID.
+func mergeAddNote(tx *sql.Tx, note *models.Note, notebook string) error {
+	var notebookID interface{}
+	if notebook != "" {
+		if _, err := tx.Exec(
+			"INSERT INTO notebooks (name, created_at) VALUES (?, ?) ON CONFLICT(name) DO NOTHING",
+			notebook, time.Now(),
+		); err != nil {
+			return err
+		}
+		var id int64
+		if err := tx.QueryRow("SELECT id FROM notebooks WHERE name = ?", notebook).Scan(&id); err != nil {
+			return err
+		}
+		notebookID = id
+	}
+
+	tagsJSON, _ := json.Marshal(note.Tags)
+	res, err := tx.Exec(
+		"INSERT INTO notes (title, body, tags, created_at, updated_at, notebook_id, starred) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		note.Title, note.Body, string(tagsJSON), note.CreatedAt, note.UpdatedAt, notebookID, note.Starred,
+	)
+	if err != nil {
+		return err
+	}
+	note.ID, _ = res.LastInsertId()
+	return syncNoteTags(tx, note.ID, note.Tags)
+}
+
+// mergeUpdateNote replaces a live note's text with the backup's, keeping
+// the backup's UpdatedAt so a second merge finds them the same. That can
+// predate the note's stored vector, so the vector is dropped.
+func mergeUpdateNote(tx *sql.Tx, note *models.Note) error {
+	tagsJSON, _ := json.Marshal(note.Tags)
+	if _, err := tx.Exec(
+		"UPDATE notes SET title = ?, body = ?, tags = ?, updated_at = ? WHERE id = ?",
+		note.Title, note.Body, string(tagsJSON), note.UpdatedAt, note.ID,
+	); err != nil {
+		return err
+	}
+	if err := deleteNoteEmbedding(tx, note.ID); err != nil {
+		return err
+	}
+	return syncNoteTags(tx, note.ID, note.Tags)
+}
+
+// mergeAddTodo inserts a todo of the backup with its timestamps and sets
+// its new ID.
+func mergeAddTodo(tx *sql.Tx, todo *models.Todo) error {
+	res, err := tx.Exec(
+		"INSERT INTO todos (title, description, status, priority, due_date, note_id, created_at, updated_at, estimate_minutes, project, deferred_until, starred, completed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		todo.Title, todo.Description, todo.Status, todo.Priority, timeOrNil(todo.DueDate), idOrNil(todo.NoteID), todo.CreatedAt, todo.UpdatedAt, todo.EstimateMinutes, todo.Project, timeOrNil(todo.DeferredUntil), todo.Starred, timeOrNil(todo.CompletedAt),
+	)
+	if err != nil {
+		return err
+	}
+	todo.ID, _ = res.LastInsertId()
+	return syncTodoTags(tx, todo)
+}
+
+// mergeUpdateTodo replaces a live todo with the backup's version, keeping
+// the backup's UpdatedAt. The star is left as it is, as in UpdateTodo.
+func mergeUpdateTodo(tx *sql.Tx, todo *models.Todo) error {
+	if _, err := tx.Exec(
+		"UPDATE todos SET title = ?, description = ?, status = ?, priority = ?, due_date = ?, note_id = ?, updated_at = ?, estimate_minutes = ?, project = ?, deferred_until = ?, completed_at = ? WHERE id = ?",
+		todo.Title, todo.Description, todo.Status, todo.Priority, timeOrNil(todo.DueDate), idOrNil(todo.NoteID), todo.UpdatedAt, todo.EstimateMinutes, todo.Project, timeOrNil(todo.DeferredUntil), timeOrNil(todo.CompletedAt), todo.ID,
+	); err != nil {
+		return err
+	}
+	return syncTodoTags(tx, todo)
+}
+
+// sameTodoContent reports whether two versions of a todo have the same
+// fields UpdateTodo writes.
+func sameTodoContent(a, b models.Todo) bool {
+	return a.Title == b.Title && a.Description == b.Description &&
+		a.Status == b.Status && a.Priority == b.Priority &&
+		a.EstimateMinutes == b.EstimateMinutes && a.Project == b.Project &&
+		sameTime(a.DueDate, b.DueDate) && sameTime(a.DeferredUntil, b.DeferredUntil) &&
+		sameID(a.NoteID, b.NoteID)
+}
+
+// sameTags reports whether two notes have the same tags in any order.
+func sameTags(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func timeOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
+
+func idOrNil(id *int64) interface{} {
+	if id == nil {
+		return nil
+	}
+	return *id
+}
+
+func nullIfZero(n int) interface{} {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+// mergeKey identifies an item of the backup across notes and todos.
+func mergeKey(itemType string, id int64) string {
+	return fmt.Sprintf("%s/%d", itemType, id)
+}
@@ -0,0 +1,194 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestMergeBackup(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	trip := &models.Note{Title: "Trip", Body: "Pack"}
+	budget := &models.Note{Title: "Budget", Body: "100"}
+	draft := &models.Note{Title: "Draft", Body: "old"}
+	for _, note := range []*models.Note{trip, budget, draft} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	book := &models.Todo{Title: "Book flights", NoteID: &trip.ID}
+	if err := store.CreateTodo(book); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	if err := store.CreateLinks([]models.Link{{SourceType: "note", SourceID: trip.ID, TargetType: "note", TargetID: draft.ID}}); err != nil {
+		t.Fatalf("CreateLinks() err = %v", err)
+	}
+	session := &models.FocusSession{StartTime: time.Now().Add(-time.Hour), Duration: 1500, Status: models.SessionStatusCompleted}
+	if err := store.CreateSession(session); err != nil {
+		t.Fatalf("CreateSession() err = %v", err)
+	}
+
+	// Take the backup, then let both copies move on
+	path := filepath.Join(t.TempDir(), "backup.db")
+	if _, err := store.db.Exec("VACUUM INTO ?", path); err != nil {
+		t.Fatalf("VACUUM INTO err = %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	budget.Body = "250"
+	if err := store.UpdateNote(budget); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+	if err := store.DeleteNote(draft.ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+	if err := store.DeleteSession(session.ID); err != nil {
+		t.Fatalf("DeleteSession() err = %v", err)
+	}
+
+	backup, err := New(&config.Config{DbPath: path})
+	if err != nil {
+		t.Fatalf("New(backup) err = %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	edited := *book
+	edited.Status = models.TodoStatusCompleted
+	if err := backup.UpdateTodo(&edited); err != nil {
+		t.Fatalf("UpdateTodo(backup) err = %v", err)
+	}
+	backup.Close()
+
+	result, err := store.MergeBackup(path)
+	if err != nil {
+		t.Fatalf("MergeBackup() err = %v", err)
+	}
+	// The draft and the session come back, the todo takes the backup's
+	// newer edit, the budget keeps the live one, the trip is the same
+	if result.Added != 2 || result.Updated != 1 || result.Unchanged != 1 || result.Links != 1 {
+		t.Errorf("MergeBackup() = %+v, want 2 added, 1 updated, 1 unchanged, 1 link", result)
+	}
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Type != "note" || result.Conflicts[0].ID != budget.ID {
+		t.Errorf("Conflicts = %+v, want the budget note", result.Conflicts)
+	}
+
+	got, _ := store.GetTodo(book.ID)
+	if got == nil || got.Status != models.TodoStatusCompleted {
+		t.Errorf("todo after merge = %+v, want completed", got)
+	}
+	kept, _ := store.GetNote(budget.ID)
+	if kept == nil || kept.Body != "250" {
+		t.Errorf("budget after merge = %+v, want the live body kept", kept)
+	}
+	restored, err := store.GetNoteByTitle("Draft")
+	if err != nil || restored == nil || restored.Body != "old" || !restored.CreatedAt.Equal(draft.CreatedAt) {
+		t.Fatalf("restored draft = %+v, err = %v", restored, err)
+	}
+	links, _ := store.GetLinksForItem("note", restored.ID)
+	if len(links) != 1 || links[0].SourceID != trip.ID {
+		t.Errorf("links of the restored draft = %+v, want one from the trip", links)
+	}
+	sessions, _ := store.ListSessions()
+	if len(sessions) != 1 || sessions[0].Duration != 1500 {
+		t.Errorf("sessions after merge = %+v, want the backup's", sessions)
+	}
+
+	// A second merge of the same backup changes nothing
+	again, err := store.MergeBackup(path)
+	if err != nil {
+		t.Fatalf("MergeBackup() again err = %v", err)
+	}
+	if again.Added != 0 || again.Updated != 0 || again.Links != 0 || len(again.Conflicts) != 1 {
+		t.Errorf("second MergeBackup() = %+v, want only the budget conflict", again)
+	}
+}
+
+func TestMergeBackupReadOnly(t *testing.T) {
+	store := newQueryTestStore(t)
+	store.readOnly = true
+	if _, err := store.MergeBackup(filepath.Join(t.TempDir(), "backup.db")); err == nil {
+		t.Error("MergeBackup() on a read-only store err = nil")
+	}
+}
+
+func TestMergeBackupFieldsRemindersAndTags(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	plan := &models.Note{Title: "Plan", Body: "Q3"}
+	ideas := &models.Note{Title: "Ideas", Body: "Later", Tags: []string{"work"}}
+	for _, note := range []*models.Note{plan, ideas} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	fix := &models.Todo{Title: "Fix login"}
+	if err := store.CreateTodo(fix); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local)
+	if err := store.SetField("note", plan.ID, "client", "acme"); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+	if err := store.SetField("todo", fix.ID, "severity", "high"); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+	if err := store.SetNoteReminder(plan.ID, &day); err != nil {
+		t.Fatalf("SetNoteReminder() err = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "backup.db")
+	if _, err := store.db.Exec("VACUUM INTO ?", path); err != nil {
+		t.Fatalf("VACUUM INTO err = %v", err)
+	}
+	if err := store.DeleteNote(plan.ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+	if err := store.SetField("todo", fix.ID, "severity", "low"); err != nil {
+		t.Fatalf("SetField() err = %v", err)
+	}
+
+	// The backup retags the ideas note without touching its text
+	backup, err := New(&config.Config{DbPath: path})
+	if err != nil {
+		t.Fatalf("New(backup) err = %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	retagged := *ideas
+	retagged.Tags = []string{"work", "someday"}
+	if err := backup.UpdateNote(&retagged); err != nil {
+		t.Fatalf("UpdateNote(backup) err = %v", err)
+	}
+	backup.Close()
+
+	result, err := store.MergeBackup(path)
+	if err != nil {
+		t.Fatalf("MergeBackup() err = %v", err)
+	}
+	if result.Added != 1 || result.Updated != 1 || result.Unchanged != 1 {
+		t.Errorf("MergeBackup() = %+v, want 1 added, 1 updated, 1 unchanged", result)
+	}
+
+	got, _ := store.GetNote(ideas.ID)
+	if got == nil || len(got.Tags) != 2 {
+		t.Errorf("ideas after merge = %+v, want the backup's tags", got)
+	}
+	restored, err := store.GetNoteByTitle("Plan")
+	if err != nil || restored == nil {
+		t.Fatalf("restored plan = %+v, err = %v", restored, err)
+	}
+	fields, _ := store.GetFields("note", restored.ID)
+	if len(fields) != 1 || fields[0].Key != "client" || fields[0].Value != "acme" {
+		t.Errorf("fields of the restored note = %+v, want client: acme", fields)
+	}
+	reminder, _ := store.GetNoteReminder(restored.ID)
+	if reminder == nil || !reminder.Equal(day) {
+		t.Errorf("reminder of the restored note = %v, want %v", reminder, day)
+	}
+	// The unchanged todo keeps its live field value
+	fields, _ = store.GetFields("todo", fix.ID)
+	if len(fields) != 1 || fields[0].Value != "low" {
+		t.Errorf("fields of the todo = %+v, want severity: low kept", fields)
+	}
+}
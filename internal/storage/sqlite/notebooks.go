@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Notebook Operations (Phase 6: Organization)
+//
+// Notebooks are an optional folder-style grouping for notes, for people
+// who prefer filing to tagging. Each note is in at most one notebook
+// (notes.notebook_id, NULL when unfiled); deleting a notebook unfiles its
+// notes rather than deleting them.
+
+// NotebookStats is a notebook with the number of notes filed in it.
+type NotebookStats struct {
+	models.Notebook
+	Notes int
+}
+
+// CreateNotebook adds a notebook called name, or returns the existing
+// one when the name is already taken (case-insensitively).
+func (s *Store) CreateNotebook(name string) (*models.Notebook, error) {
+	return createNotebook(s.db, name)
+}
+
+// createNotebook inserts or finds a notebook using db, which may be the
+// store's connection or a transaction.
+func createNotebook(db querier, name string) (*models.Notebook, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("notebook name is empty")
+	}
+	if _, err := db.Exec(
+		"INSERT INTO notebooks (name, created_at) VALUES (?, ?) ON CONFLICT(name) DO NOTHING",
+		name, time.Now(),
+	); err != nil {
+		return nil, err
+	}
+
+	var nb models.Notebook
+	err := db.QueryRow("SELECT id, name, created_at FROM notebooks WHERE name = ?", name).
+		Scan(&nb.ID, &nb.Name, &nb.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &nb, nil
+}
+
+// GetNotebook retrieves a notebook by ID. Returns nil if not found.
+func (s *Store) GetNotebook(id int64) (*models.Notebook, error) {
+	var nb models.Notebook
+	err := s.db.QueryRow("SELECT id, name, created_at FROM notebooks WHERE id = ?", id).
+		Scan(&nb.ID, &nb.Name, &nb.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nb, nil
+}
+
+// ListNotebooks returns every notebook with its note count, ordered by
+// name.
+func (s *Store) ListNotebooks() ([]NotebookStats, error) {
+	return s.ListNotebooksContext(context.Background())
+}
+
+// ListNotebooksContext is ListNotebooks with cancellation.
+func (s *Store) ListNotebooksContext(ctx context.Context) ([]NotebookStats, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT notebooks.id, notebooks.name, notebooks.created_at, COUNT(notes.id)
+		 FROM notebooks LEFT JOIN notes ON notes.notebook_id = notebooks.id
+		 GROUP BY notebooks.id ORDER BY notebooks.name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notebooks []NotebookStats
+	for rows.Next() {
+		var nb NotebookStats
+		if err := rows.Scan(&nb.ID, &nb.Name, &nb.CreatedAt, &nb.Notes); err != nil {
+			return nil, err
+		}
+		notebooks = append(notebooks, nb)
+	}
+	return notebooks, rows.Err()
+}
+
+// MoveNote files the note in notebookID, or unfiles it when notebookID
+// is 0. The note's UpdatedAt is left alone; moving is not an edit.
+func (s *Store) MoveNote(noteID, notebookID int64) error {
+	var target interface{}
+	if notebookID != 0 {
+		target = notebookID
+	}
+	_, err := s.db.Exec("UPDATE notes SET notebook_id = ? WHERE id = ?", target, noteID)
+	return err
+}
+
+// DeleteNotebook removes a notebook; its notes become unfiled.
+func (s *Store) DeleteNotebook(id int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE notes SET notebook_id = NULL WHERE notebook_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM notebooks WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
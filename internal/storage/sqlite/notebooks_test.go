@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNotebooks(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	work, err := store.CreateNotebook("Work")
+	if err != nil {
+		t.Fatalf("CreateNotebook() err = %v", err)
+	}
+	again, err := store.CreateNotebook(" work ")
+	if err != nil || again.ID != work.ID || again.Name != "Work" {
+		t.Fatalf("CreateNotebook(dup) = %+v, %v; want existing Work", again, err)
+	}
+	home, err := store.CreateNotebook("Home")
+	if err != nil {
+		t.Fatalf("CreateNotebook() err = %v", err)
+	}
+	if _, err := store.CreateNotebook("  "); err == nil {
+		t.Error("CreateNotebook(blank) err = nil, want error")
+	}
+
+	filed := &models.Note{Title: "standup", NotebookID: work.ID}
+	loose := &models.Note{Title: "loose"}
+	for _, note := range []*models.Note{filed, loose} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if got, _ := store.GetNote(filed.ID); got.NotebookID != work.ID {
+		t.Errorf("GetNote().NotebookID = %d, want %d", got.NotebookID, work.ID)
+	}
+
+	// Saving an edit keeps the note filed
+	filed.Body = "edited"
+	filed.NotebookID = 0
+	if err := store.UpdateNote(filed); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+
+	queries := []struct {
+		name  string
+		query NoteQuery
+		want  []string
+	}{
+		{"notebook", NoteQuery{Notebook: work.ID}, []string{"standup"}},
+		{"unfiled", NoteQuery{Notebook: NoNotebook}, []string{"loose"}},
+		{"empty notebook", NoteQuery{Notebook: home.ID}, []string{}},
+	}
+	for _, tt := range queries {
+		notes, err := store.QueryNotes(tt.query)
+		if err != nil {
+			t.Fatalf("%s: QueryNotes() err = %v", tt.name, err)
+		}
+		if got := noteTitles(notes); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if err := store.MoveNote(loose.ID, home.ID); err != nil {
+		t.Fatalf("MoveNote() err = %v", err)
+	}
+	stats, err := store.ListNotebooks()
+	if err != nil {
+		t.Fatalf("ListNotebooks() err = %v", err)
+	}
+	var counts []string
+	for _, nb := range stats {
+		counts = append(counts, fmt.Sprintf("%s:%d", nb.Name, nb.Notes))
+	}
+	if want := []string{"Home:1", "Work:1"}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("ListNotebooks() = %v, want %v", counts, want)
+	}
+
+	if err := store.DeleteNotebook(work.ID); err != nil {
+		t.Fatalf("DeleteNotebook() err = %v", err)
+	}
+	if got, _ := store.GetNote(filed.ID); got == nil || got.NotebookID != 0 {
+		t.Errorf("note after DeleteNotebook = %+v, want kept and unfiled", got)
+	}
+	if nb, err := store.GetNotebook(work.ID); err != nil || nb != nil {
+		t.Errorf("GetNotebook(deleted) = %+v, %v; want nil", nb, err)
+	}
+	if err := store.MoveNote(filed.ID, 0); err != nil {
+		t.Fatalf("MoveNote(0) err = %v", err)
+	}
+	if n, err := store.CountNotes(NoteQuery{Notebook: NoNotebook}); err != nil || n != 1 {
+		t.Errorf("CountNotes(unfiled) = %d, %v; want 1", n, err)
+	}
+}
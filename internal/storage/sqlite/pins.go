@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Pins (Phase 10: Home)
+//
+// Notes and todos filters pinned to the home screen. Pins are listed in
+// the order they were made; the home screen's 1-9 keys open the first
+// nine. The filter is stored as JSON, so the pins table does not change
+// when the screens gain filters.
+
+// CreatePin stores pin and sets its ID and CreatedAt.
+func (s *Store) CreatePin(pin *models.Pin) error {
+	filter, err := json.Marshal(pin.Filter)
+	if err != nil {
+		return err
+	}
+	pin.CreatedAt = time.Now()
+	res, err := s.db.Exec(
+		"INSERT INTO pins (screen, label, filter, created_at) VALUES (?, ?, ?, ?)",
+		pin.Screen, pin.Label, string(filter), pin.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	pin.ID, err = res.LastInsertId()
+	return err
+}
+
+// ListPins returns every pin, oldest first.
+func (s *Store) ListPins() ([]models.Pin, error) {
+	rows, err := s.db.Query("SELECT id, screen, label, filter, created_at FROM pins ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pins []models.Pin
+	for rows.Next() {
+		var p models.Pin
+		var filter string
+		if err := rows.Scan(&p.ID, &p.Screen, &p.Label, &filter, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		// A filter that no longer parses restores as no filter
+		_ = json.Unmarshal([]byte(filter), &p.Filter)
+		pins = append(pins, p)
+	}
+	return pins, rows.Err()
+}
+
+// DeletePin removes a pin.
+func (s *Store) DeletePin(id int64) error {
+	_, err := s.db.Exec("DELETE FROM pins WHERE id = ?", id)
+	return err
+}
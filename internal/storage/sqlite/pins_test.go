@@ -0,0 +1,49 @@
+package sqlite
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestPins(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	if pins, err := store.ListPins(); err != nil || len(pins) != 0 {
+		t.Fatalf("ListPins() = %v, %v; want none", pins, err)
+	}
+
+	high := models.TodoPriorityHigh
+	notes := &models.Pin{Screen: "notes", Label: "#work", Filter: models.PinFilter{Tags: []string{"work"}, Notebook: NoNotebook, Stale: true}}
+	todos := &models.Pin{Screen: "todos", Label: "@launch", Filter: models.PinFilter{Text: "ship", Project: "launch", Priority: &high, Status: models.TodoStatusPending}}
+	for _, pin := range []*models.Pin{notes, todos} {
+		if err := store.CreatePin(pin); err != nil {
+			t.Fatalf("CreatePin() err = %v", err)
+		}
+		if pin.ID == 0 {
+			t.Fatal("CreatePin() left ID = 0")
+		}
+	}
+
+	pins, err := store.ListPins()
+	if err != nil || len(pins) != 2 {
+		t.Fatalf("ListPins() = %v, %v; want 2 pins", pins, err)
+	}
+	if pins[0].ID != notes.ID || pins[1].ID != todos.ID {
+		t.Errorf("ListPins() order = %d, %d; want %d, %d", pins[0].ID, pins[1].ID, notes.ID, todos.ID)
+	}
+	for i, want := range []*models.Pin{notes, todos} {
+		got := pins[i]
+		if got.Screen != want.Screen || got.Label != want.Label || !reflect.DeepEqual(got.Filter, want.Filter) {
+			t.Errorf("ListPins()[%d] = %+v, want %+v", i, got, *want)
+		}
+	}
+
+	if err := store.DeletePin(notes.ID); err != nil {
+		t.Fatalf("DeletePin() err = %v", err)
+	}
+	if pins, _ := store.ListPins(); len(pins) != 1 || pins[0].ID != todos.ID {
+		t.Errorf("ListPins() after delete = %+v, want only the todos pin", pins)
+	}
+}
@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"encoding/json"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Placeholders (Phase 3: Linking System)
+//
+// Saving a note with a [[wikilink]] to a missing title creates a
+// placeholder note tagged models.PlaceholderTag. Once every note or todo
+// linking to it is deleted (or the link itself is), the placeholder is
+// an orphan nothing points to and can be cleaned up, unless someone has
+// written in it: only a placeholder whose body is still empty or the
+// stock models.PlaceholderBody counts.
+
+// ListOrphanPlaceholders returns the unwritten placeholder notes that no
+// existing note or todo links to, ordered by title.
+func (s *Store) ListOrphanPlaceholders() ([]models.Note, error) {
+	rows, err := s.db.Query(`
+		SELECT id, title, body, tags, created_at, updated_at FROM notes
+		WHERE id IN (SELECT note_id FROM note_tags WHERE tag = ?)
+		AND (body = '' OR body = ?)
+		AND NOT EXISTS (
+			SELECT 1 FROM links l
+			WHERE l.target_type = 'note' AND l.target_id = notes.id
+			AND ((l.source_type = 'note' AND l.source_id IN (SELECT id FROM notes))
+				OR (l.source_type = 'todo' AND l.source_id IN (SELECT id FROM todos)))
+		)
+		ORDER BY title COLLATE NOCASE`,
+		models.PlaceholderTag, models.PlaceholderBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []models.Note
+	for rows.Next() {
+		var note models.Note
+		var tagsStr string
+		if err := rows.Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt); err != nil {
+			return nil, err
+		}
+		json.Unmarshal([]byte(tagsStr), &note.Tags)
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestListOrphanPlaceholders(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	create := func(title string, tags ...string) *models.Note {
+		note := &models.Note{Title: title, Body: models.PlaceholderBody, Tags: tags}
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		return note
+	}
+	source := create("Planning")
+	linked := create("Budget", models.PlaceholderTag)
+	create("Roadmap", models.PlaceholderTag)
+	filled := create("Hiring")
+	deleted := create("Old draft")
+	stale := create("Archive", models.PlaceholderTag)
+	// A placeholder someone has written in is kept
+	written := create("Ideas", models.PlaceholderTag)
+	written.Body = "Things to try next quarter"
+	if err := store.UpdateNote(written); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+	blank := create("Later", models.PlaceholderTag)
+	blank.Body = ""
+	if err := store.UpdateNote(blank); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+
+	for _, link := range []models.Link{
+		{SourceType: "note", SourceID: source.ID, TargetType: "note", TargetID: linked.ID, LinkType: "wikilink"},
+		{SourceType: "note", SourceID: source.ID, TargetType: "note", TargetID: filled.ID, LinkType: "wikilink"},
+		{SourceType: "note", SourceID: deleted.ID, TargetType: "note", TargetID: stale.ID, LinkType: "wikilink"},
+	} {
+		link := link
+		if err := store.CreateLink(&link); err != nil {
+			t.Fatalf("CreateLink() err = %v", err)
+		}
+	}
+	// A link from a deleted note no longer counts
+	if err := store.DeleteNote(deleted.ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+
+	orphans, err := store.ListOrphanPlaceholders()
+	if err != nil {
+		t.Fatalf("ListOrphanPlaceholders() err = %v", err)
+	}
+	if got, want := noteTitles(orphans), []string{"Archive", "Later", "Roadmap"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListOrphanPlaceholders() = %v, want %v", got, want)
+	}
+}
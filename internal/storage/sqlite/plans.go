@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Planned Sessions (Phase 5: Focus Sessions)
+//
+// Focus blocks scheduled ahead ("3pm 45m #thesis"). A block is only a
+// plan: the session itself is recorded in sessions like any other once
+// it completes, and the block keeps its ID in session_id. Comparing the
+// two gives planned against actual focus; a block whose session was
+// deleted counts as not done.
+
+// CreatePlannedSession stores plan and sets its ID and CreatedAt.
+func (s *Store) CreatePlannedSession(plan *models.PlannedSession) error {
+	plan.CreatedAt = time.Now()
+	res, err := s.db.Exec(
+		"INSERT INTO planned_sessions (start_time, minutes, label, created_at) VALUES (?, ?, ?, ?)",
+		plan.Start, plan.Minutes, plan.Label, plan.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	plan.ID, err = res.LastInsertId()
+	return err
+}
+
+// ListPlannedSessions returns the blocks planned to start in [start, end),
+// earliest first.
+func (s *Store) ListPlannedSessions(start, end time.Time) ([]models.PlannedSession, error) {
+	rows, err := s.db.Query(
+		`SELECT id, start_time, minutes, label, session_id, created_at FROM planned_sessions
+		WHERE start_time >= ? AND start_time < ? ORDER BY start_time, id`,
+		start, end,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []models.PlannedSession
+	for rows.Next() {
+		var p models.PlannedSession
+		var sessionID sql.NullInt64
+		if err := rows.Scan(&p.ID, &p.Start, &p.Minutes, &p.Label, &sessionID, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		if sessionID.Valid {
+			id := sessionID.Int64
+			p.SessionID = &id
+		}
+		plans = append(plans, p)
+	}
+	return plans, rows.Err()
+}
+
+// DeletePlannedSession removes a planned block. The session started from
+// it, if any, is kept.
+func (s *Store) DeletePlannedSession(id int64) error {
+	_, err := s.db.Exec("DELETE FROM planned_sessions WHERE id = ?", id)
+	return err
+}
+
+// SetPlannedSessionDone records the completed session started from the
+// planned block.
+func (s *Store) SetPlannedSessionDone(id, sessionID int64) error {
+	_, err := s.db.Exec("UPDATE planned_sessions SET session_id = ? WHERE id = ?", sessionID, id)
+	return err
+}
+
+// PlanStats compares the focus blocks planned in a period with what was
+// done.
+type PlanStats struct {
+	Planned        int // Blocks planned
+	PlannedMinutes int
+	Done           int // Blocks whose session completed
+	DoneMinutes    int // Focus in those sessions
+}
+
+// GetPlanStats compares the blocks planned to start in [start, end) with
+// the sessions completed from them.
+func (s *Store) GetPlanStats(start, end time.Time) (PlanStats, error) {
+	var ps PlanStats
+	err := s.db.QueryRow(
+		`SELECT COUNT(*), COALESCE(SUM(p.minutes), 0), COUNT(ss.id), COALESCE(SUM(ss.duration), 0)
+		FROM planned_sessions p
+		LEFT JOIN sessions ss ON ss.id = p.session_id AND ss.status = 'completed'
+		WHERE p.start_time >= ? AND p.start_time < ?`,
+		start, end,
+	).Scan(&ps.Planned, &ps.PlannedMinutes, &ps.Done, &ps.DoneMinutes)
+	ps.DoneMinutes /= 60 // Stored in seconds
+	return ps, err
+}
@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestPlannedSessions(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	afternoon := &models.PlannedSession{Start: day.Add(15 * time.Hour), Minutes: 45, Label: "thesis"}
+	morning := &models.PlannedSession{Start: day.Add(9 * time.Hour), Minutes: 25}
+	tomorrow := &models.PlannedSession{Start: day.Add(33 * time.Hour), Minutes: 60}
+	for _, p := range []*models.PlannedSession{afternoon, morning, tomorrow} {
+		if err := store.CreatePlannedSession(p); err != nil {
+			t.Fatalf("CreatePlannedSession() err = %v", err)
+		}
+	}
+
+	plans, err := store.ListPlannedSessions(day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListPlannedSessions() err = %v", err)
+	}
+	if len(plans) != 2 || plans[0].ID != morning.ID || plans[1].ID != afternoon.ID || plans[1].Label != "thesis" {
+		t.Fatalf("ListPlannedSessions() = %+v, want morning then afternoon", plans)
+	}
+
+	// The afternoon block was done; the morning one was missed
+	end := afternoon.Start.Add(45 * time.Minute)
+	session := &models.FocusSession{StartTime: afternoon.Start, EndTime: &end, Duration: 45 * 60, Status: models.SessionStatusCompleted}
+	if err := store.CreateSession(session); err != nil {
+		t.Fatalf("CreateSession() err = %v", err)
+	}
+	if err := store.SetPlannedSessionDone(afternoon.ID, session.ID); err != nil {
+		t.Fatalf("SetPlannedSessionDone() err = %v", err)
+	}
+	ps, err := store.GetPlanStats(day, day.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("GetPlanStats() err = %v", err)
+	}
+	want := PlanStats{Planned: 3, PlannedMinutes: 130, Done: 1, DoneMinutes: 45}
+	if ps != want {
+		t.Fatalf("GetPlanStats() = %+v, want %+v", ps, want)
+	}
+
+	// A deleted session no longer counts as done
+	if err := store.DeleteSession(session.ID); err != nil {
+		t.Fatalf("DeleteSession() err = %v", err)
+	}
+	if err := store.DeletePlannedSession(tomorrow.ID); err != nil {
+		t.Fatalf("DeletePlannedSession() err = %v", err)
+	}
+	ps, err = store.GetPlanStats(day, day.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("GetPlanStats() err = %v", err)
+	}
+	if want := (PlanStats{Planned: 2, PlannedMinutes: 70}); ps != want {
+		t.Fatalf("GetPlanStats() after deletes = %+v, want %+v", ps, want)
+	}
+}
@@ -0,0 +1,342 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"modernc.org/sqlite"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func init() {
+	// fold(text) lower-cases text with Go's Unicode rules
+	sqlite.MustRegisterDeterministicScalarFunction("fold", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		switch v := args[0].(type) {
+		case string:
+			return strings.ToLower(v), nil
+		case []byte:
+			return strings.ToLower(string(v)), nil
+		default:
+			return v, nil
+		}
+	})
+}
+
+// Query Operations (Phase 4: Performance)
+//
+// QueryNotes and QueryTodos push list filtering and sorting into SQL so
+// screens no longer load every row and filter in Go. Text filters use
+// LIKE against the full body/description. LIKE ignores case for ASCII
+// only, so a query with other letters compares both sides lower-cased by
+// fold, a Go function registered with SQLite ("é" finds "É").
+// The ...Context variants stop early when a newer query supersedes them.
+
+// NoteSort selects the ORDER BY for QueryNotes.
+type NoteSort int
+
+const (
+	NoteSortUpdatedDesc NoteSort = iota // Newest first (default)
+	NoteSortTitle                       // Alphabetical by title
+	NoteSortUpdatedAsc                  // Oldest first
+)
+
+// NoNotebook is the NoteQuery.Notebook value that selects unfiled notes.
+const NoNotebook int64 = -1
+
+// NoteQuery filters, sorts and pages notes.
+type NoteQuery struct {
+	Text     string   // Substring of title or body
+	Tags     []string // Note must carry all of these tags
+	Notebook int64    // 0 = any notebook, NoNotebook = unfiled only
+	Starred  bool     // Only starred notes
+	// Note must have each field with the value, ignoring case
+	Fields map[string]string
+	// When set, only notes last updated before this time
+	UpdatedBefore time.Time
+	// When set, only notes created at or after this time
+	CreatedSince time.Time
+	Sort         NoteSort
+	Limit        int // 0 = no limit
+	Offset       int
+}
+
+// TodoSort selects the ORDER BY for QueryTodos.
+type TodoSort int
+
+const (
+	TodoSortCreatedDesc   TodoSort = iota // Newest first (default)
+	TodoSortPriority                      // High first, then newest
+	TodoSortCreatedAsc                    // Oldest first
+	TodoSortTitle                         // Alphabetical by title
+	TodoSortDueDate                       // Earliest due first (see models.CompareDue), no due date last
+	TodoSortCompletedDesc                 // Most recently completed first
+)
+
+// TodoQuery filters, sorts and pages todos.
+type TodoQuery struct {
+	Text     string               // Substring of title or description
+	Status   models.TodoStatus    // "" = any status
+	Priority *models.TodoPriority // nil = any priority
+	Project  string               // "" = any project
+	Tags     []string             // Todo must mention at least one #tag
+	ActiveAt time.Time            // When set, hide todos snoozed past this time
+	Open     bool                 // Only todos not yet completed
+	DueBy    time.Time            // When set, only todos due before this time
+	Starred  bool                 // Only starred todos
+	// Todo must have each field with the value, ignoring case
+	Fields map[string]string
+	// When set, only todos last updated before this time
+	UpdatedBefore time.Time
+	// When set, only todos completed at or after this time
+	CompletedSince time.Time
+	Sort           TodoSort
+	Limit          int // 0 = no limit
+	Offset         int
+}
+
+// likeContains returns a LIKE pattern matching s anywhere, escaping
+// LIKE wildcards. Use with ESCAPE '\'.
+func likeContains(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+	return "%" + s + "%"
+}
+
+// textClause returns a clause matching text anywhere in any of columns,
+// ignoring case, and appends its arguments.
+func textClause(text string, columns []string, args []interface{}) (string, []interface{}) {
+	ascii := true
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	pattern := likeContains(text)
+	if !ascii {
+		pattern = likeContains(strings.ToLower(text))
+	}
+	matches := make([]string, len(columns))
+	for i, column := range columns {
+		if !ascii {
+			column = "fold(" + column + ")"
+		}
+		matches[i] = column + ` LIKE ? ESCAPE '\'`
+		args = append(args, pattern)
+	}
+	return "(" + strings.Join(matches, " OR ") + ")", args
+}
+
+// pageClause returns the LIMIT/OFFSET suffix for a query.
+func pageClause(limit, offset int, args []interface{}) (string, []interface{}) {
+	if limit <= 0 && offset <= 0 {
+		return "", args
+	}
+	if limit <= 0 {
+		limit = -1 // SQLite: no limit
+	}
+	return " LIMIT ? OFFSET ?", append(args, limit, offset)
+}
+
+func (q NoteQuery) where() (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if q.Text != "" {
+		var clause string
+		clause, args = textClause(q.Text, []string{"title", "body"}, args)
+		clauses = append(clauses, clause)
+	}
+	for _, tag := range q.Tags {
+		clauses = append(clauses, "EXISTS (SELECT 1 FROM note_tags WHERE note_tags.note_id = notes.id AND note_tags.tag = ?)")
+		args = append(args, tag)
+	}
+	switch {
+	case q.Notebook == NoNotebook:
+		clauses = append(clauses, "notebook_id IS NULL")
+	case q.Notebook > 0:
+		clauses = append(clauses, "notebook_id = ?")
+		args = append(args, q.Notebook)
+	}
+	if q.Starred {
+		clauses = append(clauses, "starred = 1")
+	}
+	fields, args := fieldClauses("note", "notes", q.Fields, args)
+	clauses = append(clauses, fields...)
+	if !q.UpdatedBefore.IsZero() {
+		clauses = append(clauses, "updated_at < ?")
+		args = append(args, q.UpdatedBefore)
+	}
+	if !q.CreatedSince.IsZero() {
+		clauses = append(clauses, "created_at >= ?")
+		args = append(args, q.CreatedSince)
+	}
+
+	if len(clauses) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
+func (q NoteQuery) orderBy() string {
+	switch q.Sort {
+	case NoteSortTitle:
+		return " ORDER BY title COLLATE NOCASE ASC, id ASC"
+	case NoteSortUpdatedAsc:
+		return " ORDER BY updated_at ASC, id ASC"
+	default:
+		return " ORDER BY updated_at DESC, id DESC"
+	}
+}
+
+// QueryNotes returns notes matching q. Like ListNotes, only the first
+// 100 characters of each body are fetched; use GetNote for the full text.
+func (s *Store) QueryNotes(q NoteQuery) ([]models.Note, error) {
+	return s.QueryNotesContext(context.Background(), q)
+}
+
+// QueryNotesContext is QueryNotes with cancellation.
+func (s *Store) QueryNotesContext(ctx context.Context, q NoteQuery) ([]models.Note, error) {
+	where, args := q.where()
+	page, args := pageClause(q.Limit, q.Offset, args)
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id, title, substr(body, 1, 100), tags, created_at, updated_at, notebook_id, starred FROM notes"+where+q.orderBy()+page,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []models.Note
+	for rows.Next() {
+		var note models.Note
+		var tagsStr string
+		var notebookID sql.NullInt64
+		if err := rows.Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt, &notebookID, &note.Starred); err != nil {
+			return nil, err
+		}
+		json.Unmarshal([]byte(tagsStr), &note.Tags)
+		note.NotebookID = notebookID.Int64
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
+
+// CountNotes returns how many notes match q, ignoring sort and paging.
+func (s *Store) CountNotes(q NoteQuery) (int, error) {
+	where, args := q.where()
+	var n int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM notes"+where, args...).Scan(&n)
+	return n, err
+}
+
+func (q TodoQuery) where() (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if q.Text != "" {
+		var clause string
+		clause, args = textClause(q.Text, []string{"title", "description"}, args)
+		clauses = append(clauses, clause)
+	}
+	if q.Status != "" {
+		clauses = append(clauses, "status = ?")
+		args = append(args, q.Status)
+	}
+	if q.Priority != nil {
+		clauses = append(clauses, "priority = ?")
+		args = append(args, *q.Priority)
+	}
+	if q.Project != "" {
+		clauses = append(clauses, "project = ?")
+		args = append(args, q.Project)
+	}
+	if len(q.Tags) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(q.Tags)), ", ")
+		clauses = append(clauses, "EXISTS (SELECT 1 FROM todo_tags WHERE todo_tags.todo_id = todos.id AND todo_tags.tag IN ("+placeholders+"))")
+		for _, tag := range q.Tags {
+			args = append(args, strings.ToLower(tag))
+		}
+	}
+	if !q.ActiveAt.IsZero() {
+		clauses = append(clauses, "(deferred_until IS NULL OR deferred_until <= ?)")
+		args = append(args, q.ActiveAt)
+	}
+	if q.Open {
+		clauses = append(clauses, "status <> ?")
+		args = append(args, models.TodoStatusCompleted)
+	}
+	if !q.DueBy.IsZero() {
+		clauses = append(clauses, "due_date IS NOT NULL AND due_date < ?")
+		args = append(args, q.DueBy)
+	}
+	if q.Starred {
+		clauses = append(clauses, "starred = 1")
+	}
+	fields, args := fieldClauses("todo", "todos", q.Fields, args)
+	clauses = append(clauses, fields...)
+	if !q.UpdatedBefore.IsZero() {
+		clauses = append(clauses, "updated_at < ?")
+		args = append(args, q.UpdatedBefore)
+	}
+	if !q.CompletedSince.IsZero() {
+		clauses = append(clauses, "completed_at >= ?")
+		args = append(args, q.CompletedSince)
+	}
+
+	if len(clauses) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
+func (q TodoQuery) orderBy() string {
+	switch q.Sort {
+	case TodoSortPriority:
+		return " ORDER BY priority DESC, created_at DESC, id DESC"
+	case TodoSortCreatedAsc:
+		return " ORDER BY created_at ASC, id ASC"
+	case TodoSortTitle:
+		return " ORDER BY title COLLATE NOCASE ASC, id ASC"
+	case TodoSortDueDate:
+		// Due dates are stored as local "2006-01-02 15:04:05..." text: by
+		// day, then timed before untimed (midnight), then by time
+		return " ORDER BY due_date IS NULL, substr(due_date, 1, 10), substr(due_date, 12, 8) = '00:00:00', due_date ASC, created_at DESC, id DESC"
+	case TodoSortCompletedDesc:
+		return " ORDER BY completed_at IS NULL, completed_at DESC, id DESC"
+	default:
+		return " ORDER BY created_at DESC, id DESC"
+	}
+}
+
+// QueryTodos returns todos matching q.
+func (s *Store) QueryTodos(q TodoQuery) ([]models.Todo, error) {
+	return s.QueryTodosContext(context.Background(), q)
+}
+
+// QueryTodosContext is QueryTodos with cancellation.
+func (s *Store) QueryTodosContext(ctx context.Context, q TodoQuery) ([]models.Todo, error) {
+	where, args := q.where()
+	page, args := pageClause(q.Limit, q.Offset, args)
+	return s.queryTodos(ctx, "SELECT "+todoColumns+" FROM todos"+where+q.orderBy()+page, args...)
+}
+
+// CountTodos returns how many todos match q, ignoring sort and paging.
+func (s *Store) CountTodos(q TodoQuery) (int, error) {
+	return s.CountTodosContext(context.Background(), q)
+}
+
+// CountTodosContext is CountTodos with cancellation.
+func (s *Store) CountTodosContext(ctx context.Context, q TodoQuery) (int, error) {
+	where, args := q.where()
+	var n int
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM todos"+where, args...).Scan(&n)
+	return n, err
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func newQueryTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func noteTitles(notes []models.Note) []string {
+	titles := make([]string, 0, len(notes))
+	for _, note := range notes {
+		titles = append(titles, note.Title)
+	}
+	return titles
+}
+
+func todoTitles(todos []models.Todo) []string {
+	titles := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func TestQueryNotes(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	notes := []*models.Note{
+		{Title: "banana bread", Body: "flour and 100% bananas", Tags: []string{"recipe", "baking"}},
+		{Title: "Apple pie", Body: "long body " + strings.Repeat("filler ", 30) + " cinnamon", Tags: []string{"recipe"}},
+		{Title: "Cherry notes", Body: "meeting", Tags: []string{"work"}},
+	}
+	for _, note := range notes {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		time.Sleep(2 * time.Millisecond) // distinct updated_at
+	}
+
+	tests := []struct {
+		name  string
+		query NoteQuery
+		want  []string
+	}{
+		{"default newest first", NoteQuery{}, []string{"Cherry notes", "Apple pie", "banana bread"}},
+		{"oldest first", NoteQuery{Sort: NoteSortUpdatedAsc}, []string{"banana bread", "Apple pie", "Cherry notes"}},
+		{"title ignores case", NoteQuery{Sort: NoteSortTitle}, []string{"Apple pie", "banana bread", "Cherry notes"}},
+		{"text matches past the list preview", NoteQuery{Text: "CINNAMON"}, []string{"Apple pie"}},
+		{"text escapes wildcards", NoteQuery{Text: "100%"}, []string{"banana bread"}},
+		{"all tags required", NoteQuery{Tags: []string{"recipe", "baking"}}, []string{"banana bread"}},
+		{"limit and offset", NoteQuery{Sort: NoteSortTitle, Limit: 1, Offset: 1}, []string{"banana bread"}},
+		{"offset without limit", NoteQuery{Sort: NoteSortTitle, Offset: 2}, []string{"Cherry notes"}},
+		{"updated before", NoteQuery{UpdatedBefore: notes[2].UpdatedAt}, []string{"Apple pie", "banana bread"}},
+	}
+	for _, tt := range tests {
+		notes, err := store.QueryNotes(tt.query)
+		if err != nil {
+			t.Fatalf("%s: QueryNotes() err = %v", tt.name, err)
+		}
+		if got := noteTitles(notes); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if n, err := store.CountNotes(NoteQuery{Tags: []string{"recipe"}, Limit: 1}); err != nil || n != 2 {
+		t.Errorf("CountNotes(recipe) = %d, %v; want 2 (paging ignored)", n, err)
+	}
+}
+
+func TestQueryTodos(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	now := time.Now()
+	soon := now.Add(24 * time.Hour)
+	later := now.Add(72 * time.Hour)
+	snoozed := now.Add(time.Hour)
+	todos := []*models.Todo{
+		{Title: "Ship release", Description: "#work", Status: models.TodoStatusPending, Priority: models.TodoPriorityHigh, Project: "Launch", DueDate: &later},
+		{Title: "Go running #workout", Description: "", Status: models.TodoStatusCompleted, Priority: models.TodoPriorityLow},
+		{Title: "answer email", Description: "#Work inbox", Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium, DueDate: &soon},
+		{Title: "Backup laptop", Description: "", Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium, DeferredUntil: &snoozed},
+	}
+	for _, todo := range todos {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+		time.Sleep(2 * time.Millisecond) // distinct created_at
+	}
+
+	high := models.TodoPriorityHigh
+	tests := []struct {
+		name  string
+		query TodoQuery
+		want  []string
+	}{
+		{"default newest first", TodoQuery{}, []string{"Backup laptop", "answer email", "Go running #workout", "Ship release"}},
+		{"priority then newest", TodoQuery{Sort: TodoSortPriority}, []string{"Ship release", "Backup laptop", "answer email", "Go running #workout"}},
+		{"title ignores case", TodoQuery{Sort: TodoSortTitle}, []string{"answer email", "Backup laptop", "Go running #workout", "Ship release"}},
+		{"due date, none last", TodoQuery{Sort: TodoSortDueDate}, []string{"answer email", "Ship release", "Backup laptop", "Go running #workout"}},
+		{"status", TodoQuery{Status: models.TodoStatusCompleted}, []string{"Go running #workout"}},
+		{"priority", TodoQuery{Priority: &high}, []string{"Ship release"}},
+		{"project", TodoQuery{Project: "Launch"}, []string{"Ship release"}},
+		{"text", TodoQuery{Text: "EMAIL"}, []string{"answer email"}},
+		{"tag is whole word", TodoQuery{Tags: []string{"work"}}, []string{"answer email", "Ship release"}},
+		{"any of tags", TodoQuery{Tags: []string{"workout", "nope"}}, []string{"Go running #workout"}},
+		{"snoozed hidden", TodoQuery{ActiveAt: now, Status: models.TodoStatusPending}, []string{"answer email", "Ship release"}},
+		{"snooze expired", TodoQuery{ActiveAt: snoozed.Add(time.Minute), Status: models.TodoStatusPending}, []string{"Backup laptop", "answer email", "Ship release"}},
+		{"limit", TodoQuery{Limit: 2}, []string{"Backup laptop", "answer email"}},
+		{"open", TodoQuery{Open: true}, []string{"Backup laptop", "answer email", "Ship release"}},
+		{"due by", TodoQuery{Open: true, DueBy: soon.Add(time.Hour)}, []string{"answer email"}},
+		{"open and updated before", TodoQuery{Open: true, UpdatedBefore: todos[2].UpdatedAt}, []string{"Ship release"}},
+	}
+	for _, tt := range tests {
+		todos, err := store.QueryTodos(tt.query)
+		if err != nil {
+			t.Fatalf("%s: QueryTodos() err = %v", tt.name, err)
+		}
+		if got := todoTitles(todos); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	n, err := store.CountTodos(TodoQuery{Status: models.TodoStatusPending, Limit: 1})
+	if err != nil {
+		t.Fatalf("CountTodos() err = %v", err)
+	}
+	if n != 3 {
+		t.Errorf("CountTodos() = %d, want 3 (paging ignored)", n)
+	}
+
+	tags, err := store.ListTodoTags()
+	if err != nil {
+		t.Fatalf("ListTodoTags() err = %v", err)
+	}
+	if want := []string{"work", "workout"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("ListTodoTags() = %v, want %v", tags, want)
+	}
+}
+
+func TestQueryTodosDueTimeOfDay(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	for _, tt := range []struct {
+		title string
+		due   time.Time
+	}{
+		{"tomorrow morning", day.Add(32 * time.Hour)},
+		{"any time today", day},
+		{"afternoon", day.Add(15 * time.Hour)},
+		{"morning", day.Add(9*time.Hour + 30*time.Minute)},
+	} {
+		due := tt.due
+		if err := store.CreateTodo(&models.Todo{Title: tt.title, Status: models.TodoStatusPending, DueDate: &due}); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	todos, err := store.QueryTodos(TodoQuery{Sort: TodoSortDueDate})
+	if err != nil {
+		t.Fatalf("QueryTodos() err = %v", err)
+	}
+	want := []string{"morning", "afternoon", "any time today", "tomorrow morning"}
+	if got := todoTitles(todos); !reflect.DeepEqual(got, want) {
+		t.Errorf("due date order = %v, want %v", got, want)
+	}
+	if due := todos[0].DueDate; due == nil || due.Hour() != 9 || due.Minute() != 30 {
+		t.Errorf("time of day not kept: %v", due)
+	}
+}
+
+func TestQueryContextCancelled(t *testing.T) {
+	store := newQueryTestStore(t)
+	if err := store.CreateNote(&models.Note{Title: "a"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := store.QueryNotesContext(ctx, NoteQuery{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("QueryNotesContext() err = %v, want context.Canceled", err)
+	}
+	if _, err := store.QueryTodosContext(ctx, TodoQuery{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("QueryTodosContext() err = %v, want context.Canceled", err)
+	}
+	if _, err := store.CountTodosContext(ctx, TodoQuery{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("CountTodosContext() err = %v, want context.Canceled", err)
+	}
+}
+
+func TestQueryTextIgnoresUnicodeCase(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	if err := store.CreateNote(&models.Note{Title: "ÉTÉ À PARIS", Body: "Café crème"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := store.CreateNote(&models.Note{Title: "Winter", Body: "snow"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := store.CreateTodo(&models.Todo{Title: "Straße fegen", Description: "ÜBER die Brücke", Status: models.TodoStatusPending}); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	for _, text := range []string{"été", "CAFÉ", "à paris", "crème"} {
+		notes, err := store.QueryNotes(NoteQuery{Text: text})
+		if err != nil {
+			t.Fatalf("QueryNotes(%q) err = %v", text, err)
+		}
+		if got := noteTitles(notes); !reflect.DeepEqual(got, []string{"ÉTÉ À PARIS"}) {
+			t.Errorf("QueryNotes(%q) = %v, want the Paris note", text, got)
+		}
+	}
+	for _, text := range []string{"über", "BRÜCKE", "straße"} {
+		todos, err := store.QueryTodos(TodoQuery{Text: text})
+		if err != nil {
+			t.Fatalf("QueryTodos(%q) err = %v", text, err)
+		}
+		if len(todos) != 1 {
+			t.Errorf("QueryTodos(%q) = %v, want the street todo", text, todoTitles(todos))
+		}
+	}
+}
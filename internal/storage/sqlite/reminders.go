@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Note Reminders (Phase 6: Inbox)
+//
+// A tickler file for notes: a note can be set to resurface on a day,
+// stored like habit check-offs as the local date ("2006-01-02"). From
+// that day on the note sits in the inbox next to the #inbox captures
+// until it is triaged, which clears the reminder. A note has at most one
+// reminder; deleting the note deletes it.
+
+// SetNoteReminder sets the day the note resurfaces, replacing any
+// earlier one. A nil day clears the reminder.
+func (s *Store) SetNoteReminder(noteID int64, day *time.Time) error {
+	if day == nil {
+		return deleteNoteReminder(s.db, noteID)
+	}
+	_, err := s.db.Exec(
+		`INSERT INTO note_reminders (note_id, day) VALUES (?, ?)
+		ON CONFLICT(note_id) DO UPDATE SET day = excluded.day`,
+		noteID, habitDay(*day),
+	)
+	return err
+}
+
+// GetNoteReminder returns the day the note resurfaces, or nil when it
+// has no reminder.
+func (s *Store) GetNoteReminder(noteID int64) (*time.Time, error) {
+	var day string
+	err := s.db.QueryRow("SELECT day FROM note_reminders WHERE note_id = ?", noteID).Scan(&day)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t, err := time.ParseInLocation(habitDayFormat, day, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ListDueReminders returns the notes whose resurface day is on or before
+// now's, mapped to that day.
+func (s *Store) ListDueReminders(now time.Time) (map[int64]time.Time, error) {
+	rows, err := s.db.Query("SELECT note_id, day FROM note_reminders WHERE day <= ?", habitDay(now))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	due := make(map[int64]time.Time)
+	for rows.Next() {
+		var id int64
+		var day string
+		if err := rows.Scan(&id, &day); err != nil {
+			return nil, err
+		}
+		t, err := time.ParseInLocation(habitDayFormat, day, now.Location())
+		if err != nil {
+			return nil, err
+		}
+		due[id] = t
+	}
+	return due, rows.Err()
+}
+
+// CountDueReminders counts the notes ListDueReminders returns, leaving
+// out notes tagged exceptTag, which are in the inbox anyway.
+func (s *Store) CountDueReminders(now time.Time, exceptTag string) (int, error) {
+	var n int
+	err := s.db.QueryRow(
+		`SELECT COUNT(*) FROM note_reminders r WHERE r.day <= ?
+		AND NOT EXISTS (SELECT 1 FROM note_tags t WHERE t.note_id = r.note_id AND t.tag = ?)`,
+		habitDay(now), exceptTag,
+	).Scan(&n)
+	return n, err
+}
+
+// deleteNoteReminder removes the reminder of a note.
+func deleteNoteReminder(db execer, noteID int64) error {
+	_, err := db.Exec("DELETE FROM note_reminders WHERE note_id = ?", noteID)
+	return err
+}
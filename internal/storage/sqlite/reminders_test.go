@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNoteReminders(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.Local)
+	yesterday := time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local)
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	nextWeek := time.Date(2026, 3, 17, 0, 0, 0, 0, time.Local)
+
+	var notes []*models.Note
+	for _, title := range []string{"late", "today", "later", "none"} {
+		note := &models.Note{Title: title}
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		notes = append(notes, note)
+	}
+	for i, day := range []time.Time{yesterday, today, nextWeek} {
+		if err := store.SetNoteReminder(notes[i].ID, &day); err != nil {
+			t.Fatalf("SetNoteReminder() err = %v", err)
+		}
+	}
+
+	due, err := store.ListDueReminders(now)
+	if err != nil {
+		t.Fatalf("ListDueReminders() err = %v", err)
+	}
+	if len(due) != 2 || !due[notes[0].ID].Equal(yesterday) || !due[notes[1].ID].Equal(today) {
+		t.Fatalf("ListDueReminders() = %v, want late and today", due)
+	}
+
+	if n, err := store.CountDueReminders(now, "inbox"); err != nil || n != 2 {
+		t.Fatalf("CountDueReminders() = %d, %v; want 2", n, err)
+	}
+	notes[1].Tags = []string{"inbox"}
+	if err := store.UpdateNote(notes[1]); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+	if n, err := store.CountDueReminders(now, "inbox"); err != nil || n != 1 {
+		t.Fatalf("CountDueReminders() with an #inbox note = %d, %v; want 1", n, err)
+	}
+
+	got, err := store.GetNoteReminder(notes[2].ID)
+	if err != nil || got == nil || !got.Equal(nextWeek) {
+		t.Fatalf("GetNoteReminder(later) = %v, %v; want %v", got, err, nextWeek)
+	}
+	if got, err := store.GetNoteReminder(notes[3].ID); err != nil || got != nil {
+		t.Fatalf("GetNoteReminder(none) = %v, %v; want nil", got, err)
+	}
+
+	// Setting again moves the day; nil clears it
+	if err := store.SetNoteReminder(notes[2].ID, &today); err != nil {
+		t.Fatalf("SetNoteReminder() err = %v", err)
+	}
+	if err := store.SetNoteReminder(notes[1].ID, nil); err != nil {
+		t.Fatalf("SetNoteReminder(nil) err = %v", err)
+	}
+	if err := store.DeleteNote(notes[0].ID); err != nil {
+		t.Fatalf("DeleteNote() err = %v", err)
+	}
+	due, err = store.ListDueReminders(now)
+	if err != nil {
+		t.Fatalf("ListDueReminders() err = %v", err)
+	}
+	if len(due) != 1 || !due[notes[2].ID].Equal(today) {
+		t.Fatalf("ListDueReminders() after changes = %v, want only later", due)
+	}
+}
@@ -0,0 +1,31 @@
+package sqlite
+
+import "database/sql"
+
+// Settings (Phase 9: Component Library)
+//
+// UI preferences changed from inside the app, such as a screen's list
+// density, are kept in the settings key/value table so they survive a
+// restart. config.json stays read-only; its values are the defaults.
+
+// GetSetting returns the stored value for key and whether one is set.
+func (s *Store) GetSetting(key string) (string, bool, error) {
+	var value string
+	err := s.db.QueryRow("SELECT value FROM settings WHERE key = ?", key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+// SetSetting stores value for key, replacing any previous value.
+func (s *Store) SetSetting(key, value string) error {
+	_, err := s.db.Exec(
+		"INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = excluded.value",
+		key, value,
+	)
+	return err
+}
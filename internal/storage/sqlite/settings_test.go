@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+)
+
+func TestSettings(t *testing.T) {
+	cfg := &config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")}
+	store, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() err = %v", err)
+	}
+
+	if _, ok, err := store.GetSetting("density.notes"); err != nil || ok {
+		t.Fatalf("GetSetting() on empty table = ok %v, err %v; want unset", ok, err)
+	}
+	if err := store.SetSetting("density.notes", "compact"); err != nil {
+		t.Fatalf("SetSetting() err = %v", err)
+	}
+	if err := store.SetSetting("density.notes", "comfortable"); err != nil {
+		t.Fatalf("SetSetting() overwrite err = %v", err)
+	}
+	store.Close()
+
+	// Settings survive reopening the database
+	store, err = New(cfg)
+	if err != nil {
+		t.Fatalf("New() reopen err = %v", err)
+	}
+	defer store.Close()
+	value, ok, err := store.GetSetting("density.notes")
+	if err != nil || !ok || value != "comfortable" {
+		t.Fatalf("GetSetting() = %q, %v, %v; want comfortable", value, ok, err)
+	}
+}
@@ -0,0 +1,20 @@
+package sqlite
+
+// Stars (Phase 6: Organization)
+//
+// Notes and todos can be starred for quick access to the material in use
+// right now. The flag lives in a starred column on each table; queries
+// select starred items with NoteQuery.Starred and TodoQuery.Starred.
+// Starring is not an edit, so updated_at is left alone.
+
+// SetNoteStarred stars or unstars a note.
+func (s *Store) SetNoteStarred(id int64, starred bool) error {
+	_, err := s.db.Exec("UPDATE notes SET starred = ? WHERE id = ?", starred, id)
+	return err
+}
+
+// SetTodoStarred stars or unstars a todo.
+func (s *Store) SetTodoStarred(id int64, starred bool) error {
+	_, err := s.db.Exec("UPDATE todos SET starred = ? WHERE id = ?", starred, id)
+	return err
+}
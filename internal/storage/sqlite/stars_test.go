@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestStars(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	note := &models.Note{Title: "reading list"}
+	other := &models.Note{Title: "scratch"}
+	todo := &models.Todo{Title: "draft proposal", Status: models.TodoStatusPending}
+	for _, n := range []*models.Note{note, other} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	if err := store.SetNoteStarred(note.ID, true); err != nil {
+		t.Fatalf("SetNoteStarred() err = %v", err)
+	}
+	if err := store.SetTodoStarred(todo.ID, true); err != nil {
+		t.Fatalf("SetTodoStarred() err = %v", err)
+	}
+
+	// Saving edits keeps the star
+	note.Body = "edited"
+	if err := store.UpdateNote(note); err != nil {
+		t.Fatalf("UpdateNote() err = %v", err)
+	}
+	todo.Title = "draft the proposal"
+	if err := store.UpdateTodo(todo); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+
+	if got, _ := store.GetNote(note.ID); got == nil || !got.Starred {
+		t.Errorf("GetNote().Starred = false, want true")
+	}
+	notes, err := store.QueryNotes(NoteQuery{Starred: true})
+	if err != nil {
+		t.Fatalf("QueryNotes(starred) err = %v", err)
+	}
+	if got := noteTitles(notes); !reflect.DeepEqual(got, []string{"reading list"}) || !notes[0].Starred {
+		t.Errorf("QueryNotes(starred) = %v, want [reading list] starred", got)
+	}
+	todos, err := store.QueryTodos(TodoQuery{Starred: true})
+	if err != nil {
+		t.Fatalf("QueryTodos(starred) err = %v", err)
+	}
+	if got := todoTitles(todos); !reflect.DeepEqual(got, []string{"draft the proposal"}) || !todos[0].Starred {
+		t.Errorf("QueryTodos(starred) = %v, want [draft the proposal] starred", got)
+	}
+
+	if err := store.SetTodoStarred(todo.ID, false); err != nil {
+		t.Fatalf("SetTodoStarred(false) err = %v", err)
+	}
+	if n, err := store.CountTodos(TodoQuery{Starred: true}); err != nil || n != 0 {
+		t.Errorf("CountTodos(starred) after unstarring = %d, %v; want 0", n, err)
+	}
+}
@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"fmt"
+	"os"
+)
+
+// Database statistics (Phase 4: Robustness)
+//
+// DatabaseStats gathers what the Diagnostics screen shows when tracking
+// down a slow database or deciding what to archive: the file and its
+// size, free pages VACUUM would reclaim, rows per table, the largest
+// notes and the size of the embedding index.
+
+// TableCount is the number of rows in a table.
+type TableCount struct {
+	Name string
+	Rows int
+}
+
+// NoteSize is a note with the size of its body in bytes.
+type NoteSize struct {
+	ID    int64
+	Title string
+	Bytes int64
+}
+
+// DatabaseStats describes the database file and its contents.
+type DatabaseStats struct {
+	Path         string
+	FileSize     int64 // Bytes on disk, write-ahead log included
+	PageSize     int64
+	Pages        int64
+	FreePages    int64 // Unused pages VACUUM would give back
+	Tables       []TableCount
+	LargestNotes []NoteSize
+	Vectors      int   // Notes with a stored embedding
+	VectorBytes  int64 // Size of the stored embeddings
+}
+
+// DatabaseStats collects statistics, listing up to largest notes by body
+// size.
+func (s *Store) DatabaseStats(largest int) (*DatabaseStats, error) {
+	stats := &DatabaseStats{}
+
+	var seq int
+	var name string
+	if err := s.db.QueryRow("PRAGMA database_list").Scan(&seq, &name, &stats.Path); err != nil {
+		return nil, fmt.Errorf("failed to read database path: %w", err)
+	}
+	for _, path := range []string{stats.Path, stats.Path + "-wal"} {
+		if info, err := os.Stat(path); err == nil {
+			stats.FileSize += info.Size()
+		}
+	}
+	for pragma, dest := range map[string]*int64{
+		"page_size":      &stats.PageSize,
+		"page_count":     &stats.Pages,
+		"freelist_count": &stats.FreePages,
+	} {
+		if err := s.db.QueryRow("PRAGMA " + pragma).Scan(dest); err != nil {
+			return nil, err
+		}
+	}
+
+	tables, err := s.tableNames()
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		var n int
+		if err := s.db.QueryRow(`SELECT COUNT(*) FROM "` + table + `"`).Scan(&n); err != nil {
+			return nil, err
+		}
+		stats.Tables = append(stats.Tables, TableCount{Name: table, Rows: n})
+	}
+
+	rows, err := s.db.Query(
+		"SELECT id, title, LENGTH(CAST(COALESCE(body, '') AS BLOB)) AS size FROM notes ORDER BY size DESC, id LIMIT ?",
+		largest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var note NoteSize
+		if err := rows.Scan(&note.ID, &note.Title, &note.Bytes); err != nil {
+			return nil, err
+		}
+		stats.LargestNotes = append(stats.LargestNotes, note)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.QueryRow(
+		"SELECT COUNT(*), COALESCE(SUM(LENGTH(embedding)), 0) FROM note_vectors",
+	).Scan(&stats.Vectors, &stats.VectorBytes); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// tableNames returns the names of the database's own tables, sorted.
+func (s *Store) tableNames() ([]string, error) {
+	rows, err := s.db.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
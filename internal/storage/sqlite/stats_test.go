@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestDatabaseStats(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	for _, note := range []*models.Note{
+		{Title: "Short", Body: "hi"},
+		{Title: "Long", Body: strings.Repeat("x", 500)},
+		{Title: "Medium", Body: strings.Repeat("x", 50)},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := store.UpsertNoteEmbedding(1, make([]float32, 384)); err != nil {
+		t.Fatalf("UpsertNoteEmbedding() err = %v", err)
+	}
+
+	stats, err := store.DatabaseStats(2)
+	if err != nil {
+		t.Fatalf("DatabaseStats() err = %v", err)
+	}
+	if !strings.HasSuffix(stats.Path, "test.db") || stats.FileSize <= 0 {
+		t.Errorf("Path = %q, FileSize = %d", stats.Path, stats.FileSize)
+	}
+	if stats.Pages <= 0 || stats.PageSize <= 0 {
+		t.Errorf("Pages = %d, PageSize = %d", stats.Pages, stats.PageSize)
+	}
+
+	counts := map[string]int{}
+	for _, table := range stats.Tables {
+		counts[table.Name] = table.Rows
+	}
+	if counts["notes"] != 3 || counts["todos"] != 0 {
+		t.Errorf("table counts = %v, want 3 notes and 0 todos", counts)
+	}
+
+	if len(stats.LargestNotes) != 2 || stats.LargestNotes[0].Title != "Long" || stats.LargestNotes[0].Bytes != 500 ||
+		stats.LargestNotes[1].Title != "Medium" {
+		t.Errorf("LargestNotes = %+v, want Long then Medium", stats.LargestNotes)
+	}
+	if stats.Vectors != 1 || stats.VectorBytes <= 0 {
+		t.Errorf("Vectors = %d, VectorBytes = %d", stats.Vectors, stats.VectorBytes)
+	}
+}
@@ -7,10 +7,15 @@
 // - Indexed fields for efficient querying
 //
 // Database Schema:
-//   - notes: id, title, body, tags (JSON), created_at, updated_at
-//   - todos: id, title, description, status, priority, due_date, note_id, created_at, updated_at
-//   - sessions: id, start_time, end_time, duration, status, created_at
+//   - notes: id, title, body, tags (JSON), created_at, updated_at, notebook_id, starred
+//   - notebooks: id, name, created_at
+//   - note_tags: note_id, tag (normalized note tags)
+//   - todos: id, title, description, status, priority, due_date, note_id, created_at, updated_at, estimate_minutes, project, deferred_until, starred
+//   - sessions: id, start_time, end_time, duration, status, created_at, label
+//   - todo_tags: todo_id, tag (#hashtags in todo text)
 //   - links: id, source_type, source_id, target_type, target_id, link_type, created_at
+//   - settings: key, value (UI preferences changed in the app)
+//   - pins: id, screen, label, filter (JSON), created_at (home screen shortcuts)
 //
 // Phase 2: Notes & Todos
 // - Note CRUD operations with tag handling
@@ -28,11 +33,13 @@ package sqlite
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	_ "modernc.org/sqlite"
@@ -51,20 +58,45 @@ import (
 // Phase 2: Notes & Todos
 //   - CreateNote/UpdateNote/DeleteNote/GetNote/ListNotes
 //   - CreateTodo/UpdateTodo/DeleteTodo/GetTodo/ListTodos
+//   - QueryNotes/QueryTodos: SQL-side filtering, sorting and paging (Phase 4)
+//   - ListTagCounts/RenameTag: note_tags/todo_tags join tables (Phase 4)
+//   - ListTagStyles/SetTagStyle: per-tag color, icon and default priority (Phase 6)
+//   - ...Context variants: cancellable reads for UI loads and searches (Phase 4)
 //   - CreateSession/GetSession/ListSessions/UpdateSession
+//   - GetSessionLabelStats: per-label session totals (Phase 5)
+//   - DeleteSessions/CountSessionsBefore/DeleteSessionsBefore: history cleanup (Phase 5)
 //   - CreateLink/GetLinksForItem/DeleteLink
+//   - WithTx/CreateLinks/GetNotesByTitles: transactions and batch operations (Phase 4)
+//   - GetNoteByTitle: indexed, case-insensitive title lookup for wikilinks (Phase 4)
+//   - ListNoteTitles: titles only, for wikilink completion (Phase 4)
+//   - CreatePin/ListPins/DeletePin: filters pinned to the home screen (Phase 10)
+//   - Maintain/MaintenanceDue: integrity check, orphan pruning and VACUUM (Phase 4)
+//   - MergeBackup: merge a backup file in by item identity and timestamps (Phase 4)
+//   - DatabaseStats: file size, row counts and largest notes (Phase 4)
 type Store struct {
-	db *sql.DB
+	db       *sql.DB
+	readOnly bool
 }
 
+// QueryTimeout bounds list loads and searches started from the UI, so a
+// slow disk surfaces as an error instead of a frozen screen.
+const QueryTimeout = 5 * time.Second
+
 // New creates a new SQLite store and runs migrations.
 //
 // Phase 1: Creates ~/.config/flowState/flowState.db
 //   - Initializes all required tables
 //   - Creates indexes for performance
 //   - Handles existing databases gracefully
+//
+// Phase 4: Robustness - with cfg.ReadOnly the database is opened with
+// mode=ro and migrations are skipped; every write fails.
 func New(cfg *config.Config) (*Store, error) {
-	db, err := sql.Open("sqlite", cfg.DbPath)
+	dsn := cfg.DbPath
+	if cfg.ReadOnly {
+		dsn = "file:" + cfg.DbPath + "?mode=ro"
+	}
+	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -78,9 +110,11 @@ func New(cfg *config.Config) (*Store, error) {
 		return nil, fmt.Errorf("failed to enable foreign keys: %w", err)
 	}
 
-	store := &Store{db: db}
-	if err := store.migrate(); err != nil {
-		return nil, fmt.Errorf("failed to migrate: %w", err)
+	store := &Store{db: db, readOnly: cfg.ReadOnly}
+	if !store.readOnly {
+		if err := store.migrate(); err != nil {
+			return nil, fmt.Errorf("failed to migrate: %w", err)
+		}
 	}
 
 	// Phase 4: Robustness - DB Integrity Check
@@ -101,6 +135,13 @@ func New(cfg *config.Config) (*Store, error) {
 //   - Creates notes, todos, sessions, links tables
 //   - Creates indexes for tags, status, foreign keys
 func (s *Store) migrate() error {
+	// Phase 4: Performance - tags moved from the notes.tags JSON blob into
+	// join tables. Databases created before that need a one-time backfill.
+	hadTagTables, err := s.tableExists("note_tags")
+	if err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
 	migrations := []string{
 		`CREATE TABLE IF NOT EXISTS notes (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -144,12 +185,91 @@ func (s *Store) migrate() error {
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			UNIQUE(source_type, source_id, target_type, target_id)
 		)`,
+		`CREATE TABLE IF NOT EXISTS note_tags (
+			note_id INTEGER NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+			tag TEXT NOT NULL,
+			PRIMARY KEY (note_id, tag)
+		)`,
+		`CREATE TABLE IF NOT EXISTS todo_tags (
+			todo_id INTEGER NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+			tag TEXT NOT NULL,
+			PRIMARY KEY (todo_id, tag)
+		)`,
+		`CREATE TABLE IF NOT EXISTS notebooks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS settings (
+			key TEXT PRIMARY KEY,
+			value TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS pins (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			screen TEXT NOT NULL,
+			label TEXT NOT NULL,
+			filter TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS goals (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			tag TEXT NOT NULL DEFAULT '',
+			target INTEGER NOT NULL,
+			period TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS habits (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS habit_checks (
+			habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+			day TEXT NOT NULL,
+			PRIMARY KEY (habit_id, day)
+		)`,
+		`CREATE TABLE IF NOT EXISTS tag_styles (
+			tag TEXT PRIMARY KEY,
+			color TEXT NOT NULL DEFAULT '',
+			icon TEXT NOT NULL DEFAULT '',
+			priority INTEGER
+		)`,
+		`CREATE TABLE IF NOT EXISTS item_fields (
+			item_type TEXT NOT NULL,
+			item_id INTEGER NOT NULL,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL,
+			PRIMARY KEY (item_type, item_id, key)
+		)`,
+		`CREATE TABLE IF NOT EXISTS planned_sessions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			start_time DATETIME NOT NULL,
+			minutes INTEGER NOT NULL,
+			label TEXT NOT NULL DEFAULT '',
+			session_id INTEGER,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS note_reminders (
+			note_id INTEGER PRIMARY KEY,
+			day TEXT NOT NULL
+		)`,
 		`CREATE INDEX IF NOT EXISTS idx_notes_tags ON notes(tags)`,
+		`CREATE INDEX IF NOT EXISTS idx_note_tags_tag ON note_tags(tag)`,
+		`CREATE INDEX IF NOT EXISTS idx_todo_tags_tag ON todo_tags(tag)`,
 		`CREATE INDEX IF NOT EXISTS idx_note_vectors_updated_at ON note_vectors(updated_at)`,
 		`CREATE INDEX IF NOT EXISTS idx_todos_status ON todos(status)`,
 		`CREATE INDEX IF NOT EXISTS idx_todos_note_id ON todos(note_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_notes_updated_at ON notes(updated_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_created_at ON todos(created_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_priority ON todos(priority)`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_due_date ON todos(due_date)`,
 		`CREATE INDEX IF NOT EXISTS idx_links_source ON links(source_type, source_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_links_target ON links(target_type, target_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_item_fields_key ON item_fields(key, value COLLATE NOCASE)`,
+		`CREATE INDEX IF NOT EXISTS idx_note_reminders_day ON note_reminders(day)`,
+		`CREATE INDEX IF NOT EXISTS idx_planned_sessions_start ON planned_sessions(start_time)`,
 	}
 
 	for _, m := range migrations {
@@ -158,9 +278,93 @@ func (s *Store) migrate() error {
 		}
 	}
 
+	// Columns added after the initial schema. SQLite has no
+	// "ADD COLUMN IF NOT EXISTS", so each is checked before altering.
+	columns := []struct {
+		table, column, definition string
+	}{
+		{"todos", "estimate_minutes", "INTEGER DEFAULT 0"},
+		{"todos", "project", "TEXT DEFAULT ''"},
+		{"todos", "deferred_until", "DATETIME"},
+		{"sessions", "label", "TEXT DEFAULT ''"},
+		{"sessions", "energy", "INTEGER DEFAULT 0"},
+		{"notes", "notebook_id", "INTEGER REFERENCES notebooks(id) ON DELETE SET NULL"},
+		{"notes", "starred", "INTEGER DEFAULT 0"},
+		{"todos", "starred", "INTEGER DEFAULT 0"},
+		{"todos", "completed_at", "DATETIME"},
+		// Vectors stored before models were configurable are all 384-dim
+		{"note_vectors", "dimensions", "INTEGER NOT NULL DEFAULT 384"},
+	}
+	for _, c := range columns {
+		if err := s.ensureColumn(c.table, c.column, c.definition); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+
+	// Indexes on added columns must run after the columns exist.
+	lateIndexes := []string{
+		`CREATE INDEX IF NOT EXISTS idx_todos_project ON todos(project)`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_deferred_until ON todos(deferred_until)`,
+		`CREATE INDEX IF NOT EXISTS idx_sessions_label ON sessions(label)`,
+		`CREATE INDEX IF NOT EXISTS idx_notes_notebook_id ON notes(notebook_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_notes_title_nocase ON notes(title COLLATE NOCASE)`,
+		`CREATE INDEX IF NOT EXISTS idx_todos_completed_at ON todos(completed_at)`,
+		// Todos completed before completed_at existed: their last update is
+		// the closest record of when that happened.
+		`UPDATE todos SET completed_at = updated_at WHERE status = 'completed' AND completed_at IS NULL`,
+	}
+	for _, m := range lateIndexes {
+		if _, err := s.db.Exec(m); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+	}
+
+	if !hadTagTables {
+		if err := s.backfillTags(); err != nil {
+			return fmt.Errorf("tag backfill failed: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// ensureColumn adds a column to an existing table if it is missing.
+func (s *Store) ensureColumn(table, column, definition string) error {
+	rows, err := s.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name, typ  string
+			notNull    int
+			dflt       interface{}
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &primaryKey); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+// ReadOnly reports whether the store was opened without write access.
+func (s *Store) ReadOnly() bool {
+	return s.readOnly
+}
+
 func (s *Store) Close() error {
 	return s.db.Close()
 }
@@ -172,9 +376,12 @@ type NoteVectorSearchResult struct {
 	Score  float32
 }
 
+// UpsertNoteEmbedding stores a note's embedding with its dimensions.
+// Which model made it is recorded for the whole index (see
+// EmbeddingIndex).
 func (s *Store) UpsertNoteEmbedding(noteID int64, embedding []float32) error {
-	if len(embedding) != 384 {
-		return fmt.Errorf("embedding must be 384-dim, got %d", len(embedding))
+	if len(embedding) == 0 {
+		return fmt.Errorf("embedding is empty")
 	}
 
 	blob, err := encodeFloat32Slice(embedding)
@@ -183,10 +390,10 @@ func (s *Store) UpsertNoteEmbedding(noteID int64, embedding []float32) error {
 	}
 
 	_, err = s.db.Exec(
-		`INSERT INTO note_vectors (note_id, embedding, updated_at)
-		 VALUES (?, ?, CURRENT_TIMESTAMP)
-		 ON CONFLICT(note_id) DO UPDATE SET embedding=excluded.embedding, updated_at=CURRENT_TIMESTAMP`,
-		noteID, blob,
+		`INSERT INTO note_vectors (note_id, embedding, dimensions, updated_at)
+		 VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+		 ON CONFLICT(note_id) DO UPDATE SET embedding=excluded.embedding, dimensions=excluded.dimensions, updated_at=CURRENT_TIMESTAMP`,
+		noteID, blob, len(embedding),
 	)
 	return err
 }
@@ -208,22 +415,55 @@ func (s *Store) GetNoteEmbedding(noteID int64) ([]float32, bool, error) {
 	return emb, true, nil
 }
 
+// NoteEmbeddingTimes returns when the vector of each note that has one
+// was last stored, by note ID.
+func (s *Store) NoteEmbeddingTimes() (map[int64]time.Time, error) {
+	rows, err := s.db.Query("SELECT note_id, updated_at FROM note_vectors")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	times := map[int64]time.Time{}
+	for rows.Next() {
+		var id int64
+		var at time.Time
+		if err := rows.Scan(&id, &at); err != nil {
+			return nil, err
+		}
+		times[id] = at
+	}
+	return times, rows.Err()
+}
+
 func (s *Store) DeleteNoteEmbedding(noteID int64) error {
-	_, err := s.db.Exec("DELETE FROM note_vectors WHERE note_id = ?", noteID)
+	return deleteNoteEmbedding(s.db, noteID)
+}
+
+// deleteNoteEmbedding removes a note's stored vector.
+func deleteNoteEmbedding(db execer, noteID int64) error {
+	_, err := db.Exec("DELETE FROM note_vectors WHERE note_id = ?", noteID)
 	return err
 }
 
 // SearchNoteEmbeddings performs a cosine-similarity scan over stored embeddings.
 // This is intentionally simple and pure-Go; it persists vectors in SQLite and computes ranking in-process.
 func (s *Store) SearchNoteEmbeddings(query []float32, limit int) ([]NoteVectorSearchResult, error) {
-	if len(query) != 384 {
-		return nil, fmt.Errorf("query embedding must be 384-dim, got %d", len(query))
+	return s.SearchNoteEmbeddingsContext(context.Background(), query, limit)
+}
+
+// SearchNoteEmbeddingsContext is SearchNoteEmbeddings with cancellation;
+// the scan stops as soon as ctx is done. Only vectors with the query's
+// dimensions are compared.
+func (s *Store) SearchNoteEmbeddingsContext(ctx context.Context, query []float32, limit int) ([]NoteVectorSearchResult, error) {
+	if len(query) == 0 {
+		return nil, fmt.Errorf("query embedding is empty")
 	}
 	if limit <= 0 {
 		return []NoteVectorSearchResult{}, nil
 	}
 
-	rows, err := s.db.Query("SELECT note_id, embedding FROM note_vectors")
+	rows, err := s.db.QueryContext(ctx, "SELECT note_id, embedding FROM note_vectors WHERE dimensions = ?", len(query))
 	if err != nil {
 		return nil, err
 	}
@@ -314,33 +554,52 @@ func decodeFloat32Slice(b []byte) ([]float32, error) {
 // Automatically sets CreatedAt and UpdatedAt timestamps.
 // Extracts and stores tags from body if present.
 func (s *Store) CreateNote(note *models.Note) error {
-	tagsJSON, _ := json.Marshal(note.Tags)
-	now := time.Now()
-	note.CreatedAt = now
-	note.UpdatedAt = now
+	return s.WithTx(func(tx *Tx) error {
+		return tx.CreateNote(note)
+	})
+}
 
-	result, err := s.db.Exec(
-		"INSERT INTO notes (title, body, tags, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
-		note.Title, note.Body, string(tagsJSON), note.CreatedAt, note.UpdatedAt,
-	)
+// GetNote retrieves a note by ID. Returns nil if not found.
+func (s *Store) GetNote(id int64) (*models.Note, error) {
+	return s.GetNoteContext(context.Background(), id)
+}
+
+// GetNoteContext is GetNote with cancellation.
+func (s *Store) GetNoteContext(ctx context.Context, id int64) (*models.Note, error) {
+	var note models.Note
+	var tagsStr string
+	var notebookID sql.NullInt64
+
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, title, body, tags, created_at, updated_at, notebook_id, starred FROM notes WHERE id = ?",
+		id,
+	).Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt, &notebookID, &note.Starred)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	id, _ := result.LastInsertId()
-	note.ID = id
-	return nil
+	json.Unmarshal([]byte(tagsStr), &note.Tags)
+	note.NotebookID = notebookID.Int64
+	return &note, nil
 }
 
-// GetNote retrieves a note by ID. Returns nil if not found.
-func (s *Store) GetNote(id int64) (*models.Note, error) {
+// GetNoteByTitle returns the oldest note titled title, ignoring case and
+// surrounding space, or nil if there is none. Wikilinks resolve to this
+// note. The lookup uses the NOCASE title index, which folds ASCII letters
+// only; other titles must match as written.
+func (s *Store) GetNoteByTitle(title string) (*models.Note, error) {
 	var note models.Note
 	var tagsStr string
+	var notebookID sql.NullInt64
 
 	err := s.db.QueryRow(
-		"SELECT id, title, body, tags, created_at, updated_at FROM notes WHERE id = ?",
-		id,
-	).Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt)
+		"SELECT id, title, body, tags, created_at, updated_at, notebook_id, starred FROM notes WHERE title = ? COLLATE NOCASE ORDER BY id LIMIT 1",
+		strings.TrimSpace(title),
+	).Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt, &notebookID, &note.Starred)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -350,48 +609,81 @@ func (s *Store) GetNote(id int64) (*models.Note, error) {
 	}
 
 	json.Unmarshal([]byte(tagsStr), &note.Tags)
+	note.NotebookID = notebookID.Int64
 	return &note, nil
 }
 
-// ListNotes returns all notes ordered by updated_at descending.
-func (s *Store) ListNotes() ([]models.Note, error) {
-	// Phase 4: Performance - Only fetch first 100 chars of body for list view
-	rows, err := s.db.Query(
-		"SELECT id, title, substr(body, 1, 100), tags, created_at, updated_at FROM notes ORDER BY updated_at DESC",
-	)
+// ListNoteTitles returns every note title, most recently updated first,
+// without loading the bodies. Wikilink completion offers these.
+func (s *Store) ListNoteTitles() ([]string, error) {
+	rows, err := s.db.Query("SELECT title FROM notes ORDER BY updated_at DESC, id DESC")
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var notes []models.Note
+	var titles []string
 	for rows.Next() {
-		var note models.Note
-		var tagsStr string
-		if err := rows.Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt); err != nil {
+		var title string
+		if err := rows.Scan(&title); err != nil {
 			return nil, err
 		}
-		json.Unmarshal([]byte(tagsStr), &note.Tags)
-		notes = append(notes, note)
+		titles = append(titles, title)
 	}
-	return notes, nil
+	return titles, rows.Err()
+}
+
+// ListNotes returns all notes ordered by updated_at descending.
+// Phase 4: Performance - Only the first 100 chars of each body are fetched.
+func (s *Store) ListNotes() ([]models.Note, error) {
+	return s.QueryNotes(NoteQuery{})
 }
 
 // UpdateNote modifies an existing note. Updates UpdatedAt timestamp.
+// The note's notebook and star are left as they are; use MoveNote and
+// SetNoteStarred to change them.
 func (s *Store) UpdateNote(note *models.Note) error {
 	tagsJSON, _ := json.Marshal(note.Tags)
 	note.UpdatedAt = time.Now()
 
-	_, err := s.db.Exec(
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(
 		"UPDATE notes SET title = ?, body = ?, tags = ?, updated_at = ? WHERE id = ?",
 		note.Title, note.Body, string(tagsJSON), note.UpdatedAt, note.ID,
-	)
-	return err
+	); err != nil {
+		return err
+	}
+	if err := syncNoteTags(tx, note.ID, note.Tags); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
-// DeleteNote removes a note by ID.
+// DeleteNote removes a note by ID, along with its tag rows, fields and
+// reminder.
 func (s *Store) DeleteNote(id int64) error {
-	_, err := s.db.Exec("DELETE FROM notes WHERE id = ?", id)
+	return s.WithTx(func(tx *Tx) error {
+		return tx.DeleteNote(id)
+	})
+}
+
+// deleteNote removes a note and the rows that belong to it.
+func deleteNote(db execer, id int64) error {
+	if _, err := db.Exec("DELETE FROM note_tags WHERE note_id = ?", id); err != nil {
+		return err
+	}
+	if err := deleteFields(db, "note", id); err != nil {
+		return err
+	}
+	if err := deleteNoteReminder(db, id); err != nil {
+		return err
+	}
+	_, err := db.Exec("DELETE FROM notes WHERE id = ?", id)
 	return err
 }
 
@@ -399,6 +691,13 @@ func (s *Store) DeleteNote(id int64) error {
 
 // CreateTodo inserts a new todo into the database.
 func (s *Store) CreateTodo(todo *models.Todo) error {
+	return s.WithTx(func(tx *Tx) error {
+		return tx.CreateTodo(todo)
+	})
+}
+
+// createTodo inserts todo and its tag rows within tx.
+func createTodo(tx *sql.Tx, todo *models.Todo) error {
 	now := time.Now()
 	todo.CreatedAt = now
 	todo.UpdatedAt = now
@@ -413,9 +712,23 @@ func (s *Store) CreateTodo(todo *models.Todo) error {
 		noteID = *todo.NoteID
 	}
 
-	result, err := s.db.Exec(
-		"INSERT INTO todos (title, description, status, priority, due_date, note_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		todo.Title, todo.Description, todo.Status, todo.Priority, dueDate, noteID, todo.CreatedAt, todo.UpdatedAt,
+	var deferredUntil interface{}
+	if todo.DeferredUntil != nil {
+		deferredUntil = *todo.DeferredUntil
+	}
+
+	setCompletedAt(todo, now)
+	var completedAt interface{}
+	if todo.CompletedAt != nil {
+		completedAt = *todo.CompletedAt
+	}
+	if err := applyTagPriority(tx, todo); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(
+		"INSERT INTO todos (title, description, status, priority, due_date, note_id, created_at, updated_at, estimate_minutes, project, deferred_until, starred, completed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		todo.Title, todo.Description, todo.Status, todo.Priority, dueDate, noteID, todo.CreatedAt, todo.UpdatedAt, todo.EstimateMinutes, todo.Project, deferredUntil, todo.Starred, completedAt,
 	)
 	if err != nil {
 		return err
@@ -423,26 +736,40 @@ func (s *Store) CreateTodo(todo *models.Todo) error {
 
 	id, _ := result.LastInsertId()
 	todo.ID = id
+	if err := syncTodoTags(tx, todo); err != nil {
+		todo.ID = 0
+		return err
+	}
 	return nil
 }
 
-// GetTodo retrieves a todo by ID.
-func (s *Store) GetTodo(id int64) (*models.Todo, error) {
-	var todo models.Todo
-	var dueDate, noteID interface{}
+// setCompletedAt stamps a completed todo without a completion time with
+// now, and clears the time of a todo that is not completed.
+func setCompletedAt(todo *models.Todo, now time.Time) {
+	switch {
+	case todo.Status != models.TodoStatusCompleted:
+		todo.CompletedAt = nil
+	case todo.CompletedAt == nil:
+		todo.CompletedAt = &now
+	}
+}
 
-	err := s.db.QueryRow(
-		"SELECT id, title, description, status, priority, due_date, note_id, created_at, updated_at FROM todos WHERE id = ?",
-		id,
-	).Scan(&todo.ID, &todo.Title, &todo.Description, &todo.Status, &todo.Priority, &dueDate, &noteID, &todo.CreatedAt, &todo.UpdatedAt)
+// todoColumns is the column list shared by all todo SELECTs; keep it in
+// sync with scanTodo.
+const todoColumns = "id, title, description, status, priority, due_date, note_id, created_at, updated_at, estimate_minutes, project, deferred_until, starred, completed_at"
 
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTodo scans a row selected with todoColumns into a Todo.
+func scanTodo(row rowScanner) (*models.Todo, error) {
+	var todo models.Todo
+	var dueDate, noteID, estimate, project, deferredUntil, starred, completedAt interface{}
+	if err := row.Scan(&todo.ID, &todo.Title, &todo.Description, &todo.Status, &todo.Priority, &dueDate, &noteID, &todo.CreatedAt, &todo.UpdatedAt, &estimate, &project, &deferredUntil, &starred, &completedAt); err != nil {
 		return nil, err
 	}
-
 	if dueDate != nil {
 		t := dueDate.(time.Time)
 		todo.DueDate = &t
@@ -451,15 +778,29 @@ func (s *Store) GetTodo(id int64) (*models.Todo, error) {
 		nid := noteID.(int64)
 		todo.NoteID = &nid
 	}
-
+	if estimate != nil {
+		todo.EstimateMinutes = int(estimate.(int64))
+	}
+	if project != nil {
+		todo.Project = project.(string)
+	}
+	if deferredUntil != nil {
+		t := deferredUntil.(time.Time)
+		todo.DeferredUntil = &t
+	}
+	if starred != nil {
+		todo.Starred = starred.(int64) != 0
+	}
+	if completedAt != nil {
+		t := completedAt.(time.Time)
+		todo.CompletedAt = &t
+	}
 	return &todo, nil
 }
 
-// ListTodos returns all todos ordered by created_at descending.
-func (s *Store) ListTodos() ([]models.Todo, error) {
-	rows, err := s.db.Query(
-		"SELECT id, title, description, status, priority, due_date, note_id, created_at, updated_at FROM todos ORDER BY created_at DESC",
-	)
+// queryTodos runs a todo SELECT and scans every row.
+func (s *Store) queryTodos(ctx context.Context, query string, args ...interface{}) ([]models.Todo, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -467,25 +808,40 @@ func (s *Store) ListTodos() ([]models.Todo, error) {
 
 	var todos []models.Todo
 	for rows.Next() {
-		var todo models.Todo
-		var dueDate, noteID interface{}
-		if err := rows.Scan(&todo.ID, &todo.Title, &todo.Description, &todo.Status, &todo.Priority, &dueDate, &noteID, &todo.CreatedAt, &todo.UpdatedAt); err != nil {
+		todo, err := scanTodo(rows)
+		if err != nil {
 			return nil, err
 		}
-		if dueDate != nil {
-			t := dueDate.(time.Time)
-			todo.DueDate = &t
-		}
-		if noteID != nil {
-			nid := noteID.(int64)
-			todo.NoteID = &nid
-		}
-		todos = append(todos, todo)
+		todos = append(todos, *todo)
+	}
+	return todos, rows.Err()
+}
+
+// GetTodo retrieves a todo by ID.
+func (s *Store) GetTodo(id int64) (*models.Todo, error) {
+	todo, err := scanTodo(s.db.QueryRow("SELECT "+todoColumns+" FROM todos WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
-	return todos, nil
+	return todo, nil
+}
+
+// ListTodos returns all todos ordered by created_at descending.
+func (s *Store) ListTodos() ([]models.Todo, error) {
+	return s.QueryTodos(TodoQuery{})
 }
 
-// UpdateTodo modifies an existing todo.
+// ListTodosForNote returns todos whose note_id points at the given note,
+// ordered by created_at ascending.
+func (s *Store) ListTodosForNote(noteID int64) ([]models.Todo, error) {
+	return s.queryTodos(context.Background(), "SELECT "+todoColumns+" FROM todos WHERE note_id = ? ORDER BY created_at ASC", noteID)
+}
+
+// UpdateTodo modifies an existing todo. Its star is left as it is; use
+// SetTodoStarred to change it.
 func (s *Store) UpdateTodo(todo *models.Todo) error {
 	todo.UpdatedAt = time.Now()
 
@@ -499,15 +855,82 @@ func (s *Store) UpdateTodo(todo *models.Todo) error {
 		noteID = *todo.NoteID
 	}
 
-	_, err := s.db.Exec(
-		"UPDATE todos SET title = ?, description = ?, status = ?, priority = ?, due_date = ?, note_id = ?, updated_at = ? WHERE id = ?",
-		todo.Title, todo.Description, todo.Status, todo.Priority, dueDate, noteID, todo.UpdatedAt, todo.ID,
+	var deferredUntil interface{}
+	if todo.DeferredUntil != nil {
+		deferredUntil = *todo.DeferredUntil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A completed todo keeps the time it was first completed, even when
+	// edited from a copy without it, until it is reopened
+	setCompletedAt(todo, todo.UpdatedAt)
+	var completedAt interface{}
+	if todo.CompletedAt != nil {
+		completedAt = *todo.CompletedAt
+	}
+	if err := applyTagPriority(tx, todo); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"UPDATE todos SET title = ?, description = ?, status = ?, priority = ?, due_date = ?, note_id = ?, updated_at = ?, estimate_minutes = ?, project = ?, deferred_until = ?, completed_at = CASE WHEN ? = 'completed' THEN COALESCE(completed_at, ?) END WHERE id = ?",
+		todo.Title, todo.Description, todo.Status, todo.Priority, dueDate, noteID, todo.UpdatedAt, todo.EstimateMinutes, todo.Project, deferredUntil, todo.Status, completedAt, todo.ID,
+	); err != nil {
+		return err
+	}
+	if err := syncTodoTags(tx, todo); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ProjectStats summarizes the todos assigned to one project.
+type ProjectStats struct {
+	Name      string
+	Total     int
+	Completed int
+}
+
+// ListProjects returns every non-empty project with todo counts,
+// ordered by name.
+func (s *Store) ListProjects() ([]ProjectStats, error) {
+	return s.ListProjectsContext(context.Background())
+}
+
+// ListProjectsContext is ListProjects with cancellation.
+func (s *Store) ListProjectsContext(ctx context.Context) ([]ProjectStats, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT project, COUNT(*), SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END) FROM todos WHERE project <> '' GROUP BY project ORDER BY project COLLATE NOCASE",
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []ProjectStats
+	for rows.Next() {
+		var p ProjectStats
+		if err := rows.Scan(&p.Name, &p.Total, &p.Completed); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+	return projects, rows.Err()
 }
 
-// DeleteTodo removes a todo by ID.
+// DeleteTodo removes a todo by ID, along with its tag rows and fields.
 func (s *Store) DeleteTodo(id int64) error {
+	if _, err := s.db.Exec("DELETE FROM todo_tags WHERE todo_id = ?", id); err != nil {
+		return err
+	}
+	if err := deleteFields(s.db, "todo", id); err != nil {
+		return err
+	}
 	_, err := s.db.Exec("DELETE FROM todos WHERE id = ?", id)
 	return err
 }
@@ -519,8 +942,8 @@ func (s *Store) CreateSession(session *models.FocusSession) error {
 	session.CreatedAt = time.Now()
 
 	result, err := s.db.Exec(
-		"INSERT INTO sessions (start_time, end_time, duration, status, created_at) VALUES (?, ?, ?, ?, ?)",
-		session.StartTime, session.EndTime, session.Duration, session.Status, session.CreatedAt,
+		"INSERT INTO sessions (start_time, end_time, duration, status, label, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		session.StartTime, session.EndTime, session.Duration, session.Status, session.Label, session.CreatedAt,
 	)
 	if err != nil {
 		return err
@@ -536,9 +959,9 @@ func (s *Store) GetSession(id int64) (*models.FocusSession, error) {
 	var session models.FocusSession
 
 	err := s.db.QueryRow(
-		"SELECT id, start_time, end_time, duration, status, created_at FROM sessions WHERE id = ?",
+		"SELECT id, start_time, end_time, duration, status, COALESCE(label, ''), COALESCE(energy, 0), created_at FROM sessions WHERE id = ?",
 		id,
-	).Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.CreatedAt)
+	).Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.Label, &session.Energy, &session.CreatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -553,7 +976,7 @@ func (s *Store) GetSession(id int64) (*models.FocusSession, error) {
 // ListSessions returns all sessions ordered by created_at descending.
 func (s *Store) ListSessions() ([]models.FocusSession, error) {
 	rows, err := s.db.Query(
-		"SELECT id, start_time, end_time, duration, status, created_at FROM sessions ORDER BY created_at DESC",
+		"SELECT id, start_time, end_time, duration, status, COALESCE(label, ''), COALESCE(energy, 0), created_at FROM sessions ORDER BY created_at DESC",
 	)
 	if err != nil {
 		return nil, err
@@ -563,7 +986,7 @@ func (s *Store) ListSessions() ([]models.FocusSession, error) {
 	var sessions []models.FocusSession
 	for rows.Next() {
 		var session models.FocusSession
-		if err := rows.Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.CreatedAt); err != nil {
+		if err := rows.Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.Label, &session.Energy, &session.CreatedAt); err != nil {
 			return nil, err
 		}
 		sessions = append(sessions, session)
@@ -574,8 +997,8 @@ func (s *Store) ListSessions() ([]models.FocusSession, error) {
 // UpdateSession modifies an existing session.
 func (s *Store) UpdateSession(session *models.FocusSession) error {
 	_, err := s.db.Exec(
-		"UPDATE sessions SET start_time = ?, end_time = ?, duration = ?, status = ? WHERE id = ?",
-		session.StartTime, session.EndTime, session.Duration, session.Status, session.ID,
+		"UPDATE sessions SET start_time = ?, end_time = ?, duration = ?, status = ?, label = ? WHERE id = ?",
+		session.StartTime, session.EndTime, session.Duration, session.Status, session.Label, session.ID,
 	)
 	return err
 }
@@ -586,6 +1009,40 @@ func (s *Store) DeleteSession(id int64) error {
 	return err
 }
 
+// DeleteSessions removes the sessions with the given IDs.
+func (s *Store) DeleteSessions(ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	_, err := s.db.Exec("DELETE FROM sessions WHERE id IN ("+strings.Join(placeholders, ", ")+")", args...)
+	return err
+}
+
+// CountSessionsBefore returns how many sessions started before cutoff, so
+// a cleanup can be previewed before DeleteSessionsBefore.
+func (s *Store) CountSessionsBefore(cutoff time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM sessions WHERE start_time < ?", cutoff).Scan(&n)
+	return n, err
+}
+
+// DeleteSessionsBefore removes every session that started before cutoff
+// and returns how many were deleted.
+func (s *Store) DeleteSessionsBefore(cutoff time.Time) (int, error) {
+	result, err := s.db.Exec("DELETE FROM sessions WHERE start_time < ?", cutoff)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
+
 // SessionStats holds aggregated focus session statistics.
 type SessionStats struct {
 	TodaySessions     int // Number of completed sessions today
@@ -593,6 +1050,42 @@ type SessionStats struct {
 	TotalFocusMinutes int // Total focus time in minutes
 	CurrentStreak     int // Consecutive days with at least one completed session
 	LongestStreak     int // Longest streak ever achieved
+
+	Labels []LabelStats  // Per-label totals, most focus time first
+	Energy []EnergyStats // Average energy rating per part of the day
+}
+
+// LabelStats aggregates the completed sessions that share a label.
+type LabelStats struct {
+	Label        string
+	Sessions     int
+	FocusMinutes int
+}
+
+// CountCompletedSessions returns how many sessions completed that started
+// in [start, end).
+func (s *Store) CountCompletedSessions(start, end time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRow(
+		"SELECT COUNT(*) FROM sessions WHERE status = 'completed' AND start_time >= ? AND start_time < ?",
+		start, end,
+	).Scan(&n)
+	return n, err
+}
+
+// FocusMinutes returns the minutes of focus in completed sessions that
+// started in [start, end). A non-empty label counts only sessions with
+// that label, case-insensitively.
+func (s *Store) FocusMinutes(start, end time.Time, label string) (int, error) {
+	query := "SELECT COALESCE(SUM(duration), 0) FROM sessions WHERE status = 'completed' AND start_time >= ? AND start_time < ?"
+	args := []interface{}{start, end}
+	if label != "" {
+		query += " AND label = ? COLLATE NOCASE"
+		args = append(args, label)
+	}
+	var seconds int
+	err := s.db.QueryRow(query, args...).Scan(&seconds)
+	return seconds / 60, err
 }
 
 // GetSessionStats returns aggregated focus session statistics.
@@ -603,13 +1096,11 @@ func (s *Store) GetSessionStats() (*SessionStats, error) {
 	now := time.Now()
 	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	endOfToday := startOfToday.Add(24 * time.Hour)
-	err := s.db.QueryRow(
-		"SELECT COUNT(*) FROM sessions WHERE status = 'completed' AND start_time >= ? AND start_time < ?",
-		startOfToday, endOfToday,
-	).Scan(&stats.TodaySessions)
+	today, err := s.CountCompletedSessions(startOfToday, endOfToday)
 	if err != nil {
 		return nil, err
 	}
+	stats.TodaySessions = today
 
 	// Get total completed sessions and total focus time
 	err = s.db.QueryRow(
@@ -628,6 +1119,114 @@ func (s *Store) GetSessionStats() (*SessionStats, error) {
 	}
 	stats.CurrentStreak = streak
 
+	labels, err := s.GetSessionLabelStats()
+	if err != nil {
+		return nil, err
+	}
+	stats.Labels = labels
+
+	energy, err := s.GetEnergyStats()
+	if err != nil {
+		return nil, err
+	}
+	stats.Energy = energy
+
+	return stats, nil
+}
+
+// GetSessionLabelStats returns session counts and focus time per label for
+// completed, labelled sessions, most focus time first.
+func (s *Store) GetSessionLabelStats() ([]LabelStats, error) {
+	rows, err := s.db.Query(
+		`SELECT label, COUNT(*), COALESCE(SUM(duration), 0) FROM sessions
+		WHERE status = 'completed' AND COALESCE(label, '') != ''
+		GROUP BY label ORDER BY SUM(duration) DESC, label`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var labels []LabelStats
+	for rows.Next() {
+		var ls LabelStats
+		if err := rows.Scan(&ls.Label, &ls.Sessions, &ls.FocusMinutes); err != nil {
+			return nil, err
+		}
+		ls.FocusMinutes /= 60 // Stored in seconds
+		labels = append(labels, ls)
+	}
+	return labels, rows.Err()
+}
+
+// EnergyStats is the average energy rating of the rated sessions started
+// in one part of the day.
+type EnergyStats struct {
+	Period   string // "Morning", "Afternoon", "Evening" or "Night"
+	Sessions int
+	Average  float64 // 1-5
+}
+
+// energyPeriods are the parts of the day energy ratings are averaged
+// over, by the local hour a session started at; Night wraps past
+// midnight.
+var energyPeriods = []struct {
+	name     string
+	from, to int
+}{
+	{"Morning", 5, 12},
+	{"Afternoon", 12, 17},
+	{"Evening", 17, 22},
+	{"Night", 22, 5},
+}
+
+// SetSessionEnergy stores the 1-5 energy rating given when a session
+// ended; 0 clears it.
+func (s *Store) SetSessionEnergy(id int64, energy int) error {
+	if energy < 0 || energy > 5 {
+		return fmt.Errorf("energy rating %d is out of range (1-5)", energy)
+	}
+	_, err := s.db.Exec("UPDATE sessions SET energy = ? WHERE id = ?", energy, id)
+	return err
+}
+
+// GetEnergyStats averages the energy ratings of completed sessions per
+// part of the day (see energyPeriods), leaving out parts with no rated
+// sessions. The hour is taken in local time, so it is bucketed in Go.
+func (s *Store) GetEnergyStats() ([]EnergyStats, error) {
+	rows, err := s.db.Query("SELECT start_time, energy FROM sessions WHERE status = 'completed' AND energy > 0")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sums := make([]int, len(energyPeriods))
+	counts := make([]int, len(energyPeriods))
+	for rows.Next() {
+		var start time.Time
+		var energy int
+		if err := rows.Scan(&start, &energy); err != nil {
+			return nil, err
+		}
+		hour := start.Local().Hour()
+		for i, p := range energyPeriods {
+			if (p.from < p.to && hour >= p.from && hour < p.to) || (p.from > p.to && (hour >= p.from || hour < p.to)) {
+				sums[i] += energy
+				counts[i]++
+				break
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var stats []EnergyStats
+	for i, p := range energyPeriods {
+		if counts[i] > 0 {
+			stats = append(stats, EnergyStats{Period: p.name, Sessions: counts[i], Average: float64(sums[i]) / float64(counts[i])})
+		}
+	}
 	return stats, nil
 }
 
@@ -638,7 +1237,7 @@ func (s *Store) GetSessionsForDate(date time.Time) ([]models.FocusSession, error
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
 	rows, err := s.db.Query(
-		"SELECT id, start_time, end_time, duration, status, created_at FROM sessions WHERE start_time >= ? AND start_time < ? ORDER BY start_time DESC",
+		"SELECT id, start_time, end_time, duration, status, COALESCE(label, ''), COALESCE(energy, 0), created_at FROM sessions WHERE start_time >= ? AND start_time < ? ORDER BY start_time DESC",
 		startOfDay, endOfDay,
 	)
 	if err != nil {
@@ -649,7 +1248,7 @@ func (s *Store) GetSessionsForDate(date time.Time) ([]models.FocusSession, error
 	var sessions []models.FocusSession
 	for rows.Next() {
 		var session models.FocusSession
-		if err := rows.Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.CreatedAt); err != nil {
+		if err := rows.Scan(&session.ID, &session.StartTime, &session.EndTime, &session.Duration, &session.Status, &session.Label, &session.Energy, &session.CreatedAt); err != nil {
 			return nil, err
 		}
 		sessions = append(sessions, session)
@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"database/sql"
 	"os"
 	"path/filepath"
 	"testing"
@@ -605,6 +606,160 @@ func TestSessionStats(t *testing.T) {
 	}
 }
 
+// TestSessionLabelStats tests labels round-trip and aggregate per label.
+func TestSessionLabelStats(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := New(&config.Config{DbPath: filepath.Join(tmpDir, "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	now := time.Now()
+	for _, s := range []struct {
+		label   string
+		minutes int
+		status  models.SessionStatus
+	}{
+		{"writing", 25, models.SessionStatusCompleted},
+		{"writing", 50, models.SessionStatusCompleted},
+		{"clientA", 90, models.SessionStatusCompleted},
+		{"clientA", 25, models.SessionStatusCancelled},
+		{"", 25, models.SessionStatusCompleted},
+	} {
+		session := &models.FocusSession{StartTime: now, Duration: s.minutes * 60, Status: s.status, Label: s.label}
+		if err := store.CreateSession(session); err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+	}
+
+	got, err := store.GetSession(1)
+	if err != nil || got == nil || got.Label != "writing" {
+		t.Fatalf("GetSession(1) = %+v, %v; want label writing", got, err)
+	}
+
+	stats, err := store.GetSessionStats()
+	if err != nil {
+		t.Fatalf("Failed to get stats: %v", err)
+	}
+	want := []LabelStats{
+		{Label: "clientA", Sessions: 1, FocusMinutes: 90},
+		{Label: "writing", Sessions: 2, FocusMinutes: 75},
+	}
+	if len(stats.Labels) != len(want) {
+		t.Fatalf("Labels = %+v, want %+v", stats.Labels, want)
+	}
+	for i := range want {
+		if stats.Labels[i] != want[i] {
+			t.Errorf("Labels[%d] = %+v, want %+v", i, stats.Labels[i], want[i])
+		}
+	}
+}
+
+// TestSessionEnergyStats tests energy ratings round-trip and average per
+// part of the day.
+func TestSessionEnergyStats(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := New(&config.Config{DbPath: filepath.Join(tmpDir, "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	day := time.Now().AddDate(0, 0, -1)
+	at := func(hour int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.Local)
+	}
+	for _, s := range []struct {
+		start  time.Time
+		energy int
+		status models.SessionStatus
+	}{
+		{at(9), 5, models.SessionStatusCompleted},
+		{at(10), 4, models.SessionStatusCompleted},
+		{at(14), 2, models.SessionStatusCompleted},
+		{at(23), 3, models.SessionStatusCompleted},
+		{at(2), 1, models.SessionStatusCompleted},
+		{at(15), 0, models.SessionStatusCompleted}, // not rated
+		{at(19), 5, models.SessionStatusCancelled},
+	} {
+		session := &models.FocusSession{StartTime: s.start, Duration: 1500, Status: s.status}
+		if err := store.CreateSession(session); err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+		if s.energy > 0 {
+			if err := store.SetSessionEnergy(session.ID, s.energy); err != nil {
+				t.Fatalf("SetSessionEnergy() err = %v", err)
+			}
+		}
+	}
+	if err := store.SetSessionEnergy(1, 6); err == nil {
+		t.Errorf("expected a rating of 6 to be rejected")
+	}
+
+	got, err := store.GetSession(1)
+	if err != nil || got == nil || got.Energy != 5 {
+		t.Fatalf("GetSession(1) = %+v, %v; want energy 5", got, err)
+	}
+
+	stats, err := store.GetSessionStats()
+	if err != nil {
+		t.Fatalf("Failed to get stats: %v", err)
+	}
+	want := []EnergyStats{
+		{Period: "Morning", Sessions: 2, Average: 4.5},
+		{Period: "Afternoon", Sessions: 1, Average: 2},
+		{Period: "Night", Sessions: 2, Average: 2},
+	}
+	if len(stats.Energy) != len(want) {
+		t.Fatalf("Energy = %+v, want %+v", stats.Energy, want)
+	}
+	for i := range want {
+		if stats.Energy[i] != want[i] {
+			t.Errorf("Energy[%d] = %+v, want %+v", i, stats.Energy[i], want[i])
+		}
+	}
+}
+
+// TestSessionBulkDelete tests deleting by IDs and by age.
+func TestSessionBulkDelete(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := New(&config.Config{DbPath: filepath.Join(tmpDir, "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	now := time.Now()
+	var ids []int64
+	for _, daysAgo := range []int{0, 1, 40, 100, 200} {
+		session := &models.FocusSession{StartTime: now.AddDate(0, 0, -daysAgo), Duration: 1500, Status: models.SessionStatusCompleted}
+		if err := store.CreateSession(session); err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+		ids = append(ids, session.ID)
+	}
+
+	if err := store.DeleteSessions(ids[:2]); err != nil {
+		t.Fatalf("DeleteSessions() err = %v", err)
+	}
+	if sessions, _ := store.ListSessions(); len(sessions) != 3 {
+		t.Fatalf("expected 3 sessions after deleting 2, got %d", len(sessions))
+	}
+
+	cutoff := now.AddDate(0, 0, -90)
+	if n, err := store.CountSessionsBefore(cutoff); err != nil || n != 2 {
+		t.Fatalf("CountSessionsBefore() = %d, %v; want 2", n, err)
+	}
+	if n, err := store.DeleteSessionsBefore(cutoff); err != nil || n != 2 {
+		t.Fatalf("DeleteSessionsBefore() = %d, %v; want 2", n, err)
+	}
+	sessions, _ := store.ListSessions()
+	if len(sessions) != 1 || sessions[0].ID != ids[2] {
+		t.Errorf("expected only the 40-day-old session left, got %+v", sessions)
+	}
+}
+
 // TestSessionStreakCalculation tests the streak calculation with multiple days.
 func TestSessionStreakCalculation(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -837,3 +992,247 @@ func TestCancelledSessionNotCountedInStats(t *testing.T) {
 func cleanupTestDB(path string) {
 	os.Remove(path)
 }
+
+// TestListTodosForNote verifies todos are returned by their note_id relation.
+func TestListTodosForNote(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := &config.Config{DbPath: filepath.Join(tmpDir, "test.db")}
+
+	store, err := New(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	note := &models.Note{Title: "Project"}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("Failed to create note: %v", err)
+	}
+
+	linked := &models.Todo{Title: "Linked", Status: models.TodoStatusPending, NoteID: &note.ID}
+	unlinked := &models.Todo{Title: "Unlinked", Status: models.TodoStatusPending}
+	for _, todo := range []*models.Todo{linked, unlinked} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("Failed to create todo: %v", err)
+		}
+	}
+
+	todos, err := store.ListTodosForNote(note.ID)
+	if err != nil {
+		t.Fatalf("ListTodosForNote() err = %v", err)
+	}
+	if len(todos) != 1 || todos[0].ID != linked.ID {
+		t.Fatalf("expected only the linked todo, got %+v", todos)
+	}
+}
+
+// TestTodoEstimateMigration verifies that databases created before the
+// estimate_minutes column existed are upgraded in place.
+func TestTodoEstimateMigration(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "legacy.db")
+
+	legacy, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("sql.Open() err = %v", err)
+	}
+	if _, err := legacy.Exec(`CREATE TABLE todos (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		title TEXT NOT NULL,
+		description TEXT,
+		status TEXT DEFAULT 'pending',
+		priority INTEGER DEFAULT 0,
+		due_date DATETIME,
+		note_id INTEGER,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		t.Fatalf("create legacy table: %v", err)
+	}
+	if _, err := legacy.Exec(`INSERT INTO todos (title, description, status, created_at, updated_at) VALUES ('Old todo', '', 'pending', ?, ?)`, time.Now(), time.Now()); err != nil {
+		t.Fatalf("insert legacy todo: %v", err)
+	}
+	legacy.Close()
+
+	store, err := New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("New() on legacy db err = %v", err)
+	}
+	defer store.Close()
+
+	todos, err := store.ListTodos()
+	if err != nil || len(todos) != 1 {
+		t.Fatalf("ListTodos() = %v, %v", todos, err)
+	}
+	if todos[0].EstimateMinutes != 0 {
+		t.Errorf("expected legacy todo to have no estimate, got %d", todos[0].EstimateMinutes)
+	}
+
+	todos[0].EstimateMinutes = 45
+	if err := store.UpdateTodo(&todos[0]); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	got, _ := store.GetTodo(todos[0].ID)
+	if got.EstimateMinutes != 45 {
+		t.Errorf("expected estimate 45 after update, got %d", got.EstimateMinutes)
+	}
+}
+
+// TestListProjects verifies per-project counts and that unassigned todos
+// are excluded.
+func TestListProjects(t *testing.T) {
+	store, err := New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	for _, todo := range []*models.Todo{
+		{Title: "Draft", Status: models.TodoStatusCompleted, Project: "Website"},
+		{Title: "Deploy", Status: models.TodoStatusPending, Project: "Website"},
+		{Title: "Pack", Status: models.TodoStatusPending, Project: "move"},
+		{Title: "Loose end", Status: models.TodoStatusPending},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	projects, err := store.ListProjects()
+	if err != nil {
+		t.Fatalf("ListProjects() err = %v", err)
+	}
+	if len(projects) != 2 {
+		t.Fatalf("expected 2 projects, got %+v", projects)
+	}
+	if projects[0].Name != "move" || projects[0].Total != 1 || projects[0].Completed != 0 {
+		t.Errorf("unexpected first project: %+v", projects[0])
+	}
+	if projects[1].Name != "Website" || projects[1].Total != 2 || projects[1].Completed != 1 {
+		t.Errorf("unexpected second project: %+v", projects[1])
+	}
+}
+
+// TestTodoDeferredUntil verifies the snooze time round-trips and can be
+// cleared.
+func TestTodoDeferredUntil(t *testing.T) {
+	store, err := New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	until := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	todo := &models.Todo{Title: "Later", Status: models.TodoStatusPending, DeferredUntil: &until}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	got, err := store.GetTodo(todo.ID)
+	if err != nil {
+		t.Fatalf("GetTodo() err = %v", err)
+	}
+	if got.DeferredUntil == nil || !got.DeferredUntil.Equal(until) {
+		t.Fatalf("expected deferred_until %v, got %v", until, got.DeferredUntil)
+	}
+	if !got.IsSnoozed(time.Now()) {
+		t.Errorf("expected todo to be snoozed")
+	}
+
+	got.DeferredUntil = nil
+	if err := store.UpdateTodo(got); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	got, _ = store.GetTodo(todo.ID)
+	if got.DeferredUntil != nil {
+		t.Errorf("expected snooze to be cleared, got %v", got.DeferredUntil)
+	}
+}
+
+// TestTodoCompletedAt verifies the completion time is set when a todo is
+// completed, kept through later edits and cleared when it is reopened.
+func TestTodoCompletedAt(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	todo := &models.Todo{Title: "Ship", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	if todo.CompletedAt != nil {
+		t.Fatalf("open todo has completed_at %v", todo.CompletedAt)
+	}
+
+	before := time.Now().Add(-time.Second)
+	todo.Status = models.TodoStatusCompleted
+	if err := store.UpdateTodo(todo); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	got, _ := store.GetTodo(todo.ID)
+	if got.CompletedAt == nil || got.CompletedAt.Before(before) {
+		t.Fatalf("completed_at = %v, want about now", got.CompletedAt)
+	}
+	completed := *got.CompletedAt
+
+	// An edit from a copy without the time keeps the first completion
+	edited := *got
+	edited.Title, edited.CompletedAt = "Ship v2", nil
+	if err := store.UpdateTodo(&edited); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	got, _ = store.GetTodo(todo.ID)
+	if got.CompletedAt == nil || !got.CompletedAt.Equal(completed) {
+		t.Errorf("completed_at after edit = %v, want %v", got.CompletedAt, completed)
+	}
+
+	done, err := store.QueryTodos(TodoQuery{CompletedSince: before, Sort: TodoSortCompletedDesc})
+	if err != nil || len(done) != 1 {
+		t.Fatalf("QueryTodos(CompletedSince) = %v, err %v", done, err)
+	}
+	if n, _ := store.CountTodos(TodoQuery{CompletedSince: time.Now().Add(time.Hour)}); n != 0 {
+		t.Errorf("CountTodos(CompletedSince future) = %d, want 0", n)
+	}
+
+	got.Status = models.TodoStatusPending
+	if err := store.UpdateTodo(got); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	got, _ = store.GetTodo(todo.ID)
+	if got.CompletedAt != nil {
+		t.Errorf("reopened todo has completed_at %v", got.CompletedAt)
+	}
+
+	imported := &models.Todo{Title: "Old", Status: models.TodoStatusCompleted}
+	if err := store.CreateTodo(imported); err != nil || imported.CompletedAt == nil {
+		t.Errorf("todo created completed: completed_at %v, err %v", imported.CompletedAt, err)
+	}
+}
+
+// TestReadOnlyStore verifies a read-only attach can read but not write.
+func TestReadOnlyStore(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	store, err := New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("New() err = %v", err)
+	}
+	if err := store.CreateNote(&models.Note{Title: "Existing", Tags: []string{"work"}}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	store.Close()
+
+	ro, err := New(&config.Config{DbPath: dbPath, ReadOnly: true})
+	if err != nil {
+		t.Fatalf("New(ReadOnly) err = %v", err)
+	}
+	defer ro.Close()
+
+	if !ro.ReadOnly() {
+		t.Errorf("expected ReadOnly() to be true")
+	}
+	notes, err := ro.QueryNotes(NoteQuery{Tags: []string{"work"}})
+	if err != nil || len(notes) != 1 {
+		t.Fatalf("QueryNotes() = %v, %v", notes, err)
+	}
+	if err := ro.CreateNote(&models.Note{Title: "New"}); err == nil {
+		t.Errorf("expected CreateNote() to fail on a read-only store")
+	}
+}
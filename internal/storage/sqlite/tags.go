@@ -0,0 +1,447 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Tag Operations (Phase 4: Performance)
+//
+// Tags are normalized into the note_tags and todo_tags join tables so
+// "all items with tag X", tag counts and renames are indexed queries.
+// Note tags come from models.Note.Tags; todo tags are the #hashtags in
+// the title and description. The notes.tags JSON column is still written
+// for backward compatibility with older builds.
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// TagCount is how many notes and todos carry a tag.
+type TagCount struct {
+	Tag   string
+	Notes int
+	Todos int
+}
+
+// syncNoteTags replaces the join rows for a note.
+func syncNoteTags(db execer, noteID int64, tags []string) error {
+	if _, err := db.Exec("DELETE FROM note_tags WHERE note_id = ?", noteID); err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			continue
+		}
+		if _, err := db.Exec("INSERT OR IGNORE INTO note_tags (note_id, tag) VALUES (?, ?)", noteID, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncTodoTags replaces the join rows for a todo from its #hashtags.
+func syncTodoTags(db execer, todo *models.Todo) error {
+	if _, err := db.Exec("DELETE FROM todo_tags WHERE todo_id = ?", todo.ID); err != nil {
+		return err
+	}
+	for _, tag := range models.ExtractHashtags(todo.Title + " " + todo.Description) {
+		if _, err := db.Exec("INSERT OR IGNORE INTO todo_tags (todo_id, tag) VALUES (?, ?)", todo.ID, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableExists reports whether a table is present in the schema.
+func (s *Store) tableExists(name string) (bool, error) {
+	var n int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&n)
+	return n > 0, err
+}
+
+// backfillTags fills the join tables from existing notes and todos.
+// It runs once, when the join tables are first created.
+func (s *Store) backfillTags() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(
+		"INSERT OR IGNORE INTO note_tags (note_id, tag) SELECT notes.id, json_each.value FROM notes, json_each(notes.tags) WHERE json_valid(notes.tags) AND json_each.value <> ''",
+	); err != nil {
+		return err
+	}
+
+	todos, err := s.QueryTodos(TodoQuery{})
+	if err != nil {
+		return err
+	}
+	for i := range todos {
+		if err := syncTodoTags(tx, &todos[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListNoteTags returns every tag used on a note, sorted.
+func (s *Store) ListNoteTags() ([]string, error) {
+	return s.ListNoteTagsContext(context.Background())
+}
+
+// ListNoteTagsContext is ListNoteTags with cancellation.
+func (s *Store) ListNoteTagsContext(ctx context.Context) ([]string, error) {
+	return s.queryTags(ctx, "SELECT DISTINCT tag FROM note_tags ORDER BY tag")
+}
+
+// ListTodoTags returns every #hashtag used in todo titles and
+// descriptions, sorted.
+func (s *Store) ListTodoTags() ([]string, error) {
+	return s.ListTodoTagsContext(context.Background())
+}
+
+// ListTodoTagsContext is ListTodoTags with cancellation.
+func (s *Store) ListTodoTagsContext(ctx context.Context) ([]string, error) {
+	return s.queryTags(ctx, "SELECT DISTINCT tag FROM todo_tags ORDER BY tag")
+}
+
+func (s *Store) queryTags(ctx context.Context, query string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+// ListTagCounts returns every tag with its note and todo counts, ordered
+// by tag.
+func (s *Store) ListTagCounts() ([]TagCount, error) {
+	rows, err := s.db.Query(`
+		SELECT tag, SUM(notes), SUM(todos) FROM (
+			SELECT tag, COUNT(*) AS notes, 0 AS todos FROM note_tags GROUP BY tag
+			UNION ALL
+			SELECT tag, 0 AS notes, COUNT(*) AS todos FROM todo_tags GROUP BY tag
+		) GROUP BY tag ORDER BY tag`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []TagCount
+	for rows.Next() {
+		var c TagCount
+		if err := rows.Scan(&c.Tag, &c.Notes, &c.Todos); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+// ListTodoTagStats returns every todo tag with how many todos carry it
+// and how many of those are completed, ordered by tag. It is the tag
+// counterpart of ListProjects.
+func (s *Store) ListTodoTagStats() ([]ProjectStats, error) {
+	rows, err := s.db.Query(`
+		SELECT tt.tag, COUNT(*), SUM(CASE WHEN t.status = 'completed' THEN 1 ELSE 0 END)
+		FROM todo_tags tt JOIN todos t ON t.id = tt.todo_id
+		GROUP BY tt.tag ORDER BY tt.tag`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []ProjectStats
+	for rows.Next() {
+		var t ProjectStats
+		if err := rows.Scan(&t.Name, &t.Total, &t.Completed); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// RenameTag renames a tag on every note and todo. The #tag / @tag text in
+// titles, bodies and descriptions is rewritten too, so the tag survives
+// the next edit (tags are re-extracted from the text on save). The tag's
+// style moves with it. The rewritten notes keep their UpdatedAt, so their
+// stored vectors are dropped for the next indexing to embed them again.
+func (s *Store) RenameTag(oldTag, newTag string) error {
+	oldTag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(oldTag), "#@"))
+	newTag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(newTag), "#@"))
+	if oldTag == "" || newTag == "" || oldTag == newTag {
+		return nil
+	}
+	mention := regexp.MustCompile(`(?i)([#@])` + regexp.QuoteMeta(oldTag) + `([\s.,!?;:]|$)`)
+	rewrite := func(text string) string {
+		return mention.ReplaceAllString(text, "${1}"+newTag+"${2}")
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	noteIDs, err := idsForTag(tx, "SELECT note_id FROM note_tags WHERE tag = ?", oldTag)
+	if err != nil {
+		return err
+	}
+	for _, id := range noteIDs {
+		var note models.Note
+		var body sql.NullString
+		var tagsStr string
+		if err := tx.QueryRow("SELECT id, title, body, tags FROM notes WHERE id = ?", id).Scan(&note.ID, &note.Title, &body, &tagsStr); err != nil {
+			return err
+		}
+		json.Unmarshal([]byte(tagsStr), &note.Tags)
+		note.Tags = renameInSlice(note.Tags, oldTag, newTag)
+		tagsJSON, _ := json.Marshal(note.Tags)
+		if _, err := tx.Exec("UPDATE notes SET title = ?, body = ?, tags = ? WHERE id = ?", rewrite(note.Title), rewrite(body.String), string(tagsJSON), id); err != nil {
+			return err
+		}
+		if err := deleteNoteEmbedding(tx, id); err != nil {
+			return err
+		}
+		if err := syncNoteTags(tx, id, note.Tags); err != nil {
+			return err
+		}
+	}
+
+	todoIDs, err := idsForTag(tx, "SELECT todo_id FROM todo_tags WHERE tag = ?", oldTag)
+	if err != nil {
+		return err
+	}
+	for _, id := range todoIDs {
+		var todo models.Todo
+		var desc sql.NullString
+		if err := tx.QueryRow("SELECT id, title, description FROM todos WHERE id = ?", id).Scan(&todo.ID, &todo.Title, &desc); err != nil {
+			return err
+		}
+		todo.Title = rewrite(todo.Title)
+		todo.Description = rewrite(desc.String)
+		if _, err := tx.Exec("UPDATE todos SET title = ?, description = ? WHERE id = ?", todo.Title, todo.Description, id); err != nil {
+			return err
+		}
+		if err := syncTodoTags(tx, &todo); err != nil {
+			return err
+		}
+	}
+	if err := renameTagStyle(tx, oldTag, newTag); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddTag tags the given notes and todos in one transaction and returns
+// how many of them changed. A #tag is appended to each note body (on the
+// trailing line of tags, if it has one) and todo description, so the tag
+// survives the next edit; items already carrying it are left alone.
+func (s *Store) AddTag(noteIDs, todoIDs []int64, tag string) (int, error) {
+	return s.retag(noteIDs, todoIDs, tag, false)
+}
+
+// RemoveTag untags the given notes and todos in one transaction and
+// returns how many of them changed. Every #tag / @tag mention of it is
+// removed from titles, bodies and descriptions.
+func (s *Store) RemoveTag(noteIDs, todoIDs []int64, tag string) (int, error) {
+	return s.retag(noteIDs, todoIDs, tag, true)
+}
+
+func (s *Store) retag(noteIDs, todoIDs []int64, tag string, remove bool) (int, error) {
+	tag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(tag), "#@"))
+	if tag == "" {
+		return 0, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Retagging rewrites bodies and descriptions, so it counts as an edit
+	now := time.Now()
+	changed := 0
+	for _, id := range noteIDs {
+		var note models.Note
+		var body, tagsStr sql.NullString
+		if err := tx.QueryRow("SELECT id, title, body, tags FROM notes WHERE id = ?", id).Scan(&note.ID, &note.Title, &body, &tagsStr); err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return 0, err
+		}
+		note.Body = body.String
+		json.Unmarshal([]byte(tagsStr.String), &note.Tags)
+		has := containsTag(note.Tags, tag)
+		if has == !remove {
+			continue
+		}
+		if remove {
+			note.Title = stripTag(note.Title, tag)
+			note.Body = stripTag(note.Body, tag)
+			note.Tags = removeFromSlice(note.Tags, tag)
+		} else {
+			note.Body = appendTag(note.Body, tag, "\n\n")
+			note.Tags = append(note.Tags, tag)
+		}
+		tagsJSON, _ := json.Marshal(note.Tags)
+		if _, err := tx.Exec("UPDATE notes SET title = ?, body = ?, tags = ?, updated_at = ? WHERE id = ?", note.Title, note.Body, string(tagsJSON), now, id); err != nil {
+			return 0, err
+		}
+		if err := syncNoteTags(tx, id, note.Tags); err != nil {
+			return 0, err
+		}
+		changed++
+	}
+
+	for _, id := range todoIDs {
+		var todo models.Todo
+		var desc sql.NullString
+		if err := tx.QueryRow("SELECT id, title, description FROM todos WHERE id = ?", id).Scan(&todo.ID, &todo.Title, &desc); err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return 0, err
+		}
+		todo.Description = desc.String
+		has := containsTag(models.ExtractHashtags(todo.Title+" "+todo.Description), tag)
+		if has == !remove {
+			continue
+		}
+		if remove {
+			todo.Title = stripTag(todo.Title, tag)
+			todo.Description = stripTag(todo.Description, tag)
+		} else {
+			todo.Description = appendTag(todo.Description, tag, " ")
+		}
+		if _, err := tx.Exec("UPDATE todos SET title = ?, description = ?, updated_at = ? WHERE id = ?", todo.Title, todo.Description, now, id); err != nil {
+			return 0, err
+		}
+		if err := syncTodoTags(tx, &todo); err != nil {
+			return 0, err
+		}
+		changed++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// containsTag reports whether tags holds tag.
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// removeFromSlice returns tags without tag.
+func removeFromSlice(tags []string, tag string) []string {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t != tag {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// appendTag adds #tag to the end of text: on its last line when that line
+// holds only tags, otherwise after sep.
+func appendTag(text, tag, sep string) string {
+	text = strings.TrimRight(text, " \t\n")
+	if text == "" {
+		return "#" + tag
+	}
+	lastLine := text[strings.LastIndex(text, "\n")+1:]
+	tagsOnly := true
+	for _, word := range strings.Fields(lastLine) {
+		if !strings.HasPrefix(word, "#") {
+			tagsOnly = false
+			break
+		}
+	}
+	if tagsOnly {
+		sep = " "
+	}
+	return text + sep + "#" + tag
+}
+
+// stripTag removes the #tag / @tag mentions of tag from text, with the
+// space before each, and any line left holding nothing else.
+func stripTag(text, tag string) string {
+	quoted := regexp.QuoteMeta(tag)
+	lineStart := regexp.MustCompile(`(?im)^[ \t]*[#@]` + quoted + `(?:[ \t]+|$)`)
+	inline := regexp.MustCompile(`(?i)[ \t]+[#@]` + quoted + `([\s.,!?;:]|$)`)
+	for {
+		stripped := inline.ReplaceAllString(lineStart.ReplaceAllString(text, ""), "${1}")
+		if stripped == text {
+			break
+		}
+		text = stripped
+	}
+	return strings.TrimSpace(regexp.MustCompile(`\n{3,}`).ReplaceAllString(text, "\n\n"))
+}
+
+func idsForTag(tx *sql.Tx, query, tag string) ([]int64, error) {
+	rows, err := tx.Query(query, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// renameInSlice replaces oldTag with newTag, dropping duplicates.
+func renameInSlice(tags []string, oldTag, newTag string) []string {
+	seen := make(map[string]bool)
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == oldTag {
+			tag = newTag
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+	}
+	return out
+}
@@ -0,0 +1,278 @@
+package sqlite
+
+import (
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// TestTagBackfillMigration opens a database created before the join
+// tables existed and checks tags are backfilled from the old data.
+func TestTagBackfillMigration(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "legacy.db")
+
+	legacy, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("sql.Open() err = %v", err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE notes (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			body TEXT,
+			tags TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE todos (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			description TEXT,
+			status TEXT DEFAULT 'pending',
+			priority INTEGER DEFAULT 0,
+			due_date DATETIME,
+			note_id INTEGER,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+	} {
+		if _, err := legacy.Exec(stmt); err != nil {
+			t.Fatalf("create legacy table: %v", err)
+		}
+	}
+	now := time.Now()
+	if _, err := legacy.Exec(`INSERT INTO notes (title, body, tags, created_at, updated_at) VALUES ('Old note', '', '["work","ideas"]', ?, ?), ('Untagged', '', 'null', ?, ?)`, now, now, now, now); err != nil {
+		t.Fatalf("insert legacy notes: %v", err)
+	}
+	if _, err := legacy.Exec(`INSERT INTO todos (title, description, status, created_at, updated_at) VALUES ('Ship #Work', 'see #release', 'pending', ?, ?)`, now, now); err != nil {
+		t.Fatalf("insert legacy todo: %v", err)
+	}
+	legacy.Close()
+
+	store, err := New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("New() on legacy db err = %v", err)
+	}
+	defer store.Close()
+
+	noteTags, err := store.ListNoteTags()
+	if err != nil {
+		t.Fatalf("ListNoteTags() err = %v", err)
+	}
+	if want := []string{"ideas", "work"}; !reflect.DeepEqual(noteTags, want) {
+		t.Errorf("ListNoteTags() = %v, want %v", noteTags, want)
+	}
+
+	todoTags, err := store.ListTodoTags()
+	if err != nil {
+		t.Fatalf("ListTodoTags() err = %v", err)
+	}
+	if want := []string{"release", "work"}; !reflect.DeepEqual(todoTags, want) {
+		t.Errorf("ListTodoTags() = %v, want %v", todoTags, want)
+	}
+
+	notes, err := store.QueryNotes(NoteQuery{Tags: []string{"work"}})
+	if err != nil || len(notes) != 1 || notes[0].Title != "Old note" {
+		t.Errorf("QueryNotes(work) = %v, %v", noteTitles(notes), err)
+	}
+}
+
+func TestListTagCounts(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	for _, note := range []*models.Note{
+		{Title: "a", Tags: []string{"work", "ideas"}},
+		{Title: "b", Tags: []string{"work"}},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	todo := &models.Todo{Title: "Plan #work", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	other := &models.Todo{Title: "Buy milk #errands", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(other); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	counts, err := store.ListTagCounts()
+	if err != nil {
+		t.Fatalf("ListTagCounts() err = %v", err)
+	}
+	want := []TagCount{
+		{Tag: "errands", Notes: 0, Todos: 1},
+		{Tag: "ideas", Notes: 1, Todos: 0},
+		{Tag: "work", Notes: 2, Todos: 1},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("ListTagCounts() = %+v, want %+v", counts, want)
+	}
+
+	// Editing the text re-syncs the todo's tags; deleting drops them.
+	other.Title = "Buy milk"
+	if err := store.UpdateTodo(other); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	if err := store.DeleteTodo(todo.ID); err != nil {
+		t.Fatalf("DeleteTodo() err = %v", err)
+	}
+	tags, _ := store.ListTodoTags()
+	if len(tags) != 0 {
+		t.Errorf("ListTodoTags() after edit/delete = %v, want none", tags)
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	note := &models.Note{Title: "Standup", Body: "notes for #work, and @work.", Tags: []string{"work", "meeting"}}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	merged := &models.Note{Title: "Both", Body: "#work #job", Tags: []string{"work", "job"}}
+	if err := store.CreateNote(merged); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	todo := &models.Todo{Title: "File report #Work", Description: "not #workout", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	if err := store.RenameTag("#work", "job"); err != nil {
+		t.Fatalf("RenameTag() err = %v", err)
+	}
+
+	got, _ := store.GetNote(note.ID)
+	if want := []string{"job", "meeting"}; !reflect.DeepEqual(got.Tags, want) {
+		t.Errorf("note tags = %v, want %v", got.Tags, want)
+	}
+	if want := "notes for #job, and @job."; got.Body != want {
+		t.Errorf("note body = %q, want %q", got.Body, want)
+	}
+
+	got, _ = store.GetNote(merged.ID)
+	if want := []string{"job"}; !reflect.DeepEqual(got.Tags, want) {
+		t.Errorf("merged note tags = %v, want %v", got.Tags, want)
+	}
+
+	gotTodo, _ := store.GetTodo(todo.ID)
+	if gotTodo.Title != "File report #job" || gotTodo.Description != "not #workout" {
+		t.Errorf("todo = %q / %q", gotTodo.Title, gotTodo.Description)
+	}
+
+	counts, _ := store.ListTagCounts()
+	want := []TagCount{
+		{Tag: "job", Notes: 2, Todos: 1},
+		{Tag: "meeting", Notes: 1, Todos: 0},
+		{Tag: "workout", Notes: 0, Todos: 1},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("ListTagCounts() after rename = %+v, want %+v", counts, want)
+	}
+}
+
+func TestAddRemoveTag(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	plain := &models.Note{Title: "Standup", Body: "notes for the team"}
+	tagged := &models.Note{Title: "Retro", Body: "went well\n\n#meeting", Tags: []string{"meeting"}}
+	for _, note := range []*models.Note{plain, tagged} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	todo := &models.Todo{Title: "File report", Description: "by Friday", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	noteIDs, todoIDs := []int64{plain.ID, tagged.ID}, []int64{todo.ID}
+	old := time.Now().Add(-48 * time.Hour)
+	for _, table := range []string{"notes", "todos"} {
+		if _, err := store.db.Exec("UPDATE "+table+" SET updated_at = ?", old); err != nil {
+			t.Fatalf("backdate %s: %v", table, err)
+		}
+	}
+
+	n, err := store.AddTag(noteIDs, todoIDs, "#Work")
+	if err != nil || n != 3 {
+		t.Fatalf("AddTag() = %d, %v; want 3 changed", n, err)
+	}
+	got, _ := store.GetNote(plain.ID)
+	if got.Body != "notes for the team\n\n#work" || !reflect.DeepEqual(got.Tags, []string{"work"}) {
+		t.Errorf("plain note = %q %v", got.Body, got.Tags)
+	}
+	if !got.UpdatedAt.After(old) {
+		t.Errorf("plain note UpdatedAt = %v, want it bumped by the retag", got.UpdatedAt)
+	}
+	got, _ = store.GetNote(tagged.ID)
+	if got.Body != "went well\n\n#meeting #work" {
+		t.Errorf("tagged note body = %q, want the tag on its tags line", got.Body)
+	}
+	gotTodo, _ := store.GetTodo(todo.ID)
+	if gotTodo.Description != "by Friday #work" {
+		t.Errorf("todo description = %q", gotTodo.Description)
+	}
+	if !gotTodo.UpdatedAt.After(old) {
+		t.Errorf("todo UpdatedAt = %v, want it bumped by the retag", gotTodo.UpdatedAt)
+	}
+	if n, _ := store.AddTag(noteIDs, todoIDs, "work"); n != 0 {
+		t.Errorf("AddTag() again changed %d, want 0", n)
+	}
+
+	n, err = store.RemoveTag(noteIDs, todoIDs, "meeting")
+	if err != nil || n != 1 {
+		t.Fatalf("RemoveTag(meeting) = %d, %v; want 1 changed", n, err)
+	}
+	got, _ = store.GetNote(tagged.ID)
+	if got.Body != "went well\n\n#work" || !reflect.DeepEqual(got.Tags, []string{"work"}) {
+		t.Errorf("tagged note after removal = %q %v", got.Body, got.Tags)
+	}
+
+	if n, err := store.RemoveTag(noteIDs, todoIDs, "work"); err != nil || n != 3 {
+		t.Fatalf("RemoveTag(work) = %d, %v; want 3 changed", n, err)
+	}
+	got, _ = store.GetNote(plain.ID)
+	if got.Body != "notes for the team" || len(got.Tags) != 0 {
+		t.Errorf("plain note after removal = %q %v", got.Body, got.Tags)
+	}
+	gotTodo, _ = store.GetTodo(todo.ID)
+	if gotTodo.Description != "by Friday" {
+		t.Errorf("todo description after removal = %q", gotTodo.Description)
+	}
+	if counts, _ := store.ListTagCounts(); len(counts) != 0 {
+		t.Errorf("ListTagCounts() = %+v, want none", counts)
+	}
+}
+
+func TestListTodoTagStats(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	for _, todo := range []*models.Todo{
+		{Title: "Plan #launch", Status: models.TodoStatusCompleted},
+		{Title: "Ship #launch #web", Status: models.TodoStatusPending},
+		{Title: "No tags", Status: models.TodoStatusPending},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	stats, err := store.ListTodoTagStats()
+	if err != nil {
+		t.Fatalf("ListTodoTagStats() err = %v", err)
+	}
+	want := []ProjectStats{
+		{Name: "launch", Total: 2, Completed: 1},
+		{Name: "web", Total: 1, Completed: 0},
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("ListTodoTagStats() = %+v, want %+v", stats, want)
+	}
+}
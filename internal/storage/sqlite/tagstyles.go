@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Tag Styles (Phase 6: Organization)
+//
+// Per-tag settings made on the Tags screen: a color and icon the tag is
+// drawn with (see styles.FormatTag), and a default priority. Saving a
+// todo raises it to the highest default priority among its #hashtags,
+// so anything tagged #urgent is high priority; a todo already above
+// that keeps its priority. A tag with nothing set has no row.
+
+// rowQuerier is satisfied by both *sql.DB and *sql.Tx.
+type rowQuerier interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// ListTagStyles returns the styled tags, sorted by tag.
+func (s *Store) ListTagStyles() ([]models.TagStyle, error) {
+	rows, err := s.db.Query("SELECT tag, color, icon, priority FROM tag_styles ORDER BY tag")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tagStyles []models.TagStyle
+	for rows.Next() {
+		var ts models.TagStyle
+		var priority sql.NullInt64
+		if err := rows.Scan(&ts.Tag, &ts.Color, &ts.Icon, &priority); err != nil {
+			return nil, err
+		}
+		if priority.Valid {
+			p := models.TodoPriority(priority.Int64)
+			ts.Priority = &p
+		}
+		tagStyles = append(tagStyles, ts)
+	}
+	return tagStyles, rows.Err()
+}
+
+// SetTagStyle saves the settings of ts.Tag, replacing any before. A
+// style with nothing set removes the tag's row.
+func (s *Store) SetTagStyle(ts models.TagStyle) error {
+	tag := strings.ToLower(strings.TrimLeft(strings.TrimSpace(ts.Tag), "#@"))
+	if tag == "" {
+		return nil
+	}
+	color, icon := strings.TrimSpace(ts.Color), strings.TrimSpace(ts.Icon)
+	if color == "" && icon == "" && ts.Priority == nil {
+		_, err := s.db.Exec("DELETE FROM tag_styles WHERE tag = ?", tag)
+		return err
+	}
+	var priority interface{}
+	if ts.Priority != nil {
+		priority = int(*ts.Priority)
+	}
+	_, err := s.db.Exec(
+		`INSERT INTO tag_styles (tag, color, icon, priority) VALUES (?, ?, ?, ?)
+		ON CONFLICT(tag) DO UPDATE SET color = excluded.color, icon = excluded.icon, priority = excluded.priority`,
+		tag, color, icon, priority,
+	)
+	return err
+}
+
+// applyTagPriority raises todo to the highest default priority of its
+// #hashtags.
+func applyTagPriority(db rowQuerier, todo *models.Todo) error {
+	tags := models.ExtractHashtags(todo.Title + " " + todo.Description)
+	if len(tags) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		args[i] = tag
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(tags)), ", ")
+	var priority sql.NullInt64
+	if err := db.QueryRow("SELECT MAX(priority) FROM tag_styles WHERE tag IN ("+placeholders+")", args...).Scan(&priority); err != nil {
+		return err
+	}
+	if priority.Valid && models.TodoPriority(priority.Int64) > todo.Priority {
+		todo.Priority = models.TodoPriority(priority.Int64)
+	}
+	return nil
+}
+
+// renameTagStyle moves the settings of oldTag to newTag, unless newTag
+// has settings of its own.
+func renameTagStyle(db execer, oldTag, newTag string) error {
+	if _, err := db.Exec("UPDATE OR IGNORE tag_styles SET tag = ? WHERE tag = ?", newTag, oldTag); err != nil {
+		return err
+	}
+	_, err := db.Exec("DELETE FROM tag_styles WHERE tag = ?", oldTag)
+	return err
+}
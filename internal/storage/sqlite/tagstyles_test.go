@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestTagStyles(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	high, medium := models.TodoPriorityHigh, models.TodoPriorityMedium
+	for _, ts := range []models.TagStyle{
+		{Tag: "#Urgent", Color: "#ff5f87", Icon: "🔥", Priority: &high},
+		{Tag: "client", Priority: &medium},
+		{Tag: "reading", Color: "81"},
+	} {
+		if err := store.SetTagStyle(ts); err != nil {
+			t.Fatalf("SetTagStyle(%q) err = %v", ts.Tag, err)
+		}
+	}
+
+	tagStyles, err := store.ListTagStyles()
+	if err != nil || len(tagStyles) != 3 {
+		t.Fatalf("ListTagStyles() = %+v, %v; want 3", tagStyles, err)
+	}
+	if ts := tagStyles[2]; ts.Tag != "urgent" || ts.Icon != "🔥" || ts.Priority == nil || *ts.Priority != high {
+		t.Errorf("urgent = %+v, want the normalized tag with its settings", ts)
+	}
+	if tagStyles[1].Priority != nil {
+		t.Errorf("reading priority = %v, want none", *tagStyles[1].Priority)
+	}
+
+	// Saving raises the priority to the highest tag default, never lowers it
+	todo := &models.Todo{Title: "Call back #client", Description: "before noon #urgent", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	if got, _ := store.GetTodo(todo.ID); got.Priority != high {
+		t.Errorf("priority after create = %d, want high", got.Priority)
+	}
+	todo.Description = "no rush"
+	todo.Priority = models.TodoPriorityLow
+	if err := store.UpdateTodo(todo); err != nil {
+		t.Fatalf("UpdateTodo() err = %v", err)
+	}
+	if got, _ := store.GetTodo(todo.ID); got.Priority != medium {
+		t.Errorf("priority after update = %d, want medium from #client", got.Priority)
+	}
+
+	// Renames carry the style; clearing every setting removes it
+	if err := store.RenameTag("client", "customer"); err != nil {
+		t.Fatalf("RenameTag() err = %v", err)
+	}
+	if err := store.SetTagStyle(models.TagStyle{Tag: "reading"}); err != nil {
+		t.Fatalf("SetTagStyle() err = %v", err)
+	}
+	tagStyles, _ = store.ListTagStyles()
+	if len(tagStyles) != 2 || tagStyles[0].Tag != "customer" || tagStyles[1].Tag != "urgent" {
+		t.Errorf("ListTagStyles() = %+v, want customer and urgent", tagStyles)
+	}
+}
@@ -0,0 +1,183 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Transactions and batch operations (Phase 4: Performance)
+//
+// WithTx runs a multi-step flow, such as saving a note's [[wikilinks]]
+// (resolve the titles, create placeholder notes, link them), in a single
+// transaction: either every step is stored or none is, and SQLite syncs
+// to disk once instead of once per statement. The batch methods replace
+// per-item loops with one query or one prepared statement.
+
+// querier is satisfied by both *sql.DB and *sql.Tx.
+type querier interface {
+	execer
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// Tx is a transaction opened by WithTx.
+type Tx struct {
+	tx *sql.Tx
+}
+
+// WithTx runs fn in a transaction, committing if it returns nil and
+// rolling back otherwise.
+func (s *Store) WithTx(fn func(tx *Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(&Tx{tx: tx}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateNote is Store.CreateNote within the transaction.
+func (t *Tx) CreateNote(note *models.Note) error {
+	return createNote(t.tx, note)
+}
+
+// CreateTodo is Store.CreateTodo within the transaction.
+func (t *Tx) CreateTodo(todo *models.Todo) error {
+	return createTodo(t.tx, todo)
+}
+
+// DeleteNote is Store.DeleteNote within the transaction.
+func (t *Tx) DeleteNote(id int64) error {
+	return deleteNote(t.tx, id)
+}
+
+// CreateLinks is Store.CreateLinks within the transaction.
+func (t *Tx) CreateLinks(links []models.Link) error {
+	return createLinks(t.tx, links)
+}
+
+// CreateNotebook is Store.CreateNotebook within the transaction.
+func (t *Tx) CreateNotebook(name string) (*models.Notebook, error) {
+	return createNotebook(t.tx, name)
+}
+
+// GetNotesByTitles is Store.GetNotesByTitles within the transaction.
+func (t *Tx) GetNotesByTitles(titles []string) ([]models.Note, error) {
+	return getNotesByTitles(t.tx, titles)
+}
+
+// CreateLinks creates every link in one transaction, setting each link's
+// ID and CreatedAt. Links that already exist are left as they are.
+func (s *Store) CreateLinks(links []models.Link) error {
+	return s.WithTx(func(tx *Tx) error {
+		return tx.CreateLinks(links)
+	})
+}
+
+// GetNotesByTitles returns the notes whose title matches one of titles,
+// ignoring case and surrounding space, in one query on the NOCASE title
+// index (see GetNoteByTitle).
+func (s *Store) GetNotesByTitles(titles []string) ([]models.Note, error) {
+	return getNotesByTitles(s.db, titles)
+}
+
+// createNote inserts note and its tag rows within tx.
+func createNote(tx *sql.Tx, note *models.Note) error {
+	tagsJSON, _ := json.Marshal(note.Tags)
+	now := time.Now()
+	note.CreatedAt = now
+	note.UpdatedAt = now
+
+	var notebookID interface{}
+	if note.NotebookID != 0 {
+		notebookID = note.NotebookID
+	}
+
+	result, err := tx.Exec(
+		"INSERT INTO notes (title, body, tags, created_at, updated_at, notebook_id, starred) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		note.Title, note.Body, string(tagsJSON), note.CreatedAt, note.UpdatedAt, notebookID, note.Starred,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, _ := result.LastInsertId()
+	if err := syncNoteTags(tx, id, note.Tags); err != nil {
+		return err
+	}
+	note.ID = id
+	return nil
+}
+
+// createLinks inserts links with one prepared statement.
+func createLinks(tx *sql.Tx, links []models.Link) error {
+	if len(links) == 0 {
+		return nil
+	}
+	stmt, err := tx.Prepare("INSERT OR IGNORE INTO links (source_type, source_id, target_type, target_id, link_type, created_at) VALUES (?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for i := range links {
+		link := &links[i]
+		link.CreatedAt = now
+		result, err := stmt.Exec(link.SourceType, link.SourceID, link.TargetType, link.TargetID, link.LinkType, link.CreatedAt)
+		if err != nil {
+			return err
+		}
+		link.ID, _ = result.LastInsertId()
+	}
+	return nil
+}
+
+// getNotesByTitles selects the notes titled one of titles.
+func getNotesByTitles(q querier, titles []string) ([]models.Note, error) {
+	var args []interface{}
+	seen := make(map[string]bool)
+	for _, title := range titles {
+		title = strings.TrimSpace(title)
+		key := strings.ToLower(title)
+		if title == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		args = append(args, title)
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	rows, err := q.Query(
+		"SELECT id, title, body, tags, created_at, updated_at, notebook_id, starred FROM notes WHERE title COLLATE NOCASE IN (?"+strings.Repeat(", ?", len(args)-1)+") ORDER BY id",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []models.Note
+	for rows.Next() {
+		var note models.Note
+		var tagsStr string
+		var notebookID sql.NullInt64
+		if err := rows.Scan(&note.ID, &note.Title, &note.Body, &tagsStr, &note.CreatedAt, &note.UpdatedAt, &notebookID, &note.Starred); err != nil {
+			return nil, err
+		}
+		json.Unmarshal([]byte(tagsStr), &note.Tags)
+		note.NotebookID = notebookID.Int64
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
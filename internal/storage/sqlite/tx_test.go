@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestWithTx(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	// An error rolls back everything the callback wrote
+	failed := errors.New("stop")
+	err := store.WithTx(func(tx *Tx) error {
+		if err := tx.CreateNote(&models.Note{Title: "draft"}); err != nil {
+			return err
+		}
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("WithTx() err = %v, want %v", err, failed)
+	}
+	if n, _ := store.CountNotes(NoteQuery{}); n != 0 {
+		t.Fatalf("%d notes after a rolled-back transaction, want 0", n)
+	}
+
+	var source, target models.Note
+	err = store.WithTx(func(tx *Tx) error {
+		source = models.Note{Title: "Source", Tags: []string{"work"}}
+		target = models.Note{Title: "Target"}
+		if err := tx.CreateNote(&source); err != nil {
+			return err
+		}
+		if err := tx.CreateNote(&target); err != nil {
+			return err
+		}
+		return tx.CreateLinks([]models.Link{{SourceType: "note", SourceID: source.ID, TargetType: "note", TargetID: target.ID, LinkType: "wikilink"}})
+	})
+	if err != nil {
+		t.Fatalf("WithTx() err = %v", err)
+	}
+	links, _ := store.ListLinks()
+	if len(links) != 1 || links[0].SourceID != source.ID || links[0].TargetID != target.ID {
+		t.Fatalf("ListLinks() = %+v, want Source → Target", links)
+	}
+	if tags, _ := store.ListNoteTags(); !reflect.DeepEqual(tags, []string{"work"}) {
+		t.Errorf("ListNoteTags() = %v, want [work]", tags)
+	}
+}
+
+func TestCreateLinks(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	links := []models.Link{
+		{SourceType: "note", SourceID: 1, TargetType: "note", TargetID: 2, LinkType: "wikilink"},
+		{SourceType: "note", SourceID: 1, TargetType: "todo", TargetID: 3, LinkType: "related"},
+	}
+	if err := store.CreateLinks(links); err != nil {
+		t.Fatalf("CreateLinks() err = %v", err)
+	}
+	for i, link := range links {
+		if link.ID == 0 || link.CreatedAt.IsZero() {
+			t.Errorf("links[%d] = %+v, want ID and CreatedAt set", i, link)
+		}
+	}
+	// Existing links are ignored
+	if err := store.CreateLinks(links[:1]); err != nil {
+		t.Fatalf("CreateLinks() again err = %v", err)
+	}
+	if got, _ := store.ListLinks(); len(got) != 2 {
+		t.Errorf("ListLinks() = %d links, want 2", len(got))
+	}
+}
+
+func TestGetNotesByTitles(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	for _, title := range []string{"Project Plan", "Über", "Groceries"} {
+		if err := store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+
+	notes, err := store.GetNotesByTitles([]string{" project plan ", "PROJECT PLAN", "Über", "missing"})
+	if err != nil {
+		t.Fatalf("GetNotesByTitles() err = %v", err)
+	}
+	if got, want := noteTitles(notes), []string{"Project Plan", "Über"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetNotesByTitles() = %v, want %v", got, want)
+	}
+	if notes, err := store.GetNotesByTitles(nil); err != nil || len(notes) != 0 {
+		t.Errorf("GetNotesByTitles(nil) = %v, %v; want none", notes, err)
+	}
+}
+
+func TestGetNoteByTitle(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	first := &models.Note{Title: "Roadmap"}
+	for _, note := range []*models.Note{first, {Title: "roadmap"}, {Title: "Budget"}} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+
+	got, err := store.GetNoteByTitle("  ROADMAP ")
+	if err != nil || got == nil || got.ID != first.ID {
+		t.Fatalf("GetNoteByTitle() = %+v, %v; want the oldest Roadmap", got, err)
+	}
+	if got, err := store.GetNoteByTitle("missing"); err != nil || got != nil {
+		t.Errorf("GetNoteByTitle(missing) = %+v, %v; want nil", got, err)
+	}
+
+	// Both lookups are served by the title index, not a table scan
+	for query, args := range map[string][]interface{}{
+		"SELECT id FROM notes WHERE title = ? COLLATE NOCASE":       {"a"},
+		"SELECT id FROM notes WHERE title COLLATE NOCASE IN (?, ?)": {"a", "b"},
+	} {
+		rows, err := store.db.Query("EXPLAIN QUERY PLAN "+query, args...)
+		if err != nil {
+			t.Fatalf("EXPLAIN %q err = %v", query, err)
+		}
+		var plan []string
+		for rows.Next() {
+			var id, parent, notused int
+			var detail string
+			if err := rows.Scan(&id, &parent, &notused, &detail); err != nil {
+				t.Fatalf("scan plan: %v", err)
+			}
+			plan = append(plan, detail)
+		}
+		rows.Close()
+		if !strings.Contains(strings.Join(plan, "\n"), "idx_notes_title_nocase") {
+			t.Errorf("%q does not use the title index: %v", query, plan)
+		}
+	}
+}
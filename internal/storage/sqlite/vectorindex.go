@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Embedding index (Phase 5: Semantic Search)
+//
+// note_vectors holds the embeddings of one model. Each vector records its
+// dimensions, and the settings table records which model the index was
+// built with, so a change of embedding model is noticed instead of
+// comparing vectors that mean different things. ResetEmbeddingIndex
+// empties the index for the new model before it is rebuilt.
+
+// Settings keys describing the embedding index.
+const (
+	EmbeddingModelKey      = "embeddings.model"
+	EmbeddingDimensionsKey = "embeddings.dimensions"
+)
+
+// legacyEmbeddingModel is the model of vectors stored before the model
+// was recorded; it was the only one.
+const (
+	legacyEmbeddingModel      = "all-MiniLM-L6-v2"
+	legacyEmbeddingDimensions = 384
+)
+
+// EmbeddingIndex describes the stored note embeddings.
+type EmbeddingIndex struct {
+	Model      string // Empty when nothing was ever indexed
+	Dimensions int
+	Vectors    int
+}
+
+// EmbeddingIndex returns the model the index was built with and how many
+// notes it holds.
+func (s *Store) EmbeddingIndex() (EmbeddingIndex, error) {
+	var index EmbeddingIndex
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM note_vectors").Scan(&index.Vectors); err != nil {
+		return EmbeddingIndex{}, err
+	}
+	model, ok, err := s.GetSetting(EmbeddingModelKey)
+	if err != nil {
+		return EmbeddingIndex{}, err
+	}
+	if !ok {
+		if index.Vectors > 0 {
+			index.Model, index.Dimensions = legacyEmbeddingModel, legacyEmbeddingDimensions
+		}
+		return index, nil
+	}
+	index.Model = model
+	if value, _, err := s.GetSetting(EmbeddingDimensionsKey); err != nil {
+		return EmbeddingIndex{}, err
+	} else if index.Dimensions, err = strconv.Atoi(value); err != nil {
+		return EmbeddingIndex{}, fmt.Errorf("invalid %s setting %q", EmbeddingDimensionsKey, value)
+	}
+	return index, nil
+}
+
+// ResetEmbeddingIndex deletes every stored embedding and records model
+// and dimensions as the index's, ready to be rebuilt.
+func (s *Store) ResetEmbeddingIndex(model string, dimensions int) error {
+	if s.readOnly {
+		return errors.New("database is open read-only")
+	}
+	return s.WithTx(func(t *Tx) error {
+		if _, err := t.tx.Exec("DELETE FROM note_vectors"); err != nil {
+			return err
+		}
+		for key, value := range map[string]string{
+			EmbeddingModelKey:      model,
+			EmbeddingDimensionsKey: strconv.Itoa(dimensions),
+		} {
+			if _, err := t.tx.Exec(
+				"INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = excluded.value",
+				key, value,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestEmbeddingIndex(t *testing.T) {
+	store := newQueryTestStore(t)
+
+	if index, err := store.EmbeddingIndex(); err != nil || index != (EmbeddingIndex{}) {
+		t.Fatalf("EmbeddingIndex() of a new database = %+v, %v; want it empty", index, err)
+	}
+
+	// Vectors from before the model was recorded are the old default's
+	note := &models.Note{Title: "n"}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	legacy := make([]float32, 384)
+	legacy[0] = 1
+	if err := store.UpsertNoteEmbedding(note.ID, legacy); err != nil {
+		t.Fatalf("UpsertNoteEmbedding() err = %v", err)
+	}
+	index, err := store.EmbeddingIndex()
+	if err != nil || index != (EmbeddingIndex{Model: "all-MiniLM-L6-v2", Dimensions: 384, Vectors: 1}) {
+		t.Fatalf("EmbeddingIndex() = %+v, %v; want the legacy model", index, err)
+	}
+
+	if err := store.ResetEmbeddingIndex("bge-base-en-v1.5", 768); err != nil {
+		t.Fatalf("ResetEmbeddingIndex() err = %v", err)
+	}
+	index, err = store.EmbeddingIndex()
+	if err != nil || index != (EmbeddingIndex{Model: "bge-base-en-v1.5", Dimensions: 768}) {
+		t.Fatalf("EmbeddingIndex() after reset = %+v, %v", index, err)
+	}
+
+	// A search compares only vectors of its own length
+	wide := make([]float32, 768)
+	wide[0] = 1
+	if err := store.UpsertNoteEmbedding(note.ID, wide); err != nil {
+		t.Fatalf("UpsertNoteEmbedding(768) err = %v", err)
+	}
+	if results, err := store.SearchNoteEmbeddings(legacy, 10); err != nil || len(results) != 0 {
+		t.Errorf("384-dim search = %+v, %v; want no matches", results, err)
+	}
+	if results, err := store.SearchNoteEmbeddings(wide, 10); err != nil || len(results) != 1 {
+		t.Errorf("768-dim search = %+v, %v; want the note", results, err)
+	}
+}
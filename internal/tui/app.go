@@ -16,11 +16,13 @@
 //   - Ctrl+T: Todos screen
 //   - Ctrl+F: Focus sessions
 //   - Ctrl+S: Semantic search
+//   - Ctrl+P: Week planner
 //   - Ctrl+H: Home screen / Help
 //   - q: Quit application
 package app
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,12 +30,21 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
 	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+	"github.com/Jericoz-JC/flowState-CLI/internal/goals"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/notify"
+	"github.com/Jericoz-JC/flowState-CLI/internal/plugins"
 	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/spellcheck"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/keymap"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
 )
 
 // Screen represents the current visible screen.
@@ -44,6 +55,16 @@ import (
 //   - ScreenTodos: Todo management (Phase 2)
 //   - ScreenFocus: Focus timer (Phase 4)
 //   - ScreenSearch: Semantic search (Phase 5)
+//   - ScreenPlanner: Week planner (Phase 6)
+//   - ScreenProjects: Projects overview (Phase 6)
+//   - ScreenInbox: Quick capture triage (Phase 6)
+//   - ScreenStarred: Starred notes and todos (Phase 6)
+//   - ScreenDiagnostics: Database statistics and file paths (Phase 4)
+//   - ScreenDone: Todos completed today and this week (Phase 2)
+//   - ScreenHabits: Daily habits with check-offs and streaks (Phase 5)
+//   - ScreenPlugins: Installed plugins and their actions (Phase 10)
+//   - ScreenTags: Tags with their counts, colors, icons and default priorities (Phase 6)
+//   - ScreenModel: Semantic model download (Phase 5)
 type Screen int
 
 const (
@@ -53,6 +74,16 @@ const (
 	ScreenFocus
 	ScreenSearch
 	ScreenMindMap
+	ScreenPlanner
+	ScreenProjects
+	ScreenInbox
+	ScreenStarred
+	ScreenDiagnostics
+	ScreenDone
+	ScreenHabits
+	ScreenPlugins
+	ScreenTags
+	ScreenModel
 )
 
 // Model is the main application model.
@@ -76,6 +107,31 @@ const (
 //
 // Phase 5: Focus Sessions
 //   - focusScreen: Pomodoro-style focus timer with session tracking
+//
+// Phase 6: Week Planning
+//   - plannerScreen: Assign todos to days of the coming week
+//   - projectsScreen: Project completion overview (opened from Todos)
+//   - inboxScreen: Triage quick captures into todos or notes via Ctrl+O
+//   - starredScreen: Starred notes and todos via Alt+S
+//   - tagsScreen: Per-tag color, icon and default priority, opened with t
+//     on Home
+//
+// Phase 4: Robustness
+//   - diagnosticsScreen: Database statistics, opened with d on Home
+//   - doneScreen: Completed todos, opened with c on Home
+//   - habitsScreen: Habit tracker, opened with h on Home
+//
+// Phase 5: Semantic Search
+//   - modelScreen: Embedding model download, opened with m on Home and
+//     on first run
+//
+// Phase 10: Integrations
+//   - plugins: Plugin executables discovered at startup; they get events
+//     in the background
+//   - pluginsScreen: Plugin actions, opened with p on Home
+//
+// Phase 10: Navigation
+//   - tabs: Workspaces with their own screens, switched with Alt+1..9
 type Model struct {
 	width              int
 	height             int
@@ -84,16 +140,90 @@ type Model struct {
 	store              *sqlite.Store
 	embedder           *embeddings.Embedder
 	semantic           *search.SemanticSearch
+	spell              *spellcheck.Checker // Shared by every tab's notes screen; nil when off
 	notesScreen        *screens.NotesListModel
 	todosScreen        *screens.TodosListModel
 	focusScreen        *screens.FocusModel
 	searchScreen       *screens.SearchModel
 	mindMapScreen      *screens.MindMapModel
+	plannerScreen      *screens.PlannerModel
+	projectsScreen     *screens.ProjectsModel
+	inboxScreen        *screens.InboxModel
+	starredScreen      *screens.StarredModel
 	linkScreen         *screens.LinkModel
 	quickCaptureScreen *screens.QuickCaptureModel
+	diagnosticsScreen  *screens.DiagnosticsModel
+	doneScreen         *screens.DoneModel
+	habitsScreen       *screens.HabitsModel
+	plugins            *plugins.Host
+	pluginsScreen      *screens.PluginsModel
+	tagsScreen         *screens.TagsModel
+	modelScreen        *screens.ModelModel
 	showHelpModal      bool
+	helpModal          components.HelpModal // Keys of the screen the modal was opened on
 	status             string
 	lastUpdate         time.Time
+
+	// toast is a short-lived notice shown above the status bar, e.g. when
+	// a focus side timer finishes. toastSeq drops stale expiry messages.
+	toast    string
+	toastSeq int
+
+	startupToast string // Toast shown once the program starts (see maintenance.go)
+
+	// Navigation history (see navigation.go)
+	backStack    []navEntry
+	forwardStack []navEntry
+
+	// Tabbed workspaces (see tabs.go). The active tab's screens and
+	// history live in the fields above; tabs[activeTab] is refreshed from
+	// them when switching away.
+	tabs      []workspace
+	activeTab int
+
+	homeCounts homeCounts // Counts shown in the home menu
+
+	// Filters pinned to Home (see home.go). pinCounts is nil until the
+	// counts for pinSeq arrive.
+	pins        []models.Pin
+	pinCounts   map[int64]int
+	pinSeq      int
+	pinsPending bool
+
+	// Background search indexing (see indexing.go)
+	indexPending bool
+	indexing     bool
+
+	goals []goals.Progress // Goals shown with progress bars on Home
+
+	windowTitle string // Terminal title last set (see title.go)
+
+	// Quick switcher (see recent.go): recently opened notes and todos,
+	// newest first, and the overlay when it is open
+	recent   []recentItem
+	switcher *switcher
+}
+
+// toastDuration is how long a toast stays on screen.
+const toastDuration = 5 * time.Second
+
+// toastExpiredMsg clears the toast it was scheduled for.
+type toastExpiredMsg struct {
+	seq int
+}
+
+// showToast shows text as the toast and schedules its expiry. With
+// reduced motion it stays until the next key.
+func (m *Model) showToast(text string) tea.Cmd {
+	m.toast = text
+	m.toastSeq++
+	seq := m.toastSeq
+	if styles.ReducedMotion() {
+		return nil
+	}
+	return tea.Tick(toastDuration, func(time.Time) tea.Msg {
+		return toastExpiredMsg{seq: seq}
+	})
 }
 
 // New creates and initializes the application.
@@ -109,40 +239,123 @@ func New(cfg *config.Config) (*Model, error) {
 		return nil, fmt.Errorf("failed to open store: %w", err)
 	}
 
+	// Phase 4: Robustness - periodic housekeeping, before anything reads
+	maintenance := ""
+	if cfg.SafeMode {
+		maintenance = safeModeToast
+	} else if !cfg.ReadOnly {
+		maintenance = runStartupMaintenance(store, cfg.MaintenanceDays)
+	}
+
 	embedder, err := embeddings.New(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create embedder: %w", err)
 	}
 
 	semantic := search.New(embedder, store)
-	// Best-effort initial indexing (can be re-run later).
-	_ = semantic.IndexAllNotes()
+	if !cfg.SafeMode {
+		backend, notice := openVectorBackend(cfg)
+		semantic.SetVectorBackend(backend)
+		if notice != "" && maintenance == "" {
+			maintenance = notice
+		}
+	}
+	// Best-effort initial indexing (can be re-run later). A read-only
+	// attach leaves indexing to the instance that owns the database, and
+	// safe mode skips it.
+	if !cfg.ReadOnly && !cfg.SafeMode {
+		_ = semantic.IndexAllNotes()
+	}
 
-	notesScreen := screens.NewNotesListModel(store)
-	todosScreen := screens.NewTodosListModel(store)
+	datefmt.Set(datefmt.New(cfg.DateFormat, cfg.ClockFormat, cfg.WeekStart).WithRelative(cfg.RelativeDates()))
+	todoWorkflow, err := workflow.New(cfg.TodoStatuses)
+	workflow.Set(todoWorkflow)
+	if err != nil && maintenance == "" {
+		// Bad entries are left out; say which rather than fail to start
+		maintenance = "todo_statuses: " + err.Error()
+	}
+	if tagStyles, err := store.ListTagStyles(); err == nil {
+		setTagLooks(tagStyles)
+	}
+	// Phase 4: Accessibility - before the screens build their inputs
+	styles.UseHighContrast(cfg.HighContrast)
+	styles.SetReducedMotion(cfg.ReducedMotion)
+	styles.SetPlainOutput(cfg.PlainOutput)
+
+	var checker *spellcheck.Checker
+	if cfg.SpellCheck {
+		// Safe mode makes do with the bundled word list, so a broken
+		// user dictionary cannot keep the app from starting
+		dictionaryPath := cfg.DictionaryPath
+		if cfg.SafeMode {
+			dictionaryPath = ""
+		}
+		checker, err = spellcheck.Load(dictionaryPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dictionary: %w", err)
+		}
+	}
 	focusScreen := screens.NewFocusModel(store)
+	if !cfg.SafeMode {
+		focusScreen.SetHooks(cfg.FocusBlockCommands, cfg.FocusUnblockCommands, cfg.FocusHookTimeout())
+	}
+	focusScreen.SetBreakActivities(cfg.BreakActivities)
+	focusScreen.SetAutoStart(cfg.BreakAutoStarts(), cfg.AutoStartWork, cfg.FocusChime)
+	focusScreen.SetListDensity(cfg.CompactList("focus_history"))
+	var notifier *notify.Notifier
+	if !cfg.SafeMode {
+		notifier = notify.New(cfg.WebhookURL, cfg.WebhookEvents)
+	}
+	focusScreen.SetNotifier(notifier, cfg.DailyFocusGoalMinutes)
+	if cfg.FocusJournal {
+		focusScreen.SetJournal(cfg.DailyNoteTitleFor)
+	}
+	focusScreen.SetEnergyPrompt(cfg.EnergyPrompt)
+	var pluginHost *plugins.Host
+	if !cfg.SafeMode {
+		pluginHost = plugins.Discover(context.Background(), cfg.PluginDir(), 0)
+		pluginHost.Emit(plugins.EventStartup, map[string]string{"db_path": cfg.DbPath})
+	}
+	focusScreen.SetPlugins(pluginHost)
 	linkScreen := screens.NewLinkModel(store)
 	quickCaptureScreen := screens.NewQuickCaptureModel(store)
-	searchScreen := screens.NewSearchModel(store, semantic)
-	mindMapScreen := screens.NewMindMapModel(store)
+	diagnosticsScreen := screens.NewDiagnosticsModel(store, cfg)
+	doneScreen := screens.NewDoneModel(store)
+	habitsScreen := screens.NewHabitsModel(store)
+	pluginsScreen := screens.NewPluginsModel(pluginHost)
+	tagsScreen := screens.NewTagsModel(store)
+	modelScreen := screens.NewModelModel(embedder, cfg)
+	modelScreen.SetSearchIndex(semantic)
 
-	return &Model{
+	m := &Model{
 		currentScreen:      ScreenHome,
 		config:             cfg,
 		store:              store,
 		embedder:           embedder,
 		semantic:           semantic,
-		notesScreen:        &notesScreen,
-		todosScreen:        &todosScreen,
+		spell:              checker,
 		focusScreen:        &focusScreen,
-		searchScreen:       &searchScreen,
-		mindMapScreen:      &mindMapScreen,
 		linkScreen:         &linkScreen,
 		quickCaptureScreen: &quickCaptureScreen,
+		diagnosticsScreen:  &diagnosticsScreen,
+		doneScreen:         &doneScreen,
+		habitsScreen:       &habitsScreen,
+		plugins:            pluginHost,
+		pluginsScreen:      &pluginsScreen,
+		tagsScreen:         &tagsScreen,
+		modelScreen:        &modelScreen,
 		showHelpModal:      false,
 		status:             "Ready",
 		lastUpdate:         time.Now(),
-	}, nil
+		startupToast:       maintenance,
+	}
+	m.tabs = []workspace{m.newWorkspace(ScreenHome)}
+	m.useTab(0)
+	m.loadHomeCounts()
+	if !m.offerIndexRebuild() {
+		m.offerModelDownload()
+	}
+	return m, nil
 }
 
 // SetSize updates the model dimensions when window is resized.
@@ -174,6 +387,36 @@ func (m *Model) SetSize(width, height int) {
 	if m.mindMapScreen != nil {
 		m.mindMapScreen.SetSize(width, height)
 	}
+	if m.plannerScreen != nil {
+		m.plannerScreen.SetSize(width, height)
+	}
+	if m.projectsScreen != nil {
+		m.projectsScreen.SetSize(width, height)
+	}
+	if m.inboxScreen != nil {
+		m.inboxScreen.SetSize(width, height)
+	}
+	if m.starredScreen != nil {
+		m.starredScreen.SetSize(width, height)
+	}
+	if m.diagnosticsScreen != nil {
+		m.diagnosticsScreen.SetSize(width, height)
+	}
+	if m.doneScreen != nil {
+		m.doneScreen.SetSize(width, height)
+	}
+	if m.habitsScreen != nil {
+		m.habitsScreen.SetSize(width, height)
+	}
+	if m.pluginsScreen != nil {
+		m.pluginsScreen.SetSize(width, height)
+	}
+	if m.tagsScreen != nil {
+		m.tagsScreen.SetSize(width, height)
+	}
+	if m.modelScreen != nil {
+		m.modelScreen.SetSize(width, height)
+	}
 }
 
 // Update handles incoming messages and updates the model.
@@ -184,7 +427,30 @@ func (m *Model) SetSize(width, height int) {
 //
 // Phase 2: Notes & Todos
 //   - Delegates to notesScreen or todosScreen when active
+//
+// Phase 10: Navigation
+//   - Keeps the terminal title in step with the screen and focus timer
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok && styles.ReducedMotion() {
+		// Toasts do not expire with reduced motion; the next key clears them
+		m.toast = ""
+	}
+	model, cmd := m.update(msg)
+	m.trackRecent()
+	if title := m.titleCmd(); title != nil {
+		cmd = tea.Batch(cmd, title)
+	}
+	if counts := m.pinCountsCmd(); counts != nil {
+		cmd = tea.Batch(cmd, counts)
+	}
+	if index := m.indexCmd(); index != nil {
+		cmd = tea.Batch(cmd, index)
+	}
+	return model, cmd
+}
+
+// update handles msg for Update.
+func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Help modal has highest priority when open.
 	if m.showHelpModal {
 		switch msg := msg.(type) {
@@ -193,11 +459,26 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "?", "esc", "q":
 				m.showHelpModal = false
 				return m, nil
+			case "j", "down":
+				m.helpModal.ScrollDown()
+			case "k", "up":
+				m.helpModal.ScrollUp()
 			}
 		}
 		return m, nil
 	}
 
+	// Quick switcher overlay (Phase 10)
+	if m.switcher != nil {
+		m.updateSwitcher(msg)
+		if _, ok := msg.(tea.KeyMsg); ok {
+			return m, nil
+		}
+	} else if isSwitcherKey(msg) && (m.quickCaptureScreen == nil || !m.quickCaptureScreen.IsOpen()) &&
+		(m.linkScreen == nil || !m.linkScreen.IsOpen()) {
+		return m, m.openSwitcher()
+	}
+
 	// Handle quick capture modal if open
 	if m.quickCaptureScreen != nil && m.quickCaptureScreen.IsOpen() {
 		switch msg := msg.(type) {
@@ -209,6 +490,8 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.status = "Ready"
 				if m.currentScreen == ScreenNotes {
 					m.notesScreen.LoadNotes()
+				} else if m.currentScreen == ScreenInbox && m.inboxScreen != nil {
+					_ = m.inboxScreen.LoadInbox()
 				}
 			}
 			return m, cmd
@@ -226,31 +509,131 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	switch msg := msg.(type) {
+	case screens.FocusTimerDoneMsg:
+		var cmd tea.Cmd
+		if m.focusScreen != nil {
+			updatedFocus, focusCmd := m.focusScreen.Update(msg)
+			m.focusScreen = &updatedFocus
+			cmd = focusCmd
+		}
+		return m, tea.Batch(cmd, m.showToast("⏰ "+msg.Label+" — time's up"))
+
+	case screens.ToastMsg:
+		return m, m.showToast(msg.Text)
+
+	case screens.TagStylesChangedMsg:
+		setTagLooks(msg.Styles)
+		return m, nil
+
+	case toastExpiredMsg:
+		if msg.seq == m.toastSeq {
+			m.toast = ""
+		}
+		return m, nil
+
+	case indexedMsg:
+		m.indexing = false
+		return m, nil
+
+	case pinCountsMsg:
+		if msg.seq == m.pinSeq {
+			m.pinCounts = msg.counts
+		}
+		return m, nil
+
+	case screens.FocusHookMsg, screens.FocusTimerTickMsg, screens.FocusTickMsg, screens.PlannedSessionDueMsg:
+		// Hooks, side timers, planned blocks and the session itself run
+		// in the background; deliver their messages even off-screen
+		if m.focusScreen != nil {
+			updatedFocus, cmd := m.focusScreen.Update(msg)
+			m.focusScreen = &updatedFocus
+			return m, cmd
+		}
+		return m, nil
+
+	case screens.ModelProgressMsg, screens.ModelDownloadedMsg, screens.ModelIndexRebuiltMsg:
+		// A model download or index rebuild goes on after leaving its screen
+		if m.modelScreen != nil {
+			updatedModel, cmd := m.modelScreen.Update(msg)
+			m.modelScreen = &updatedModel
+			return m, cmd
+		}
+		return m, nil
+
 	case screens.OpenNoteMsg:
 		// Open the note from search results by navigating to Notes and selecting it.
-		m.currentScreen = ScreenNotes
-		m.status = "Notes"
-		if m.notesScreen != nil {
-			_ = m.notesScreen.LoadNotes()
-			m.notesScreen.SelectNoteByID(msg.NoteID)
+		m.navigate(ScreenNotes)
+		m.notesScreen.SelectNoteByID(msg.NoteID)
+		return m, nil
+	case screens.OpenTodoMsg:
+		// Show a starred todo on the Todos screen.
+		m.navigate(ScreenTodos)
+		m.todosScreen.SelectTodoByID(msg.TodoID)
+		return m, nil
+	case screens.CreateTodoForNoteMsg:
+		// Jump to Todos with the create form pre-linked to the note.
+		m.navigate(ScreenTodos)
+		m.todosScreen.OpenCreateForNote(msg.NoteID, msg.Title)
+		return m, nil
+	case screens.OpenProjectsMsg:
+		m.navigate(ScreenProjects)
+		return m, nil
+	case screens.ShowTodosMsg:
+		// Leave the projects overview, filtered to the chosen project
+		// or tag.
+		m.navigate(ScreenTodos)
+		if msg.Tag != "" {
+			m.todosScreen.ApplyPin(models.PinFilter{Tags: []string{msg.Tag}})
+		} else {
+			m.todosScreen.SetProjectFilter(msg.Project)
+		}
+		return m, nil
+	case screens.BackMsg:
+		m.goBack()
+		return m, nil
+	case screens.OpenLinkMsg:
+		// Triage "link" from the inbox: open the link modal for the item.
+		if m.linkScreen != nil {
+			m.linkScreen.Open(msg.ItemType, msg.ItemID, msg.Title)
+			m.status = "Links"
 		}
 		return m, nil
 	case tea.KeyMsg:
 		switch msg.String() {
-		case "q", "ctrl+c":
+		case "ctrl+c":
 			return m, tea.Quit
+		case "q":
+			if !m.inputActive() {
+				return m, tea.Quit
+			}
 		case "?":
-			m.showHelpModal = true
+			if !m.inputActive() {
+				m.helpModal = m.newHelpModal()
+				m.showHelpModal = true
+				return m, nil
+			}
+		case "alt+left":
+			m.goBack()
+			return m, nil
+		case "alt+right":
+			m.goForward()
+			return m, nil
+		case "alt+w":
+			return m, m.tabToast(m.closeTab())
+		case "alt+s":
+			m.navigate(ScreenStarred)
 			return m, nil
 		}
+		if i := tabKey(msg.String()); i >= 0 {
+			return m, m.tabToast(m.switchTab(i))
+		}
 
 		// Use cross-platform key bindings
 		// IMPORTANT: Return early after handling global shortcuts to prevent
 		// the key event from being passed to screen components (which might consume it)
 		if keymap.IsModH(msg) {
 			// Ctrl+H: Go Home - highest priority navigation
-			m.currentScreen = ScreenHome
-			m.status = "Home"
+			m.navigate(ScreenHome)
 			return m, nil
 		} else if keymap.IsModX(msg) {
 			// Open quick capture modal from anywhere
@@ -260,32 +643,26 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		} else if keymap.IsModN(msg) {
-			m.currentScreen = ScreenNotes
-			m.status = "Notes"
-			m.notesScreen.LoadNotes()
+			m.navigate(ScreenNotes)
 			return m, nil
 		} else if keymap.IsModT(msg) {
-			m.currentScreen = ScreenTodos
-			m.status = "Todos"
-			m.todosScreen.LoadTodos()
+			m.navigate(ScreenTodos)
 			return m, nil
-		} else if keymap.IsModF(msg) {
-			m.currentScreen = ScreenFocus
-			m.status = "Focus"
-			if m.focusScreen != nil {
-				m.focusScreen.LoadHistory()
-			}
+		} else if keymap.IsModF(msg) && !m.editingNote() {
+			// (the note editor keeps Ctrl+F for find & replace)
+			m.navigate(ScreenFocus)
 			return m, nil
 		} else if keymap.IsModSlash(msg) {
-			m.currentScreen = ScreenSearch
-			m.status = "Search"
+			m.navigate(ScreenSearch)
 			return m, nil
 		} else if keymap.IsModG(msg) {
-			m.currentScreen = ScreenMindMap
-			m.status = "Mind Map"
-			if m.mindMapScreen != nil {
-				_ = m.mindMapScreen.LoadGraph()
-			}
+			m.navigate(ScreenMindMap)
+			return m, nil
+		} else if keymap.IsModP(msg) {
+			m.navigate(ScreenPlanner)
+			return m, nil
+		} else if keymap.IsModO(msg) {
+			m.navigate(ScreenInbox)
 			return m, nil
 		} else if keymap.IsModL(msg) {
 			// Open link modal for currently selected item
@@ -307,6 +684,8 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	switch m.currentScreen {
+	case ScreenHome:
+		return m, m.updateHome(msg)
 	case ScreenNotes:
 		_, cmd := m.notesScreen.Update(msg)
 		return m, cmd
@@ -331,11 +710,100 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.mindMapScreen = &updatedMM
 			return m, cmd
 		}
+	case ScreenPlanner:
+		if m.plannerScreen != nil {
+			updatedPlanner, cmd := m.plannerScreen.Update(msg)
+			m.plannerScreen = &updatedPlanner
+			return m, cmd
+		}
+	case ScreenProjects:
+		if m.projectsScreen != nil {
+			updatedProjects, cmd := m.projectsScreen.Update(msg)
+			m.projectsScreen = &updatedProjects
+			return m, cmd
+		}
+	case ScreenInbox:
+		if m.inboxScreen != nil {
+			updatedInbox, cmd := m.inboxScreen.Update(msg)
+			m.inboxScreen = &updatedInbox
+			return m, cmd
+		}
+	case ScreenStarred:
+		if m.starredScreen != nil {
+			updatedStarred, cmd := m.starredScreen.Update(msg)
+			m.starredScreen = &updatedStarred
+			return m, cmd
+		}
+	case ScreenDiagnostics:
+		if m.diagnosticsScreen != nil {
+			updatedDiagnostics, cmd := m.diagnosticsScreen.Update(msg)
+			m.diagnosticsScreen = &updatedDiagnostics
+			return m, cmd
+		}
+	case ScreenDone:
+		if m.doneScreen != nil {
+			updatedDone, cmd := m.doneScreen.Update(msg)
+			m.doneScreen = &updatedDone
+			return m, cmd
+		}
+	case ScreenHabits:
+		if m.habitsScreen != nil {
+			updatedHabits, cmd := m.habitsScreen.Update(msg)
+			m.habitsScreen = &updatedHabits
+			return m, cmd
+		}
+	case ScreenPlugins:
+		if m.pluginsScreen != nil {
+			updatedPlugins, cmd := m.pluginsScreen.Update(msg)
+			m.pluginsScreen = &updatedPlugins
+			return m, cmd
+		}
+	case ScreenTags:
+		if m.tagsScreen != nil {
+			updatedTags, cmd := m.tagsScreen.Update(msg)
+			m.tagsScreen = &updatedTags
+			return m, cmd
+		}
+	case ScreenModel:
+		if m.modelScreen != nil {
+			updatedModel, cmd := m.modelScreen.Update(msg)
+			m.modelScreen = &updatedModel
+			return m, cmd
+		}
 	}
 
 	return m, nil
 }
 
+// inputActive reports whether the current screen has a focused text
+// field, in which case plain letters are typed rather than treated as
+// global shortcuts.
+func (m *Model) inputActive() bool {
+	switch m.currentScreen {
+	case ScreenNotes:
+		return m.notesScreen != nil && m.notesScreen.InputActive()
+	case ScreenTodos:
+		return m.todosScreen != nil && m.todosScreen.InputActive()
+	case ScreenSearch:
+		return m.searchScreen != nil && m.searchScreen.InputActive()
+	case ScreenFocus:
+		return m.focusScreen != nil && m.focusScreen.InputActive()
+	case ScreenHabits:
+		return m.habitsScreen != nil && m.habitsScreen.InputActive()
+	case ScreenTags:
+		return m.tagsScreen != nil && m.tagsScreen.InputActive()
+	case ScreenModel:
+		return m.modelScreen != nil && m.modelScreen.InputActive()
+	}
+	return false
+}
+
+// editingNote reports whether the notes screen has a note open in the
+// editor.
+func (m *Model) editingNote() bool {
+	return m.currentScreen == ScreenNotes && m.notesScreen != nil && m.notesScreen.Editing()
+}
+
 // View renders the current screen.
 //
 // Phase 1: Core Infrastructure
@@ -346,7 +814,18 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // Phase 2: Notes & Todos
 //   - Notes screen with note list/create/edit
 //   - Todos screen with todo list/create/toggle
+//
+// Phase 4: Accessibility
+//   - With plain output the frame is rewritten for screen readers
 func (m *Model) View() string {
+	if styles.PlainOutput() {
+		return styles.Plain(m.view())
+	}
+	return m.view()
+}
+
+// view renders the frame for View.
+func (m *Model) view() string {
 	if m.width == 0 {
 		return "Initializing..."
 	}
@@ -377,6 +856,66 @@ func (m *Model) View() string {
 		} else {
 			content = "Mind map unavailable"
 		}
+	case ScreenPlanner:
+		if m.plannerScreen != nil {
+			content = m.plannerScreen.View()
+		} else {
+			content = "Planner unavailable"
+		}
+	case ScreenProjects:
+		if m.projectsScreen != nil {
+			content = m.projectsScreen.View()
+		} else {
+			content = "Projects unavailable"
+		}
+	case ScreenInbox:
+		if m.inboxScreen != nil {
+			content = m.inboxScreen.View()
+		} else {
+			content = "Inbox unavailable"
+		}
+	case ScreenStarred:
+		if m.starredScreen != nil {
+			content = m.starredScreen.View()
+		} else {
+			content = "Starred unavailable"
+		}
+	case ScreenDiagnostics:
+		if m.diagnosticsScreen != nil {
+			content = m.diagnosticsScreen.View()
+		} else {
+			content = "Diagnostics unavailable"
+		}
+	case ScreenDone:
+		if m.doneScreen != nil {
+			content = m.doneScreen.View()
+		} else {
+			content = "Done unavailable"
+		}
+	case ScreenHabits:
+		if m.habitsScreen != nil {
+			content = m.habitsScreen.View()
+		} else {
+			content = "Habits unavailable"
+		}
+	case ScreenPlugins:
+		if m.pluginsScreen != nil {
+			content = m.pluginsScreen.View()
+		} else {
+			content = "Plugins unavailable"
+		}
+	case ScreenTags:
+		if m.tagsScreen != nil {
+			content = m.tagsScreen.View()
+		} else {
+			content = "Tags unavailable"
+		}
+	case ScreenModel:
+		if m.modelScreen != nil {
+			content = m.modelScreen.View()
+		} else {
+			content = "Model unavailable"
+		}
 	default:
 		content = m.homeView()
 	}
@@ -391,60 +930,114 @@ func (m *Model) View() string {
 		content = m.quickCaptureScreen.View()
 	}
 
+	// Overlay the quick switcher
+	if m.switcher != nil {
+		content = m.switcherView()
+	}
+
 	// Overlay help modal last (highest priority)
 	if m.showHelpModal {
 		content = m.helpModalView()
 	}
 
+	zen := (m.currentScreen == ScreenFocus && m.focusScreen != nil && m.focusScreen.Zen() ||
+		m.currentScreen == ScreenNotes && m.notesScreen != nil && m.notesScreen.Writing()) &&
+		!m.showHelpModal && m.switcher == nil && (m.linkScreen == nil || !m.linkScreen.IsOpen()) &&
+		(m.quickCaptureScreen == nil || !m.quickCaptureScreen.IsOpen())
+
 	// Build status bar with platform-appropriate shortcuts
 	mod := keymap.ModKeyDisplay()
+	status := m.status
+	if tabs := m.tabStrip(); tabs != "" {
+		status = tabs + " | " + status
+	}
+	if m.store != nil && m.store.ReadOnly() {
+		// Phase 4: Robustness - attached while another instance holds the lock
+		status = "🔒 READ-ONLY | " + status
+	}
+	if m.safeMode() {
+		status = "🛟 SAFE MODE | " + status
+	}
 	statusBar := styles.StatusBarStyle.Render(
 		fmt.Sprintf(" %s | [%s+X] Capture [%s+N] Notes [%s+T] Todos [%s+G] Map [%s+L] Link [%s+H] Home [q] Quit ",
-			m.status, mod, mod, mod, mod, mod, mod),
+			status, mod, mod, mod, mod, mod, mod),
 	)
 
+	toast := ""
+	if m.toast != "" {
+		text := m.toast
+		if styles.PlainOutput() {
+			text = "Notice: " + text
+		}
+		toast = lipgloss.NewStyle().
+			Foreground(styles.BackgroundColor).
+			Background(styles.AccentColor).
+			Bold(true).
+			Padding(0, 1).
+			Render(text)
+	}
+
+	// The focus zen view and the note editor's writing mode hide the
+	// status bar too
+	if zen {
+		return lipgloss.JoinVertical(lipgloss.Left, content, toast)
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		content,
-		"",
+		toast,
 		statusBar,
 	)
 }
 
-func (m *Model) helpModalView() string {
-	mod := keymap.ModKeyDisplay()
-	box := lipgloss.NewStyle().
-		Border(lipgloss.DoubleBorder()).
-		BorderForeground(styles.AccentColor). // Hot pink border
-		Padding(1, 2).
-		Width(52)
-
-	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.SecondaryColor) // Neon cyan
-	keyStyle := lipgloss.NewStyle().Foreground(styles.AccentColor)                 // Hot pink
-	descStyle := lipgloss.NewStyle().Foreground(styles.TextColor)                  // Off-white
-	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)                // Pale blue
-
-	title := titleStyle.Render(styles.DecoStar + " Keyboard Shortcuts " + styles.DecoStar)
-	lines := []string{
-		title,
-		"",
-		keyStyle.Render(mod+"+X") + descStyle.Render("  Quick Capture"),
-		keyStyle.Render(mod+"+N") + descStyle.Render("  Notes"),
-		keyStyle.Render(mod+"+T") + descStyle.Render("  Todos"),
-		keyStyle.Render(mod+"+F") + descStyle.Render("  Focus"),
-		keyStyle.Render(mod+"+/") + descStyle.Render("  Search"),
-		keyStyle.Render(mod+"+G") + descStyle.Render("  Mind Map"),
-		keyStyle.Render(mod+"+L") + descStyle.Render("  Links"),
-		keyStyle.Render(mod+"+H") + descStyle.Render("  Home"),
-		"",
-		keyStyle.Render("q") + descStyle.Render("      Quit"),
-		keyStyle.Render("?") + descStyle.Render("      Toggle this help"),
-		"",
-		mutedStyle.Render("Press Esc or ? to close"),
+// newHelpModal builds the help modal for the current screen: the screen's
+// own keys, when it lists them, followed by the global ones.
+func (m *Model) newHelpModal() components.HelpModal {
+	title := "Keyboard Shortcuts"
+	var sections []components.HelpSection
+	switch {
+	case m.currentScreen == ScreenHome:
+		title = "Home - " + title
+		sections = components.HomeHelp
+	case m.currentScreen == ScreenNotes && m.notesScreen != nil:
+		title = "Notes - " + title
+		sections = m.notesScreen.HelpSections()
+	case m.currentScreen == ScreenTodos && m.todosScreen != nil:
+		title = "Todos - " + title
+		sections = m.todosScreen.HelpSections()
+	case m.currentScreen == ScreenFocus && m.focusScreen != nil:
+		title = "Focus - " + title
+		sections = m.focusScreen.HelpSections()
+	case m.currentScreen == ScreenStarred && m.starredScreen != nil:
+		title = "Starred - " + title
+		sections = m.starredScreen.HelpSections()
+	case m.currentScreen == ScreenDiagnostics && m.diagnosticsScreen != nil:
+		title = "Diagnostics - " + title
+		sections = m.diagnosticsScreen.HelpSections()
+	case m.currentScreen == ScreenDone && m.doneScreen != nil:
+		title = "Done - " + title
+		sections = m.doneScreen.HelpSections()
+	case m.currentScreen == ScreenHabits && m.habitsScreen != nil:
+		title = "Habits - " + title
+		sections = m.habitsScreen.HelpSections()
+	case m.currentScreen == ScreenPlugins && m.pluginsScreen != nil:
+		title = "Plugins - " + title
+		sections = m.pluginsScreen.HelpSections()
+	case m.currentScreen == ScreenTags && m.tagsScreen != nil:
+		title = "Tags - " + title
+		sections = m.tagsScreen.HelpSections()
+	case m.currentScreen == ScreenModel && m.modelScreen != nil:
+		title = "Model - " + title
+		sections = m.modelScreen.HelpSections()
 	}
-	content := box.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	sections = append(sections[:len(sections):len(sections)], components.GlobalHelp...)
+	return components.NewHelpModal(title, sections)
+}
 
-	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+func (m *Model) helpModalView() string {
+	m.helpModal.SetSize(m.width, m.height)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.helpModal.View())
 }
 
 // homeView renders the home screen.
@@ -460,7 +1053,9 @@ func (m *Model) helpModalView() string {
 func (m *Model) homeView() string {
 	// ASCII art logo - use small version on narrow terminals
 	var logo string
-	if m.width >= styles.LogoMinWidth {
+	if styles.PlainOutput() {
+		logo = styles.LogoStyle.Render("flowState")
+	} else if m.width >= styles.LogoMinWidth {
 		logo = styles.LogoStyle.Render(styles.LogoASCII)
 	} else {
 		logo = styles.LogoStyle.Render(styles.LogoASCIISmall)
@@ -469,27 +1064,23 @@ func (m *Model) homeView() string {
 	// Subtitle
 	subtitle := styles.SubtitleStyle.Render("Your unified terminal productivity system")
 
-	// Menu items with styled shortcuts
-	menuItems := lipgloss.JoinVertical(
-		lipgloss.Left,
-		"",
-		styles.MenuItemStyle.Render(styles.KeyHint("Ctrl+N", "Notes")+"   - Capture and organize your thoughts"),
-		styles.MenuItemStyle.Render(styles.KeyHint("Ctrl+T", "Todos")+"   - Track your tasks and priorities"),
-		styles.MenuItemStyle.Render(styles.KeyHint("Ctrl+F", "Focus")+"   - Pomodoro timer for deep work"),
-		styles.MenuItemStyle.Render(styles.KeyHint("Ctrl+/", "Search")+"  - Find anything with semantic search"),
-		"",
-	)
+	// Menu items with styled shortcuts and live counts (see home.go)
+	menuItems := m.renderHomeMenu()
 
 	// Quick tips
 	tips := styles.HelpStyle.Render("Press " + styles.KeyStyle.Render("q") + " to quit • " + styles.KeyStyle.Render("Ctrl+H") + " for help")
 
-	return lipgloss.JoinVertical(
-		lipgloss.Center,
-		logo,
-		subtitle,
-		menuItems,
-		tips,
-	)
+	parts := []string{logo, subtitle, menuItems}
+	// Pinned filters, opened with 1-9 (see home.go)
+	if pins := m.renderHomePins(); pins != "" {
+		parts = append(parts, pins)
+	}
+	// Goals with progress bars (see home.go)
+	if goalRows := m.renderHomeGoals(); goalRows != "" {
+		parts = append(parts, goalRows)
+	}
+	parts = append(parts, tips)
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
 }
 
 // focusView placeholder for focus session screen.
@@ -560,17 +1151,70 @@ func (m *Model) searchView() string {
 // Phase 1: Core Infrastructure
 //   - Returns nil (no initial command)
 func (m *Model) Init() tea.Cmd {
+	var plans tea.Cmd
+	if m.focusScreen != nil {
+		plans = m.focusScreen.SchedulePlans()
+	}
+	return tea.Batch(m.startupToastCmd(), plans)
+}
+
+// Open shows the note or todo named by target, as for
+// `flowState open note/42`. It fails if the item does not exist.
+func (m *Model) Open(target deeplink.Target) error {
+	switch target.Kind {
+	case deeplink.KindNote:
+		note, err := m.store.GetNote(target.ID)
+		if err != nil {
+			return err
+		}
+		if note == nil {
+			return fmt.Errorf("note %d not found", target.ID)
+		}
+		m.navigate(ScreenNotes)
+		m.notesScreen.SelectNoteByID(note.ID)
+	case deeplink.KindTodo:
+		todo, err := m.store.GetTodo(target.ID)
+		if err != nil {
+			return err
+		}
+		if todo == nil {
+			return fmt.Errorf("todo %d not found", target.ID)
+		}
+		m.navigate(ScreenTodos)
+		m.todosScreen.SelectTodoByID(todo.ID)
+	default:
+		return fmt.Errorf("cannot open %s", target)
+	}
 	return nil
 }
 
 // Close cleans up resources on exit.
 //
 // Phase 1: Core Infrastructure
+//   - Posts queued webhook milestones and delivers queued plugin events
 //   - Closes SQLite database
 //   - Closes vector store
 func (m *Model) Close() error {
+	if m.focusScreen != nil {
+		_ = m.focusScreen.ReleaseBlock()
+		m.focusScreen.CloseNotifier()
+	}
+	m.plugins.Close(3 * time.Second)
+	if m.semantic != nil {
+		_ = m.semantic.Close()
+	}
 	if m.store != nil {
 		m.store.Close()
 	}
 	return nil
 }
+
+// setTagLooks passes the tag colors and icons set on the Tags screen to
+// the styles package.
+func setTagLooks(tagStyles []models.TagStyle) {
+	looks := make(map[string]styles.TagLook, len(tagStyles))
+	for _, ts := range tagStyles {
+		looks[ts.Tag] = styles.TagLook{Color: ts.Color, Icon: ts.Icon}
+	}
+	styles.SetTagLooks(looks)
+}
@@ -146,15 +146,19 @@ func (h ASCIIHeader) renderBoxed() string {
 	// Add item count if present
 	if h.itemCount >= 0 {
 		countText := fmt.Sprintf("%d", h.itemCount)
-		padding := contentWidth - len(titleContent) - len(countText) - 2
+		padding := contentWidth - lipgloss.Width(titleContent) - lipgloss.Width(countText) - 2
 		if padding > 0 {
 			titleContent += strings.Repeat(" ", padding) + countText
 		}
 	}
 
-	// Pad to content width
-	if len(titleContent) < contentWidth {
-		titleContent += strings.Repeat(" ", contentWidth-len(titleContent))
+	// Fit to content width, measured in terminal cells so that emoji and
+	// other double-width runes keep the right border straight
+	if lipgloss.Width(titleContent) > contentWidth {
+		titleContent = lipgloss.NewStyle().MaxWidth(contentWidth).Render(titleContent)
+	}
+	if width := lipgloss.Width(titleContent); width < contentWidth {
+		titleContent += strings.Repeat(" ", contentWidth-width)
 	}
 
 	// Build the box
@@ -193,18 +197,20 @@ func (h ASCIIHeader) renderBanner() string {
 	titleStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
 	iconStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor)
 
-	// Build banner
-	lineWidth := (contentWidth - len(spacedTitle) - 10) / 2
+	// Build banner, with the lines on either side filling what the
+	// decorated title leaves
+	middle := accentStyle.Render(" ✦ ") +
+		iconStyle.Render(h.icon) + " " +
+		titleStyle.Render(spacedTitle) + " " +
+		iconStyle.Render(h.icon) +
+		accentStyle.Render(" ✦ ")
+	lineWidth := (contentWidth - lipgloss.Width(middle)) / 2
 	if lineWidth < 2 {
 		lineWidth = 2
 	}
 
 	topLine := decoStyle.Render(strings.Repeat("═", lineWidth)) +
-		accentStyle.Render(" ✦ ") +
-		iconStyle.Render(h.icon) + " " +
-		titleStyle.Render(spacedTitle) + " " +
-		iconStyle.Render(h.icon) +
-		accentStyle.Render(" ✦ ") +
+		middle +
 		decoStyle.Render(strings.Repeat("═", lineWidth))
 
 	result := topLine
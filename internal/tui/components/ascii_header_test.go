@@ -3,6 +3,8 @@ package components
 import (
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestNewASCIIHeader(t *testing.T) {
@@ -158,3 +160,49 @@ func TestASCIIHeaderBannerStyle(t *testing.T) {
 		t.Errorf("banner style should have substantial content")
 	}
 }
+
+func TestASCIIHeaderBoxedWideRunes(t *testing.T) {
+	tests := []struct {
+		name, icon, title string
+		count             int
+	}{
+		{"emoji icon", "📝", "Notes", -1},
+		{"emoji with count", "🍅", "Focus", 12},
+		{"emoji in title", "✅", "Todos 🎉", 3},
+		{"double-width title", "🧠", "日本語ノート", -1},
+		{"title wider than box", "🔍", strings.Repeat("検索", 20), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewASCIIHeader(tt.icon, tt.title)
+			h.SetStyle(HeaderStyleBoxed)
+			h.SetWidth(50)
+			h.SetItemCount(tt.count)
+
+			lines := strings.Split(h.View(), "\n")
+			if len(lines) != 3 {
+				t.Fatalf("expected a 3-line box, got %d lines", len(lines))
+			}
+			want := lipgloss.Width(lines[0])
+			for i, line := range lines {
+				if got := lipgloss.Width(line); got != want {
+					t.Errorf("line %d is %d cells wide, want %d:\n%s", i, got, want, strings.Join(lines, "\n"))
+				}
+			}
+		})
+	}
+}
+
+func TestASCIIHeaderBannerFillsWidth(t *testing.T) {
+	for _, title := range []string{"Notes", "日本語"} {
+		h := NewASCIIHeader("📝", title)
+		h.SetStyle(HeaderStyleBanner)
+		h.SetWidth(60)
+
+		// Both side lines are the same length, so the banner is centered
+		// within a cell of the content width
+		if got := lipgloss.Width(h.View()); got < 55 || got > 56 {
+			t.Errorf("%s: banner is %d cells wide, want 55-56", title, got)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package components provides reusable TUI components for flowState-cli.
+//
+// ConfirmModal asks a yes/no question before an action, such as a delete
+// that cannot be undone, with the same keys and look on every screen.
+package components
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// ConfirmModal is a yes/no dialog. y runs the yes callback, n or Esc the
+// no callback; either answer closes it. While it is open it takes every
+// key.
+//
+// The callbacks run when the answer is given, not when the modal opens.
+// Screens whose model is copied on every update should have them change
+// shared state (the store) or return a command, rather than change the
+// screen they were made on.
+type ConfirmModal struct {
+	title   string
+	message string
+	danger  bool
+	onYes   func() tea.Cmd
+	onNo    func() tea.Cmd
+	open    bool
+	helpBar HelpBar
+}
+
+// NewConfirmModal creates a closed confirmation modal.
+func NewConfirmModal() ConfirmModal {
+	return ConfirmModal{helpBar: NewHelpBar(ConfirmHints)}
+}
+
+// Open shows the modal with a title and message. onYes and onNo may be
+// nil.
+func (c *ConfirmModal) Open(title, message string, onYes, onNo func() tea.Cmd) {
+	c.title = title
+	c.message = message
+	c.danger = false
+	c.onYes = onYes
+	c.onNo = onNo
+	c.open = true
+}
+
+// OpenDanger shows the modal for a destructive action: the title gets a
+// warning sign and both title and border turn the error color.
+func (c *ConfirmModal) OpenDanger(title, message string, onYes, onNo func() tea.Cmd) {
+	c.Open(title, message, onYes, onNo)
+	c.danger = true
+}
+
+// Close hides the modal without running either callback.
+func (c *ConfirmModal) Close() {
+	c.open = false
+	c.onYes = nil
+	c.onNo = nil
+}
+
+// IsOpen returns whether the modal is waiting for an answer.
+func (c *ConfirmModal) IsOpen() bool {
+	return c.open
+}
+
+// SetWidth updates the width of the modal's help bar.
+func (c *ConfirmModal) SetWidth(width int) {
+	c.helpBar.SetWidth(width)
+}
+
+// Update handles a key while the modal is open and returns the command of
+// the callback the answer ran. Keys other than y, n and Esc are ignored.
+func (c *ConfirmModal) Update(msg tea.KeyMsg) tea.Cmd {
+	if !c.open {
+		return nil
+	}
+	var callback func() tea.Cmd
+	switch msg.String() {
+	case "y", "Y":
+		callback = c.onYes
+	case "n", "N", "esc":
+		callback = c.onNo
+	default:
+		return nil
+	}
+	c.Close()
+	if callback == nil {
+		return nil
+	}
+	return callback()
+}
+
+// View renders the modal, or "" when closed.
+func (c *ConfirmModal) View() string {
+	if !c.open {
+		return ""
+	}
+	titleStyle := styles.TitleStyle
+	panelStyle := styles.PanelStyle
+	title := c.title
+	if c.danger {
+		titleStyle = titleStyle.Foreground(styles.ErrorColor)
+		panelStyle = panelStyle.BorderForeground(styles.ErrorColor)
+		title = "⚠️ " + title
+	}
+	lines := []string{titleStyle.Render(title), ""}
+	if c.message != "" {
+		lines = append(lines, styles.SubtitleStyle.Render(c.message), "")
+	}
+	lines = append(lines, c.helpBar.View())
+	return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Center, lines...))
+}
@@ -0,0 +1,82 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type confirmAnswerMsg string
+
+func confirmKey(s string) tea.KeyMsg {
+	if s == "esc" {
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestConfirmModalAnswers(t *testing.T) {
+	answer := func(s string) func() tea.Cmd {
+		return func() tea.Cmd {
+			return func() tea.Msg { return confirmAnswerMsg(s) }
+		}
+	}
+
+	tests := []struct {
+		key  string
+		want tea.Msg
+	}{
+		{"y", confirmAnswerMsg("yes")},
+		{"Y", confirmAnswerMsg("yes")},
+		{"n", confirmAnswerMsg("no")},
+		{"esc", confirmAnswerMsg("no")},
+	}
+	for _, tt := range tests {
+		c := NewConfirmModal()
+		c.Open("Archive?", "", answer("yes"), answer("no"))
+		cmd := c.Update(confirmKey(tt.key))
+		if c.IsOpen() {
+			t.Errorf("%s: expected the modal to close", tt.key)
+		}
+		if cmd == nil || cmd() != tt.want {
+			t.Errorf("%s: expected the %v callback to run", tt.key, tt.want)
+		}
+	}
+}
+
+func TestConfirmModalIgnoresOtherKeys(t *testing.T) {
+	c := NewConfirmModal()
+	ran := false
+	c.Open("Archive?", "", func() tea.Cmd { ran = true; return nil }, nil)
+
+	if cmd := c.Update(confirmKey("x")); cmd != nil || !c.IsOpen() || ran {
+		t.Errorf("expected other keys to leave the modal open without running a callback")
+	}
+	// A nil no callback just closes the modal
+	if cmd := c.Update(confirmKey("n")); cmd != nil || c.IsOpen() || ran {
+		t.Errorf("expected n to close without running the yes callback")
+	}
+	if c.View() != "" {
+		t.Errorf("expected a closed modal to render nothing")
+	}
+}
+
+func TestConfirmModalView(t *testing.T) {
+	c := NewConfirmModal()
+	c.Open("Archive?", "It can be restored.", nil, nil)
+	view := c.View()
+	for _, want := range []string{"Archive?", "It can be restored.", "Yes", "No"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in the view, got:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "⚠️") {
+		t.Errorf("expected no warning sign without danger styling")
+	}
+
+	c.OpenDanger("Delete?", "This action cannot be undone.", nil, nil)
+	if !strings.Contains(c.View(), "⚠️ Delete?") {
+		t.Errorf("expected the danger title to carry a warning sign, got:\n%s", c.View())
+	}
+}
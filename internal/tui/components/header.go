@@ -87,9 +87,10 @@ func (h *Header) View() string {
 		if h.itemCount == 1 {
 			countText = "1 item"
 		}
-		// Calculate padding to right-align count
-		titleLen := len(h.icon) + 1 + len(h.title)
-		countLen := len(countText)
+		// Calculate padding to right-align count, in terminal cells
+		// (emoji icons are two wide but several bytes long)
+		titleLen := lipgloss.Width(h.icon) + 1 + lipgloss.Width(h.title)
+		countLen := lipgloss.Width(countText)
 		padding := h.width - titleLen - countLen - 6 // Account for border/padding
 		if padding > 0 {
 			titleLine += strings.Repeat(" ", padding) + countStyle.Render(countText)
@@ -104,7 +105,7 @@ func (h *Header) View() string {
 			parts = append(parts, crumb.Icon+" "+crumb.Title)
 		}
 		parts = append(parts, h.icon+" "+h.title)
-		breadcrumbLine = breadcrumbStyle.Render(strings.Join(parts, " " + styles.DecoArrow + " "))
+		breadcrumbLine = breadcrumbStyle.Render(strings.Join(parts, " "+styles.DecoArrow+" "))
 	}
 
 	// Build vaporwave divider
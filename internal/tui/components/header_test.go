@@ -0,0 +1,32 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestHeaderCountAlignsWithWideRunes(t *testing.T) {
+	tests := []struct{ icon, title string }{
+		{"#", "Notes"},
+		{"📝", "Notes"},
+		{"📝", "Notes · 📓 Work"},
+		{"✅", "待办事项"},
+	}
+	for _, tt := range tests {
+		h := NewHeader(tt.icon, tt.title)
+		h.SetWidth(60)
+		h.SetItemCount(7)
+
+		// JoinVertical pads the line to the divider; drop that padding
+		first := strings.TrimRight(strings.Split(h.View(), "\n")[0], " ")
+		if !strings.HasSuffix(first, "7 items") {
+			t.Fatalf("%q: expected the count on the title line, got %q", tt.title, first)
+		}
+		// The count ends at the same column whatever the title's runes
+		if got := lipgloss.Width(first); got != 60-6 {
+			t.Errorf("%q: title line is %d cells wide, want %d", tt.title, got, 60-6)
+		}
+	}
+}
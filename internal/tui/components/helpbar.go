@@ -18,6 +18,7 @@ type HelpHint struct {
 	Key         string // e.g., "c", "Ctrl+N"
 	Description string // e.g., "Create", "Notes"
 	Primary     bool   // Primary actions are highlighted
+	Detail      string // Longer description for the help modal (optional)
 }
 
 // HelpBar provides context-sensitive keyboard hints.
@@ -74,7 +75,7 @@ func (h *HelpBar) View() string {
 		if hint.Primary {
 			ks = primaryKeyStyle
 		}
-		part := ks.Render("["+hint.Key+"]") + " " + descStyle.Render(hint.Description)
+		part := ks.Render("["+displayKey(hint.Key)+"]") + " " + descStyle.Render(hint.Description)
 		parts = append(parts, part)
 	}
 
@@ -102,8 +103,10 @@ var (
 		{Key: "e", Description: "Edit"},
 		{Key: "p", Description: "Preview"},
 		{Key: "d", Description: "Delete"},
-		{Key: "/", Description: "Filter"},
-		{Key: "Ctrl+R", Description: "Reset"},
+		{Key: "/", Description: "Filter", Detail: "Filter by text; key:value words match custom fields"},
+		{Key: "s", Description: "Sort", Detail: "Cycle sort mode"},
+		{Key: "t", Description: "Tag", Detail: "Pick tags to filter by"},
+		{Key: "b", Description: "Notebook", Detail: "Switch notebook"},
 		{Key: "Ctrl+H", Description: "Home"},
 	}
 
@@ -117,23 +120,122 @@ var (
 	// NotesPreviewHints are the hints when previewing a note
 	NotesPreviewHints = []HelpHint{
 		{Key: "e", Description: "Edit", Primary: true},
+		{Key: "j/k", Description: "Tasks", Detail: "Move between tasks"},
+		{Key: "T", Description: "New Todo", Detail: "New linked todo"},
+		{Key: "Space", Description: "Toggle Task"},
+		{Key: "o", Description: "Outline", Detail: "Outline of the note's headings"},
+		{Key: "F", Description: "Field", Detail: "Set a custom field, e.g. client: acme"},
+		{Key: "R", Description: "Resurface", Detail: "Bring the note back to the inbox on a later day"},
+		{Key: "/", Description: "Search", Detail: "Search within the note"},
 		{Key: "Esc", Description: "Close"},
 		{Key: "p", Description: "Close"},
 	}
 
+	// NotesPreviewSearchHints are the hints while searching within the
+	// previewed note
+	NotesPreviewSearchHints = []HelpHint{
+		{Key: "n/N", Description: "Next/Prev", Primary: true, Detail: "Next or previous match (↑/↓ while typing)"},
+		{Key: "Enter", Description: "Done", Detail: "Close the search bar, keeping the matches"},
+		{Key: "/", Description: "Search", Detail: "Change the query"},
+		{Key: "Esc", Description: "Clear"},
+	}
+
+	// PlaceholderPreviewHints are the hints when previewing a wikilink
+	// placeholder note
+	PlaceholderPreviewHints = []HelpHint{
+		{Key: "f", Description: "Fill In", Primary: true, Detail: "Write the placeholder's body"},
+		{Key: "e", Description: "Edit"},
+		{Key: "T", Description: "New Todo", Detail: "New linked todo"},
+		{Key: "Esc", Description: "Close"},
+	}
+
+	// NotesOutlineHints are the hints for the outline beside the note
+	// preview
+	NotesOutlineHints = []HelpHint{
+		{Key: "j/k", Description: "Headings", Primary: true, Detail: "Move between headings, scrolling the note"},
+		{Key: "←/→", Description: "Fold", Detail: "Collapse or expand subheadings"},
+		{Key: "PgUp/PgDn", Description: "Scroll"},
+		{Key: "Enter", Description: "Close Outline"},
+	}
+
+	// NotesFindHints are the hints for the find & replace bar in the note
+	// editor
+	NotesFindHints = []HelpHint{
+		{Key: "Enter", Description: "Next", Primary: true, Detail: "Next match"},
+		{Key: "↑/↓", Description: "Prev/Next"},
+		{Key: "Tab", Description: "Find/Replace", Detail: "Switch between the find and replace fields"},
+		{Key: "Ctrl+R", Description: "Replace", Detail: "Replace this match"},
+		{Key: "Ctrl+A", Description: "Replace All"},
+		{Key: "Esc", Description: "Close"},
+	}
+
+	// NotebookSwitchHints are the hints for the notebook switcher (b)
+	NotebookSwitchHints = []HelpHint{
+		{Key: "↑/↓", Description: "Navigate"},
+		{Key: "Enter", Description: "Open", Primary: true, Detail: "Show the notebook, creating a typed new one"},
+		{Key: "Ctrl+D", Description: "Delete", Detail: "Delete the notebook; its notes become unfiled"},
+		{Key: "Esc", Description: "Cancel"},
+	}
+
+	// NotebookMoveHints are the hints for moving a note to a notebook (m)
+	NotebookMoveHints = []HelpHint{
+		{Key: "↑/↓", Description: "Navigate"},
+		{Key: "Enter", Description: "Move", Primary: true, Detail: "File the note there, creating a typed new notebook"},
+		{Key: "Esc", Description: "Cancel"},
+	}
+
+	// TagSuggestHints are the hints for the tag suggestions after saving
+	// an untagged note
+	TagSuggestHints = []HelpHint{
+		{Key: "↑/↓", Description: "Navigate"},
+		{Key: "Space", Description: "Toggle", Detail: "Keep or drop a tag"},
+		{Key: "Enter", Description: "Add Tags", Primary: true, Detail: "Add the ticked tags"},
+		{Key: "Esc", Description: "Skip", Detail: "Leave the note untagged"},
+	}
+
+	// RetagHints are the hints for retagging filtered results (#)
+	RetagHints = []HelpHint{
+		{Key: "Enter", Description: "Next", Primary: true, Detail: "Confirm the tag and the number of results"},
+		{Key: "-tag", Description: "Remove", Detail: "Prefix the tag with - to remove it instead"},
+		{Key: "Esc", Description: "Cancel"},
+	}
+
 	// TodosListHints are the hints for the todos list view
 	TodosListHints = []HelpHint{
 		{Key: "c", Description: "Create", Primary: true},
 		{Key: "e", Description: "Edit"},
-		{Key: "d", Description: "Delete"},
-		{Key: "Space", Description: "Toggle"},
-		{Key: "?", Description: "Help"},
+		{Key: "v", Description: "View", Detail: "View full details"},
+		{Key: "Space", Description: "Toggle", Detail: "Toggle completion"},
+		{Key: "s", Description: "Sort", Detail: "Cycle sort mode"},
+		{Key: "f", Description: "Status", Detail: "Cycle status filter"},
+		{Key: "p", Description: "Priority", Detail: "Cycle priority filter"},
+		{Key: "t", Description: "Tag", Detail: "Cycle tag filter"},
+		{Key: "P", Description: "Project", Detail: "Assign to a project"},
+		{Key: "z", Description: "Snooze", Detail: "Snooze until later"},
 		{Key: "Ctrl+H", Description: "Home"},
 	}
 
+	// TodosTableHints are the hints for the todos table view
+	TodosTableHints = []HelpHint{
+		{Key: "1-6", Description: "Sort", Primary: true, Detail: "Sort by column"},
+		{Key: "←/→", Description: "Scroll", Detail: "Scroll columns"},
+		{Key: "e", Description: "Edit"},
+		{Key: "Space", Description: "Toggle", Detail: "Toggle completion"},
+		{Key: "T", Description: "Cards", Detail: "Back to the card list"},
+	}
+
+	// TodosPreviewHints are the hints when viewing a todo's details
+	TodosPreviewHints = []HelpHint{
+		{Key: "e", Description: "Edit", Primary: true},
+		{Key: "F", Description: "Field", Detail: "Set a custom field, e.g. client: acme"},
+		{Key: "y", Description: "Copy Link", Detail: "Copy the todo's flowstate://todo/ID link"},
+		{Key: "d", Description: "Delete"},
+		{Key: "Esc", Description: "Close"},
+	}
+
 	// TodosEditHints are the hints when editing a todo
 	TodosEditHints = []HelpHint{
-		{Key: "Tab", Description: "Switch Field"},
+		{Key: "Tab", Description: "Switch Field", Detail: "Next field"},
 		{Key: "Ctrl+S", Description: "Save", Primary: true},
 		{Key: "Esc", Description: "Cancel"},
 	}
@@ -164,6 +266,8 @@ var (
 		{Key: "s", Description: "Start", Primary: true},
 		{Key: "d", Description: "Duration"},
 		{Key: "h", Description: "History"},
+		{Key: "l", Description: "Label"},
+		{Key: "t", Description: "Timer"},
 		{Key: "Ctrl+H", Description: "Home"},
 	}
 
@@ -172,6 +276,8 @@ var (
 		{Key: "p", Description: "Pause", Primary: true},
 		{Key: "c", Description: "Cancel"},
 		{Key: "b", Description: "Skip to Break"},
+		{Key: "t", Description: "Timer"},
+		{Key: "z", Description: "Zen"},
 	}
 
 	// FocusPausedHints are the hints when focus timer is paused
@@ -187,11 +293,21 @@ var (
 		{Key: "Esc", Description: "End Break"},
 	}
 
+	// FocusBreakPendingHints are the hints when a break waits to be started
+	FocusBreakPendingHints = []HelpHint{
+		{Key: "s", Description: "Start Break", Primary: true},
+		{Key: "Esc", Description: "Skip Break"},
+	}
+
 	// FocusHistoryHints are the hints for session history view
 	FocusHistoryHints = []HelpHint{
 		{Key: "d", Description: "Delete"},
+		{Key: "m", Description: "Mark"},
+		{Key: "P", Description: "Clean Up"},
+		{Key: "f", Description: "Filter Label"},
+		{Key: "Enter", Description: "Collapse Day"},
+		{Key: "/", Description: "Go to Date"},
 		{Key: "Esc", Description: "Back", Primary: true},
-		{Key: "h", Description: "Back"},
 	}
 
 	// FocusDurationHints are the hints for duration picker
@@ -225,7 +341,321 @@ var (
 		{Key: "h/j/k/l", Description: "Move"},
 		{Key: "+/-", Description: "Zoom"},
 		{Key: "Enter", Description: "Open Note", Primary: true},
+		{Key: "i", Description: "Insights"},
 		{Key: "?", Description: "Help"},
 		{Key: "Ctrl+H", Description: "Home"},
 	}
+
+	// InsightsHints are the hints for the mind map insights report.
+	InsightsHints = []HelpHint{
+		{Key: "j/k", Description: "Move"},
+		{Key: "Enter", Description: "Open Note", Primary: true},
+		{Key: "i/Esc", Description: "Back to Map"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// ProjectsHints are the hints for the projects overview screen.
+	ProjectsHints = []HelpHint{
+		{Key: "j/k", Description: "Move"},
+		{Key: "Enter", Description: "Show Todos", Primary: true},
+		{Key: "b", Description: "Burndown"},
+		{Key: "t", Description: "Projects/Tags"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+		{Key: "Ctrl+H", Description: "Home"},
+	}
+
+	// PlannerHints are the hints for the week planner screen.
+	PlannerHints = []HelpHint{
+		{Key: "h/l", Description: "Day"},
+		{Key: "j/k", Description: "Todo"},
+		{Key: "H/L", Description: "Move Todo", Primary: true},
+		{Key: "r", Description: "Reload"},
+		{Key: "?", Description: "Help"},
+		{Key: "Ctrl+H", Description: "Home"},
+	}
+
+	// StarredHints are the hints for the Starred screen.
+	StarredHints = []HelpHint{
+		{Key: "j/k", Description: "Move"},
+		{Key: "Enter", Description: "Open", Primary: true, Detail: "Open the note or todo on its screen"},
+		{Key: "*", Description: "Unstar"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// DiagnosticsHints are the hints for the Diagnostics screen.
+	DiagnosticsHints = []HelpHint{
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "r", Description: "Reload", Primary: true},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// ModelHints are the hints for the Model screen.
+	ModelHints = []HelpHint{
+		{Key: "Enter", Description: "Download", Primary: true},
+		{Key: "u", Description: "From URL"},
+		{Key: "x", Description: "Cancel"},
+		{Key: "r", Description: "Rebuild index"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// DoneHints are the hints for the Done screen.
+	DoneHints = []HelpHint{
+		{Key: "Tab", Description: "Today/Week", Primary: true},
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// HabitsHints are the hints for the Habits screen.
+	HabitsHints = []HelpHint{
+		{Key: "Space", Description: "Check off", Primary: true},
+		{Key: "h/l", Description: "Day"},
+		{Key: "n", Description: "New"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// PluginsHints are the hints for the Plugins screen.
+	PluginsHints = []HelpHint{
+		{Key: "Enter", Description: "Run", Primary: true},
+		{Key: "j/k", Description: "Move"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// TagsHints are the hints for the Tags screen.
+	TagsHints = []HelpHint{
+		{Key: "c", Description: "Color", Primary: true},
+		{Key: "i", Description: "Icon"},
+		{Key: "p", Description: "Priority", Detail: "Default priority: none, low, medium, high"},
+		{Key: "Esc", Description: "Back"},
+		{Key: "?", Description: "Help"},
+	}
+
+	// InboxHints are the hints for the inbox triage screen.
+	InboxHints = []HelpHint{
+		{Key: "t", Description: "Todo", Primary: true},
+		{Key: "n", Description: "Keep Note", Primary: true},
+		{Key: "l", Description: "Link"},
+		{Key: "d", Description: "Delete"},
+		{Key: "j/k", Description: "Skip"},
+		{Key: "?", Description: "Help"},
+		{Key: "Ctrl+H", Description: "Home"},
+	}
+)
+
+// Help modal sections. Each screen's sections start from the hints its
+// help bar shows and add the keys the bar has no room for.
+var (
+	// GlobalHelp lists the keys that work on every screen.
+	GlobalHelp = []HelpSection{
+		{Title: "Go To", Hints: []HelpHint{
+			{Key: "Ctrl+X", Description: "Quick Capture", Primary: true},
+			{Key: "Ctrl+N", Description: "Notes"},
+			{Key: "Ctrl+T", Description: "Todos"},
+			{Key: "Ctrl+F", Description: "Focus"},
+			{Key: "Ctrl+/", Description: "Search"},
+			{Key: "Ctrl+G", Description: "Mind Map"},
+			{Key: "Ctrl+P", Description: "Week Planner"},
+			{Key: "Ctrl+O", Description: "Inbox"},
+			{Key: "Alt+S", Description: "Starred", Detail: "Starred notes and todos"},
+			{Key: "Ctrl+L", Description: "Links"},
+			{Key: "Ctrl+H", Description: "Home"},
+			{Key: "Alt+←", Description: "Back", Detail: "Back to the previous screen"},
+			{Key: "Alt+→", Description: "Forward"},
+			{Key: "Alt+R", Description: "Recent items", Detail: "Recently opened notes and todos"},
+		}},
+		{Title: "Tabs", Hints: []HelpHint{
+			{Key: "Alt+1..9", Description: "Switch tab", Detail: "The number after the last tab opens a new one"},
+			{Key: "Alt+W", Description: "Close tab"},
+		}},
+		{Title: "General", Hints: []HelpHint{
+			{Key: "q", Description: "Quit"},
+			{Key: "?", Description: "Toggle this help"},
+		}},
+	}
+
+	// HomeHelp lists the keys on the home screen.
+	HomeHelp = []HelpSection{
+		{Title: "Home", Hints: []HelpHint{
+			{Key: "1-9", Description: "Open a pinned filter"},
+			{Key: "c", Description: "Done", Detail: "Todos completed today and this week"},
+			{Key: "d", Description: "Diagnostics", Detail: "Database size, row counts and file paths"},
+			{Key: "h", Description: "Habits", Detail: "Daily check-offs with streaks"},
+			{Key: "m", Description: "Model", Detail: "Download the semantic search model"},
+			{Key: "p", Description: "Plugins", Detail: "Run actions of the installed plugins"},
+			{Key: "t", Description: "Tags", Detail: "Tag colors, icons and default priorities"},
+		}},
+	}
+
+	// NotesHelp lists every key on the notes screen.
+	NotesHelp = []HelpSection{
+		{Title: "List", Hints: withHints(NotesListHints,
+			HelpHint{Key: "j/k", Description: "Move"},
+			HelpHint{Key: "T", Description: "New linked todo"},
+			HelpHint{Key: "m", Description: "Move to notebook"},
+			HelpHint{Key: "*", Description: "Star/unstar"},
+			HelpHint{Key: "a", Description: "Show stale notes only"},
+			HelpHint{Key: "H", Description: "Pin/unpin the filter on Home"},
+			HelpHint{Key: "#", Description: "Tag/untag all filtered notes"},
+			HelpHint{Key: "D", Description: "Compact/comfortable rows"},
+			HelpHint{Key: "S", Description: "Share via share_command"},
+			HelpHint{Key: "y", Description: "Copy a [[wikilink]] to the note"},
+			HelpHint{Key: "Ctrl+R", Description: "Reset filters"},
+		)},
+		{Title: "Preview", Hints: withHints(NotesPreviewHints,
+			HelpHint{Key: "S", Description: "Share via share_command"},
+			HelpHint{Key: "A", Description: "Add summary via summarize_command"},
+			HelpHint{Key: "y", Description: "Copy a [[wikilink]] to the note"},
+			HelpHint{Key: "n/N", Description: "Next/previous match of the / search"},
+			HelpHint{Key: "f", Description: "Fill in a 👻 wikilink placeholder"},
+			HelpHint{Key: "PgUp/PgDn", Description: "Scroll a long note"},
+		)},
+		{Title: "Notebooks", Hints: NotebookSwitchHints},
+		{Title: "Tag Suggestions", Hints: TagSuggestHints},
+		{Title: "Retag (#)", Hints: RetagHints},
+		{Title: "Outline", Hints: NotesOutlineHints},
+		{Title: "Find & Replace", Hints: NotesFindHints},
+		{Title: "Editor", Hints: withHints(NotesEditHints,
+			HelpHint{Key: "Ctrl+E", Description: "Preview markdown"},
+			HelpHint{Key: "Ctrl+B", Description: "Bold"},
+			HelpHint{Key: "Ctrl+I", Description: "Italic"},
+			HelpHint{Key: "Ctrl+G", Description: "Add tags from the picker"},
+			HelpHint{Key: "F7", Description: "Spelling suggestions"},
+			HelpHint{Key: "Ctrl+↓/↑", Description: "Shrink/grow the body"},
+			HelpHint{Key: "Ctrl+F", Description: "Find & replace"},
+			HelpHint{Key: "Ctrl+J", Description: "Go to line"},
+			HelpHint{Key: "Ctrl+Home/End", Description: "Start/end of the body"},
+			HelpHint{Key: "F8", Description: "Writing mode (body only, typewriter scrolling)"},
+			HelpHint{Key: "[[", Description: "Complete a wikilink (↑/↓, Enter/Tab, Esc)"},
+		)},
+	}
+
+	// TodosHelp lists every key on the todos screen.
+	TodosHelp = []HelpSection{
+		{Title: "List", Hints: withHints(TodosListHints,
+			HelpHint{Key: "x", Description: "Next status", Detail: "Move on through the workflow, with todo_statuses"},
+			HelpHint{Key: "j/k", Description: "Move"},
+			HelpHint{Key: "d", Description: "Delete"},
+			HelpHint{Key: "y", Description: "Copy the todo's flowstate://todo/ID link"},
+			HelpHint{Key: "Ctrl+R", Description: "Reset filters"},
+		)},
+		{Title: "Organize", Hints: []HelpHint{
+			{Key: "*", Description: "Star/unstar"},
+			{Key: "/", Description: "Search filter", Detail: "key:value words match custom fields"},
+			{Key: "b", Description: "Group by project / linked note / off"},
+			{Key: "Enter/Space", Description: "Collapse/expand a group"},
+			{Key: "O", Description: "Projects overview"},
+			{Key: "Z", Description: "Show/hide snoozed"},
+			{Key: "a", Description: "Show stale todos only"},
+			{Key: "H", Description: "Pin/unpin the filter on Home"},
+			{Key: "#", Description: "Tag/untag all filtered todos"},
+			{Key: "D", Description: "Compact/comfortable rows"},
+		}},
+		{Title: "Due Date", Hints: []HelpHint{
+			{Key: "+/-", Description: "A day later/earlier"},
+			{Key: "w", Description: "A week later"},
+			{Key: "0", Description: "Clear"},
+		}},
+		{Title: "Retag (#)", Hints: RetagHints},
+		{Title: "Table (T)", Hints: TodosTableHints},
+		{Title: "Details (v)", Hints: TodosPreviewHints},
+		{Title: "Editor", Hints: TodosEditHints},
+	}
+
+	// FocusHelp lists every key on the focus screen.
+	FocusHelp = []HelpSection{
+		{Title: "Idle", Hints: withHints(FocusIdleHints,
+			HelpHint{Key: "x", Description: "Cancel the next timer"},
+			HelpHint{Key: "a", Description: "Plan a focus block for later today, e.g. 3pm 45m #thesis"},
+			HelpHint{Key: "A", Description: "Cancel the next planned block"},
+		)},
+		{Title: "Planned block due", Hints: []HelpHint{
+			{Key: "enter", Description: "Start it with its length and label"},
+			{Key: "esc", Description: "Skip it"},
+		}},
+		{Title: "Running", Hints: FocusRunningHints},
+		{Title: "Paused", Hints: withHints(FocusPausedHints,
+			HelpHint{Key: "z", Description: "Zen view"},
+		)},
+		{Title: "Break", Hints: withHints(FocusBreakHints,
+			HelpHint{Key: "1-9", Description: "Tick a break activity"},
+			HelpHint{Key: "z", Description: "Zen view"},
+		)},
+		{Title: "Duration", Hints: FocusDurationHints},
+		{Title: "Energy rating", Hints: []HelpHint{
+			{Key: "1-5", Description: "Rate the session's energy", Detail: "Asked when a work session ends, with energy_prompt on"},
+			{Key: "esc", Description: "Skip the rating"},
+		}},
+		{Title: "History", Hints: withHints(FocusHistoryHints,
+			HelpHint{Key: "D", Description: "Compact/comfortable rows"},
+		)},
+	}
+
+	// DiagnosticsHelp lists every key on the Diagnostics screen.
+	DiagnosticsHelp = []HelpSection{
+		{Title: "Diagnostics", Hints: withHints(DiagnosticsHints,
+			HelpHint{Key: "g", Description: "Back to the top"},
+		)},
+	}
+
+	// ModelHelp lists every key on the Model screen.
+	ModelHelp = []HelpSection{
+		{Title: "Semantic Model", Hints: []HelpHint{
+			{Key: "Enter", Description: "Download", Detail: "Resumes a cancelled download"},
+			{Key: "u", Description: "Download from URL", Detail: "A mirror, or a local path when offline"},
+			{Key: "x", Description: "Cancel", Detail: "What arrived is kept for resuming"},
+			{Key: "r", Description: "Rebuild index", Detail: "Embeds every note again with the configured model"},
+			{Key: "Esc", Description: "Back", Detail: "The download continues"},
+			{Key: "?", Description: "Help"},
+		}},
+	}
+
+	// DoneHelp lists every key on the Done screen.
+	DoneHelp = []HelpSection{
+		{Title: "Done", Hints: withHints(DoneHints,
+			HelpHint{Key: "w", Description: "Today/Week"},
+			HelpHint{Key: "g", Description: "Back to the top"},
+			HelpHint{Key: "r", Description: "Reload"},
+		)},
+	}
+
+	// PluginsHelp lists every key on the Plugins screen.
+	PluginsHelp = []HelpSection{
+		{Title: "Plugins", Hints: withHints(PluginsHints,
+			HelpHint{Key: "g", Description: "Back to the top"},
+		)},
+	}
+
+	// TagsHelp lists every key on the Tags screen.
+	TagsHelp = []HelpSection{
+		{Title: "Tags", Hints: withHints(TagsHints,
+			HelpHint{Key: "x", Description: "Clear", Detail: "Remove the tag's color, icon and priority"},
+			HelpHint{Key: "j/k", Description: "Move between tags"},
+			HelpHint{Key: "r", Description: "Reload"},
+		)},
+	}
+
+	// HabitsHelp lists every key on the Habits screen.
+	HabitsHelp = []HelpSection{
+		{Title: "Grid", Hints: withHints(HabitsHints,
+			HelpHint{Key: "x", Description: "Check off", Detail: "Or uncheck, on the selected day"},
+			HelpHint{Key: "j/k", Description: "Move between habits"},
+			HelpHint{Key: "t", Description: "Back to today"},
+			HelpHint{Key: "e", Description: "Rename"},
+			HelpHint{Key: "d", Description: "Delete", Detail: "With its check-offs"},
+			HelpHint{Key: "r", Description: "Reload"},
+		)},
+	}
+
+	// StarredHelp lists every key on the Starred screen.
+	StarredHelp = []HelpSection{
+		{Title: "Starred", Hints: withHints(StarredHints,
+			HelpHint{Key: "r", Description: "Reload"},
+		)},
+	}
 )
@@ -0,0 +1,225 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/keymap"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// HelpModal lists a screen's full keybinding set (Phase 10: Help).
+//
+// The help bar only has room for a screen's most common keys; the modal
+// shows every key, grouped into sections built from the same HelpHint
+// definitions, so the bar and the modal never disagree. Sections are
+// spread over as many columns as the terminal width allows; when they
+// still do not fit the height, j/k scroll the modal.
+
+// HelpSection is a titled group of hints in the help modal.
+type HelpSection struct {
+	Title string
+	Hints []HelpHint
+}
+
+// helpModalChrome is the height taken by the border, padding, title and
+// footer around the sections.
+const helpModalChrome = 8
+
+// helpModalColumnGap separates section columns.
+const helpModalColumnGap = 3
+
+// helpModalFrameWidth is the width taken by the border and padding.
+const helpModalFrameWidth = 6
+
+// HelpModal renders a help modal for a screen.
+type HelpModal struct {
+	title    string
+	sections []HelpSection
+	width    int
+	height   int
+	offset   int // First body line shown when scrolled
+}
+
+// NewHelpModal creates a help modal with the given title and sections.
+func NewHelpModal(title string, sections []HelpSection) HelpModal {
+	return HelpModal{
+		title:    title,
+		sections: sections,
+		width:    80,
+		height:   24,
+	}
+}
+
+// SetSize updates the space the modal may use.
+func (h *HelpModal) SetSize(width, height int) {
+	h.width = width
+	h.height = height
+}
+
+// ScrollDown scrolls the modal one line down, if it is cut off.
+func (h *HelpModal) ScrollDown() {
+	h.offset++
+}
+
+// ScrollUp scrolls the modal one line up.
+func (h *HelpModal) ScrollUp() {
+	h.offset = max(h.offset-1, 0)
+}
+
+// View renders the modal box. Callers place it on screen.
+func (h *HelpModal) View() string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.SecondaryColor) // Neon cyan
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)                // Pale blue
+
+	blocks := make([]string, 0, len(h.sections))
+	for _, section := range h.sections {
+		if len(section.Hints) > 0 {
+			blocks = append(blocks, renderHelpSection(section))
+		}
+	}
+
+	innerWidth := h.width - helpModalFrameWidth
+	body := lipgloss.JoinHorizontal(lipgloss.Top, layoutHelpColumns(blocks, innerWidth)...)
+	body = lipgloss.NewStyle().MaxWidth(innerWidth).Render(body)
+
+	footer := "Press Esc or ? to close"
+	lines := strings.Split(body, "\n")
+	if visible := max(h.height-helpModalChrome, 1); len(lines) > visible {
+		h.offset = min(h.offset, len(lines)-visible)
+		lines = lines[h.offset : h.offset+visible]
+		footer = fmt.Sprintf("j/k scroll (%d-%d of %d) · %s",
+			h.offset+1, h.offset+visible, len(strings.Split(body, "\n")), footer)
+	} else {
+		h.offset = 0
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render(styles.DecoStar+" "+h.title+" "+styles.DecoStar),
+		"",
+		strings.Join(lines, "\n"),
+		"",
+		mutedStyle.Render(footer),
+	)
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(styles.AccentColor). // Hot pink border
+		Padding(1, 2).
+		Render(content)
+}
+
+// renderHelpSection renders a section title and its keys, with the
+// descriptions aligned.
+func renderHelpSection(section HelpSection) string {
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.PrimaryColor)
+	keyStyle := lipgloss.NewStyle().Foreground(styles.AccentColor) // Hot pink
+	primaryKeyStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+	descStyle := lipgloss.NewStyle().Foreground(styles.TextColor) // Off-white
+
+	keyWidth := 0
+	for _, hint := range section.Hints {
+		keyWidth = max(keyWidth, lipgloss.Width(displayKey(hint.Key)))
+	}
+
+	lines := []string{headingStyle.Render(section.Title)}
+	for _, hint := range section.Hints {
+		ks := keyStyle
+		if hint.Primary {
+			ks = primaryKeyStyle
+		}
+		desc := hint.Description
+		if hint.Detail != "" {
+			desc = hint.Detail
+		}
+		lines = append(lines, ks.Width(keyWidth+2).Render(displayKey(hint.Key))+descStyle.Render(desc))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// layoutHelpColumns spreads section blocks over as many columns as fit
+// width, keeping sections whole and the columns about equally tall.
+func layoutHelpColumns(blocks []string, width int) []string {
+	if len(blocks) == 0 {
+		return nil
+	}
+	blockWidth, tallest, total := 0, 0, 0
+	for _, block := range blocks {
+		blockWidth = max(blockWidth, lipgloss.Width(block))
+		tallest = max(tallest, lipgloss.Height(block))
+		total += lipgloss.Height(block) + 1
+	}
+	maxColumns := max((width+helpModalColumnGap)/(blockWidth+helpModalColumnGap), 1)
+
+	// The shortest column height that fits the blocks in maxColumns.
+	var columns [][]string
+	for limit := tallest; ; limit++ {
+		columns = stackHelpBlocks(blocks, limit)
+		if len(columns) <= maxColumns || limit > total {
+			break
+		}
+	}
+
+	rendered := make([]string, len(columns))
+	for i, column := range columns {
+		style := lipgloss.NewStyle().Width(blockWidth)
+		if i < len(columns)-1 {
+			style = style.MarginRight(helpModalColumnGap)
+		}
+		rendered[i] = style.Render(strings.Join(column, "\n\n"))
+	}
+	return rendered
+}
+
+// stackHelpBlocks fills columns top to bottom, starting a new column when
+// the next block would make the current one taller than limit.
+func stackHelpBlocks(blocks []string, limit int) [][]string {
+	var columns [][]string
+	var current []string
+	height := 0
+	for _, block := range blocks {
+		h := lipgloss.Height(block)
+		if len(current) > 0 && height+1+h > limit {
+			columns = append(columns, current)
+			current, height = nil, 0
+		}
+		if len(current) > 0 {
+			height++
+		}
+		current = append(current, block)
+		height += h
+	}
+	return append(columns, current)
+}
+
+// displayKey shows "Ctrl+" keys with the platform's modifier, e.g. "⌘+N"
+// on macOS.
+func displayKey(key string) string {
+	if rest, ok := strings.CutPrefix(key, "Ctrl+"); ok {
+		return keymap.ModKeyDisplay() + "+" + rest
+	}
+	return key
+}
+
+// WithDescriptions returns a copy of hints with the descriptions of the
+// given keys replaced, for help bars that show live state such as the
+// current sort mode.
+func WithDescriptions(hints []HelpHint, descriptions map[string]string) []HelpHint {
+	out := make([]HelpHint, len(hints))
+	copy(out, hints)
+	for i, hint := range out {
+		if desc, ok := descriptions[hint.Key]; ok {
+			out[i].Description = desc
+		}
+	}
+	return out
+}
+
+// withHints returns hints followed by more, without modifying hints.
+func withHints(hints []HelpHint, more ...HelpHint) []HelpHint {
+	out := make([]HelpHint, 0, len(hints)+len(more))
+	out = append(out, hints...)
+	return append(out, more...)
+}
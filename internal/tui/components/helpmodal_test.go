@@ -0,0 +1,108 @@
+package components
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHelpModalShowsEverySection(t *testing.T) {
+	t.Parallel()
+
+	modal := NewHelpModal("Todos - Keyboard Shortcuts", append(TodosHelp, GlobalHelp...))
+	modal.SetSize(200, 60)
+	view := modal.View()
+
+	for _, section := range append(TodosHelp, GlobalHelp...) {
+		if !strings.Contains(view, section.Title) {
+			t.Errorf("view missing section %q", section.Title)
+		}
+		for _, hint := range section.Hints {
+			desc := hint.Description
+			if hint.Detail != "" {
+				desc = hint.Detail
+			}
+			if !strings.Contains(view, desc) {
+				t.Errorf("view missing %q (%s) in section %q", desc, hint.Key, section.Title)
+			}
+		}
+	}
+	if strings.Contains(view, "j/k scroll") {
+		t.Errorf("modal that fits should not offer scrolling:\n%s", view)
+	}
+}
+
+func TestHelpModalScrollsWhenTooTall(t *testing.T) {
+	t.Parallel()
+
+	modal := NewHelpModal("Notes", NotesHelp)
+	modal.SetSize(50, 16)
+	top := modal.View()
+	if !strings.Contains(top, "j/k scroll (1-8 of") {
+		t.Fatalf("expected scroll hint, got:\n%s", top)
+	}
+	if !strings.Contains(top, "List") {
+		t.Errorf("expected first section at the top, got:\n%s", top)
+	}
+
+	modal.ScrollDown()
+	if !strings.Contains(modal.View(), "j/k scroll (2-9 of") {
+		t.Errorf("expected view to scroll one line, got:\n%s", modal.View())
+	}
+	modal.ScrollUp()
+	modal.ScrollUp()
+	if modal.View() != top {
+		t.Errorf("scrolling up past the top should stay at the top")
+	}
+
+	for range 200 {
+		modal.ScrollDown()
+	}
+	if !strings.Contains(modal.View(), "Spelling suggestions") {
+		t.Errorf("expected last key after scrolling to the end, got:\n%s", modal.View())
+	}
+}
+
+func TestHelpSectionsStartWithHelpBarHints(t *testing.T) {
+	t.Parallel()
+
+	// The modal must list at least what the help bar shows.
+	testCases := []struct {
+		name    string
+		section HelpSection
+		bar     []HelpHint
+	}{
+		{"notes list", NotesHelp[0], NotesListHints},
+		{"todos list", TodosHelp[0], TodosListHints},
+		{"focus idle", FocusHelp[0], FocusIdleHints},
+	}
+	for _, tc := range testCases {
+		if len(tc.section.Hints) < len(tc.bar) {
+			t.Fatalf("%s: section has %d hints, bar has %d", tc.name, len(tc.section.Hints), len(tc.bar))
+		}
+		for i, hint := range tc.bar {
+			if tc.section.Hints[i] != hint {
+				t.Errorf("%s: hint %d = %+v, want %+v", tc.name, i, tc.section.Hints[i], hint)
+			}
+		}
+	}
+}
+
+func TestWithDescriptions(t *testing.T) {
+	t.Parallel()
+
+	hints := WithDescriptions(TodosListHints, map[string]string{"s": "Due Date"})
+	for i, hint := range hints {
+		want := TodosListHints[i].Description
+		if hint.Key == "s" {
+			want = "Due Date"
+		}
+		if hint.Description != want {
+			t.Errorf("%s: Description = %q, want %q", hint.Key, hint.Description, want)
+		}
+	}
+	for _, hint := range TodosListHints {
+		if hint.Key == "s" && hint.Description != "Sort" {
+			t.Errorf("WithDescriptions modified TodosListHints: %q", hint.Description)
+		}
+	}
+}
@@ -1,11 +1,23 @@
 package components
 
 import (
+	"github.com/charmbracelet/bubbles/cursor"
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
 )
 
+// CursorMode returns the mode of text cursors: blinking, or steady when
+// reduced motion is on.
+func CursorMode() cursor.Mode {
+	if styles.ReducedMotion() {
+		return cursor.CursorStatic
+	}
+	return cursor.CursorBlink
+}
+
 type TextInputModel struct {
 	textinput textinput.Model
 	focused   bool
@@ -16,7 +28,8 @@ func NewTextInput(placeholder string) TextInputModel {
 	ti.Placeholder = placeholder
 	ti.Focus()
 	ti.Prompt = "> "
-	ti.CharLimit = 200 // Safety: Reasonable limit for titles
+	ti.CharLimit = 400 // Safety: Twice the title limit, so forms can say a title is too long
+	ti.Cursor.SetMode(CursorMode())
 
 	return TextInputModel{textinput: ti, focused: true}
 }
@@ -62,9 +75,10 @@ func NewTextArea(placeholder string) TextAreaModel {
 	ta.Placeholder = placeholder
 	ta.Focus()
 	ta.Prompt = "| "
-	ta.CharLimit = 20000 // Safety: Enforce limit to prevent memory spikes
+	ta.CharLimit = 40000 // Safety: Prevents memory spikes; twice the body limit, so forms can say a paste is too long
 	ta.ShowLineNumbers = false
 	ta.SetHeight(10)
+	ta.Cursor.SetMode(CursorMode())
 
 	return TextAreaModel{textarea: ta, focused: true}
 }
@@ -99,3 +113,60 @@ func (m *TextAreaModel) Update(msg tea.Msg) (TextAreaModel, tea.Cmd) {
 func (m *TextAreaModel) View() string {
 	return m.textarea.View()
 }
+
+// SetHeight sets how many lines the text area shows.
+func (m *TextAreaModel) SetHeight(height int) {
+	m.textarea.SetHeight(height)
+}
+
+// Height returns how many lines the text area shows.
+func (m *TextAreaModel) Height() int {
+	return m.textarea.Height()
+}
+
+// SetShowLineNumbers shows or hides line numbers beside the text.
+func (m *TextAreaModel) SetShowLineNumbers(show bool) {
+	m.textarea.ShowLineNumbers = show
+}
+
+// LineCount returns the number of lines in the text.
+func (m *TextAreaModel) LineCount() int {
+	return m.textarea.LineCount()
+}
+
+// GoToLine moves the cursor to the start of line (1-based), clamped to the
+// text, and scrolls it into view.
+func (m *TextAreaModel) GoToLine(line int) {
+	m.MoveTo(line-1, 0)
+}
+
+// MoveTo moves the cursor to column col (in runes) of line (0-based), both
+// clamped to the text, and scrolls it into view.
+func (m *TextAreaModel) MoveTo(line, col int) {
+	target := min(max(line, 0), m.textarea.LineCount()-1)
+	// Cursor moves go by wrapped row; a row per rune is always enough
+	for steps := len(m.textarea.Value()); m.textarea.Line() > target && steps >= 0; steps-- {
+		m.textarea.CursorUp()
+	}
+	for steps := len(m.textarea.Value()); m.textarea.Line() < target && steps >= 0; steps-- {
+		m.textarea.CursorDown()
+	}
+	m.textarea.SetCursor(col)
+	m.textarea, _ = m.textarea.Update(nil) // Scrolls the cursor into view
+}
+
+// Cursor returns the cursor's line and column (in runes) within Value.
+func (m *TextAreaModel) Cursor() (line, col int) {
+	info := m.textarea.LineInfo()
+	return m.textarea.Line(), info.StartColumn + info.ColumnOffset
+}
+
+// ReplaceRange replaces runes [start, end) of the cursor's line with s and
+// leaves the cursor after the replacement.
+func (m *TextAreaModel) ReplaceRange(start, end int, s string) {
+	m.textarea.SetCursor(end)
+	for i := start; i < end; i++ {
+		m.textarea, _ = m.textarea.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	m.textarea.InsertString(s)
+}
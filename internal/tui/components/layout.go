@@ -0,0 +1,25 @@
+package components
+
+import "github.com/charmbracelet/lipgloss"
+
+// Layout (Phase 4: UX Overhaul).
+//
+// A screen stacks fixed parts (header, filter and sort lines, help bar)
+// around one flexible part, a list or canvas, which gets the rows left
+// over. FillHeight measures the fixed parts as rendered, so a help bar
+// that wraps on a narrow terminal or a filter line that appears shrinks
+// the list rather than pushing the help bar off the screen.
+
+// AppChrome is the rows the app draws below every screen: the toast line
+// and the status bar.
+const AppChrome = 2
+
+// FillHeight returns the rows of height left for the flexible part once
+// the fixed parts are stacked with it, and at least minRows. Each fixed
+// part counts as many rows as it renders; "" is a blank spacer row.
+func FillHeight(height, minRows int, fixed ...string) int {
+	for _, part := range fixed {
+		height -= lipgloss.Height(part)
+	}
+	return max(height, minRows)
+}
@@ -0,0 +1,22 @@
+package components
+
+import "testing"
+
+func TestFillHeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		height int
+		fixed  []string
+		want   int
+	}{
+		{"no fixed parts", 20, nil, 20},
+		{"one-line parts and spacers", 20, []string{"header", "", "help"}, 17},
+		{"multi-line parts", 20, []string{"header\n═════", "help\nwrapped"}, 16},
+		{"never below the minimum", 5, []string{"a\nb\nc", "d\ne\nf"}, 3},
+	}
+	for _, tt := range tests {
+		if got := FillHeight(tt.height, 3, tt.fixed...); got != tt.want {
+			t.Errorf("%s: FillHeight() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
@@ -117,8 +117,12 @@ func (s AnimatedSpinner) View() string {
 	return frame + " " + labelStyle.Render(s.label)
 }
 
-// tick returns a command that sends a SpinnerTickMsg after the interval
+// tick returns a command that sends a SpinnerTickMsg after the interval.
+// With reduced motion the spinner holds its first frame.
 func (s AnimatedSpinner) tick() tea.Cmd {
+	if styles.ReducedMotion() {
+		return nil
+	}
 	return tea.Tick(s.interval, func(t time.Time) tea.Msg {
 		return SpinnerTickMsg{}
 	})
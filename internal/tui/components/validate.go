@@ -0,0 +1,78 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Field validation (Phase 4: Robustness).
+//
+// Forms check each field against a list of rules and show the first
+// failure as an inline error under the field. Length rules run as the
+// user types; the rest wait until a save is attempted, so a new form does
+// not open full of errors.
+
+// Rule checks a field's value.
+type Rule struct {
+	check func(value string) string // Error message, or "" when valid
+	live  bool                      // Checked on every keystroke
+}
+
+// Required rejects a value that is empty or only whitespace.
+func Required(label string) Rule {
+	return Rule{check: func(value string) string {
+		if strings.TrimSpace(value) == "" {
+			return label + " is required"
+		}
+		return ""
+	}}
+}
+
+// MaxLength rejects a value longer than limit characters.
+func MaxLength(label string, limit int) Rule {
+	return Rule{live: true, check: func(value string) string {
+		if n := utf8.RuneCountInString(value); n > limit {
+			return fmt.Sprintf("%s is too long (%d of %d characters)", label, n, limit)
+		}
+		return ""
+	}}
+}
+
+// Parses rejects a value parse returns an error for, using the error as
+// the message.
+func Parses(parse func(value string) error) Rule {
+	return Rule{check: func(value string) string {
+		if err := parse(value); err != nil {
+			return err.Error()
+		}
+		return ""
+	}}
+}
+
+// Check returns the message of the first rule value fails, or "". Until
+// submitted is set only rules checked as the user types run.
+func Check(value string, submitted bool, rules ...Rule) string {
+	for _, r := range rules {
+		if !submitted && !r.live {
+			continue
+		}
+		if msg := r.check(value); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+// FieldError renders msg as an inline error under a field, or "" when
+// there is no error.
+func FieldError(msg string) string {
+	if msg == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(styles.ErrorColor).Render("⚠ " + msg)
+}
@@ -0,0 +1,47 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckRules(t *testing.T) {
+	rules := []Rule{
+		Required("Title"),
+		MaxLength("Title", 5),
+		Parses(func(value string) error {
+			if strings.Contains(value, "!") {
+				return errors.New("no shouting")
+			}
+			return nil
+		}),
+	}
+
+	tests := []struct {
+		value     string
+		submitted bool
+		want      string
+	}{
+		{"", false, ""}, // Required waits for a save
+		{"  ", true, "Title is required"},
+		{"héllo", true, ""}, // Five characters, six bytes
+		{"héllo!", false, "Title is too long (6 of 5 characters)"},
+		{"hi!", false, ""},
+		{"hi!", true, "no shouting"},
+	}
+	for _, tt := range tests {
+		if got := Check(tt.value, tt.submitted, rules...); got != tt.want {
+			t.Errorf("Check(%q, %v) = %q, want %q", tt.value, tt.submitted, got, tt.want)
+		}
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	if got := FieldError(""); got != "" {
+		t.Errorf("FieldError(\"\") = %q, want \"\"", got)
+	}
+	if got := FieldError("Title is required"); !strings.Contains(got, "⚠ Title is required") {
+		t.Errorf("FieldError rendered %q, want the message with a warning sign", got)
+	}
+}
@@ -0,0 +1,313 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// ScrollBuffer is the number of rows kept visible above and below the
+// selection while scrolling, so the cursor never sits on the edge.
+const ScrollBuffer = 2
+
+// virtualItemRows is the rows per item in comfortable density: title and
+// description, followed by a blank spacer row between items.
+const virtualItemRows = 2
+
+// VirtualList is a scrolling list that renders only the rows in view.
+//
+// Phase 4: Performance
+//   - Drop-in for the subset of bubbles/list the screens use
+//     (SetItems, Items, SelectedItem, Select, Index, SetSize, Update, View)
+//   - View renders only the window of items that fits the height, so the
+//     cost of a frame does not grow with the number of items
+//   - Items render like list.DefaultDelegate (title + description) when
+//     they implement list.DefaultItem, unless an ItemDelegate is set
+//   - j/k or arrows move, PgUp/PgDn page, g/G or Home/End jump
+//   - Compact density draws one title row per item with no spacer, so
+//     twice as many items fit
+type VirtualList struct {
+	items  []list.Item
+	index  int // Selected item
+	offset int // First item in the window
+	width  int
+	height int
+
+	itemStyles list.DefaultItemStyles
+	delegate   ItemDelegate
+	compact    bool
+	itemKey    ItemKey
+}
+
+// ItemDelegate renders a list item's rows in place of the default title
+// and description, e.g. to color rows by priority. Render returns the
+// title row and the description row; returning nil falls back to the
+// default rendering. Rows wider than width are truncated.
+type ItemDelegate interface {
+	Render(item list.Item, selected bool, width int) []string
+}
+
+// ItemKey identifies a list item across reloads, such as by its database
+// ID. ok is false for rows that are not items, such as group headers.
+type ItemKey func(item list.Item) (key int64, ok bool)
+
+// SetCompact switches between compact (title only) and comfortable
+// (title, description and spacer) rows, keeping the selection in view.
+func (l *VirtualList) SetCompact(compact bool) {
+	l.compact = compact
+	l.scrollToSelection()
+}
+
+// Compact reports whether the list uses compact rows.
+func (l *VirtualList) Compact() bool {
+	return l.compact
+}
+
+// itemLayout returns the rows each item draws and the spacer rows between
+// items for the current density.
+func (l *VirtualList) itemLayout() (rows, gap int) {
+	if l.compact {
+		return 1, 0
+	}
+	return virtualItemRows, 1
+}
+
+// SetDelegate sets the delegate used to render items (nil for the default).
+func (l *VirtualList) SetDelegate(d ItemDelegate) {
+	l.delegate = d
+}
+
+// NewVirtualList creates an empty virtual list.
+func NewVirtualList() VirtualList {
+	return VirtualList{itemStyles: list.NewDefaultItemStyles()}
+}
+
+// SetItemKey sets how items are identified, so SetItems can keep the
+// selection on the same item (nil to keep the same index).
+func (l *VirtualList) SetItemKey(key ItemKey) {
+	l.itemKey = key
+}
+
+// SetItems replaces the items. With an item key set, the selected item
+// stays selected wherever it moved to, or the nearest item takes its place
+// when it is gone; otherwise the selection keeps its index, clamped.
+func (l *VirtualList) SetItems(items []list.Item) {
+	var key int64
+	keyed := false
+	if selected := l.SelectedItem(); selected != nil && l.itemKey != nil {
+		key, keyed = l.itemKey(selected)
+	}
+	l.items = items
+	if !keyed {
+		l.Select(l.index)
+		return
+	}
+	for i, item := range items {
+		if k, ok := l.itemKey(item); ok && k == key {
+			l.Select(i)
+			return
+		}
+	}
+	l.Select(l.nearestKeyed(l.index))
+}
+
+// nearestKeyed returns the index of the item nearest to i that has a key,
+// looking at and after i first (where the next item slides into the place
+// of one removed), or i when there is none.
+func (l *VirtualList) nearestKeyed(i int) int {
+	i = min(i, len(l.items)-1)
+	for d := 0; d < len(l.items); d++ {
+		for _, j := range []int{i + d, i - d - 1} {
+			if j >= 0 && j < len(l.items) {
+				if _, ok := l.itemKey(l.items[j]); ok {
+					return j
+				}
+			}
+		}
+	}
+	return i
+}
+
+// Items returns all items.
+func (l *VirtualList) Items() []list.Item {
+	return l.items
+}
+
+// Index returns the selected item's index.
+func (l *VirtualList) Index() int {
+	return l.index
+}
+
+// SelectedItem returns the selected item, or nil when the list is empty.
+func (l *VirtualList) SelectedItem() list.Item {
+	if l.index < 0 || l.index >= len(l.items) {
+		return nil
+	}
+	return l.items[l.index]
+}
+
+// Select moves the selection to index i (clamped) and scrolls it into view.
+func (l *VirtualList) Select(i int) {
+	if i >= len(l.items) {
+		i = len(l.items) - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	l.index = i
+	l.scrollToSelection()
+}
+
+// SetSize sets the list dimensions.
+func (l *VirtualList) SetSize(width, height int) {
+	l.width = width
+	l.height = height
+	l.scrollToSelection()
+}
+
+// pageSize returns how many items fit in the window. One row is reserved
+// for the position indicator when the items overflow.
+func (l *VirtualList) pageSize() int {
+	itemRows, gap := l.itemLayout()
+	rows := l.height
+	if len(l.items)*(itemRows+gap)-gap > rows {
+		rows--
+	}
+	n := (rows + gap) / (itemRows + gap)
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// scrollToSelection adjusts the window so the selection stays visible
+// with ScrollBuffer items of context where possible.
+func (l *VirtualList) scrollToSelection() {
+	page := l.pageSize()
+	margin := ScrollBuffer
+	if margin > (page-1)/2 {
+		margin = (page - 1) / 2
+	}
+
+	if l.index-margin < l.offset {
+		l.offset = l.index - margin
+	}
+	if l.index+margin >= l.offset+page {
+		l.offset = l.index + margin - page + 1
+	}
+
+	maxOffset := len(l.items) - page
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if l.offset > maxOffset {
+		l.offset = maxOffset
+	}
+	if l.offset < 0 {
+		l.offset = 0
+	}
+}
+
+// Update handles navigation keys.
+func (l VirtualList) Update(msg tea.Msg) (VirtualList, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return l, nil
+	}
+
+	switch keyMsg.String() {
+	case "up", "k":
+		l.Select(l.index - 1)
+	case "down", "j":
+		l.Select(l.index + 1)
+	case "pgup":
+		l.Select(l.index - l.pageSize())
+	case "pgdown":
+		l.Select(l.index + l.pageSize())
+	case "home", "g":
+		l.Select(0)
+	case "end", "G":
+		l.Select(len(l.items) - 1)
+	}
+	return l, nil
+}
+
+// View renders the items in the current window.
+func (l VirtualList) View() string {
+	if len(l.items) == 0 || l.width <= 0 {
+		return ""
+	}
+
+	page := l.pageSize()
+	end := l.offset + page
+	if end > len(l.items) {
+		end = len(l.items)
+	}
+
+	itemRows, gap := l.itemLayout()
+	rows := make([]string, 0, (end-l.offset)*(itemRows+gap)+1)
+	for i := l.offset; i < end; i++ {
+		if i > l.offset && gap > 0 {
+			rows = append(rows, "")
+		}
+		rows = append(rows, l.renderItem(i)...)
+	}
+
+	if len(l.items) > page {
+		position := fmt.Sprintf("  %d/%d", l.index+1, len(l.items))
+		if l.offset > 0 {
+			position += " ↑"
+		}
+		if end < len(l.items) {
+			position += " ↓"
+		}
+		rows = append(rows, lipgloss.NewStyle().Foreground(styles.MutedColor).Render(position))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// renderItem renders one item's title and, unless compact, description
+// lines.
+func (l VirtualList) renderItem(i int) []string {
+	itemRows, _ := l.itemLayout()
+	if l.delegate != nil {
+		if rows := l.delegate.Render(l.items[i], i == l.index, l.width); rows != nil {
+			out := make([]string, itemRows)
+			for r := range out {
+				if r < len(rows) {
+					out[r] = lipgloss.NewStyle().MaxWidth(l.width).Render(rows[r])
+				}
+			}
+			return out
+		}
+	}
+
+	item, ok := l.items[i].(list.DefaultItem)
+	if !ok {
+		return make([]string, itemRows)
+	}
+
+	titleStyle, descStyle := l.itemStyles.NormalTitle, l.itemStyles.NormalDesc
+	if i == l.index {
+		titleStyle, descStyle = l.itemStyles.SelectedTitle, l.itemStyles.SelectedDesc
+	}
+	if l.compact {
+		return []string{titleStyle.MaxWidth(l.width).Render(item.Title())}
+	}
+
+	desc := item.Description()
+	if nl := strings.IndexByte(desc, '\n'); nl >= 0 {
+		desc = desc[:nl]
+	}
+
+	return []string{
+		titleStyle.MaxWidth(l.width).Render(item.Title()),
+		descStyle.MaxWidth(l.width).Render(desc),
+	}
+}
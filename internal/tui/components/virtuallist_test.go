@@ -0,0 +1,190 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type testItem string
+
+func (i testItem) Title() string       { return string(i) }
+func (i testItem) Description() string { return "desc " + string(i) }
+func (i testItem) FilterValue() string { return string(i) }
+
+func newTestVirtualList(n int) VirtualList {
+	items := make([]list.Item, n)
+	for i := range items {
+		items[i] = testItem(fmt.Sprintf("item-%d", i))
+	}
+	l := NewVirtualList()
+	l.SetSize(60, 20)
+	l.SetItems(items)
+	return l
+}
+
+func TestVirtualListRendersOnlyVisibleRows(t *testing.T) {
+	t.Parallel()
+
+	l := newTestVirtualList(100000)
+	v := l.View()
+
+	if lines := strings.Count(v, "\n") + 1; lines > 20 {
+		t.Fatalf("expected at most 20 lines, got %d", lines)
+	}
+	if !strings.Contains(v, "item-0") {
+		t.Fatalf("expected first item in view:\n%s", v)
+	}
+	if strings.Contains(v, "item-10") {
+		t.Fatalf("expected items beyond the window not to render:\n%s", v)
+	}
+	if !strings.Contains(v, "1/100000") {
+		t.Fatalf("expected position indicator:\n%s", v)
+	}
+}
+
+func TestVirtualListScrollsWithSelection(t *testing.T) {
+	t.Parallel()
+
+	l := newTestVirtualList(50)
+	page := l.pageSize()
+
+	for i := 0; i < page; i++ {
+		l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	}
+	if l.Index() != page {
+		t.Fatalf("Index() = %d, want %d", l.Index(), page)
+	}
+	if !strings.Contains(l.View(), fmt.Sprintf("item-%d", page)) {
+		t.Fatalf("expected selection to be scrolled into view")
+	}
+	if l.index > l.offset+page-1-ScrollBuffer {
+		t.Fatalf("expected %d rows of buffer below selection, offset = %d", ScrollBuffer, l.offset)
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if l.Index() != 49 || !strings.Contains(l.View(), "item-49") {
+		t.Fatalf("expected G to jump to the last item, index = %d", l.Index())
+	}
+
+	l, _ = l.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if l.Index() != 0 || l.offset != 0 {
+		t.Fatalf("expected g to jump to the top, index = %d offset = %d", l.Index(), l.offset)
+	}
+
+	// Shrinking the items keeps the selection in range
+	l.Select(40)
+	l.SetItems(l.Items()[:5])
+	if l.Index() != 4 || l.SelectedItem() == nil {
+		t.Fatalf("expected selection clamped to 4, got %d", l.Index())
+	}
+}
+
+// markDelegate renders selected items with a marker and leaves items
+// named "plain" to the default rendering.
+type markDelegate struct{}
+
+func (markDelegate) Render(item list.Item, selected bool, width int) []string {
+	name := string(item.(testItem))
+	if name == "plain" {
+		return nil
+	}
+	marker := " "
+	if selected {
+		marker = ">"
+	}
+	return []string{marker + name + strings.Repeat("x", width), "row two", "extra row"}
+}
+
+func TestVirtualListDelegate(t *testing.T) {
+	t.Parallel()
+
+	l := NewVirtualList()
+	l.SetSize(20, 20)
+	l.SetItems([]list.Item{testItem("first"), testItem("plain")})
+	l.SetDelegate(markDelegate{})
+
+	lines := strings.Split(l.View(), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected two rows per item plus a spacer, got %d lines:\n%s", len(lines), l.View())
+	}
+	if !strings.HasPrefix(lines[0], ">first") || len(lines[0]) > 20 {
+		t.Errorf("expected the delegate's truncated title row, got %q", lines[0])
+	}
+	if lines[1] != "row two" {
+		t.Errorf("expected the delegate's second row, got %q", lines[1])
+	}
+	if !strings.Contains(lines[3], "plain") || !strings.Contains(lines[4], "desc plain") {
+		t.Errorf("expected nil from the delegate to fall back to the default rows:\n%s", l.View())
+	}
+}
+
+func TestVirtualListCompactDensity(t *testing.T) {
+	t.Parallel()
+
+	l := newTestVirtualList(100)
+	comfortable := strings.Count(l.View(), "desc item-")
+
+	l.SetCompact(true)
+	v := l.View()
+	if got := strings.Count(v, "item-"); got < 2*comfortable {
+		t.Fatalf("expected compact rows to fit at least twice as many items (%d), got %d", 2*comfortable, got)
+	}
+	if strings.Contains(v, "desc ") {
+		t.Fatalf("expected compact rows to omit descriptions:\n%s", v)
+	}
+	if lines := strings.Count(v, "\n") + 1; lines > 20 {
+		t.Fatalf("expected at most 20 lines, got %d", lines)
+	}
+
+	l.Select(50)
+	if !strings.Contains(l.View(), "item-50") {
+		t.Fatalf("expected the selection to scroll into view:\n%s", l.View())
+	}
+}
+
+func TestVirtualListKeepsSelectionByKey(t *testing.T) {
+	t.Parallel()
+
+	// Items are keyed by their number; "header" rows have no key
+	key := func(item list.Item) (int64, bool) {
+		var n int64
+		_, err := fmt.Sscanf(string(item.(testItem)), "item-%d", &n)
+		return n, err == nil
+	}
+	items := func(names ...string) []list.Item {
+		out := make([]list.Item, len(names))
+		for i, name := range names {
+			out[i] = testItem(name)
+		}
+		return out
+	}
+
+	l := NewVirtualList()
+	l.SetSize(60, 20)
+	l.SetItemKey(key)
+	l.SetItems(items("item-1", "item-2", "item-3"))
+	l.Select(1)
+
+	// A new item at the top moves item-2 down a row
+	l.SetItems(items("item-0", "item-1", "item-2", "item-3"))
+	if got := l.SelectedItem(); got != testItem("item-2") {
+		t.Errorf("expected item-2 to stay selected, got %v", got)
+	}
+
+	// item-2 is gone: the item that took its place is selected
+	l.SetItems(items("item-0", "item-1", "item-3"))
+	if got := l.SelectedItem(); got != testItem("item-3") {
+		t.Errorf("expected item-3 to take the place of item-2, got %v", got)
+	}
+
+	// The last item is gone, leaving a header where it was: the nearest
+	// item before it is selected
+	l.SetItems(items("item-0", "item-1", "header"))
+	if got := l.SelectedItem(); got != testItem("item-1") {
+		t.Errorf("expected item-1, the nearest item, got %v", got)
+	}
+}
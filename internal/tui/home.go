@@ -0,0 +1,300 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/goals"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Home statistics (Phase 10: Home).
+//
+// The home menu shows live counts next to its entries, e.g. "Notes (142)",
+// "Todos (9 due today)" and "Focus (2/4 sessions)". Each is a single
+// COUNT query, run when the app starts and whenever Home is opened.
+//
+// Below the menu, filters pinned with H on the notes and todos lists are
+// listed with 1-9 to open them. Their counts run off the UI loop once
+// Home is shown, so a slow text filter never delays the screen; "…"
+// stands in until they arrive.
+//
+// Goals added with `flowState goals add` follow, each with a progress bar
+// for the current week or month.
+
+// homeCounts are the counts shown in the home menu.
+type homeCounts struct {
+	notes         int
+	openTodos     int
+	dueToday      int // Open todos due today or overdue
+	focusSessions int // Sessions completed today
+	inbox         int
+	starred       int // Starred notes and todos
+	doneToday     int // Todos completed today
+	habitsDone    int // Habits checked off today
+	habits        int
+}
+
+// loadHomeCounts refreshes the home menu counts. Counts that fail to load
+// are left at zero; the menu is still usable without them.
+func (m *Model) loadHomeCounts() {
+	if m.store == nil {
+		return
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	tomorrow := today.AddDate(0, 0, 1)
+
+	var c homeCounts
+	c.notes, _ = m.store.CountNotes(sqlite.NoteQuery{})
+	c.inbox, _ = m.store.CountNotes(sqlite.NoteQuery{Tags: []string{screens.InboxTag}})
+	resurfaced, _ := m.store.CountDueReminders(now, screens.InboxTag)
+	c.inbox += resurfaced
+	c.openTodos, _ = m.store.CountTodos(sqlite.TodoQuery{Open: true})
+	c.dueToday, _ = m.store.CountTodos(sqlite.TodoQuery{Open: true, DueBy: tomorrow})
+	c.focusSessions, _ = m.store.CountCompletedSessions(today, tomorrow)
+	starredNotes, _ := m.store.CountNotes(sqlite.NoteQuery{Starred: true})
+	starredTodos, _ := m.store.CountTodos(sqlite.TodoQuery{Starred: true})
+	c.starred = starredNotes + starredTodos
+	c.doneToday, _ = m.store.CountTodos(sqlite.TodoQuery{CompletedSince: today})
+	c.habitsDone, c.habits, _ = m.store.CountHabitsChecked(today)
+	m.homeCounts = c
+	if m.safeMode() {
+		// Goals and pins run arbitrary filters; safe mode leaves them out
+		return
+	}
+	m.goals, _ = goals.MeasureAll(m.store, now)
+	m.loadPins()
+}
+
+// pinCountsMsg carries the pin counts for the pins loaded as seq.
+type pinCountsMsg struct {
+	seq    int
+	counts map[int64]int // By pin ID; pins that failed to count are absent
+}
+
+// loadPins reloads the pinned filters and marks their counts as pending.
+func (m *Model) loadPins() {
+	m.pins, _ = m.store.ListPins()
+	if len(m.pins) > models.MaxPins {
+		m.pins = m.pins[:models.MaxPins]
+	}
+	m.pinCounts = nil
+	m.pinSeq++
+	m.pinsPending = len(m.pins) > 0
+}
+
+// pinCountsCmd counts the items behind each pin once Home is showing, or
+// returns nil when there is nothing to count.
+func (m *Model) pinCountsCmd() tea.Cmd {
+	if !m.pinsPending || m.currentScreen != ScreenHome {
+		return nil
+	}
+	m.pinsPending = false
+	store, pins, seq := m.store, m.pins, m.pinSeq
+	staleNotes, staleTodos := m.config.StaleNoteAge(), m.config.StaleTodoAge()
+	return func() tea.Msg {
+		counts := make(map[int64]int, len(pins))
+		for _, pin := range pins {
+			if n, err := screens.CountPin(store, pin, staleNotes, staleTodos); err == nil {
+				counts[pin.ID] = n
+			}
+		}
+		return pinCountsMsg{seq: seq, counts: counts}
+	}
+}
+
+// updateHome handles keys on the home screen: 1-9 open a pinned filter,
+// c the Done screen, d Diagnostics, h Habits, m Model, p Plugins and t
+// Tags.
+func (m *Model) updateHome(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || len(key.Runes) != 1 || key.Alt {
+		return nil
+	}
+	r := key.Runes[0]
+	switch r {
+	case 'c':
+		m.navigate(ScreenDone)
+		return nil
+	case 'd':
+		m.navigate(ScreenDiagnostics)
+		return nil
+	case 'h':
+		m.navigate(ScreenHabits)
+		return nil
+	case 'm':
+		m.navigate(ScreenModel)
+		return nil
+	case 'p':
+		m.navigate(ScreenPlugins)
+		return nil
+	case 't':
+		m.navigate(ScreenTags)
+		return nil
+	}
+	if r < '1' || r > '9' {
+		return nil
+	}
+	m.openPin(int(r - '1'))
+	return nil
+}
+
+// openPin shows the i-th pinned filter on its screen.
+func (m *Model) openPin(i int) {
+	if i >= len(m.pins) {
+		return
+	}
+	pin := m.pins[i]
+	switch pin.Screen {
+	case screens.PinNotes:
+		m.navigate(ScreenNotes)
+		m.notesScreen.ApplyPin(pin.Filter)
+	case screens.PinTodos:
+		m.navigate(ScreenTodos)
+		m.todosScreen.ApplyPin(pin.Filter)
+	}
+}
+
+// focusTarget is the number of work sessions that makes up the daily
+// focus goal, or 0 when no goal is set.
+func (m *Model) focusTarget() int {
+	if m.config == nil || m.config.DailyFocusGoalMinutes <= 0 || m.focusScreen == nil {
+		return 0
+	}
+	work := max(m.focusScreen.WorkMinutes(), 1)
+	return (m.config.DailyFocusGoalMinutes + work - 1) / work
+}
+
+// homeMenuItem is one entry in the home menu.
+type homeMenuItem struct {
+	key, name, count, description string
+}
+
+// homeMenuItems lists the home menu entries with their counts.
+func (m *Model) homeMenuItems() []homeMenuItem {
+	c := m.homeCounts
+
+	todos := fmt.Sprintf("%d open", c.openTodos)
+	if c.dueToday > 0 {
+		todos = fmt.Sprintf("%d due today", c.dueToday)
+	}
+	focus := fmt.Sprintf("%d today", c.focusSessions)
+	if target := m.focusTarget(); target > 0 {
+		focus = fmt.Sprintf("%d/%d sessions", c.focusSessions, target)
+	}
+
+	items := []homeMenuItem{
+		{"Ctrl+N", "Notes", fmt.Sprint(c.notes), "Capture and organize your thoughts"},
+		{"Ctrl+T", "Todos", todos, "Track your tasks and priorities"},
+		{"Ctrl+F", "Focus", focus, "Pomodoro timer for deep work"},
+		{"Ctrl+P", "Planner", "", "Plan your week day by day"},
+		{"Ctrl+O", "Inbox", fmt.Sprint(c.inbox), "Triage your quick captures"},
+		{"Alt+S", "Starred", fmt.Sprint(c.starred), "Your starred notes and todos"},
+		{"Ctrl+/", "Search", "", "Find anything with semantic search"},
+		{"c", "Done", fmt.Sprintf("%d today", c.doneToday), "Todos completed today and this week"},
+		{"h", "Habits", fmt.Sprintf("%d/%d today", c.habitsDone, c.habits), "Daily check-offs with streaks"},
+		{"t", "Tags", "", "Tag colors, icons and default priorities"},
+		{"d", "Diagnostics", "", "Database size, row counts and file paths"},
+		{"m", "Model", m.modelStatus(), "Download the semantic search model"},
+	}
+	// Listed once plugins are installed; p works either way
+	if n := m.pluginsScreen.Count(); n > 0 {
+		items = append(items, homeMenuItem{"p", "Plugins", fmt.Sprint(n), "Actions of the installed plugins"})
+	}
+	return items
+}
+
+// renderHomeMenu renders the menu entries with the descriptions aligned.
+func (m *Model) renderHomeMenu() string {
+	countStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	items := m.homeMenuItems()
+	labels := make([]string, len(items))
+	width := 0
+	for i, item := range items {
+		labels[i] = styles.KeyHint(item.key, item.name)
+		if item.count != "" {
+			labels[i] += " " + countStyle.Render("("+item.count+")")
+		}
+		width = max(width, lipgloss.Width(labels[i]))
+	}
+
+	rows := make([]string, 0, len(items)+2)
+	rows = append(rows, "")
+	for i, item := range items {
+		pad := strings.Repeat(" ", width-lipgloss.Width(labels[i]))
+		rows = append(rows, styles.MenuItemStyle.Render(labels[i]+pad+" - "+item.description))
+	}
+	rows = append(rows, "")
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderHomePins renders the pinned filters with their keys and counts,
+// or "" when nothing is pinned.
+func (m *Model) renderHomePins() string {
+	if len(m.pins) == 0 {
+		return ""
+	}
+	countStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	rows := make([]string, 0, len(m.pins)+2)
+	rows = append(rows, styles.MenuItemStyle.Render(countStyle.Italic(true).Render("📌 Pinned")))
+	for i, pin := range m.pins {
+		icon := "📝"
+		if pin.Screen == screens.PinTodos {
+			icon = "✅"
+		}
+		count := "…"
+		if m.pinCounts != nil {
+			count = "?"
+			if n, ok := m.pinCounts[pin.ID]; ok {
+				count = fmt.Sprint(n)
+			}
+		}
+		label := styles.KeyHint(fmt.Sprint(i+1), icon+" "+pin.Label)
+		rows = append(rows, styles.MenuItemStyle.Render(label+" "+countStyle.Render("("+count+")")))
+	}
+	rows = append(rows, "")
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderHomeGoals renders each goal with a progress bar, or "" when there
+// are no goals.
+func (m *Model) renderHomeGoals() string {
+	if len(m.goals) == 0 {
+		return ""
+	}
+	countStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	titleWidth := 0
+	for _, p := range m.goals {
+		titleWidth = max(titleWidth, lipgloss.Width(p.Goal.Title))
+	}
+	titleWidth = min(titleWidth, 32)
+
+	rows := make([]string, 0, len(m.goals)+2)
+	rows = append(rows, styles.MenuItemStyle.Render(countStyle.Italic(true).Render("🎯 Goals")))
+	for _, p := range m.goals {
+		title := p.Goal.Title
+		if lipgloss.Width(title) > titleWidth {
+			title = ansi.Truncate(title, titleWidth, "…")
+		}
+		title = lipgloss.NewStyle().Width(titleWidth).Render(title)
+		count := p.Count()
+		if p.Done() {
+			count += " ✓"
+		}
+		rows = append(rows, styles.MenuItemStyle.Render(title+"  "+styles.VaporwaveProgressBar(p.Fraction(), 20)+"  "+countStyle.Render(count)))
+	}
+	rows = append(rows, "")
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Search indexing (Phase 5: Semantic Search).
+//
+// Notes created or edited since startup are not indexed yet, so opening
+// Search refreshes the index. Embedding every changed note can take a
+// while, so it runs as a command off the UI thread; at most one runs at
+// a time, and opening Search again meanwhile queues another pass.
+
+// indexedMsg reports that a background indexing pass finished.
+type indexedMsg struct{}
+
+// indexCmd returns the pending indexing pass as a command, or nil when
+// none is pending or one is still running.
+func (m *Model) indexCmd() tea.Cmd {
+	if !m.indexPending || m.indexing {
+		return nil
+	}
+	m.indexPending = false
+	if m.semantic == nil || m.store.ReadOnly() || m.safeMode() {
+		return nil
+	}
+	m.indexing = true
+	semantic := m.semantic
+	return func() tea.Msg {
+		// Best-effort: a failed pass leaves the old vectors searchable.
+		_ = semantic.IndexAllNotes()
+		return indexedMsg{}
+	}
+}
@@ -16,6 +16,8 @@ const (
 	KeyFocus       = "Ctrl+F" // Navigate to Focus screen
 	KeySearch      = "Ctrl+/" // Navigate to Search screen
 	KeyMindMap     = "Ctrl+G" // Navigate to Mind Map screen
+	KeyPlanner     = "Ctrl+P" // Navigate to Week Planner screen
+	KeyInbox       = "Ctrl+O" // Navigate to Inbox screen
 	KeyQuickCap    = "Ctrl+X" // Open Quick Capture modal
 	KeyLinks       = "Ctrl+L" // Open Links modal
 	KeyHelp        = "?"      // Toggle help modal
@@ -59,6 +61,8 @@ var GlobalBindings = []Binding{
 	{Key: KeyFocus, Description: "Focus", Primary: false},
 	{Key: KeySearch, Description: "Search", Primary: false},
 	{Key: KeyMindMap, Description: "Mind Map", Primary: false},
+	{Key: KeyPlanner, Description: "Planner", Primary: false},
+	{Key: KeyInbox, Description: "Inbox", Primary: false},
 	{Key: KeyQuickCap, Description: "Quick Capture", Primary: true},
 	{Key: KeyHelp, Description: "Help", Primary: false},
 	{Key: KeyQuit, Description: "Quit", Primary: false},
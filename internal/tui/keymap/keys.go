@@ -110,12 +110,13 @@ func IsModR(msg tea.KeyMsg) bool {
 }
 
 // IsModSlash checks if the key message is Ctrl+/ (or Cmd+/ on macOS).
+// Terminals send Ctrl+/ as 0x1F, which Bubble Tea reports as "ctrl+_".
 func IsModSlash(msg tea.KeyMsg) bool {
 	key := strings.ToLower(msg.String())
 	if IsMacOS() {
-		return key == "cmd+/" || key == "ctrl+/"
+		return key == "cmd+/" || key == "ctrl+/" || key == "ctrl+_"
 	}
-	return key == "ctrl+/"
+	return key == "ctrl+/" || key == "ctrl+_"
 }
 
 // IsModG checks if the key message is Ctrl+G (or Cmd+G on macOS).
@@ -127,6 +128,26 @@ func IsModG(msg tea.KeyMsg) bool {
 	return key == "ctrl+g"
 }
 
+// IsModP checks if the key message is Ctrl+P (or Cmd+P on macOS).
+// Used for opening the week planner.
+func IsModP(msg tea.KeyMsg) bool {
+	key := strings.ToLower(msg.String())
+	if IsMacOS() {
+		return key == "cmd+p" || key == "ctrl+p"
+	}
+	return key == "ctrl+p"
+}
+
+// IsModO checks if the key message is Ctrl+O (or Cmd+O on macOS).
+// Used for opening the inbox.
+func IsModO(msg tea.KeyMsg) bool {
+	key := strings.ToLower(msg.String())
+	if IsMacOS() {
+		return key == "cmd+o" || key == "ctrl+o"
+	}
+	return key == "ctrl+o"
+}
+
 // IsModE checks if the key message is Ctrl+E (or Cmd+E on macOS).
 // Used for toggling markdown preview in notes.
 func IsModE(msg tea.KeyMsg) bool {
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Startup maintenance (Phase 4: Robustness).
+//
+// With maintenance_days set, the app runs the database housekeeping of
+// `flowState maintenance` before the first frame when the last run is
+// that many days old, and says what it did in a toast. A read-only
+// attach never runs it.
+
+// runStartupMaintenance runs the housekeeping if it is due and returns
+// the toast describing it, or "" when it did not run.
+func runStartupMaintenance(store *sqlite.Store, days int) string {
+	due, err := store.MaintenanceDue(days, time.Now())
+	if err != nil || !due {
+		return ""
+	}
+	report, err := store.Maintain()
+	if errors.Is(err, sqlite.ErrIntegrity) {
+		log.Printf("maintenance: integrity check failed: %v", report.Problems)
+		return "⚠ " + report.Summary() + " (run flowState maintenance)"
+	}
+	if err != nil {
+		log.Printf("maintenance: %v", err)
+		return "⚠ Maintenance failed: " + err.Error()
+	}
+	log.Printf("maintenance: %s in %s", report.Summary(), report.Duration)
+	return "🧹 " + report.Summary()
+}
+
+// startupToastCmd shows the toast left by startup work, once.
+func (m *Model) startupToastCmd() tea.Cmd {
+	if m.startupToast == "" {
+		return nil
+	}
+	text := m.startupToast
+	m.startupToast = ""
+	return m.showToast(text)
+}
@@ -0,0 +1,61 @@
+package app
+
+// First-run model download (Phase 5: Semantic Search).
+//
+// The first time the app starts with embeddings_enabled and no model
+// downloaded, it opens on the Model screen (see screens/model.go) to
+// offer the download. The offer is made once: skipping it is remembered
+// in the settings table, and m on Home opens the screen any time. A
+// read-only attach and safe mode never make it.
+//
+// When embedding_model changed since the search index was built, startup
+// skips indexing and opens the Model screen asking to rebuild the index
+// instead (see offerIndexRebuild); until then semantic search says so.
+
+// modelOfferedKey is the settings key set once the download was offered.
+const modelOfferedKey = "model.offered"
+
+// offerModelDownload opens the Model screen if the model is missing and
+// has not been offered before.
+func (m *Model) offerModelDownload() {
+	cfg := m.config
+	if !cfg.EmbeddingsEnabled || cfg.ReadOnly || cfg.SafeMode || m.embedder == nil || m.embedder.HasModel() {
+		return
+	}
+	if _, offered, err := m.store.GetSetting(modelOfferedKey); err != nil || offered {
+		return
+	}
+	if err := m.store.SetSetting(modelOfferedKey, "1"); err != nil {
+		return
+	}
+	m.modelScreen.SetFirstRun(true)
+	m.navigate(ScreenModel)
+}
+
+// modelStatus is the Model entry's count on Home: whether the model is
+// there or downloading.
+func (m *Model) modelStatus() string {
+	switch {
+	case m.modelScreen != nil && m.modelScreen.Downloading():
+		return "downloading"
+	case m.embedder != nil && m.embedder.HasModel():
+		return "installed"
+	}
+	return "not downloaded"
+}
+
+// offerIndexRebuild opens the Model screen asking to rebuild the search
+// index if it was built with another embedding model. It reports whether
+// it did.
+func (m *Model) offerIndexRebuild() bool {
+	cfg := m.config
+	if cfg.ReadOnly || cfg.SafeMode || m.semantic == nil {
+		return false
+	}
+	if _, stale, err := m.semantic.NeedsRebuild(); err != nil || !stale {
+		return false
+	}
+	m.modelScreen.OfferRebuild(true)
+	m.navigate(ScreenModel)
+	return true
+}
@@ -0,0 +1,169 @@
+package app
+
+// Navigation history (Phase 10: Navigation).
+//
+// Every screen change goes through navigate, which remembers the screen
+// being left together with its selected note or todo. Esc on a screen's
+// base view (the screen sends screens.BackMsg) and Alt+← return to it with
+// the selection restored, so search → note → linked todo unwinds step by
+// step; Alt+→ goes forward again. With nothing to go back to, Esc returns
+// Home.
+
+// maxNavHistory bounds the back and forward stacks.
+const maxNavHistory = 50
+
+// navEntry is a place in the history: a screen and the note or todo
+// selected on it (0 for none).
+type navEntry struct {
+	screen Screen
+	itemID int64
+}
+
+// here returns the current screen and selection as a history entry.
+func (m *Model) here() navEntry {
+	e := navEntry{screen: m.currentScreen}
+	switch m.currentScreen {
+	case ScreenNotes:
+		if note := m.notesScreen.GetSelectedNote(); note != nil {
+			e.itemID = note.ID
+		}
+	case ScreenTodos:
+		if todo := m.todosScreen.GetSelectedTodo(); todo != nil {
+			e.itemID = todo.ID
+		}
+	}
+	return e
+}
+
+// navigate switches to screen, remembering the current place so Back
+// returns to it. Re-entering the current screen only reloads it.
+func (m *Model) navigate(screen Screen) {
+	if screen != m.currentScreen {
+		m.backStack = pushNav(m.backStack, m.here())
+		m.forwardStack = nil
+	}
+	m.enterScreen(screen)
+}
+
+// goBack returns to the previous place, or Home when there is none.
+func (m *Model) goBack() {
+	if len(m.backStack) == 0 {
+		if m.currentScreen != ScreenHome {
+			m.navigate(ScreenHome)
+		}
+		return
+	}
+	prev := m.backStack[len(m.backStack)-1]
+	m.backStack = m.backStack[:len(m.backStack)-1]
+	m.forwardStack = pushNav(m.forwardStack, m.here())
+	m.restore(prev)
+}
+
+// goForward revisits the place Back last left, if any.
+func (m *Model) goForward() {
+	if len(m.forwardStack) == 0 {
+		return
+	}
+	next := m.forwardStack[len(m.forwardStack)-1]
+	m.forwardStack = m.forwardStack[:len(m.forwardStack)-1]
+	m.backStack = pushNav(m.backStack, m.here())
+	m.restore(next)
+}
+
+// restore enters e's screen and reselects its item.
+func (m *Model) restore(e navEntry) {
+	m.enterScreen(e.screen)
+	if e.itemID == 0 {
+		return
+	}
+	switch e.screen {
+	case ScreenNotes:
+		m.notesScreen.SelectNoteByID(e.itemID)
+	case ScreenTodos:
+		m.todosScreen.SelectTodoByID(e.itemID)
+	}
+}
+
+// pushNav appends e to stack, dropping the oldest entry when full.
+func pushNav(stack []navEntry, e navEntry) []navEntry {
+	if len(stack) >= maxNavHistory {
+		stack = stack[1:]
+	}
+	return append(stack, e)
+}
+
+// enterScreen shows screen and refreshes what it displays.
+func (m *Model) enterScreen(screen Screen) {
+	m.currentScreen = screen
+	switch screen {
+	case ScreenHome:
+		m.status = "Home"
+		m.loadHomeCounts()
+	case ScreenNotes:
+		m.status = "Notes"
+		_ = m.notesScreen.LoadNotes()
+		m.notesScreen.RefreshPreview()
+	case ScreenTodos:
+		m.status = "Todos"
+		m.todosScreen.LoadTodos()
+	case ScreenFocus:
+		m.status = "Focus"
+		if m.focusScreen != nil {
+			_ = m.focusScreen.LoadHistory()
+		}
+	case ScreenSearch:
+		m.status = "Search"
+		// Index notes changed since startup in the background (see
+		// indexing.go).
+		m.indexPending = true
+	case ScreenMindMap:
+		m.status = "Mind Map"
+		if m.mindMapScreen != nil {
+			_ = m.mindMapScreen.LoadGraph()
+		}
+	case ScreenPlanner:
+		m.status = "Planner"
+		if m.plannerScreen != nil {
+			_ = m.plannerScreen.LoadTodos()
+		}
+	case ScreenProjects:
+		m.status = "Projects"
+		if m.projectsScreen != nil {
+			_ = m.projectsScreen.LoadProjects()
+		}
+	case ScreenInbox:
+		m.status = "Inbox"
+		if m.inboxScreen != nil {
+			_ = m.inboxScreen.LoadInbox()
+		}
+	case ScreenStarred:
+		m.status = "Starred"
+		if m.starredScreen != nil {
+			_ = m.starredScreen.LoadStarred()
+		}
+	case ScreenDiagnostics:
+		m.status = "Diagnostics"
+		if m.diagnosticsScreen != nil {
+			_ = m.diagnosticsScreen.LoadStats()
+		}
+	case ScreenDone:
+		m.status = "Done"
+		if m.doneScreen != nil {
+			_ = m.doneScreen.LoadTodos()
+		}
+	case ScreenHabits:
+		m.status = "Habits"
+		if m.habitsScreen != nil {
+			_ = m.habitsScreen.LoadHabits()
+		}
+	case ScreenPlugins:
+		m.status = "Plugins"
+	case ScreenTags:
+		m.status = "Tags"
+		if m.tagsScreen != nil {
+			_ = m.tagsScreen.LoadTags()
+		}
+	case ScreenModel:
+		m.status = "Model"
+	}
+}
@@ -0,0 +1,200 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Quick switcher (Phase 10: Navigation).
+//
+// The app remembers the notes and todos most recently opened (previewed
+// or edited) on any tab, newest first. Alt+R shows them in a small
+// overlay with the previous item selected, like switching buffers in an
+// editor: pressing it again moves down the stack, ↑ moves back up, Enter
+// (or 1-9) opens the item in its preview and Esc closes the overlay.
+// Ctrl+Tab would be the usual key, but Bubble Tea does not report it.
+
+// maxRecent bounds the recently opened list.
+const maxRecent = 10
+
+// recentItem is a recently opened note or todo.
+type recentItem struct {
+	kind string // "note" or "todo"
+	id   int64
+}
+
+// recentEntry is a row of the switcher overlay.
+type recentEntry struct {
+	item  recentItem
+	title string
+}
+
+// switcher is the open quick switcher overlay.
+type switcher struct {
+	entries []recentEntry
+	index   int
+}
+
+// openedItem returns the note or todo open on the current screen, if any.
+func (m *Model) openedItem() (recentItem, bool) {
+	switch m.currentScreen {
+	case ScreenNotes:
+		if id := m.notesScreen.OpenedNoteID(); id > 0 {
+			return recentItem{kind: "note", id: id}, true
+		}
+	case ScreenTodos:
+		if id := m.todosScreen.OpenedTodoID(); id > 0 {
+			return recentItem{kind: "todo", id: id}, true
+		}
+	}
+	return recentItem{}, false
+}
+
+// trackRecent moves the item open on the current screen to the front of
+// the recently opened list.
+func (m *Model) trackRecent() {
+	item, ok := m.openedItem()
+	if !ok || len(m.recent) > 0 && m.recent[0] == item {
+		return
+	}
+	list := []recentItem{item}
+	for _, r := range m.recent {
+		if r != item && len(list) < maxRecent {
+			list = append(list, r)
+		}
+	}
+	m.recent = list
+}
+
+// openSwitcher shows the overlay with the item before the newest
+// selected. Items deleted since they were opened are forgotten.
+func (m *Model) openSwitcher() tea.Cmd {
+	var entries []recentEntry
+	var kept []recentItem
+	for _, r := range m.recent {
+		title, ok := m.recentTitle(r)
+		if !ok {
+			continue
+		}
+		kept = append(kept, r)
+		entries = append(entries, recentEntry{item: r, title: title})
+	}
+	m.recent = kept
+	if len(entries) == 0 {
+		return m.showToast("No recently opened notes or todos")
+	}
+	m.switcher = &switcher{entries: entries}
+	if len(entries) > 1 {
+		m.switcher.index = 1
+	}
+	return nil
+}
+
+// recentTitle looks up r's current title; ok is false once it is deleted.
+func (m *Model) recentTitle(r recentItem) (string, bool) {
+	if r.kind == "note" {
+		note, err := m.store.GetNote(r.id)
+		if err != nil || note == nil {
+			return "", false
+		}
+		return note.Title, true
+	}
+	todo, err := m.store.GetTodo(r.id)
+	if err != nil || todo == nil {
+		return "", false
+	}
+	return todo.Title, true
+}
+
+// updateSwitcher handles a message while the overlay is open.
+func (m *Model) updateSwitcher(msg tea.Msg) {
+	s := m.switcher
+	n := len(s.entries)
+	if isSwitcherKey(msg) {
+		s.index = (s.index + 1) % n
+		return
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return
+	}
+	switch k := key.String(); k {
+	case "tab", "down", "j":
+		s.index = (s.index + 1) % n
+	case "shift+tab", "up", "k":
+		s.index = (s.index - 1 + n) % n
+	case "esc":
+		m.switcher = nil
+	case "enter":
+		m.switchTo(s.entries[s.index].item)
+	default:
+		if len(k) == 1 && k[0] >= '1' && k[0] <= '9' && int(k[0]-'1') < n {
+			m.switchTo(s.entries[k[0]-'1'].item)
+		}
+	}
+}
+
+// switchTo closes the overlay and opens item in its preview.
+func (m *Model) switchTo(item recentItem) {
+	m.switcher = nil
+	if item.kind == "note" {
+		m.navigate(ScreenNotes)
+		m.notesScreen.SelectNoteByID(item.id)
+		m.notesScreen.PreviewNote(item.id)
+	} else {
+		m.navigate(ScreenTodos)
+		m.todosScreen.SelectTodoByID(item.id)
+		m.todosScreen.PreviewTodo(item.id)
+	}
+	m.trackRecent()
+}
+
+// isSwitcherKey reports whether msg is Alt+R, the switcher key.
+func isSwitcherKey(msg tea.Msg) bool {
+	key, ok := msg.(tea.KeyMsg)
+	return ok && key.String() == "alt+r"
+}
+
+// switcherView renders the overlay, centered.
+func (m *Model) switcherView() string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.SecondaryColor)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	width := min(60, max(m.width-12, 20))
+	rows := make([]string, len(m.switcher.entries))
+	for i, e := range m.switcher.entries {
+		icon := "📝"
+		if e.item.kind == "todo" {
+			icon = "☐"
+		}
+		row := fmt.Sprintf("%d %s %s", i+1, icon, e.title)
+		if lipgloss.Width(row) > width {
+			row = ansi.Truncate(row, width, "…")
+		}
+		if i == m.switcher.index {
+			rows[i] = styles.SelectedItemStyle.Render("▶ " + row)
+		} else {
+			rows[i] = "  " + row
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		titleStyle.Render("Recent"),
+		"",
+		strings.Join(rows, "\n"),
+		"",
+		mutedStyle.Render("Alt+R/↓ next · ↑ back · Enter open · Esc close"),
+	)
+	box := lipgloss.NewStyle().
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(styles.AccentColor).
+		Padding(1, 2).
+		Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
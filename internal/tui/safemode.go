@@ -0,0 +1,20 @@
+package app
+
+// Safe mode (Phase 4: Robustness).
+//
+// `flowState --safe-mode` starts the app with everything that runs on its
+// own, rather than on a key press, turned off: semantic indexing and tag
+// suggestions, plugins, the focus blocker hooks, webhook notifications,
+// the share and summarize commands, startup maintenance, the user
+// spell-check dictionary, and the goals and pinned filter counts on Home.
+// A corrupt index, a hanging plugin, a slow filter or a broken dictionary
+// then cannot stand between you and your notes. The status bar says so for the whole
+// run; quit and start normally to turn it all back on.
+
+// safeModeToast is shown once a safe mode run starts.
+const safeModeToast = "🛟 Safe mode: indexing, plugins, hooks and Home extras are off"
+
+// safeMode reports whether the app was started with --safe-mode.
+func (m *Model) safeMode() bool {
+	return m.config != nil && m.config.SafeMode
+}
@@ -0,0 +1,111 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Burndown (Phase 6: Projects).
+//
+// Pressing b on the Projects screen charts how many of the selected
+// project's (or tag's) todos were open at the end of each of the last
+// weeks, so a project that keeps growing is easy to tell from one that
+// is shrinking. The weekly snapshots are rebuilt from the todos
+// themselves: a todo counts as open from its creation until its
+// completed_at, the completion log the Done screen reads. The current
+// week is measured now.
+
+const (
+	defaultBurndownWeeks = 8
+	minBurndownWeeks     = 2
+	maxBurndownWeeks     = 26
+)
+
+// burndownPoint is the number of open todos at the end of one week.
+type burndownPoint struct {
+	week time.Time // Start of the week
+	open int
+}
+
+// burndown counts the todos open at the end of each of the last weeks,
+// oldest first; the current week is counted at now.
+func burndown(todos []models.Todo, weeks int, now time.Time) []burndownPoint {
+	current := datefmt.StartOfWeek(now)
+	points := make([]burndownPoint, weeks)
+	for i := range points {
+		week := current.AddDate(0, 0, -7*(weeks-1-i))
+		at := week.AddDate(0, 0, 7)
+		if at.After(now) {
+			at = now
+		}
+		points[i].week = week
+		for _, todo := range todos {
+			if openAt(todo, at) {
+				points[i].open++
+			}
+		}
+	}
+	return points
+}
+
+// openAt reports whether todo existed and was not yet completed at t. A
+// completed todo without a completion time counts as completed when it
+// was last updated.
+func openAt(todo models.Todo, t time.Time) bool {
+	if todo.CreatedAt.After(t) {
+		return false
+	}
+	if todo.Status != models.TodoStatusCompleted {
+		return true
+	}
+	completed := todo.UpdatedAt
+	if todo.CompletedAt != nil {
+		completed = *todo.CompletedAt
+	}
+	return completed.After(t)
+}
+
+// renderBurndown draws one bar per week, scaled to the busiest week, and
+// a line saying how the open count changed over the period.
+func renderBurndown(name string, points []burndownPoint, width int) string {
+	title := styles.SelectedItemStyle.Render(fmt.Sprintf("Open todos in %s, last %d weeks", name, len(points)))
+	if len(points) == 0 {
+		return title
+	}
+
+	most := 0
+	for _, p := range points {
+		most = max(most, p.open)
+	}
+	barWidth := max(min(width-24, 40), 10)
+
+	rows := []string{title, ""}
+	for _, p := range points {
+		progress := 0.0
+		if most > 0 {
+			progress = float64(p.open) / float64(most)
+		}
+		label := lipgloss.NewStyle().Width(12).Render(datefmt.Short(p.week))
+		rows = append(rows, label+styles.VaporwaveProgressBar(progress, barWidth)+fmt.Sprintf(" %d", p.open))
+	}
+
+	first, last := points[0].open, points[len(points)-1].open
+	var trend string
+	switch {
+	case last < first:
+		trend = fmt.Sprintf("▼ %d fewer open than %d weeks ago", first-last, len(points)-1)
+	case last > first:
+		trend = fmt.Sprintf("▲ %d more open than %d weeks ago", last-first, len(points)-1)
+	default:
+		trend = fmt.Sprintf("No change in %d weeks", len(points)-1)
+	}
+	rows = append(rows, "", styles.SubtitleStyle.Render(trend))
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,36 @@
+package screens
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/clipboard"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Copying references (Phase 10: Sharing).
+//
+// y on a note, in the list or its preview, copies a wikilink to it
+// ("[[Trip plan]]") to paste into another note. Todos have no wikilinks,
+// so y on a todo copies its deep link ("flowstate://todo/7") instead.
+// Like shared URLs, the reference goes to the clipboard through the
+// terminal (see the clipboard package).
+
+// noteReference returns the wikilink to note.
+func noteReference(note *models.Note) string {
+	return "[[" + note.Title + "]]"
+}
+
+// todoReference returns the deep link to todo.
+func todoReference(todo *models.Todo) string {
+	return deeplink.URI(deeplink.KindTodo, todo.ID)
+}
+
+// copyReference copies ref to the clipboard and toasts it.
+func copyReference(ref string) tea.Cmd {
+	text := "📋 Copied " + ref
+	if err := clipboard.Copy(ref); err != nil {
+		text = "Copy failed: " + err.Error()
+	}
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
@@ -0,0 +1,47 @@
+package screens
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/clipboard"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestCopyReferences(t *testing.T) {
+	var copied bytes.Buffer
+	old := clipboard.Output
+	clipboard.Output = &copied
+	defer func() { clipboard.Output = old }()
+	y := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}}
+
+	notes := newTestNotesModel(t)
+	if err := notes.store.CreateNote(&models.Note{Title: "Trip plan", Body: "Pack"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	notes.LoadNotes()
+	_, cmd := notes.Update(y)
+	if copied.String() != clipboard.Sequence("[[Trip plan]]") {
+		t.Errorf("expected the note's wikilink on the clipboard, got %q", copied.String())
+	}
+	if toast, ok := cmd().(ToastMsg); !ok || !strings.Contains(toast.Text, "[[Trip plan]]") {
+		t.Errorf("expected a toast naming the reference, got %#v", toast)
+	}
+
+	todos := newTestTodosModel(t)
+	todo := &models.Todo{Title: "Book flights", Status: models.TodoStatusPending}
+	if err := todos.store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	todos.LoadTodos()
+	copied.Reset()
+	todos.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	todos.Update(y)
+	if want := clipboard.Sequence(fmt.Sprintf("flowstate://todo/%d", todo.ID)); copied.String() != want {
+		t.Errorf("expected the todo's link on the clipboard from its preview, got %q", copied.String())
+	}
+}
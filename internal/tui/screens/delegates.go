@@ -0,0 +1,214 @@
+package screens
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
+)
+
+// Row delegates for the notes and todos lists (Phase 9: Component Library).
+//
+// The default list rendering is plain title/description text. These
+// delegates draw rows with the theme instead:
+//   - Todos: a priority stripe (high, medium, low), a colored status
+//     badge, tag pills, and the due date colored by urgency (overdue in
+//     the error color, due within three days as a warning)
+//   - Notes: muted date, bold title and tag pills; wikilink placeholders
+//     are dimmed behind a 👻 (Phase 3)
+//   - Both: a ★ before the title of starred items, and a muted "stale"
+//     marker on items left untouched past the threshold (Phase 6)
+//
+// The selected row is marked with ▶ and drawn brighter. Completed todos
+// are muted and struck through.
+
+// delegateSeparator joins the parts of a description row.
+func delegateSeparator() string {
+	return lipgloss.NewStyle().Foreground(styles.MutedColor).Render(" • ")
+}
+
+// rowMarker returns the selection marker that starts every row.
+func rowMarker(selected bool) string {
+	if selected {
+		return lipgloss.NewStyle().Foreground(styles.AccentColor).Bold(true).Render("▶ ")
+	}
+	return "  "
+}
+
+// rowTitleStyle returns the style for a row's title.
+func rowTitleStyle(selected bool) lipgloss.Style {
+	if selected {
+		return lipgloss.NewStyle().Foreground(styles.HighlightColor).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(styles.TextColor)
+}
+
+// tagPills renders up to max tags as pills, with "…" for the rest.
+func tagPills(tags []string, max int) string {
+	pill := styles.TagStyle.UnsetMarginRight()
+	pills := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		if i == max {
+			pills = append(pills, lipgloss.NewStyle().Foreground(styles.MutedColor).Render("…"))
+			break
+		}
+		pills = append(pills, styles.ColorTag(pill, tag).Render(styles.TagText(tag)))
+	}
+	return strings.Join(pills, " ")
+}
+
+// todoDelegate renders TodoItem rows. Other items (project headers) use
+// the default rendering.
+type todoDelegate struct {
+	staleAfter time.Duration // Mark open todos unchanged this long; 0 = never
+}
+
+// priorityStripe returns the colored bar for a todo's priority. Plain
+// output names the priority, as the bar only shows it by color.
+func priorityStripe(priority models.TodoPriority) string {
+	if styles.PlainOutput() {
+		return "(" + priorityName(priority) + " priority)"
+	}
+	color := styles.BorderColor
+	switch priority {
+	case models.TodoPriorityHigh:
+		color = styles.ErrorColor
+	case models.TodoPriorityMedium:
+		color = styles.WarningColor
+	case models.TodoPriorityLow:
+		color = styles.SuccessColor
+	}
+	return lipgloss.NewStyle().Foreground(color).Render("▌")
+}
+
+// statusBadge returns the colored status icon for a todo.
+func statusBadge(status models.TodoStatus) string {
+	return lipgloss.NewStyle().Foreground(statusColor(status)).Bold(true).Render(todoStatusIcon(status))
+}
+
+// statusColor returns the color of a todo status: the configured one,
+// or the theme's.
+func statusColor(status models.TodoStatus) lipgloss.TerminalColor {
+	if c := workflow.Get(status).Color; c != "" {
+		return lipgloss.Color(c)
+	}
+	switch status {
+	case models.TodoStatusCompleted:
+		return styles.SuccessColor
+	case models.TodoStatusInProgress:
+		return styles.WarningColor
+	case models.TodoStatusPending:
+		return styles.MutedColor
+	}
+	return styles.AccentColor
+}
+
+// dueStyle colors a due label by urgency.
+func dueStyle(daysUntil int) lipgloss.Style {
+	switch {
+	case daysUntil < 0:
+		return lipgloss.NewStyle().Foreground(styles.ErrorColor).Bold(true)
+	case daysUntil <= 3:
+		return lipgloss.NewStyle().Foreground(styles.WarningColor)
+	default:
+		return lipgloss.NewStyle().Foreground(styles.MutedColor)
+	}
+}
+
+func (d todoDelegate) Render(item list.Item, selected bool, width int) []string {
+	ti, ok := item.(TodoItem)
+	if !ok {
+		return nil
+	}
+	todo := ti.todo
+	done := todo.Status == models.TodoStatusCompleted
+
+	titleStyle := rowTitleStyle(selected)
+	if done {
+		titleStyle = titleStyle.Foreground(styles.MutedColor).Strikethrough(true)
+	}
+	title := rowMarker(selected) + priorityStripe(todo.Priority) + " " +
+		statusBadge(todo.Status) + " " + starBadge(todo.Starred) + titleStyle.Render(todo.Title)
+
+	muted := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	var parts []string
+	if todo.Project != "" {
+		parts = append(parts, lipgloss.NewStyle().Foreground(styles.SecondaryColor).Render("📁 "+todo.Project))
+	}
+	if pills := tagPills(extractTagsFromTodo(&todo), 3); pills != "" {
+		parts = append(parts, pills)
+	}
+	if est := models.FormatMinutes(todo.EstimateMinutes); est != "" {
+		parts = append(parts, muted.Render("⏱ "+est))
+	}
+	if todo.IsSnoozed(time.Now()) {
+		parts = append(parts, muted.Render("💤 until "+datefmt.ShortDateTime(*todo.DeferredUntil)))
+	}
+	if todo.DueDate != nil && !done {
+		label, days := dueLabel(*todo.DueDate, time.Now())
+		parts = append(parts, dueStyle(days).Render(label))
+	}
+	if !done {
+		if badge := staleBadge(todo.UpdatedAt, d.staleAfter); badge != "" {
+			parts = append(parts, badge)
+		}
+	}
+	parts = append(parts, muted.Render("edited "+datefmt.Age(todo.UpdatedAt)))
+	if todo.Description != "" {
+		preview := strings.TrimSpace(tagPattern.ReplaceAllString(todo.Description, ""))
+		if len(preview) > 40 {
+			preview = preview[:40] + "..."
+		}
+		if preview != "" {
+			parts = append(parts, muted.Render(preview))
+		}
+	}
+	return []string{title, "    " + strings.Join(parts, delegateSeparator())}
+}
+
+// noteDelegate renders NoteItem rows.
+type noteDelegate struct {
+	staleAfter time.Duration // Mark notes untouched this long; 0 = never
+}
+
+func (d noteDelegate) Render(item list.Item, selected bool, width int) []string {
+	ni, ok := item.(NoteItem)
+	if !ok {
+		return nil
+	}
+	note := ni.note
+
+	date := lipgloss.NewStyle().Foreground(styles.MutedColor).Render(datefmt.Age(note.UpdatedAt))
+	titleStyle := rowTitleStyle(selected)
+	placeholder := note.IsPlaceholder()
+	if placeholder {
+		titleStyle = titleStyle.Foreground(styles.MutedColor).Italic(true)
+	}
+	title := rowMarker(selected) + date + " " + starBadge(note.Starred)
+	if placeholder {
+		title += "👻 "
+	}
+	title += titleStyle.Render(note.Title)
+	if pills := tagPills(note.Tags, 4); pills != "" {
+		title += " " + pills
+	}
+	if badge := staleBadge(note.UpdatedAt, d.staleAfter); badge != "" {
+		title += " " + badge
+	}
+
+	descStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	if placeholder {
+		return []string{title, "  " + descStyle.Italic(true).Render("Placeholder for a [[wikilink]], not written yet")}
+	}
+	if selected {
+		descStyle = descStyle.Foreground(styles.TextColor)
+	}
+	desc, _, _ := strings.Cut(ni.Description(), "\n")
+	return []string{title, "  " + descStyle.Render(desc)}
+}
@@ -0,0 +1,87 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+func TestTodoDelegateColors(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	overdue := time.Now().AddDate(0, 0, -3)
+	later := startOfDay(time.Now()).AddDate(0, 0, 20)
+	tests := []struct {
+		name string
+		todo models.Todo
+		want []string
+	}{
+		{
+			name: "high priority overdue",
+			todo: models.Todo{Title: "Ship", Priority: models.TodoPriorityHigh, DueDate: &overdue},
+			want: []string{
+				lipgloss.NewStyle().Foreground(styles.ErrorColor).Render("▌"),
+				lipgloss.NewStyle().Foreground(styles.ErrorColor).Bold(true).Render("Overdue 3 days"),
+			},
+		},
+		{
+			name: "low priority due later",
+			todo: models.Todo{Title: "Plan", Priority: models.TodoPriorityLow, DueDate: &later},
+			want: []string{
+				lipgloss.NewStyle().Foreground(styles.SuccessColor).Render("▌"),
+				lipgloss.NewStyle().Foreground(styles.MutedColor).Render("Due " + later.Format("Jan 2")),
+			},
+		},
+		{
+			name: "tags as pills",
+			todo: models.Todo{Title: "Tagged #work", Description: "notes #home"},
+			want: []string{styles.TagStyle.UnsetMarginRight().Render("#work")},
+		},
+		{
+			name: "in progress badge",
+			todo: models.Todo{Title: "Doing", Status: models.TodoStatusInProgress},
+			want: []string{lipgloss.NewStyle().Foreground(styles.WarningColor).Bold(true).Render("◐")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := todoDelegate{}.Render(TodoItem{todo: tt.todo}, false, 80)
+			if len(rows) != 2 {
+				t.Fatalf("expected title and description rows, got %d", len(rows))
+			}
+			got := strings.Join(rows, "\n")
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("expected row to contain %q, got %q", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDelegatesMarkSelection(t *testing.T) {
+	todo := TodoItem{todo: models.Todo{Title: "Ship"}}
+	if rows := (todoDelegate{}).Render(todo, true, 80); !strings.Contains(rows[0], "▶") {
+		t.Errorf("expected selected todo row to be marked, got %q", rows[0])
+	}
+	if rows := (todoDelegate{}).Render(todo, false, 80); strings.Contains(rows[0], "▶") {
+		t.Errorf("expected unselected todo row to be unmarked, got %q", rows[0])
+	}
+	if rows := (todoDelegate{}).Render(projectHeaderItem{name: "Launch"}, false, 80); rows != nil {
+		t.Errorf("expected project headers to use the default rendering")
+	}
+
+	note := NoteItem{note: models.Note{Title: "Kickoff", Body: "agenda\nmore", Tags: []string{"work"}}}
+	rows := noteDelegate{}.Render(note, true, 80)
+	if !strings.Contains(rows[0], "▶") || !strings.Contains(rows[0], "#work") || strings.Contains(rows[1], "more") {
+		t.Errorf("unexpected note rows %q", rows)
+	}
+}
@@ -0,0 +1,46 @@
+package screens
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+)
+
+// List density (Phase 9: Component Library).
+//
+// Each list can show compact one-line rows or comfortable rows with a
+// description. list_density in config.json sets a screen's default; D
+// toggles it and the choice is stored in the database, where it wins over
+// the default on the next start.
+
+// densityKey is the settings key holding screen's density.
+func densityKey(screen string) string {
+	return "density." + screen
+}
+
+// loadListDensity applies the density stored for screen, or compact (the
+// configured default) when none is stored.
+func loadListDensity(store *sqlite.Store, l *components.VirtualList, screen string, compact bool) {
+	if store != nil {
+		if value, ok, err := store.GetSetting(densityKey(screen)); err == nil && ok {
+			compact = value == config.DensityCompact
+		}
+	}
+	l.SetCompact(compact)
+}
+
+// toggleListDensity switches l's density, stores it for screen and
+// returns a toast naming the new density.
+func toggleListDensity(store *sqlite.Store, l *components.VirtualList, screen string) tea.Cmd {
+	l.SetCompact(!l.Compact())
+	value, text := config.DensityComfortable, "Comfortable rows"
+	if l.Compact() {
+		value, text = config.DensityCompact, "Compact rows"
+	}
+	if store != nil {
+		_ = store.SetSetting(densityKey(screen), value)
+	}
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
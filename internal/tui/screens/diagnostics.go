@@ -0,0 +1,195 @@
+package screens
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Diagnostics (Phase 4: Robustness).
+//
+// The Diagnostics screen (d on Home) reports on the database, for
+// tracking down a slow app or deciding what to archive: the file's size
+// and the space VACUUM would reclaim, rows per table, the largest notes,
+// the embedding index, when maintenance last ran, and where flowState
+// keeps its files. The numbers are read when the screen opens; r reads
+// them again.
+
+// diagnosticsLargestNotes is how many of the largest notes are listed.
+const diagnosticsLargestNotes = 5
+
+// DiagnosticsModel is the Diagnostics screen.
+type DiagnosticsModel struct {
+	store *sqlite.Store
+	cfg   *config.Config
+
+	stats           *sqlite.DatabaseStats
+	lastMaintenance time.Time
+	err             error
+	offset          int // First line shown, when the report is taller than the screen
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewDiagnosticsModel creates the Diagnostics screen.
+func NewDiagnosticsModel(store *sqlite.Store, cfg *config.Config) DiagnosticsModel {
+	return DiagnosticsModel{
+		store:   store,
+		cfg:     cfg,
+		header:  components.NewHeader("🩺", "Diagnostics"),
+		helpBar: components.NewHelpBar(components.DiagnosticsHints),
+	}
+}
+
+func (m *DiagnosticsModel) Init() tea.Cmd { return nil }
+
+func (m *DiagnosticsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// HelpSections returns the Diagnostics screen's keys for the help modal.
+func (m *DiagnosticsModel) HelpSections() []components.HelpSection {
+	return components.DiagnosticsHelp
+}
+
+// LoadStats reads the statistics from the database.
+func (m *DiagnosticsModel) LoadStats() error {
+	m.stats, m.err = m.store.DatabaseStats(diagnosticsLargestNotes)
+	if m.err != nil {
+		return m.err
+	}
+	m.lastMaintenance, _ = m.store.LastMaintenance()
+	return nil
+}
+
+func (m *DiagnosticsModel) Update(msg tea.Msg) (DiagnosticsModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return *m, nil
+	}
+	switch key.String() {
+	case "j", "down":
+		m.offset++
+	case "k", "up":
+		m.offset--
+	case "g", "home":
+		m.offset = 0
+	case "r":
+		m.LoadStats()
+		return *m, toastCmd("Diagnostics reloaded")
+	case "esc":
+		return *m, goBack
+	}
+	m.offset = max(min(m.offset, len(m.reportLines())-m.bodyHeight()), 0)
+	return *m, nil
+}
+
+// bodyHeight is how many report lines fit between header and help bar.
+func (m *DiagnosticsModel) bodyHeight() int {
+	return max(m.height-2-lipgloss.Height(m.header.View())-lipgloss.Height(m.helpBar.View())-2, 1)
+}
+
+func (m *DiagnosticsModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	lines := m.reportLines()
+	m.offset = max(min(m.offset, len(lines)-m.bodyHeight()), 0)
+	end := min(m.offset+m.bodyHeight(), len(lines))
+
+	return panel.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		strings.Join(lines[m.offset:end], "\n"),
+		"",
+		m.helpBar.View(),
+	))
+}
+
+// reportLines renders the report a line at a time, for scrolling.
+func (m *DiagnosticsModel) reportLines() []string {
+	if m.err != nil {
+		return []string{components.FieldError("Could not read statistics: " + m.err.Error())}
+	}
+	if m.stats == nil {
+		return []string{styles.SubtitleStyle.Render("Loading…")}
+	}
+	s := m.stats
+	var lines []string
+	section := func(title string) {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, styles.SelectedItemStyle.Render(title))
+	}
+	row := func(label, value string) {
+		lines = append(lines, "  "+styles.SubtitleStyle.Render(fmt.Sprintf("%-18s", label))+" "+value)
+	}
+
+	section("Database")
+	row("File", s.Path)
+	row("Size on disk", sqlite.FormatSize(s.FileSize))
+	free := sqlite.FormatSize(s.FreePages * s.PageSize)
+	row("Pages", fmt.Sprintf("%d of %s (%s free)", s.Pages, sqlite.FormatSize(s.PageSize), free))
+	maintained := "never"
+	if !m.lastMaintenance.IsZero() {
+		maintained = datefmt.Age(m.lastMaintenance)
+	}
+	row("Last maintenance", maintained)
+	if s.FreePages > 0 {
+		lines = append(lines, "  "+styles.HelpStyle.Render("flowState maintenance reclaims the free pages"))
+	}
+
+	section("Rows")
+	for _, t := range s.Tables {
+		row(t.Name, fmt.Sprint(t.Rows))
+	}
+
+	section("Largest notes")
+	if len(s.LargestNotes) == 0 {
+		lines = append(lines, "  "+styles.SubtitleStyle.Render("No notes yet"))
+	}
+	for _, n := range s.LargestNotes {
+		row(sqlite.FormatSize(n.Bytes), truncate(n.Title, max(m.width-30, 10)))
+	}
+
+	section("Embedding index")
+	row("Vectors", fmt.Sprintf("%d %s", s.Vectors, pluralize(s.Vectors, "note", "notes")))
+	row("Size", sqlite.FormatSize(s.VectorBytes))
+	if m.cfg != nil {
+		enabled := "on"
+		if !m.cfg.EmbeddingsEnabled {
+			enabled = "off"
+		}
+		row("Embeddings", enabled)
+	}
+
+	if m.cfg != nil {
+		section("Paths")
+		row("Config", filepath.Join(m.cfg.DataDir, "config.json"))
+		row("Data directory", m.cfg.DataDir)
+		row("Database", m.cfg.DbPath)
+		row("Models", m.cfg.ModelPath)
+		row("Dictionary", m.cfg.DictionaryPath)
+		if log, err := filepath.Abs("debug.log"); err == nil {
+			row("Debug log", log)
+		}
+	}
+	return lines
+}
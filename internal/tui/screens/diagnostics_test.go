@@ -0,0 +1,64 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestDiagnosticsReport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := &config.Config{DataDir: dir, DbPath: filepath.Join(dir, "test.db")}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	for _, note := range []*models.Note{
+		{Title: "Big research dump", Body: strings.Repeat("words ", 400)},
+		{Title: "Tiny", Body: "ok"},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+
+	m := NewDiagnosticsModel(store, cfg)
+	m.SetSize(100, 80)
+	if err := m.LoadStats(); err != nil {
+		t.Fatalf("LoadStats() err = %v", err)
+	}
+
+	v := m.View()
+	for _, want := range []string{"Diagnostics", "Size on disk", "notes", "Big research dump", "2.3 KB", "Last maintenance", "never", cfg.DbPath} {
+		if !strings.Contains(v, want) {
+			t.Errorf("view missing %q:\n%s", want, v)
+		}
+	}
+	if strings.Index(v, "Big research dump") > strings.Index(v, "Tiny") {
+		t.Error("largest note should be listed first")
+	}
+
+	// A short screen scrolls the report
+	m.SetSize(100, 14)
+	top := m.View()
+	for i := 0; i < 5; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	}
+	if m.View() == top {
+		t.Error("j did not scroll the report")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if m.View() != top {
+		t.Error("g did not return to the top")
+	}
+}
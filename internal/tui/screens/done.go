@@ -0,0 +1,195 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Done (Phase 2: Todos).
+//
+// The Done screen (c on Home) reviews what got finished: the todos
+// completed today, or since the start of the week, newest first and
+// grouped by day, with when each was completed, its project and the
+// estimated time they add up to. Tab switches between today and the
+// week. Completion times come from the todos' completed_at column.
+
+// DonePeriod is the stretch of time the Done screen covers.
+type DonePeriod int
+
+const (
+	DoneToday DonePeriod = iota
+	DoneThisWeek
+)
+
+// DoneModel is the Done screen.
+type DoneModel struct {
+	store  *sqlite.Store
+	period DonePeriod
+	todos  []models.Todo
+	err    error
+	offset int // First line shown, when the list is taller than the screen
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewDoneModel creates the Done screen.
+func NewDoneModel(store *sqlite.Store) DoneModel {
+	return DoneModel{
+		store:   store,
+		header:  components.NewHeader("🏁", "Done"),
+		helpBar: components.NewHelpBar(components.DoneHints),
+	}
+}
+
+func (m *DoneModel) Init() tea.Cmd { return nil }
+
+func (m *DoneModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// HelpSections returns the Done screen's keys for the help modal.
+func (m *DoneModel) HelpSections() []components.HelpSection {
+	return components.DoneHelp
+}
+
+// Period returns the stretch of time shown.
+func (m *DoneModel) Period() DonePeriod {
+	return m.period
+}
+
+// since returns when the shown period starts.
+func (m *DoneModel) since(now time.Time) time.Time {
+	if m.period == DoneThisWeek {
+		return datefmt.StartOfWeek(now)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+// LoadTodos reads the todos completed in the shown period.
+func (m *DoneModel) LoadTodos() error {
+	m.todos, m.err = m.store.QueryTodos(sqlite.TodoQuery{
+		CompletedSince: m.since(time.Now()),
+		Sort:           sqlite.TodoSortCompletedDesc,
+	})
+	return m.err
+}
+
+func (m *DoneModel) Update(msg tea.Msg) (DoneModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return *m, nil
+	}
+	switch key.String() {
+	case "tab", "w":
+		m.period = (m.period + 1) % 2
+		m.offset = 0
+		m.LoadTodos()
+	case "j", "down":
+		m.offset++
+	case "k", "up":
+		m.offset--
+	case "g", "home":
+		m.offset = 0
+	case "r":
+		m.LoadTodos()
+		return *m, toastCmd("Done list reloaded")
+	case "esc":
+		return *m, goBack
+	}
+	m.offset = max(min(m.offset, len(m.lines())-m.bodyHeight()), 0)
+	return *m, nil
+}
+
+// bodyHeight is how many lines fit between header and help bar.
+func (m *DoneModel) bodyHeight() int {
+	return max(m.height-2-lipgloss.Height(m.header.View())-lipgloss.Height(m.helpBar.View())-2, 1)
+}
+
+func (m *DoneModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	lines := m.lines()
+	m.offset = max(min(m.offset, len(lines)-m.bodyHeight()), 0)
+	end := min(m.offset+m.bodyHeight(), len(lines))
+
+	return panel.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		strings.Join(lines[m.offset:end], "\n"),
+		"",
+		m.helpBar.View(),
+	))
+}
+
+// lines renders the summary and the completed todos a line at a time,
+// for scrolling.
+func (m *DoneModel) lines() []string {
+	tabs := []string{"Today", "This week"}
+	for i, tab := range tabs {
+		if DonePeriod(i) == m.period {
+			tabs[i] = styles.SelectedItemStyle.Render("【" + tab + "】")
+		} else {
+			tabs[i] = styles.SubtitleStyle.Render(" " + tab + " ")
+		}
+	}
+	lines := []string{strings.Join(tabs, " "), ""}
+
+	if m.err != nil {
+		return append(lines, components.FieldError("Could not load completed todos: "+m.err.Error()))
+	}
+	if len(m.todos) == 0 {
+		empty := "Nothing completed today yet"
+		if m.period == DoneThisWeek {
+			empty = "Nothing completed this week yet"
+		}
+		return append(lines, styles.SubtitleStyle.Render(empty))
+	}
+
+	estimate := 0
+	for _, todo := range m.todos {
+		estimate += todo.EstimateMinutes
+	}
+	summary := fmt.Sprintf("%d %s done", len(m.todos), pluralize(len(m.todos), "todo", "todos"))
+	if estimate > 0 {
+		summary += " · " + models.FormatMinutes(estimate) + " estimated"
+	}
+	lines = append(lines, styles.SubtitleStyle.Render(summary))
+
+	day := ""
+	for _, todo := range m.todos {
+		completed := todo.CompletedAt.Local()
+		if m.period == DoneThisWeek {
+			if d := datefmt.WeekdayDate(completed); d != day {
+				day = d
+				lines = append(lines, "", styles.SelectedItemStyle.Render(day))
+			}
+		} else if day == "" {
+			day = "today"
+			lines = append(lines, "")
+		}
+		line := "  " + styles.SubtitleStyle.Render(fmt.Sprintf("%8s", datefmt.Clock(completed))) +
+			"  ✓ " + truncate(todo.Title, max(m.width-30, 10))
+		if todo.Project != "" {
+			line += " " + styles.HelpStyle.Render("("+todo.Project+")")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,56 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestDoneListsCompletedTodos(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store, err := sqlite.New(&config.Config{DataDir: dir, DbPath: filepath.Join(dir, "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	for _, todo := range []*models.Todo{
+		{Title: "Ship the release", Status: models.TodoStatusCompleted, Project: "launch", EstimateMinutes: 30},
+		{Title: "Still open", Status: models.TodoStatusPending},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	m := NewDoneModel(store)
+	m.SetSize(100, 40)
+	if err := m.LoadTodos(); err != nil {
+		t.Fatalf("LoadTodos() err = %v", err)
+	}
+	view := m.View()
+	for _, want := range []string{"1 todo done", "30m estimated", "Ship the release", "(launch)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+	if strings.Contains(view, "Still open") {
+		t.Error("view lists an open todo")
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if updated.Period() != DoneThisWeek {
+		t.Errorf("Period() = %v after Tab, want DoneThisWeek", updated.Period())
+	}
+	if !strings.Contains(updated.View(), "Ship the release") {
+		t.Error("week view is missing the todo completed today")
+	}
+}
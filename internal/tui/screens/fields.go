@@ -0,0 +1,102 @@
+package screens
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Custom fields (Phase 6: Organization).
+//
+// The note preview (p) and todo details (v) list the item's fields,
+// such as client: acme or severity: high. F there asks for "key: value"
+// and sets the field; a key with no value removes it. The / filters on
+// both screens match fields with key:value words, e.g. "invoice
+// client:acme" (see sqlite.ParseFieldFilters).
+
+// fieldEditor is the "key: value" prompt for setting a field on the
+// previewed note or todo.
+type fieldEditor struct {
+	input   components.TextInputModel
+	open    bool
+	errText string
+}
+
+// Open shows the prompt, empty.
+func (e *fieldEditor) Open() {
+	e.input = components.NewTextInput("client: acme (no value removes the field)")
+	e.input.Focus()
+	e.open = true
+	e.errText = ""
+}
+
+// Update handles keys while the prompt is open. On Enter it sets the
+// field on itemType/id and reports true, so the caller reloads the
+// preview's fields.
+func (e *fieldEditor) Update(store *sqlite.Store, itemType string, id int64, msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		e.open = false
+		return false, nil
+	case "enter":
+		key, value, _ := strings.Cut(e.input.Value(), ":")
+		key, ok := sqlite.NormalizeFieldKey(key)
+		if !ok {
+			e.errText = "Start with a field name of letters, digits, _ or -, then a colon"
+			return false, nil
+		}
+		if err := store.SetField(itemType, id, key, value); err != nil {
+			e.errText = "Failed to save: " + err.Error()
+			return false, nil
+		}
+		e.open = false
+		if strings.TrimSpace(value) == "" {
+			return true, toastCmd("Removed field " + key)
+		}
+		return true, toastCmd("Set " + key)
+	}
+	var cmd tea.Cmd
+	e.input, cmd = e.input.Update(msg)
+	return false, cmd
+}
+
+// View renders the prompt and any error under it.
+func (e *fieldEditor) View() string {
+	label := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).Render("Set field:")
+	view := lipgloss.JoinHorizontal(lipgloss.Center, label, " ", e.input.View(), "  ",
+		styles.HelpStyle.Render("[Enter] Save  [Esc] Cancel"))
+	if e.errText != "" {
+		view = lipgloss.JoinVertical(lipgloss.Left, view, components.FieldError(e.errText))
+	}
+	return view
+}
+
+// loadFields returns the fields of itemType/id; a failed read shows none.
+func loadFields(store *sqlite.Store, itemType string, id int64) []models.Field {
+	fields, err := store.GetFields(itemType, id)
+	if err != nil {
+		return nil
+	}
+	return fields
+}
+
+// renderFields renders the "Fields" section of a preview, or "" when
+// the item has none.
+func renderFields(fields []models.Field) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(styles.MutedColor).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor)
+	lines := []string{labelStyle.Render("Fields")}
+	for _, f := range fields {
+		lines = append(lines, keyStyle.Render(f.Key+":")+" "+f.Value)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
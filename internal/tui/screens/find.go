@@ -0,0 +1,230 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Find & replace in the note body editor (Phase 4: UX Overhaul).
+//
+// Ctrl+F in the editor opens a find bar under the body. Matches are
+// case-insensitive and highlighted; Enter or ↓ moves to the next one and
+// ↑ to the previous. Tab switches to the replace field, Ctrl+R replaces
+// the current match and Ctrl+A replaces them all.
+
+const (
+	highlightOn  = "\x1b[7m"
+	highlightOff = "\x1b[27m"
+)
+
+// textMatch is a match in the body: its line and rune column.
+type textMatch struct {
+	line, col int
+}
+
+// Editing reports whether a note is open in the editor, where Ctrl+F
+// finds text instead of opening the Focus screen.
+func (m *NotesListModel) Editing() bool {
+	return m.showCreate
+}
+
+// openFind focuses the body and shows the find bar, keeping the last query.
+func (m *NotesListModel) openFind() {
+	m.titleInput.Blur()
+	m.bodyInput.Focus()
+	m.showFind = true
+	m.findOnReplace = false
+	m.findInput.Focus()
+	m.replaceInput.Blur()
+	m.findFrom(m.cursorMatch())
+}
+
+// updateFind handles keys while the find bar is open.
+func (m *NotesListModel) updateFind(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "ctrl+f":
+		m.showFind = false
+		return nil
+	case "tab", "shift+tab":
+		m.findOnReplace = !m.findOnReplace
+		if m.findOnReplace {
+			m.findInput.Blur()
+			m.replaceInput.Focus()
+		} else {
+			m.replaceInput.Blur()
+			m.findInput.Focus()
+		}
+		return nil
+	case "enter", "down":
+		m.findFrom(m.findIndex + 1)
+		return nil
+	case "up":
+		m.findFrom(m.findIndex - 1)
+		return nil
+	case "ctrl+r":
+		m.replaceCurrent()
+		return nil
+	case "ctrl+a":
+		return m.replaceAll()
+	}
+
+	var cmd tea.Cmd
+	if m.findOnReplace {
+		m.replaceInput, cmd = m.replaceInput.Update(msg)
+		return cmd
+	}
+	query := m.findInput.Value()
+	m.findInput, cmd = m.findInput.Update(msg)
+	if m.findInput.Value() != query {
+		// The query changed: start again from the cursor
+		m.findFrom(m.cursorMatch())
+	}
+	return cmd
+}
+
+// findMatches returns where the query occurs in the body, in order.
+func (m *NotesListModel) findMatches() []textMatch {
+	query := []rune(strings.ToLower(m.findInput.Value()))
+	if len(query) == 0 {
+		return nil
+	}
+	var matches []textMatch
+	for i, line := range strings.Split(m.bodyInput.Value(), "\n") {
+		for _, col := range indexAllFold([]rune(line), query) {
+			matches = append(matches, textMatch{line: i, col: col})
+		}
+	}
+	return matches
+}
+
+// cursorMatch returns the index of the first match at or after the cursor.
+func (m *NotesListModel) cursorMatch() int {
+	row, col := m.bodyInput.Cursor()
+	for i, match := range m.findMatches() {
+		if match.line > row || match.line == row && match.col >= col {
+			return i
+		}
+	}
+	return 0
+}
+
+// findFrom selects match i, wrapping around the ends, and moves the
+// cursor to it.
+func (m *NotesListModel) findFrom(i int) {
+	matches := m.findMatches()
+	if len(matches) == 0 {
+		m.findIndex = 0
+		return
+	}
+	m.findIndex = (i%len(matches) + len(matches)) % len(matches)
+	match := matches[m.findIndex]
+	m.bodyInput.MoveTo(match.line, match.col)
+}
+
+// replaceCurrent replaces the selected match and moves to the next one.
+func (m *NotesListModel) replaceCurrent() {
+	matches := m.findMatches()
+	if len(matches) == 0 {
+		return
+	}
+	match := matches[min(m.findIndex, len(matches)-1)]
+	m.bodyInput.MoveTo(match.line, match.col)
+	end := match.col + len([]rune(m.findInput.Value()))
+	m.bodyInput.ReplaceRange(match.col, end, m.replaceInput.Value())
+	// The next match is now at the cursor, after the replacement
+	m.findFrom(m.cursorMatch())
+}
+
+// replaceAll replaces every match and reports how many there were.
+func (m *NotesListModel) replaceAll() tea.Cmd {
+	matches := m.findMatches()
+	if len(matches) == 0 {
+		return nil
+	}
+	queryLen := len([]rune(m.findInput.Value()))
+	replacement := m.replaceInput.Value()
+	lines := strings.Split(m.bodyInput.Value(), "\n")
+	// Replace from the last match back so earlier columns stay valid
+	for i := len(matches) - 1; i >= 0; i-- {
+		match := matches[i]
+		line := []rune(lines[match.line])
+		lines[match.line] = string(line[:match.col]) + replacement + string(line[match.col+queryLen:])
+	}
+	row, col := m.bodyInput.Cursor()
+	m.bodyInput.SetValue(strings.Join(lines, "\n"))
+	m.bodyInput.MoveTo(row, col)
+	m.findIndex = 0
+	text := fmt.Sprintf("Replaced %d matches", len(matches))
+	if len(matches) == 1 {
+		text = "Replaced 1 match"
+	}
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
+
+// indexAllFold returns the start of each non-overlapping, case-insensitive
+// occurrence of query (already lowercase) in text.
+func indexAllFold(text, query []rune) []int {
+	var starts []int
+	for i := 0; i+len(query) <= len(text); {
+		if equalFold(text[i:i+len(query)], query) {
+			starts = append(starts, i)
+			i += len(query)
+		} else {
+			i++
+		}
+	}
+	return starts
+}
+
+// equalFold reports whether a lowercases to b.
+func equalFold(a, b []rune) bool {
+	for i := range a {
+		if unicode.ToLower(a[i]) != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// highlightMatches highlights the query wherever it shows in the rendered
+// body, as the spell-checker underlines words.
+func (m *NotesListModel) highlightMatches(view string) string {
+	query := []rune(strings.ToLower(m.findInput.Value()))
+	if len(query) == 0 {
+		return view
+	}
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		visible, runeAt := visibleRunes(line)
+		var spans [][2]int
+		for _, start := range indexAllFold(visible, query) {
+			spans = append(spans, [2]int{start, start + len(query)})
+		}
+		lines[i] = wrapSpans(line, runeAt, spans, highlightOn, highlightOff)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderFind renders the find bar shown under the body.
+func (m *NotesListModel) renderFind() string {
+	labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	count := "No matches"
+	if matches := m.findMatches(); len(matches) > 0 {
+		count = fmt.Sprintf("%d/%d", m.findIndex+1, len(matches))
+	} else if m.findInput.Value() == "" {
+		count = ""
+	}
+	m.helpBar.SetHints(components.NotesFindHints)
+	return lipgloss.JoinHorizontal(lipgloss.Center,
+		labelStyle.Render("Find:"), " ", m.findInput.View(), "  ",
+		labelStyle.Render("Replace:"), " ", m.replaceInput.View(), "  ",
+		styles.HelpStyle.Render(count),
+	)
+}
@@ -0,0 +1,83 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNotesFindAndReplace(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	var cmd tea.Cmd
+	update := func(msg tea.KeyMsg) {
+		var mm tea.Model
+		mm, cmd = m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	typeText := func(s string) {
+		for _, r := range s {
+			update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.bodyInput.SetValue("Teh cat\nthe dog and teh bird\nTEH end")
+
+	update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if !m.Editing() || !m.showFind {
+		t.Fatalf("expected Ctrl+F to open the find bar in the editor")
+	}
+	typeText("teh")
+	if v := m.View(); !strings.Contains(v, "1/3") || !strings.Contains(v, highlightOn+"Teh"+highlightOff) {
+		t.Fatalf("expected 3 highlighted matches, got:\n%s", v)
+	}
+	if row, col := m.bodyInput.Cursor(); row != 0 || col != 0 {
+		t.Fatalf("cursor = %d:%d, want the first match at 0:0", row, col)
+	}
+
+	// Next, previous and wrap-around
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if row, col := m.bodyInput.Cursor(); row != 1 || col != 12 {
+		t.Fatalf("cursor after Enter = %d:%d, want 1:12", row, col)
+	}
+	update(tea.KeyMsg{Type: tea.KeyUp})
+	update(tea.KeyMsg{Type: tea.KeyUp})
+	if row, _ := m.bodyInput.Cursor(); row != 2 || !strings.Contains(m.View(), "3/3") {
+		t.Fatalf("expected ↑ from the first match to wrap to the last, at row %d", row)
+	}
+
+	// Replace the current match, then the rest
+	update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("the")
+	update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if got, want := m.bodyInput.Value(), "Teh cat\nthe dog and teh bird\nthe end"; got != want {
+		t.Fatalf("after Ctrl+R body = %q, want %q", got, want)
+	}
+	update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if got, want := m.bodyInput.Value(), "the cat\nthe dog and the bird\nthe end"; got != want {
+		t.Fatalf("after Ctrl+A body = %q, want %q", got, want)
+	}
+	if msg, ok := cmd().(ToastMsg); !ok || msg.Text != "Replaced 2 matches" {
+		t.Fatalf("Ctrl+A toast = %#v", msg)
+	}
+
+	// Esc closes the bar, not the editor
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showFind || !m.showCreate {
+		t.Fatalf("expected Esc to close only the find bar")
+	}
+}
+
+func TestIndexAllFold(t *testing.T) {
+	t.Parallel()
+
+	got := indexAllFold([]rune("aAa ÉtÉ été"), []rune("été"))
+	if len(got) != 2 || got[0] != 4 || got[1] != 8 {
+		t.Fatalf("indexAllFold() = %v, want [4 8]", got)
+	}
+	if got := indexAllFold([]rune("aaaa"), []rune("aa")); len(got) != 2 {
+		t.Fatalf("overlapping matches counted: %v", got)
+	}
+}
@@ -8,14 +8,23 @@
 package screens
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/hooks"
 	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/notify"
+	"github.com/Jericoz-JC/flowState-CLI/internal/plugins"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
@@ -30,7 +39,8 @@ const (
 	FocusModePaused
 	FocusModeBreak
 	FocusModeHistory
-	FocusModeDuration // Duration picker
+	FocusModeDuration     // Duration picker
+	FocusModeBreakPending // Work done; waiting for the user to start the break
 )
 
 // Duration presets in minutes
@@ -39,8 +49,16 @@ var (
 	BreakDurations = []int{5, 10, 15}
 )
 
-// tickMsg is sent every second when timer is running.
-type tickMsg time.Time
+// FocusTickMsg is sent every second when timer is running. It is
+// exported so integration tests can fast-forward a session.
+type FocusTickMsg time.Time
+
+// FocusHookMsg reports the result of the distraction blocker hooks. The
+// app routes it to the focus screen from any screen.
+type FocusHookMsg struct {
+	Block bool // true for the block commands, false for unblock
+	Err   error
+}
 
 // clearFeedbackMsg is sent to clear the "Saved" indicator after a delay.
 type clearFeedbackMsg struct{}
@@ -65,7 +83,19 @@ type autoExitDurationMsg struct {
 //   - h: Toggle history view
 //   - d: Change duration (opens duration picker)
 //   - b: Skip to break / Skip break
-//   - Esc: Return to idle / Cancel action
+//   - 1-9: Tick off a break checklist item (during break)
+//   - 1-5, Esc: Rate the energy of the session just finished, or skip it
+//     (when energy_prompt is on; see focus_energy.go)
+//   - t / x: Add a side timer / cancel the next one (see timers.go)
+//   - a / A: Plan a focus block for later today / cancel the next one;
+//     Enter/Esc start or skip a block when it is due (see focus_plan.go)
+//   - l: Label the session ("writing", "#clientA") and start it
+//   - z: Toggle the zen view during a session (see focus_zen.go)
+//   - f: Cycle the history label filter (in history)
+//   - Enter/Space, /: Collapse a day, jump to a date (in history; see focus_history.go)
+//   - m, d, P: Mark, delete (with confirmation), clean up old sessions (in history)
+//   - s/Enter, Esc: Start or skip the break when it does not auto-start
+//   - Esc: Return to idle / Cancel action; in idle, back to the previous screen
 type FocusModel struct {
 	store          *sqlite.Store
 	mode           FocusMode
@@ -76,7 +106,7 @@ type FocusModel struct {
 	startTime      time.Time     // When current session started
 	currentSession *models.FocusSession
 	sessions       []models.FocusSession
-	sessionList    list.Model
+	sessionList    components.VirtualList
 	stats          *sqlite.SessionStats
 	header         components.Header
 	helpBar        components.HelpBar
@@ -88,18 +118,94 @@ type FocusModel struct {
 	durationJustChanged bool   // Show "Saved" indicator briefly
 	lastChangedField    string // "work" or "break" - which field was just changed
 	autoExitSequence    int    // Sequence number for auto-exit timer cancellation
+
+	// Distraction blocker hooks (Phase 5): block commands run when a work
+	// session starts, unblock commands when it completes or is cancelled
+	blockCommands   []string
+	unblockCommands []string
+	hookTimeout     time.Duration
+	blocked         bool          // Block commands started; an unblock is owed
+	blockDone       chan struct{} // Closed when the block commands finish
+	hookErr         string        // Last hook failure, shown under the timer
+
+	// Break checklist (Phase 5): short activities ticked off during breaks
+	breakActivities []string
+	breakChecked    []bool
+
+	// Side timers (Phase 5): named countdowns alongside the session
+	sideTimers     []sideTimer
+	nextTimerID    int
+	timersTicking  bool // A FocusTimerTickMsg chain is running
+	showTimerInput bool
+	timerInput     components.TextInputModel
+	timerInputErr  string
+
+	// Session labels (Phase 5): the label is kept for the next session
+	// until changed; historyLabel filters the history list ("" = all)
+	label          string
+	showLabelInput bool
+	labelInput     components.TextInputModel
+	historyLabel   string
+
+	// History grouping (Phase 5): collapsed days keyed YYYY-MM-DD, and
+	// the jump-to-date prompt
+	collapsedDays    map[string]bool
+	showHistoryJump  bool
+	historyJumpInput components.TextInputModel
+	historyJumpErr   string
+
+	// History cleanup (Phase 5): marked sessions, the cleanup prompt and a
+	// delete waiting for confirmation
+	markedSessions map[int64]bool
+	showPurge      bool
+	purgeInput     components.TextInputModel
+	purgeErr       string
+	confirmDelete  components.ConfirmModal
+
+	// Phase transitions (Phase 5): whether breaks and the next work
+	// session start on their own, and the terminal bell when a phase ends
+	autoStartBreak bool
+	autoStartWork  bool
+	chime          bool
+	bell           io.Writer
+	phaseNotice    string // Shown in idle after a break ends, until the next key
+
+	// Webhook milestones (Phase 10): see focus_notify.go
+	notifier  *notify.Notifier
+	dailyGoal int // Daily focus goal in minutes; 0 for none
+
+	// Plugins (Phase 10): get session.completed events; nil for none
+	plugins *plugins.Host
+
+	// Focus journal (Phase 5): see focus_journal.go; nil when off
+	journalTitle func(time.Time) string
+
+	// Zen view (Phase 5): see focus_zen.go
+	zen bool
+
+	// Energy ratings (Phase 5): see focus_energy.go; rateSessionID is the
+	// session the prompt is open for (0 = closed)
+	energyPrompt  bool
+	rateSessionID int64
+
+	// Planned sessions (Phase 5): see focus_plan.go. planSeq invalidates
+	// the due ticks of earlier loads; activePlanID is the block the
+	// running session was started from (0 = none)
+	plans         []models.PlannedSession
+	planSeq       int
+	duePlan       *models.PlannedSession
+	activePlanID  int64
+	showPlanInput bool
+	planInput     components.TextInputModel
+	planInputErr  string
+	planStats     sqlite.PlanStats // This week's blocks, for the history stats
 }
 
 // NewFocusModel creates a new focus session screen.
 func NewFocusModel(store *sqlite.Store) FocusModel {
-	items := []list.Item{}
-	delegate := list.NewDefaultDelegate()
-
-	l := list.New(items, delegate, 0, 0)
-	l.Title = ""
-	l.SetShowHelp(false)
-	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	// Phase 4: Performance - Only visible rows are rendered
+	l := components.NewVirtualList()
+	l.SetItemKey(sessionItemKey)
 
 	return FocusModel{
 		store:         store,
@@ -111,6 +217,10 @@ func NewFocusModel(store *sqlite.Store) FocusModel {
 		sessionList:   l,
 		header:        components.NewHeader("🍅", "Focus Sessions"),
 		helpBar:       components.NewHelpBar(components.FocusIdleHints),
+		confirmDelete: components.NewConfirmModal(),
+		// Breaks start on their own unless configured otherwise
+		autoStartBreak: true,
+		bell:           os.Stdout,
 	}
 }
 
@@ -123,9 +233,131 @@ func (m *FocusModel) Init() tea.Cmd {
 func (m *FocusModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.sessionList.SetSize(width-4, height-14)
+	m.sessionList.SetSize(width-4, height) // The history view fits it to the free rows
 	m.header.SetWidth(width - 4)
 	m.helpBar.SetWidth(width - 4)
+	m.confirmDelete.SetWidth(width - 4)
+}
+
+// WorkMinutes returns the length of a work session in minutes.
+func (m *FocusModel) WorkMinutes() int {
+	return m.workDuration
+}
+
+// TitleStatus returns the remaining time and an icon for the current
+// phase ("18:42 🍅", "04:10 ☕", "18:42 ⏸"), or "" when no session runs.
+// The app shows it in the terminal title.
+func (m *FocusModel) TitleStatus() string {
+	var icon string
+	switch m.mode {
+	case FocusModeRunning:
+		icon = "🍅"
+	case FocusModePaused:
+		icon = "⏸"
+	case FocusModeBreak:
+		icon = "☕"
+	default:
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d %s", int(m.remaining.Minutes()), int(m.remaining.Seconds())%60, icon)
+}
+
+// SetHooks configures the distraction blocker commands. A zero timeout
+// uses hooks.DefaultTimeout.
+func (m *FocusModel) SetHooks(block, unblock []string, timeout time.Duration) {
+	m.blockCommands = block
+	m.unblockCommands = unblock
+	m.hookTimeout = timeout
+}
+
+// SetAutoStart configures the phase transitions: whether a break starts
+// as soon as a work session completes, whether the next work session
+// starts when a break ends, and whether to ring the terminal bell then.
+func (m *FocusModel) SetAutoStart(breaks, work, chime bool) {
+	m.autoStartBreak = breaks
+	m.autoStartWork = work
+	m.chime = chime
+}
+
+// HelpSections returns every focus key, grouped for the help modal.
+func (m *FocusModel) HelpSections() []components.HelpSection {
+	return components.FocusHelp
+}
+
+// SetListDensity sets the configured default row density of the history
+// list; a density chosen with D and stored in the database takes precedence.
+func (m *FocusModel) SetListDensity(compact bool) {
+	loadListDensity(m.store, &m.sessionList, "focus_history", compact)
+}
+
+// chimeCmd rings the terminal bell when chimes are on.
+func (m *FocusModel) chimeCmd() tea.Cmd {
+	if !m.chime || m.bell == nil {
+		return nil
+	}
+	w := m.bell
+	return func() tea.Msg {
+		fmt.Fprint(w, "\a")
+		return nil
+	}
+}
+
+// SetBreakActivities sets the checklist shown during breaks. An empty list
+// hides it.
+func (m *FocusModel) SetBreakActivities(activities []string) {
+	m.breakActivities = activities
+	m.breakChecked = make([]bool, len(activities))
+}
+
+// startBreak switches to break mode with a fresh checklist.
+func (m *FocusModel) startBreak() {
+	m.mode = FocusModeBreak
+	m.remaining = time.Duration(m.breakDuration) * time.Minute
+	m.totalDuration = m.remaining
+	m.breakChecked = make([]bool, len(m.breakActivities))
+}
+
+// blockCmd runs the block commands for a starting work session.
+func (m *FocusModel) blockCmd() tea.Cmd {
+	if len(m.blockCommands) == 0 && len(m.unblockCommands) == 0 {
+		return nil
+	}
+	m.blocked = true
+	done := make(chan struct{})
+	m.blockDone = done
+	commands, timeout := m.blockCommands, m.hookTimeout
+	return func() tea.Msg {
+		defer close(done)
+		return FocusHookMsg{Block: true, Err: hooks.Run(context.Background(), commands, timeout)}
+	}
+}
+
+// unblockCmd runs the unblock commands once the work session is over. It
+// waits for block commands still in flight so the two never interleave.
+func (m *FocusModel) unblockCmd() tea.Cmd {
+	if !m.blocked {
+		return nil
+	}
+	m.blocked = false
+	done, commands, timeout := m.blockDone, m.unblockCommands, m.hookTimeout
+	return func() tea.Msg {
+		if done != nil {
+			<-done
+		}
+		return FocusHookMsg{Block: false, Err: hooks.Run(context.Background(), commands, timeout)}
+	}
+}
+
+// ReleaseBlock runs the unblock commands synchronously if a work session
+// still holds the block. The app calls it on exit so quitting mid-session
+// never leaves distractions blocked.
+func (m *FocusModel) ReleaseBlock() error {
+	if cmd := m.unblockCmd(); cmd != nil {
+		if msg, ok := cmd().(FocusHookMsg); ok {
+			return msg.Err
+		}
+	}
+	return nil
 }
 
 // LoadHistory loads session history from the database.
@@ -136,11 +368,17 @@ func (m *FocusModel) LoadHistory() error {
 	}
 	m.sessions = sessions
 
-	items := make([]list.Item, 0, len(sessions))
+	shown := make([]models.FocusSession, 0, len(sessions))
 	for _, session := range sessions {
-		items = append(items, SessionItem{session: session})
+		if m.historyLabel != "" && session.Label != m.historyLabel {
+			continue
+		}
+		shown = append(shown, session)
 	}
-	m.sessionList.SetItems(items)
+	sort.SliceStable(shown, func(i, j int) bool {
+		return shown[i].StartTime.After(shown[j].StartTime)
+	})
+	m.sessionList.SetItems(groupSessionsByDay(shown, m.collapsedDays, m.markedSessions))
 
 	// Load stats
 	stats, err := m.store.GetSessionStats()
@@ -148,6 +386,8 @@ func (m *FocusModel) LoadHistory() error {
 		return err
 	}
 	m.stats = stats
+	now := time.Now()
+	m.planStats, _ = m.store.GetPlanStats(datefmt.StartOfWeek(now), now)
 
 	return nil
 }
@@ -155,7 +395,7 @@ func (m *FocusModel) LoadHistory() error {
 // tickCmd returns a command that sends a tick every second.
 func tickCmd() tea.Cmd {
 	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
-		return tickMsg(t)
+		return FocusTickMsg(t)
 	})
 }
 
@@ -163,8 +403,12 @@ func tickCmd() tea.Cmd {
 func (m *FocusModel) Update(msg tea.Msg) (FocusModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if cmd, ok := m.updateSideTimers(msg); ok {
+		return *m, cmd
+	}
+
 	switch msg := msg.(type) {
-	case tickMsg:
+	case FocusTickMsg:
 		if m.mode == FocusModeRunning || m.mode == FocusModeBreak {
 			m.remaining -= time.Second
 			if m.remaining <= 0 {
@@ -173,12 +417,31 @@ func (m *FocusModel) Update(msg tea.Msg) (FocusModel, tea.Cmd) {
 			cmds = append(cmds, tickCmd())
 		}
 
+	case PlannedSessionDueMsg:
+		return *m, m.planDue(msg)
+
+	case FocusHookMsg:
+		m.hookErr = ""
+		if msg.Err != nil {
+			action := "Unblock"
+			if msg.Block {
+				action = "Block"
+			}
+			m.hookErr = fmt.Sprintf("%s hook failed: %v", action, msg.Err)
+		}
+		return *m, nil
+
 	case clearFeedbackMsg:
 		// Clear the "Saved" indicator
 		m.durationJustChanged = false
 		m.lastChangedField = ""
 		return *m, nil
 
+	case sessionsDeletedMsg:
+		m.markedSessions = nil
+		m.LoadHistory()
+		return *m, nil
+
 	case autoExitDurationMsg:
 		// Auto-exit duration picker if sequence matches (not cancelled by new input)
 		if m.mode == FocusModeDuration && msg.sequence == m.autoExitSequence {
@@ -189,6 +452,32 @@ func (m *FocusModel) Update(msg tea.Msg) (FocusModel, tea.Cmd) {
 		return *m, nil
 
 	case tea.KeyMsg:
+		if m.showTimerInput {
+			return m.handleTimerPrompt(msg)
+		}
+		if m.showLabelInput {
+			return m.handleLabelPrompt(msg)
+		}
+		if m.showPlanInput {
+			return m.handlePlanPrompt(msg)
+		}
+		if m.confirmDelete.IsOpen() {
+			return *m, m.confirmDelete.Update(msg)
+		}
+		if m.showHistoryJump {
+			return m.handleHistoryJump(msg)
+		}
+		if m.showPurge {
+			return m.handlePurgePrompt(msg)
+		}
+		if m.rateSessionID != 0 {
+			if cmd, ok := m.handleEnergyPrompt(msg); ok {
+				return *m, cmd
+			}
+		}
+		if cmd, ok := m.handleDuePlan(msg); ok {
+			return *m, cmd
+		}
 		switch m.mode {
 		case FocusModeDuration:
 			return m.handleDurationInput(msg)
@@ -202,35 +491,118 @@ func (m *FocusModel) Update(msg tea.Msg) (FocusModel, tea.Cmd) {
 	return *m, tea.Batch(cmds...)
 }
 
+// startWorkSession starts a work session with the current label.
+func (m *FocusModel) startWorkSession() tea.Cmd {
+	// Create in-memory session for tracking (NOT saved to DB yet)
+	// Session will only be saved when completed successfully
+	m.currentSession = &models.FocusSession{
+		StartTime: time.Now(),
+		Duration:  m.workDuration * 60, // Store in seconds
+		Status:    models.SessionStatusRunning,
+		Label:     m.label,
+	}
+	m.remaining = time.Duration(m.workDuration) * time.Minute
+	m.totalDuration = m.remaining
+	m.startTime = time.Now()
+	m.mode = FocusModeRunning
+	return tea.Batch(tickCmd(), m.blockCmd())
+}
+
+// handleLabelPrompt handles keys while the session label prompt is open.
+// Enter saves the label (empty clears it) and starts the session.
+func (m *FocusModel) handleLabelPrompt(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showLabelInput = false
+		return *m, nil
+	case "enter":
+		m.showLabelInput = false
+		m.label = normalizeSessionLabel(m.labelInput.Value())
+		return *m, m.startWorkSession()
+	}
+	var cmd tea.Cmd
+	m.labelInput, cmd = m.labelInput.Update(msg)
+	return *m, cmd
+}
+
+// normalizeSessionLabel trims a typed label and drops a leading #, so
+// "#writing" and "writing" group together.
+func normalizeSessionLabel(label string) string {
+	return strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(label), "#")), " ")
+}
+
+// cycleHistoryLabel moves the history filter to the next label with
+// completed sessions, wrapping back to all sessions.
+func (m *FocusModel) cycleHistoryLabel() {
+	var labels []string
+	if m.stats != nil {
+		for _, ls := range m.stats.Labels {
+			labels = append(labels, ls.Label)
+		}
+	}
+	next := ""
+	if m.historyLabel == "" {
+		if len(labels) > 0 {
+			next = labels[0]
+		}
+	} else {
+		for i, l := range labels {
+			if l == m.historyLabel && i+1 < len(labels) {
+				next = labels[i+1]
+			}
+		}
+	}
+	m.historyLabel = next
+	m.LoadHistory()
+}
+
 // handleTimerComplete handles when the timer reaches zero.
 func (m *FocusModel) handleTimerComplete() (FocusModel, tea.Cmd) {
 	if m.mode == FocusModeRunning {
 		// Work session completed - NOW save to database
 		now := time.Now()
+		var journal tea.Cmd
 		if m.currentSession != nil {
 			m.currentSession.EndTime = &now
 			m.currentSession.Status = models.SessionStatusCompleted
 			// Create the session in DB only on completion
 			if err := m.store.CreateSession(m.currentSession); err != nil {
 				// Log error but continue (session tracking is best-effort)
+			} else {
+				m.recordPlanned(m.currentSession)
+				m.notifySessionDone(m.currentSession)
+				journal = m.journalSession(m.currentSession)
+				m.askEnergy(m.currentSession)
 			}
 		}
 
-		// Start break
-		m.mode = FocusModeBreak
-		m.remaining = time.Duration(m.breakDuration) * time.Minute
-		m.totalDuration = m.remaining
 		m.currentSession = nil
+		cmds := []tea.Cmd{m.unblockCmd(), m.chimeCmd(), journal}
 
-		return *m, tickCmd()
+		// Start break, or wait for the user to start it
+		if m.autoStartBreak {
+			m.startBreak()
+			cmds = append(cmds, tickCmd())
+		} else {
+			m.mode = FocusModeBreakPending
+			m.remaining = time.Duration(m.breakDuration) * time.Minute
+			m.totalDuration = m.remaining
+		}
+
+		return *m, tea.Batch(cmds...)
 	} else if m.mode == FocusModeBreak {
+		m.LoadHistory() // Refresh stats
+		if m.autoStartWork {
+			return *m, tea.Batch(m.startWorkSession(), m.chimeCmd())
+		}
+
 		// Break completed - return to idle
 		m.mode = FocusModeIdle
 		m.remaining = time.Duration(m.workDuration) * time.Minute
 		m.totalDuration = m.remaining
-		m.LoadHistory() // Refresh stats
+		m.phaseNotice = "Break over — press s to start the next session"
 
-		return *m, nil
+		return *m, m.chimeCmd()
 	}
 
 	return *m, nil
@@ -238,21 +610,17 @@ func (m *FocusModel) handleTimerComplete() (FocusModel, tea.Cmd) {
 
 // handleTimerInput handles keyboard input for timer modes (idle, running, paused, break).
 func (m *FocusModel) handleTimerInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	m.phaseNotice = ""
+	if m.mode == FocusModeBreakPending {
+		return m.handleBreakPending(msg)
+	}
+
 	switch msg.String() {
 	case "s":
 		if m.mode == FocusModeIdle || m.mode == FocusModePaused {
 			// Start or resume timer
 			if m.mode == FocusModeIdle {
-				// Create in-memory session for tracking (NOT saved to DB yet)
-				// Session will only be saved when completed successfully
-				m.currentSession = &models.FocusSession{
-					StartTime: time.Now(),
-					Duration:  m.workDuration * 60, // Store in seconds
-					Status:    models.SessionStatusRunning,
-				}
-				m.remaining = time.Duration(m.workDuration) * time.Minute
-				m.totalDuration = m.remaining
-				m.startTime = time.Now()
+				return *m, m.startWorkSession()
 			}
 			m.mode = FocusModeRunning
 			return *m, tickCmd()
@@ -269,11 +637,12 @@ func (m *FocusModel) handleTimerInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 			// Cancel current session - just discard, don't save to DB
 			// (cancelled sessions are not worth tracking)
 			m.currentSession = nil
+			m.activePlanID = 0
 			m.mode = FocusModeIdle
 			m.remaining = time.Duration(m.workDuration) * time.Minute
 			m.totalDuration = m.remaining
 			m.LoadHistory()
-			return *m, nil
+			return *m, m.unblockCmd()
 		}
 
 	case "h":
@@ -295,17 +664,21 @@ func (m *FocusModel) handleTimerInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 		if m.mode == FocusModeRunning {
 			// Skip to break (complete current session early)
 			now := time.Now()
+			var journal tea.Cmd
 			if m.currentSession != nil {
 				m.currentSession.EndTime = &now
 				m.currentSession.Status = models.SessionStatusCompleted
 				// Save session to DB on early completion
-				m.store.CreateSession(m.currentSession)
+				if m.store.CreateSession(m.currentSession) == nil {
+					m.recordPlanned(m.currentSession)
+					m.notifySessionDone(m.currentSession)
+					journal = m.journalSession(m.currentSession)
+					m.askEnergy(m.currentSession)
+				}
 				m.currentSession = nil
 			}
-			m.mode = FocusModeBreak
-			m.remaining = time.Duration(m.breakDuration) * time.Minute
-			m.totalDuration = m.remaining
-			return *m, tickCmd()
+			m.startBreak()
+			return *m, tea.Batch(tickCmd(), m.unblockCmd(), journal)
 		} else if m.mode == FocusModeBreak {
 			// Skip break
 			m.mode = FocusModeIdle
@@ -315,6 +688,45 @@ func (m *FocusModel) handleTimerInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 			return *m, nil
 		}
 
+	case "l":
+		if m.mode == FocusModeIdle {
+			m.labelInput = components.NewTextInput("writing, #clientA (optional)")
+			m.labelInput.SetValue(m.label)
+			m.showLabelInput = true
+			return *m, nil
+		}
+
+	case "z":
+		if m.toggleZen() {
+			return *m, nil
+		}
+
+	case "t":
+		m.openTimerPrompt()
+		return *m, nil
+
+	case "x":
+		if len(m.sideTimers) > 0 {
+			m.removeSideTimer(m.sideTimers[0].id)
+		}
+		m.timerInputErr = ""
+		return *m, nil
+
+	case "a":
+		m.openPlanPrompt()
+		return *m, nil
+
+	case "A":
+		return *m, m.cancelNextPlan()
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		if m.mode == FocusModeBreak {
+			if i := int(msg.String()[0] - '1'); i < len(m.breakChecked) {
+				m.breakChecked[i] = !m.breakChecked[i]
+			}
+			return *m, nil
+		}
+
 	case "esc":
 		if m.mode == FocusModeBreak {
 			// Allow skipping break with Esc
@@ -324,11 +736,31 @@ func (m *FocusModel) handleTimerInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 			m.LoadHistory()
 			return *m, nil
 		}
+		if m.mode == FocusModeIdle {
+			return *m, goBack
+		}
 	}
 
 	return *m, nil
 }
 
+// handleBreakPending handles keys once a work session is done and the
+// break waits to be started.
+func (m *FocusModel) handleBreakPending(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "s", "enter":
+		m.startBreak()
+		return *m, tickCmd()
+	case "esc", "b", "c":
+		// Skip the break
+		m.mode = FocusModeIdle
+		m.remaining = time.Duration(m.workDuration) * time.Minute
+		m.totalDuration = m.remaining
+		m.LoadHistory()
+	}
+	return *m, nil
+}
+
 // handleDurationInput handles keyboard input for duration picker.
 // UX: Arrow keys update values immediately (live preview) with visual feedback,
 // Tab switches fields, Enter confirms all and exits.
@@ -376,7 +808,9 @@ func (m *FocusModel) handleDurationInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 }
 
 // applySelectedDuration applies the currently selected duration immediately.
-// Returns commands to show feedback briefly and auto-exit after 500ms.
+// Returns commands to show feedback briefly and auto-exit after 500ms. With
+// reduced motion the "Saved" indicator stays and the picker waits for
+// Enter or Esc.
 func (m *FocusModel) applySelectedDuration(durations []int) tea.Cmd {
 	if m.selectingWork {
 		m.workDuration = durations[m.durationIndex]
@@ -393,6 +827,9 @@ func (m *FocusModel) applySelectedDuration(durations []int) tea.Cmd {
 
 	// Increment sequence to cancel any pending auto-exit timers
 	m.autoExitSequence++
+	if styles.ReducedMotion() {
+		return nil
+	}
 	currentSequence := m.autoExitSequence
 
 	// Return commands: clear feedback after 300ms, auto-exit after 500ms
@@ -412,15 +849,27 @@ func (m *FocusModel) handleHistoryInput(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
 	case "esc", "h":
 		m.mode = FocusModeIdle
 		return *m, nil
+	case "f":
+		m.cycleHistoryLabel()
+		return *m, nil
+	case "enter", " ":
+		m.toggleHistoryDay()
+		return *m, nil
+	case "/":
+		m.openHistoryJump()
+		return *m, nil
 	case "d":
-		// Delete selected session
-		if len(m.sessionList.Items()) > 0 {
-			if selected, ok := m.sessionList.SelectedItem().(SessionItem); ok {
-				m.store.DeleteSession(selected.session.ID)
-				m.LoadHistory()
-			}
-		}
+		// Delete marked sessions, or the selected one, after confirmation
+		m.requestDelete()
 		return *m, nil
+	case "m":
+		m.toggleSessionMark()
+		return *m, nil
+	case "P":
+		m.openPurgePrompt()
+		return *m, nil
+	case "D":
+		return *m, toggleListDensity(m.store, &m.sessionList, "focus_history")
 	}
 
 	// Pass navigation keys to list
@@ -447,6 +896,9 @@ func (m *FocusModel) View() string {
 	case FocusModeDuration:
 		return m.renderDurationPicker()
 	default:
+		if m.Zen() {
+			return m.renderZen()
+		}
 		return m.renderTimer()
 	}
 }
@@ -461,8 +913,14 @@ func (m *FocusModel) renderTimer() string {
 		m.helpBar.SetHints(components.FocusRunningHints)
 	case FocusModePaused:
 		m.helpBar.SetHints(components.FocusPausedHints)
+	case FocusModeBreakPending:
+		m.helpBar.SetHints(components.FocusBreakPendingHints)
 	case FocusModeBreak:
-		m.helpBar.SetHints(components.FocusBreakHints)
+		hints := components.FocusBreakHints
+		if len(m.breakActivities) > 0 {
+			hints = append([]components.HelpHint{{Key: "1-9", Description: "Tick"}}, hints...)
+		}
+		m.helpBar.SetHints(hints)
 	}
 
 	// Mode-specific styled header
@@ -488,16 +946,45 @@ func (m *FocusModel) renderTimer() string {
 		m.header.View(),
 		"",
 		modeHeader,
+	}
+	if line := m.renderSessionLabel(); line != "" {
+		contentParts = append(contentParts, line)
+	}
+	if prompt := m.renderEnergyPrompt(); prompt != "" {
+		contentParts = append(contentParts, prompt)
+	}
+	if m.phaseNotice != "" {
+		noticeStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+		contentParts = append(contentParts, noticeStyle.Render(m.phaseNotice))
+	}
+	contentParts = append(contentParts,
 		"",
 		timer,
 		"",
 		progress,
-	}
+	)
 
 	if sessionIndicator != "" {
 		contentParts = append(contentParts, "", sessionIndicator)
 	}
 
+	if timers := m.renderSideTimers(); timers != "" {
+		contentParts = append(contentParts, "", timers)
+	}
+
+	if plans := m.renderPlans(); plans != "" {
+		contentParts = append(contentParts, "", plans)
+	}
+
+	if m.mode == FocusModeBreak && len(m.breakActivities) > 0 {
+		contentParts = append(contentParts, "", m.renderBreakChecklist())
+	}
+
+	if m.hookErr != "" {
+		warnStyle := lipgloss.NewStyle().Foreground(styles.WarningColor)
+		contentParts = append(contentParts, "", warnStyle.Render("⚠ "+m.hookErr))
+	}
+
 	contentParts = append(contentParts,
 		"",
 		stats,
@@ -513,10 +1000,23 @@ func (m *FocusModel) renderTimer() string {
 	return styles.PanelStyle.Render(content)
 }
 
+// renderSessionLabel renders the label prompt, or the label of the
+// current (or next) session. It is empty when there is neither.
+func (m *FocusModel) renderSessionLabel() string {
+	labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	if m.showLabelInput {
+		return labelStyle.Render("Label:") + " " + m.labelInput.View()
+	}
+	if m.label == "" || m.mode == FocusModeBreak || m.mode == FocusModeBreakPending {
+		return ""
+	}
+	return labelStyle.Render("🏷 " + m.label)
+}
+
 // renderModeHeader renders a styled header based on current mode.
 func (m *FocusModel) renderModeHeader() string {
 	var headerText string
-	var headerColor lipgloss.Color
+	var headerColor lipgloss.TerminalColor
 	var icon string
 
 	switch m.mode {
@@ -532,6 +1032,10 @@ func (m *FocusModel) renderModeHeader() string {
 		headerText = "P A U S E D"
 		headerColor = styles.WarningColor
 		icon = "⏸"
+	case FocusModeBreakPending:
+		headerText = "W O R K   C O M P L E T E"
+		headerColor = styles.SuccessColor
+		icon = "✓"
 	case FocusModeBreak:
 		headerText = "B R E A K   T I M E"
 		headerColor = styles.SecondaryColor
@@ -598,7 +1102,7 @@ func (m *FocusModel) renderLargeTimer() string {
 	timeStr := fmt.Sprintf("%02d:%02d", minutes, seconds)
 
 	// Determine color based on mode
-	var timerColor lipgloss.Color
+	var timerColor lipgloss.TerminalColor
 	switch m.mode {
 	case FocusModeRunning:
 		timerColor = styles.SuccessColor // Cyan for active
@@ -610,17 +1114,52 @@ func (m *FocusModel) renderLargeTimer() string {
 		timerColor = styles.PrimaryColor // Lavender for idle
 	}
 
-	// Render large ASCII art timer
-	asciiTimer := styles.RenderASCIITime(timeStr, timerColor)
-
 	// Center the timer
 	timerStyle := lipgloss.NewStyle().
 		Padding(1, 2).
 		Align(lipgloss.Center)
 
+	// Render large ASCII art timer, falling back to a small box when the
+	// digits would not fit inside the panel
+	asciiTimer := styles.RenderASCIITime(timeStr, timerColor)
+	if m.width > 0 && lipgloss.Width(timerStyle.Render(asciiTimer))+focusPanelChrome > m.width {
+		return timerStyle.Render(styles.RenderCompactTime(timeStr, timerColor))
+	}
+
 	return timerStyle.Render(asciiTimer)
 }
 
+// focusPanelChrome is the horizontal space PanelStyle's border and padding
+// take around the timer.
+const focusPanelChrome = 6
+
+// renderBreakChecklist renders the break activities with their checkboxes.
+// Only the first nine can be ticked from the keyboard, so only those show.
+func (m *FocusModel) renderBreakChecklist() string {
+	titleStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	uncheckedStyle := lipgloss.NewStyle().Foreground(styles.TextColor)
+	checkedStyle := lipgloss.NewStyle().Foreground(styles.SuccessColor).Strikethrough(true)
+
+	activities := m.breakActivities
+	if len(activities) > 9 {
+		activities = activities[:9]
+	}
+	done := 0
+	lines := make([]string, 0, len(activities)+1)
+	for i, activity := range activities {
+		item := uncheckedStyle.Render("[ ] " + activity)
+		if i < len(m.breakChecked) && m.breakChecked[i] {
+			item = checkedStyle.Render("[✓] " + activity)
+			done++
+		}
+		lines = append(lines, keyStyle.Render(fmt.Sprintf("%d ", i+1))+item)
+	}
+	title := titleStyle.Render(fmt.Sprintf("Break checklist %d/%d", done, len(activities)))
+
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{title}, lines...)...)
+}
+
 // renderProgressBar renders a visual progress bar with gradient effect.
 func (m *FocusModel) renderProgressBar() string {
 	if m.totalDuration == 0 {
@@ -679,6 +1218,8 @@ func (m *FocusModel) renderStatsSummary() string {
 		lipgloss.Center,
 		statItemStyle.Render("Today: ")+statValueStyle.Render(fmt.Sprintf("%d", todaySessions)),
 		statsStyle.Render(" │ "),
+		statItemStyle.Render("Week: ")+statValueStyle.Render(fmt.Sprintf("%d", m.weekSessions(time.Now()))),
+		statsStyle.Render(" │ "),
 		statItemStyle.Render("Streak: ")+statValueStyle.Render(fmt.Sprintf("%d days 🔥", streak)),
 		statsStyle.Render(" │ "),
 		statItemStyle.Render("Total: ")+statValueStyle.Render(fmt.Sprintf("%dh %dm", totalMinutes/60, totalMinutes%60)),
@@ -707,6 +1248,19 @@ func (m *FocusModel) renderStatsSummary() string {
 	return statsContent
 }
 
+// weekSessions counts the sessions completed since the configured start of
+// the current week.
+func (m *FocusModel) weekSessions(now time.Time) int {
+	start := datefmt.StartOfWeek(now)
+	count := 0
+	for _, session := range m.sessions {
+		if session.Status == models.SessionStatusCompleted && !session.StartTime.Before(start) {
+			count++
+		}
+	}
+	return count
+}
+
 // getLast7DaysActivity returns session counts for the last 7 days.
 func (m *FocusModel) getLast7DaysActivity() []int {
 	if len(m.sessions) == 0 {
@@ -737,6 +1291,9 @@ func (m *FocusModel) getLast7DaysActivity() []int {
 
 // renderHistory renders the session history view.
 func (m *FocusModel) renderHistory() string {
+	if m.confirmDelete.IsOpen() {
+		return m.confirmDelete.View()
+	}
 	m.helpBar.SetHints(components.FocusHistoryHints)
 
 	title := styles.TitleStyle.Render("📊 Session History")
@@ -755,21 +1312,67 @@ func (m *FocusModel) renderHistory() string {
 
 	// Stats header
 	statsHeader := m.renderStatsSummary()
+	if labels := m.renderLabelStats(); labels != "" {
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "", labels)
+	}
+	if energy := m.renderEnergyStats(); energy != "" {
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "", energy)
+	}
+	if plans := m.renderPlanStats(); plans != "" {
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "", plans)
+	}
+	if jump := m.renderHistoryJump(); jump != "" {
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "", jump)
+	}
+	if purge := m.renderPurgePrompt(); purge != "" {
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "", purge)
+	}
+	if n := len(m.markedSessions); n > 0 {
+		markedStyle := lipgloss.NewStyle().Foreground(styles.AccentColor).Bold(true)
+		statsHeader = lipgloss.JoinVertical(lipgloss.Left, statsHeader, "",
+			markedStyle.Render(fmt.Sprintf("%d marked — [d] delete them", n)))
+	}
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		statsHeader,
-		"",
-		m.sessionList.View(),
-		"",
-		m.helpBar.View(),
-	)
+	// The session list fills what the stats and help bar leave inside
+	// the panel
+	top := lipgloss.JoinVertical(lipgloss.Left, title, "", statsHeader, "")
+	bottom := lipgloss.JoinVertical(lipgloss.Left, "", m.helpBar.View())
+	free := m.height - components.AppChrome - styles.PanelStyle.GetVerticalFrameSize()
+	m.sessionList.SetSize(m.width-4, components.FillHeight(free, minListRows, top, bottom))
 
+	content := lipgloss.JoinVertical(lipgloss.Left, top, m.sessionList.View(), bottom)
 	return styles.PanelStyle.Render(content)
 }
 
+// renderLabelStats renders focus time per label, marking the label the
+// history is filtered by. Only the top labels fit on the line.
+func (m *FocusModel) renderLabelStats() string {
+	if m.stats == nil || len(m.stats.Labels) == 0 {
+		return ""
+	}
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	labelStyle := lipgloss.NewStyle().Foreground(styles.TextColor)
+	activeStyle := lipgloss.NewStyle().Foreground(styles.AccentColor).Bold(true)
+
+	filter := "all"
+	if m.historyLabel != "" {
+		filter = m.historyLabel
+	}
+	parts := []string{mutedStyle.Render("By label (showing " + filter + "):")}
+	for i, ls := range m.stats.Labels {
+		if i == 4 {
+			parts = append(parts, mutedStyle.Render(fmt.Sprintf("+%d more", len(m.stats.Labels)-i)))
+			break
+		}
+		style := labelStyle
+		if ls.Label == m.historyLabel {
+			style = activeStyle
+		}
+		parts = append(parts, style.Render(fmt.Sprintf("%s %d · %dh %dm", ls.Label, ls.Sessions, ls.FocusMinutes/60, ls.FocusMinutes%60)))
+	}
+	return strings.Join(parts, "  ")
+}
+
 // renderDurationPicker renders the duration selection UI.
 func (m *FocusModel) renderDurationPicker() string {
 	m.helpBar.SetHints(components.FocusDurationHints)
@@ -865,10 +1468,19 @@ func (m *FocusModel) renderDurationOptions(durations []int, current int, isActiv
 // SessionItem implements list.Item for displaying sessions in the history list.
 type SessionItem struct {
 	session models.FocusSession
+	marked  bool // Marked for deletion in history
+}
+
+// sessionItemKey keeps the selected session selected when the history
+// reloads.
+func sessionItemKey(item list.Item) (int64, bool) {
+	s, ok := item.(SessionItem)
+	return s.session.ID, ok
 }
 
 func (s SessionItem) Title() string {
-	date := s.session.StartTime.Format("2006-01-02 15:04")
+	// The day is in the group header above
+	date := datefmt.Clock(s.session.StartTime)
 	duration := s.session.Duration / 60 // Convert to minutes
 
 	statusIcon := "✓"
@@ -878,7 +1490,18 @@ func (s SessionItem) Title() string {
 		statusIcon = "●"
 	}
 
-	return fmt.Sprintf("%s %s - %d min", statusIcon, date, duration)
+	if s.marked {
+		statusIcon = "◉ " + statusIcon
+	}
+
+	title := fmt.Sprintf("%s %s - %d min", statusIcon, date, duration)
+	if s.session.Label != "" {
+		title += " · " + s.session.Label
+	}
+	if s.session.Energy > 0 {
+		title += fmt.Sprintf(" · energy %d/5", s.session.Energy)
+	}
+	return title
 }
 
 func (s SessionItem) Description() string {
@@ -890,5 +1513,5 @@ func (s SessionItem) Description() string {
 }
 
 func (s SessionItem) FilterValue() string {
-	return s.session.StartTime.Format("2006-01-02")
+	return s.session.StartTime.Format("2006-01-02") + " " + s.session.Label
 }
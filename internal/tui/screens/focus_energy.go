@@ -0,0 +1,94 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Energy ratings (Phase 5: Focus Sessions).
+//
+// With energy_prompt on, finishing a work session asks for a 1-5 rating
+// of how sharp it felt. The prompt stays under the timer through the
+// break until a digit rates the session or Esc skips it; the rest of the
+// keys keep working. The history stats average the ratings by the part
+// of the day the sessions started in, to show when deep work goes best.
+
+// SetEnergyPrompt turns the energy prompt after work sessions on or off.
+func (m *FocusModel) SetEnergyPrompt(on bool) {
+	m.energyPrompt = on
+}
+
+// askEnergy opens the energy prompt for session, which has just been
+// saved as completed.
+func (m *FocusModel) askEnergy(session *models.FocusSession) {
+	if m.energyPrompt && session != nil && session.ID != 0 && !m.store.ReadOnly() {
+		m.rateSessionID = session.ID
+	}
+}
+
+// handleEnergyPrompt rates the session the prompt is open for. It
+// reports false for keys the prompt does not use.
+func (m *FocusModel) handleEnergyPrompt(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch key := msg.String(); key {
+	case "esc":
+		m.rateSessionID = 0
+		return nil, true
+	case "1", "2", "3", "4", "5":
+		id := m.rateSessionID
+		m.rateSessionID = 0
+		if err := m.store.SetSessionEnergy(id, int(key[0]-'0')); err != nil {
+			return toastCmd("Could not save the rating: " + err.Error()), true
+		}
+		return toastCmd("Energy " + key + "/5 saved"), true
+	}
+	return nil, false
+}
+
+// renderEnergyPrompt renders the open energy prompt, or nothing.
+func (m *FocusModel) renderEnergyPrompt() string {
+	if m.rateSessionID == 0 {
+		return ""
+	}
+	promptStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+	return promptStyle.Render("⚡ How was your energy? 1 (drained) – 5 (sharp), Esc to skip")
+}
+
+// renderEnergyStats renders the average energy rating per part of the
+// day and names the sharpest one.
+func (m *FocusModel) renderEnergyStats() string {
+	if m.stats == nil || len(m.stats.Energy) == 0 {
+		return ""
+	}
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	textStyle := lipgloss.NewStyle().Foreground(styles.TextColor)
+	bestStyle := lipgloss.NewStyle().Foreground(styles.AccentColor).Bold(true)
+
+	best := m.stats.Energy[0]
+	for _, es := range m.stats.Energy[1:] {
+		if es.Average > best.Average {
+			best = es
+		}
+	}
+	parts := []string{mutedStyle.Render("Energy by time of day:")}
+	for _, es := range m.stats.Energy {
+		style := textStyle
+		if es.Period == best.Period && len(m.stats.Energy) > 1 {
+			style = bestStyle
+		}
+		parts = append(parts, style.Render(fmt.Sprintf("%s %.1f (%d)", es.Period, es.Average, es.Sessions)))
+	}
+	if len(m.stats.Energy) > 1 {
+		when := "in the " + strings.ToLower(best.Period)
+		if best.Period == "Night" {
+			when = "at night"
+		}
+		parts = append(parts, mutedStyle.Render("— sharpest "+when))
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,324 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Focus history grouped by day (Phase 5).
+//
+// Sessions are listed newest first under one header per day, showing the
+// day's session count and completed focus time. Days collapse and expand
+// individually, and the list can jump to a date.
+//
+// Deleting always asks for confirmation: the selected session, the
+// sessions marked with m, or (P) every session older than N days, with a
+// count preview as N is typed.
+//
+// Keyboard Shortcuts (history view):
+//   - Enter/Space: Collapse or expand the day under the cursor
+//   - /: Jump to a date ("2026-03-02", "today", "yesterday")
+//   - m: Mark or unmark the session for deletion
+//   - d: Delete the marked sessions, or the selected one
+//   - P: Delete sessions older than N days
+//   - D: Toggle compact/comfortable rows
+
+// historyDayLayout keys collapsed days.
+const historyDayLayout = "2006-01-02"
+
+// defaultPurgeDays prefills the "older than N days" cleanup prompt.
+const defaultPurgeDays = 90
+
+// sessionsDeletedMsg reports a confirmed delete. The model is copied on
+// every update, so the history reloads when this arrives rather than in
+// the confirmation's callback.
+type sessionsDeletedMsg struct{}
+
+// sessionDayHeaderItem heads one day of sessions in the history list. It
+// is not a SessionItem, so session actions such as delete ignore it.
+type sessionDayHeaderItem struct {
+	day       time.Time
+	count     int
+	minutes   int
+	collapsed bool
+}
+
+func (h sessionDayHeaderItem) Title() string {
+	arrow := "▾"
+	if h.collapsed {
+		arrow = "▸"
+	}
+	return fmt.Sprintf("%s 📅 %s — %d %s · %dh %dm",
+		arrow, datefmt.WeekdayDate(h.day), h.count, pluralize(h.count, "session", "sessions"), h.minutes/60, h.minutes%60)
+}
+
+func (h sessionDayHeaderItem) Description() string { return "" }
+
+func (h sessionDayHeaderItem) FilterValue() string { return "" }
+
+// groupSessionsByDay turns sessions (newest first) into list items with a
+// header before each day. Sessions of collapsed days are left out.
+func groupSessionsByDay(sessions []models.FocusSession, collapsed map[string]bool, marked map[int64]bool) []list.Item {
+	var items []list.Item
+	for i := 0; i < len(sessions); {
+		day := startOfDay(sessions[i].StartTime)
+		j := i
+		header := sessionDayHeaderItem{day: day, collapsed: collapsed[day.Format(historyDayLayout)]}
+		for ; j < len(sessions) && startOfDay(sessions[j].StartTime).Equal(day); j++ {
+			header.count++
+			if sessions[j].Status == models.SessionStatusCompleted {
+				header.minutes += sessions[j].Duration / 60
+			}
+		}
+		items = append(items, header)
+		if !header.collapsed {
+			for _, session := range sessions[i:j] {
+				items = append(items, SessionItem{session: session, marked: marked[session.ID]})
+			}
+		}
+		i = j
+	}
+	return items
+}
+
+// pluralize picks the singular or plural noun for n.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// toggleHistoryDay collapses or expands the day of the selected row and
+// keeps that day's header selected.
+func (m *FocusModel) toggleHistoryDay() {
+	var day time.Time
+	switch item := m.sessionList.SelectedItem().(type) {
+	case sessionDayHeaderItem:
+		day = item.day
+	case SessionItem:
+		day = startOfDay(item.session.StartTime)
+	default:
+		return
+	}
+	key := day.Format(historyDayLayout)
+	if m.collapsedDays == nil {
+		m.collapsedDays = make(map[string]bool)
+	}
+	m.collapsedDays[key] = !m.collapsedDays[key]
+	m.LoadHistory()
+	m.selectHistoryDay(day)
+}
+
+// selectHistoryDay selects the header of day, or of the closest earlier
+// day with sessions. It reports whether one was found.
+func (m *FocusModel) selectHistoryDay(day time.Time) bool {
+	for i, it := range m.sessionList.Items() {
+		if header, ok := it.(sessionDayHeaderItem); ok && !header.day.After(day) {
+			m.sessionList.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+// parseHistoryDate parses a jump target: YYYY-MM-DD, "today" or
+// "yesterday". History has nothing after today, so later dates are
+// rejected.
+func parseHistoryDate(s string, now time.Time) (time.Time, error) {
+	switch s = strings.ToLower(strings.TrimSpace(s)); s {
+	case "today":
+		return startOfDay(now), nil
+	case "yesterday":
+		return startOfDay(now.AddDate(0, 0, -1)), nil
+	}
+	day, err := time.ParseInLocation(historyDayLayout, s, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD, today or yesterday)", s)
+	}
+	if day.After(now) {
+		return time.Time{}, fmt.Errorf("%s is in the future", s)
+	}
+	return day, nil
+}
+
+// openHistoryJump opens the jump-to-date prompt.
+func (m *FocusModel) openHistoryJump() {
+	m.historyJumpInput = components.NewTextInput("YYYY-MM-DD, today, yesterday")
+	m.historyJumpErr = ""
+	m.showHistoryJump = true
+}
+
+// handleHistoryJump handles keys while the jump-to-date prompt is open.
+func (m *FocusModel) handleHistoryJump(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showHistoryJump = false
+		m.historyJumpErr = ""
+		return *m, nil
+	case "enter":
+		day, err := parseHistoryDate(m.historyJumpInput.Value(), time.Now())
+		if err != nil {
+			m.historyJumpErr = err.Error()
+			return *m, nil
+		}
+		if !m.selectHistoryDay(day) {
+			m.historyJumpErr = "No sessions on or before " + day.Format(historyDayLayout)
+			return *m, nil
+		}
+		m.showHistoryJump = false
+		m.historyJumpErr = ""
+		return *m, nil
+	}
+	var cmd tea.Cmd
+	m.historyJumpInput, cmd = m.historyJumpInput.Update(msg)
+	return *m, cmd
+}
+
+// renderHistoryJump renders the jump-to-date prompt, or "" when closed.
+func (m *FocusModel) renderHistoryJump() string {
+	if !m.showHistoryJump {
+		return ""
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	line := labelStyle.Render("Go to date:") + " " + m.historyJumpInput.View()
+	if m.historyJumpErr != "" {
+		line += "\n" + components.FieldError(m.historyJumpErr)
+	}
+	return line
+}
+
+// toggleSessionMark marks or unmarks the selected session for deletion.
+func (m *FocusModel) toggleSessionMark() {
+	item, ok := m.sessionList.SelectedItem().(SessionItem)
+	if !ok {
+		return
+	}
+	if m.markedSessions == nil {
+		m.markedSessions = make(map[int64]bool)
+	}
+	if m.markedSessions[item.session.ID] {
+		delete(m.markedSessions, item.session.ID)
+	} else {
+		m.markedSessions[item.session.ID] = true
+	}
+	m.LoadHistory()
+}
+
+// requestDelete asks to delete the marked sessions, or the selected one
+// when none are marked.
+func (m *FocusModel) requestDelete() {
+	var ids []int64
+	for id := range m.markedSessions {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		item, ok := m.sessionList.SelectedItem().(SessionItem)
+		if !ok {
+			return
+		}
+		ids = []int64{item.session.ID}
+	}
+	store := m.store
+	title := fmt.Sprintf("Delete %d %s?", len(ids), pluralize(len(ids), "Session", "Sessions"))
+	m.confirmSessionDelete(title, func() { store.DeleteSessions(ids) })
+}
+
+// confirmSessionDelete asks before running del, which deletes sessions
+// from the store.
+func (m *FocusModel) confirmSessionDelete(title string, del func()) {
+	m.confirmDelete.OpenDanger(title, "This action cannot be undone.", func() tea.Cmd {
+		del()
+		return func() tea.Msg { return sessionsDeletedMsg{} }
+	}, nil)
+}
+
+// openPurgePrompt opens the "older than N days" cleanup prompt.
+func (m *FocusModel) openPurgePrompt() {
+	m.purgeInput = components.NewTextInput("days")
+	m.purgeInput.SetValue(fmt.Sprintf("%d", defaultPurgeDays))
+	m.purgeErr = ""
+	m.showPurge = true
+}
+
+// purgeCutoff parses the typed number of days into a cutoff: sessions
+// started before the start of that day are deleted.
+func (m *FocusModel) purgeCutoff() (int, time.Time, error) {
+	days, err := strconv.Atoi(strings.TrimSpace(m.purgeInput.Value()))
+	if err != nil || days < 1 {
+		return 0, time.Time{}, fmt.Errorf("enter a number of days (1 or more)")
+	}
+	return days, startOfDay(time.Now()).AddDate(0, 0, -days), nil
+}
+
+// handlePurgePrompt handles keys while the cleanup prompt is open. Enter
+// moves on to the confirmation.
+func (m *FocusModel) handlePurgePrompt(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showPurge = false
+		m.purgeErr = ""
+		return *m, nil
+	case "enter":
+		days, cutoff, err := m.purgeCutoff()
+		if err != nil {
+			m.purgeErr = err.Error()
+			return *m, nil
+		}
+		count, err := m.store.CountSessionsBefore(cutoff)
+		if err != nil {
+			m.purgeErr = err.Error()
+			return *m, nil
+		}
+		if count == 0 {
+			m.purgeErr = fmt.Sprintf("No sessions older than %d days", days)
+			return *m, nil
+		}
+		m.showPurge = false
+		m.purgeErr = ""
+		store := m.store
+		title := fmt.Sprintf("Delete %d %s Older Than %d Days?", count, pluralize(count, "Session", "Sessions"), days)
+		m.confirmSessionDelete(title, func() { store.DeleteSessionsBefore(cutoff) })
+		return *m, nil
+	}
+	var cmd tea.Cmd
+	m.purgeInput, cmd = m.purgeInput.Update(msg)
+	m.purgeErr = ""
+	return *m, cmd
+}
+
+// renderPurgePrompt renders the cleanup prompt with a live count of the
+// sessions it would delete, or "" when closed.
+func (m *FocusModel) renderPurgePrompt() string {
+	if !m.showPurge {
+		return ""
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	line := labelStyle.Render("Delete sessions older than (days):") + " " + m.purgeInput.View()
+	if _, cutoff, err := m.purgeCutoff(); err == nil {
+		if count, err := m.store.CountSessionsBefore(cutoff); err == nil {
+			line += "\n" + mutedStyle.Render(fmt.Sprintf("%d %s before %s would be deleted",
+				count, pluralize(count, "session", "sessions"), cutoff.Format(historyDayLayout)))
+		}
+	}
+	if m.purgeErr != "" {
+		line += "\n" + components.FieldError(m.purgeErr)
+	}
+	return line
+}
@@ -0,0 +1,197 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// historySessionCount counts the sessions (not day headers) in history.
+func historySessionCount(m FocusModel) int {
+	n := 0
+	for _, it := range m.sessionList.Items() {
+		if _, ok := it.(SessionItem); ok {
+			n++
+		}
+	}
+	return n
+}
+
+// newHistoryFocusModel returns a focus model in history mode with two
+// sessions today, one two days ago and one a week ago.
+func newHistoryFocusModel(t *testing.T) (FocusModel, time.Time) {
+	t.Helper()
+	m := newTestFocusModel(t)
+	today := startOfDay(time.Now()).Add(9 * time.Hour)
+	for _, s := range []struct {
+		start   time.Time
+		minutes int
+		status  models.SessionStatus
+	}{
+		{today.AddDate(0, 0, -7), 25, models.SessionStatusCompleted},
+		{today.AddDate(0, 0, -2), 50, models.SessionStatusCompleted},
+		{today, 25, models.SessionStatusCompleted},
+		{today.Add(time.Hour), 25, models.SessionStatusCancelled},
+	} {
+		session := &models.FocusSession{StartTime: s.start, Duration: s.minutes * 60, Status: s.status}
+		if err := m.store.CreateSession(session); err != nil {
+			t.Fatalf("CreateSession() err = %v", err)
+		}
+	}
+	m = typeKeys(m, "h")
+	return m, today
+}
+
+func TestFocusHistoryGroupedByDay(t *testing.T) {
+	t.Parallel()
+
+	m, today := newHistoryFocusModel(t)
+	items := m.sessionList.Items()
+	if len(items) != 7 {
+		t.Fatalf("expected 3 day headers + 4 sessions, got %d items", len(items))
+	}
+	header, ok := items[0].(sessionDayHeaderItem)
+	if !ok || !header.day.Equal(startOfDay(today)) {
+		t.Fatalf("expected today's header first, got %#v", items[0])
+	}
+	// Cancelled sessions count but add no focus time
+	if header.count != 2 || header.minutes != 25 {
+		t.Errorf("today header = %d sessions, %d min; want 2, 25", header.count, header.minutes)
+	}
+	if first, ok := items[1].(SessionItem); !ok || !first.session.StartTime.Equal(today.Add(time.Hour)) {
+		t.Errorf("expected today's latest session under the header, got %#v", items[1])
+	}
+	if view := m.View(); !containsString(view, "2 sessions · 0h 25m") || !containsString(view, "1 session · 0h 50m") {
+		t.Errorf("expected daily totals in the history view, got:\n%s", view)
+	}
+}
+
+func TestFocusHistoryCollapseAndJump(t *testing.T) {
+	t.Parallel()
+
+	m, today := newHistoryFocusModel(t)
+
+	// Collapse today from its header
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if historySessionCount(m) != 2 {
+		t.Fatalf("expected today's 2 sessions hidden, %d sessions left", historySessionCount(m))
+	}
+	if header, ok := m.sessionList.SelectedItem().(sessionDayHeaderItem); !ok || !header.collapsed {
+		t.Errorf("expected the collapsed header to stay selected")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if historySessionCount(m) != 4 {
+		t.Errorf("expected Space to expand today again")
+	}
+
+	// Jump to a day without sessions lands on the closest earlier day
+	m = typeKeys(m, "/")
+	if !m.InputActive() {
+		t.Fatalf("expected the jump prompt to take input")
+	}
+	m = typeKeys(m, today.AddDate(0, 0, -4).Format("2006-01-02"))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	header, ok := m.sessionList.SelectedItem().(sessionDayHeaderItem)
+	if !ok || !header.day.Equal(startOfDay(today.AddDate(0, 0, -7))) {
+		t.Errorf("expected the week-old day selected, got %#v", m.sessionList.SelectedItem())
+	}
+
+	m = typeKeys(m, "/")
+	m = typeKeys(m, "someday")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.InputActive() || !containsString(m.View(), "invalid date") {
+		t.Errorf("expected an invalid date to keep the prompt open with an error")
+	}
+}
+
+// confirmYes answers y to a delete confirmation and delivers the message
+// its command reports the delete with.
+func confirmYes(t *testing.T, m FocusModel) FocusModel {
+	t.Helper()
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd == nil {
+		t.Fatalf("expected y to confirm the delete")
+	}
+	m, _ = m.Update(cmd())
+	return m
+}
+
+func TestFocusHistoryDeleteConfirms(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newHistoryFocusModel(t)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown}) // first session under today's header
+
+	m = typeKeys(m, "d")
+	if !m.confirmDelete.IsOpen() || !containsString(m.View(), "Delete 1 Session?") {
+		t.Fatalf("expected a delete confirmation, got:\n%s", m.View())
+	}
+	m = typeKeys(m, "n")
+	if m.confirmDelete.IsOpen() || historySessionCount(m) != 4 {
+		t.Fatalf("expected n to cancel without deleting")
+	}
+
+	// Mark two sessions and delete both at once
+	m = typeKeys(m, "m")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = typeKeys(m, "m")
+	if !containsString(m.View(), "2 marked") {
+		t.Errorf("expected the marked count in the view")
+	}
+	m = typeKeys(m, "d")
+	if !containsString(m.View(), "Delete 2 Sessions?") {
+		t.Fatalf("expected a confirmation for the marked sessions, got:\n%s", m.View())
+	}
+	m = confirmYes(t, m)
+	if historySessionCount(m) != 2 || len(m.markedSessions) != 0 {
+		t.Errorf("expected 2 sessions left and marks cleared, got %d", historySessionCount(m))
+	}
+}
+
+func TestFocusHistoryPurgeOlderThan(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newHistoryFocusModel(t)
+	m = typeKeys(m, "P")
+	if !m.InputActive() {
+		t.Fatalf("expected the cleanup prompt to take input")
+	}
+	// Replace the default 90 with 5 days: only the week-old session goes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m = typeKeys(m, "5")
+	if !containsString(m.View(), "1 session before") {
+		t.Errorf("expected a live count preview, got:\n%s", m.View())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !containsString(m.View(), "Delete 1 Session Older Than 5 Days?") {
+		t.Fatalf("expected a cleanup confirmation, got:\n%s", m.View())
+	}
+	m = confirmYes(t, m)
+	if historySessionCount(m) != 3 {
+		t.Errorf("expected 3 sessions after cleanup, got %d", historySessionCount(m))
+	}
+
+	// Nothing older than 90 days: the prompt stays open with a message
+	m = typeKeys(m, "P")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.confirmDelete.IsOpen() || !containsString(m.View(), "No sessions older than 90 days") {
+		t.Errorf("expected no confirmation when nothing matches, got:\n%s", m.View())
+	}
+}
+
+func TestParseHistoryDateRejectsFuture(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	if _, err := parseHistoryDate("2026-03-11", now); err == nil || !strings.Contains(err.Error(), "in the future") {
+		t.Errorf("expected a future date to be rejected, got %v", err)
+	}
+	if _, err := parseHistoryDate("2026-03-10", now); err != nil {
+		t.Errorf("expected today's date to parse, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+//go:build !windows
+
+package screens
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runFocusHookCmd runs cmd, which may be a batch, and feeds any hook
+// results back into m.
+func runFocusHookCmd(t *testing.T, m FocusModel, cmd tea.Cmd) FocusModel {
+	t.Helper()
+	if cmd == nil {
+		return m
+	}
+	var msgs []tea.Msg
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if c == nil {
+				continue
+			}
+			msgs = append(msgs, c())
+		}
+	default:
+		msgs = append(msgs, msg)
+	}
+	for _, msg := range msgs {
+		if hookMsg, ok := msg.(FocusHookMsg); ok {
+			m, _ = m.Update(hookMsg)
+		}
+	}
+	return m
+}
+
+func TestFocusHooksBlockAndUnblock(t *testing.T) {
+	t.Parallel()
+
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	m := newTestFocusModel(t)
+	m.SetHooks([]string{"echo block >> " + log}, []string{"echo unblock >> " + log}, time.Second)
+
+	mm, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = runFocusHookCmd(t, mm, cmd)
+	mm, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = runFocusHookCmd(t, mm, cmd)
+
+	data, _ := os.ReadFile(log)
+	if string(data) != "block\nunblock\n" {
+		t.Fatalf("hook log = %q, want block then unblock", data)
+	}
+	if err := m.ReleaseBlock(); err != nil {
+		t.Fatalf("ReleaseBlock() err = %v", err)
+	}
+	if data, _ := os.ReadFile(log); string(data) != "block\nunblock\n" {
+		t.Errorf("ReleaseBlock() after unblock ran hooks again: %q", data)
+	}
+}
+
+func TestFocusHooksReleaseOnExit(t *testing.T) {
+	t.Parallel()
+
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	m := newTestFocusModel(t)
+	m.SetHooks([]string{"echo block >> " + log}, []string{"echo unblock >> " + log}, time.Second)
+
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = mm
+	// The block command never ran; ReleaseBlock must not hang on it
+	m.blockDone = nil
+	if err := m.ReleaseBlock(); err != nil {
+		t.Fatalf("ReleaseBlock() err = %v", err)
+	}
+	if data, _ := os.ReadFile(log); string(data) != "unblock\n" {
+		t.Errorf("hook log = %q, want unblock on exit", data)
+	}
+}
+
+func TestFocusHookFailureShown(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m, _ = m.Update(FocusHookMsg{Block: true, Err: errors.New("blocker missing")})
+	if view := m.View(); !strings.Contains(view, "Block hook failed: blocker missing") {
+		t.Errorf("expected hook failure in view, got:\n%s", view)
+	}
+	m, _ = m.Update(FocusHookMsg{Block: true})
+	if strings.Contains(m.View(), "hook failed") {
+		t.Errorf("expected a successful hook to clear the warning")
+	}
+}
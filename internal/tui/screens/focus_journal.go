@@ -0,0 +1,123 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Focus journal (Phase 5: Focus Sessions).
+//
+// With focus_journal on, each completed work session is appended to the
+// day's daily note as a list item:
+//
+//	- 🍅 25m on [[Thesis outline]] (14:05–14:30)
+//
+// The session label becomes a [[wikilink]] when a note has that title,
+// and stays plain text otherwise, so labels like "writing" do not spawn
+// placeholder notes. The daily note is created, tagged #daily, when the
+// day's first session completes; a wikilink placeholder with its title is
+// filled in rather than duplicated.
+
+// DailyNoteTag is the tag a daily note gets when the journal creates it.
+const DailyNoteTag = "daily"
+
+// SetJournal turns the focus journal on, with title naming the daily
+// note for a date; nil turns it off.
+func (m *FocusModel) SetJournal(title func(time.Time) string) {
+	m.journalTitle = title
+}
+
+// journalSession appends session, which has just been saved as
+// completed, to its day's daily note. A failure is reported in a toast;
+// the session itself is already stored.
+func (m *FocusModel) journalSession(session *models.FocusSession) tea.Cmd {
+	if m.journalTitle == nil || session == nil || m.store.ReadOnly() {
+		return nil
+	}
+	if err := m.appendToDailyNote(session); err != nil {
+		return toastCmd("Could not log the session: " + err.Error())
+	}
+	return nil
+}
+
+// appendToDailyNote adds the journal line for session to the daily note,
+// creating the note when needed, and links the note the label names.
+func (m *FocusModel) appendToDailyNote(session *models.FocusSession) error {
+	var linked *models.Note
+	if session.Label != "" {
+		if note, err := m.store.GetNoteByTitle(session.Label); err == nil && note != nil && !note.IsPlaceholder() {
+			linked = note
+		}
+	}
+	line := journalLine(session, linked)
+
+	title := m.journalTitle(session.StartTime)
+	daily, err := m.store.GetNoteByTitle(title)
+	if err != nil {
+		return err
+	}
+	if daily == nil {
+		daily = &models.Note{Title: title, Body: line, Tags: []string{DailyNoteTag}}
+		if err := m.store.CreateNote(daily); err != nil {
+			return err
+		}
+	} else {
+		if daily.IsPlaceholder() {
+			daily.Body = ""
+			tags := []string{DailyNoteTag}
+			for _, tag := range daily.Tags {
+				if tag != models.PlaceholderTag && tag != DailyNoteTag {
+					tags = append(tags, tag)
+				}
+			}
+			daily.Tags = tags
+		}
+		body := strings.TrimRight(daily.Body, "\n")
+		if body != "" {
+			body += "\n"
+		}
+		body += line
+		if n := utf8.RuneCountInString(body); n > models.MaxBodyLength {
+			return fmt.Errorf("daily note %q is full (%d of %d characters)", title, n, models.MaxBodyLength)
+		}
+		daily.Body = body
+		if err := m.store.UpdateNote(daily); err != nil {
+			return err
+		}
+	}
+
+	if linked == nil || linked.ID == daily.ID {
+		return nil
+	}
+	return m.store.CreateLinks([]models.Link{{
+		SourceType: "note",
+		SourceID:   daily.ID,
+		TargetType: "note",
+		TargetID:   linked.ID,
+		LinkType:   "wikilink",
+	}})
+}
+
+// journalLine formats the daily note line for a completed session;
+// linked is the note its label names, or nil.
+func journalLine(session *models.FocusSession, linked *models.Note) string {
+	line := "- 🍅 " + models.FormatMinutes(session.Duration/60)
+	switch {
+	case linked != nil:
+		line += " on [[" + linked.Title + "]]"
+	case session.Label != "":
+		line += " on " + session.Label
+	}
+	end := session.StartTime.Add(time.Duration(session.Duration) * time.Second)
+	if session.EndTime != nil {
+		end = *session.EndTime
+	}
+	return line + fmt.Sprintf(" (%s–%s)", datefmt.Clock(session.StartTime), datefmt.Clock(end))
+}
@@ -0,0 +1,81 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestJournalLine(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 9, 14, 5, 0, 0, time.Local)
+	end := start.Add(25 * time.Minute)
+	session := &models.FocusSession{StartTime: start, EndTime: &end, Duration: 25 * 60, Label: "Thesis outline"}
+	times := " (" + datefmt.Clock(start) + "–" + datefmt.Clock(end) + ")"
+
+	if got, want := journalLine(session, &models.Note{Title: "Thesis outline"}), "- 🍅 25m on [[Thesis outline]]"+times; got != want {
+		t.Errorf("linked: %q, want %q", got, want)
+	}
+	if got, want := journalLine(session, nil), "- 🍅 25m on Thesis outline"+times; got != want {
+		t.Errorf("plain label: %q, want %q", got, want)
+	}
+	session.Label = ""
+	if got, want := journalLine(session, nil), "- 🍅 25m"+times; got != want {
+		t.Errorf("no label: %q, want %q", got, want)
+	}
+}
+
+func TestFocusJournalAppendsToDailyNote(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m.SetJournal(func(time.Time) string { return "Today" })
+	thesis := &models.Note{Title: "Thesis outline", Body: "Chapters"}
+	if err := m.store.CreateNote(thesis); err != nil {
+		t.Fatal(err)
+	}
+
+	complete := func(label string) {
+		m.label = label
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}}) // Complete early
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}}) // Skip the break
+	}
+	complete("Thesis outline")
+	complete("writing")
+
+	daily, err := m.store.GetNoteByTitle("Today")
+	if err != nil || daily == nil {
+		t.Fatalf("daily note missing: %v", err)
+	}
+	lines := strings.Split(daily.Body, "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "on [[Thesis outline]]") || !strings.Contains(lines[1], "on writing (") {
+		t.Errorf("daily note body = %q", daily.Body)
+	}
+	if len(daily.Tags) != 1 || daily.Tags[0] != DailyNoteTag {
+		t.Errorf("daily note tags = %v", daily.Tags)
+	}
+	links, err := m.store.GetLinksForItem("note", daily.ID)
+	if err != nil || len(links) != 1 || links[0].TargetID != thesis.ID {
+		t.Errorf("links = %+v, err %v; want one to the thesis note", links, err)
+	}
+}
+
+func TestFocusJournalOff(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+
+	notes, err := m.store.ListNotes()
+	if err != nil || len(notes) != 0 {
+		t.Errorf("expected no notes without the journal, got %d (%v)", len(notes), err)
+	}
+}
@@ -0,0 +1,101 @@
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/notify"
+	"github.com/Jericoz-JC/flowState-CLI/internal/plugins"
+)
+
+// Webhook milestones (Phase 10: Integrations).
+//
+// When a webhook is configured, completing a focus work session posts a
+// message, plus one when it brings today's focus time up to the daily
+// goal and one when the day's first session extends the streak to a
+// milestone. Posting happens in the notify package's background goroutine,
+// so completing a session never waits on the network. Plugins subscribed
+// to session.completed get the session the same way.
+
+// streakMilestones are the streak lengths, in days, worth announcing.
+var streakMilestones = []int{3, 7, 14, 30, 50, 100, 200, 365}
+
+// SetNotifier sets where milestones are posted (nil disables them) and the
+// daily focus goal in minutes (0 for none).
+func (m *FocusModel) SetNotifier(n *notify.Notifier, dailyGoalMinutes int) {
+	m.notifier = n
+	m.dailyGoal = dailyGoalMinutes
+}
+
+// SetPlugins sets the plugins told about completed sessions (nil for
+// none).
+func (m *FocusModel) SetPlugins(host *plugins.Host) {
+	m.plugins = host
+}
+
+// notifySessionDone posts the milestones reached by session, which has
+// just been saved as completed, and tells the plugins about it.
+func (m *FocusModel) notifySessionDone(session *models.FocusSession) {
+	if session != nil {
+		m.plugins.Emit(plugins.EventSessionCompleted, *session)
+	}
+	if m.notifier == nil || session == nil {
+		return
+	}
+	sessions, err := m.store.GetSessionsForDate(session.StartTime)
+	if err != nil {
+		return
+	}
+	todayCount, todayMinutes := 0, 0
+	for _, s := range sessions {
+		if s.Status == models.SessionStatusCompleted {
+			todayCount++
+			todayMinutes += s.Duration / 60
+		}
+	}
+	streak := 0
+	if todayCount == 1 {
+		// Only the day's first session can extend the streak
+		streak, _ = m.store.GetCurrentStreak()
+	}
+	for _, e := range focusMilestones(session, todayMinutes, streak, m.dailyGoal) {
+		m.notifier.Notify(e)
+	}
+}
+
+// focusMilestones returns the events for a completed session, given
+// today's focus minutes including it, the streak it started today (0 if
+// it was not the day's first session) and the daily goal in minutes.
+func focusMilestones(session *models.FocusSession, todayMinutes, streak, goal int) []notify.Event {
+	minutes := session.Duration / 60
+	text := fmt.Sprintf("🍅 Focus session completed: %s", models.FormatMinutes(minutes))
+	if session.Label != "" {
+		text += " (" + session.Label + ")"
+	}
+	events := []notify.Event{{Kind: notify.EventSession, Text: text}}
+
+	if goal > 0 && todayMinutes >= goal && todayMinutes-minutes < goal {
+		events = append(events, notify.Event{
+			Kind: notify.EventDailyGoal,
+			Text: fmt.Sprintf("🎯 Daily focus goal reached: %s of %s", models.FormatMinutes(todayMinutes), models.FormatMinutes(goal)),
+		})
+	}
+	if slices.Contains(streakMilestones, streak) {
+		events = append(events, notify.Event{
+			Kind: notify.EventStreak,
+			Text: fmt.Sprintf("🔥 %d-day focus streak!", streak),
+		})
+	}
+	return events
+}
+
+// notifyFlushTimeout is how long Close waits for queued milestones.
+const notifyFlushTimeout = 3 * time.Second
+
+// CloseNotifier posts any queued milestones, waiting briefly, and stops the
+// notifier.
+func (m *FocusModel) CloseNotifier() {
+	m.notifier.Close(notifyFlushTimeout)
+}
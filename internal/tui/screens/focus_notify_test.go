@@ -0,0 +1,74 @@
+package screens
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/notify"
+)
+
+func TestFocusMilestones(t *testing.T) {
+	session := &models.FocusSession{Duration: 25 * 60, Label: "writing"}
+
+	kinds := func(events []notify.Event) string {
+		var ks []string
+		for _, e := range events {
+			ks = append(ks, e.Kind)
+		}
+		return strings.Join(ks, ",")
+	}
+
+	tests := []struct {
+		name                string
+		today, streak, goal int
+		want                string
+	}{
+		{"plain session", 25, 0, 0, "session"},
+		{"goal crossed", 100, 0, 90, "session,daily_goal"},
+		{"goal already hit", 125, 0, 90, "session"},
+		{"streak milestone", 25, 7, 0, "session,streak"},
+		{"ordinary streak", 25, 8, 0, "session"},
+	}
+	for _, tt := range tests {
+		events := focusMilestones(session, tt.today, tt.streak, tt.goal)
+		if got := kinds(events); got != tt.want {
+			t.Errorf("%s: kinds = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+	if text := focusMilestones(session, 25, 0, 0)[0].Text; !strings.Contains(text, "25m (writing)") {
+		t.Errorf("session text = %q", text)
+	}
+}
+
+func TestFocusPostsMilestonesOnCompletion(t *testing.T) {
+	var mu sync.Mutex
+	var texts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&p)
+		mu.Lock()
+		texts = append(texts, p["text"])
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	m := newTestFocusModel(t)
+	m.SetNotifier(notify.New(srv.URL, nil), 20)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}}) // Complete early
+	m.CloseNotifier()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(texts) != 2 || !strings.Contains(texts[0], "Focus session completed") || !strings.Contains(texts[1], "goal reached") {
+		t.Fatalf("posted %q, want the session and the daily goal", texts)
+	}
+}
@@ -0,0 +1,292 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Planned sessions (Phase 5: Focus Sessions).
+//
+// Focus blocks can be scheduled for later today ("3pm 45m #thesis").
+// Each one waits on a tick for its start time, which the app routes here
+// from any screen: the app shows a toast, and the focus screen offers
+// to start the block with its length and label (Enter) or skip it
+// (Esc). Today's blocks are listed under the timer and in the week
+// planner; the history stats compare this week's planned focus with the
+// sessions completed from the blocks.
+//
+// Keyboard Shortcuts (any timer mode):
+//   - a: Plan a block, e.g. "3pm 45m #thesis", "15:30 writing" or "9:00am 1h"
+//   - A: Cancel the next block that has not started
+
+// PlannedSessionDueMsg is sent when a planned block's start time arrives.
+type PlannedSessionDueMsg struct {
+	ID  int64
+	seq int // planSeq when the tick was scheduled; older ticks are stale
+}
+
+// planTimeLayouts are the accepted ways of writing a block's start time.
+var planTimeLayouts = []string{"3pm", "3:04pm", "15:04"}
+
+// parsePlannedSession parses "<time>[:] [duration] [on] [label]" into a
+// block for later today. The duration is a Go duration ("45m", "1h30m")
+// or a bare number of minutes, defaulting to defaultMinutes.
+func parsePlannedSession(input string, now time.Time, defaultMinutes int) (models.PlannedSession, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return models.PlannedSession{}, fmt.Errorf("enter a start time, e.g. 3pm 45m #thesis")
+	}
+
+	clock := strings.ToLower(strings.TrimSuffix(fields[0], ":"))
+	var start time.Time
+	if hour, err := strconv.Atoi(clock); err == nil && hour >= 0 && hour < 24 {
+		start = time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	} else {
+		parsed := false
+		for _, layout := range planTimeLayouts {
+			if t, err := time.Parse(layout, clock); err == nil {
+				start = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+				parsed = true
+				break
+			}
+		}
+		if !parsed {
+			return models.PlannedSession{}, fmt.Errorf("invalid time %q (try 3pm, 3:30pm or 15:30)", fields[0])
+		}
+	}
+	if !start.After(now) {
+		return models.PlannedSession{}, fmt.Errorf("%s has passed; plan a time later today", datefmt.Clock(start))
+	}
+
+	plan := models.PlannedSession{Start: start, Minutes: defaultMinutes}
+	rest := fields[1:]
+	if len(rest) > 0 {
+		if d, err := time.ParseDuration(strings.ToLower(rest[0])); err == nil {
+			plan.Minutes = int(d / time.Minute)
+			rest = rest[1:]
+		} else if n, err := strconv.Atoi(rest[0]); err == nil {
+			plan.Minutes = n
+			rest = rest[1:]
+		}
+	}
+	if plan.Minutes < 1 || plan.Minutes > 8*60 {
+		return models.PlannedSession{}, fmt.Errorf("a block must be between 1m and 8h")
+	}
+	if len(rest) > 0 && strings.EqualFold(rest[0], "on") {
+		rest = rest[1:]
+	}
+	plan.Label = normalizeSessionLabel(strings.Join(rest, " "))
+	return plan, nil
+}
+
+// SchedulePlans loads today's planned blocks and returns the ticks that
+// fire when the ones still ahead start. Ticks from earlier loads are
+// ignored from now on.
+func (m *FocusModel) SchedulePlans() tea.Cmd {
+	now := time.Now()
+	today := startOfDay(now)
+	plans, err := m.store.ListPlannedSessions(today, today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil
+	}
+	m.plans = plans
+	m.planSeq++
+
+	var cmds []tea.Cmd
+	for _, plan := range plans {
+		if plan.SessionID != nil || !plan.Start.After(now) {
+			continue
+		}
+		msg := PlannedSessionDueMsg{ID: plan.ID, seq: m.planSeq}
+		cmds = append(cmds, tea.Tick(plan.Start.Sub(now), func(time.Time) tea.Msg { return msg }))
+	}
+	return tea.Batch(cmds...)
+}
+
+// planDue offers the block of msg to be started, unless the tick is stale.
+func (m *FocusModel) planDue(msg PlannedSessionDueMsg) tea.Cmd {
+	if msg.seq != m.planSeq {
+		return nil
+	}
+	for _, plan := range m.plans {
+		if plan.ID == msg.ID && plan.SessionID == nil {
+			m.duePlan = &plan
+			return tea.Batch(m.chimeCmd(), toastCmd("⏰ Planned focus: "+describePlan(plan)+" — start it on the focus screen"))
+		}
+	}
+	return nil
+}
+
+// handleDuePlan starts (Enter, s) or skips (Esc) the block offered by
+// planDue. It reports false for other keys, and while a session runs.
+func (m *FocusModel) handleDuePlan(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if m.duePlan == nil || m.mode != FocusModeIdle {
+		return nil, false
+	}
+	switch msg.String() {
+	case "enter", "s":
+		plan := *m.duePlan
+		m.duePlan = nil
+		return m.startPlannedSession(plan), true
+	case "esc":
+		m.duePlan = nil
+		return nil, true
+	}
+	return nil, false
+}
+
+// startPlannedSession starts a work session with the block's length and
+// label. The label is kept for later sessions, as with l.
+func (m *FocusModel) startPlannedSession(plan models.PlannedSession) tea.Cmd {
+	m.label = plan.Label
+	cmd := m.startWorkSession()
+	m.remaining = time.Duration(plan.Minutes) * time.Minute
+	m.totalDuration = m.remaining
+	m.currentSession.Duration = plan.Minutes * 60
+	m.activePlanID = plan.ID
+	return cmd
+}
+
+// recordPlanned links the session just saved as completed to the block
+// it was started from, if any.
+func (m *FocusModel) recordPlanned(session *models.FocusSession) {
+	id := m.activePlanID
+	m.activePlanID = 0
+	if id == 0 || session == nil || session.ID == 0 {
+		return
+	}
+	if err := m.store.SetPlannedSessionDone(id, session.ID); err != nil {
+		return
+	}
+	for i := range m.plans {
+		if m.plans[i].ID == id {
+			sessionID := session.ID
+			m.plans[i].SessionID = &sessionID
+		}
+	}
+}
+
+// openPlanPrompt opens the prompt for a new planned block.
+func (m *FocusModel) openPlanPrompt() {
+	m.planInput = components.NewTextInput("3pm 45m #thesis")
+	m.planInput.Focus()
+	m.planInputErr = ""
+	m.showPlanInput = true
+}
+
+// handlePlanPrompt handles keys while the plan prompt is open.
+func (m *FocusModel) handlePlanPrompt(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showPlanInput = false
+		m.planInputErr = ""
+		return *m, nil
+	case "enter":
+		plan, err := parsePlannedSession(m.planInput.Value(), time.Now(), m.workDuration)
+		if err != nil {
+			m.planInputErr = err.Error()
+			return *m, nil
+		}
+		if err := m.store.CreatePlannedSession(&plan); err != nil {
+			m.planInputErr = "Failed to save: " + err.Error()
+			return *m, nil
+		}
+		m.showPlanInput = false
+		m.planInputErr = ""
+		return *m, tea.Batch(m.SchedulePlans(), toastCmd("Planned "+describePlan(plan)))
+	}
+	var cmd tea.Cmd
+	m.planInput, cmd = m.planInput.Update(msg)
+	return *m, cmd
+}
+
+// cancelNextPlan deletes the earliest block that has not started yet.
+func (m *FocusModel) cancelNextPlan() tea.Cmd {
+	now := time.Now()
+	for _, plan := range m.plans {
+		if plan.SessionID != nil || !plan.Start.After(now) {
+			continue
+		}
+		if err := m.store.DeletePlannedSession(plan.ID); err != nil {
+			return toastCmd("Could not cancel the block: " + err.Error())
+		}
+		return tea.Batch(m.SchedulePlans(), toastCmd("Cancelled "+describePlan(plan)))
+	}
+	return toastCmd("No planned blocks ahead today")
+}
+
+// describePlan renders a block as "15:00 · 45m on thesis".
+func describePlan(plan models.PlannedSession) string {
+	text := datefmt.Clock(plan.Start) + " · " + models.FormatMinutes(plan.Minutes)
+	if plan.Label != "" {
+		text += " on " + plan.Label
+	}
+	return text
+}
+
+// renderPlans renders the plan prompt, the due block's offer and today's
+// blocks, or "" when there is none of them. Done blocks are ticked and
+// missed ones dimmed.
+func (m *FocusModel) renderPlans() string {
+	var lines []string
+	if m.showPlanInput {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+		lines = append(lines, labelStyle.Render("Plan a block (time, length, label):")+" "+m.planInput.View())
+	}
+	if m.planInputErr != "" {
+		lines = append(lines, components.FieldError(m.planInputErr))
+	}
+	if m.duePlan != nil && m.mode == FocusModeIdle {
+		dueStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+		lines = append(lines, dueStyle.Render("⏰ "+describePlan(*m.duePlan)+" is due — Enter to start, Esc to skip"))
+	}
+
+	if len(m.plans) > 0 {
+		mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+		textStyle := lipgloss.NewStyle().Foreground(styles.TextColor)
+		now := time.Now()
+		parts := []string{mutedStyle.Render("🗓 Planned today:")}
+		for _, plan := range m.plans {
+			switch {
+			case plan.SessionID != nil:
+				parts = append(parts, mutedStyle.Render(describePlan(plan)+" ✓"))
+			case plan.Start.After(now):
+				parts = append(parts, textStyle.Render(describePlan(plan)))
+			default:
+				parts = append(parts, mutedStyle.Strikethrough(true).Render(describePlan(plan)))
+			}
+		}
+		lines = append(lines, strings.Join(parts, "  "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderPlanStats compares the blocks planned so far this week with the
+// sessions completed from them, or "" when none were planned.
+func (m *FocusModel) renderPlanStats() string {
+	ps := m.planStats
+	if ps.Planned == 0 {
+		return ""
+	}
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	valueStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+	planned := models.FormatMinutes(ps.PlannedMinutes)
+	done := models.FormatMinutes(ps.DoneMinutes)
+	if done == "" {
+		done = "0m"
+	}
+	return mutedStyle.Render("Planned this week: ") +
+		valueStyle.Render(fmt.Sprintf("%d blocks · %s", ps.Planned, planned)) +
+		mutedStyle.Render(" → done: ") +
+		valueStyle.Render(fmt.Sprintf("%d · %s", ps.Done, done))
+}
@@ -0,0 +1,130 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestParsePlannedSession(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 10, 11, 20, 0, 0, time.Local)
+	at := func(h, m int) time.Time { return time.Date(2026, 3, 10, h, m, 0, 0, time.Local) }
+	for _, tc := range []struct {
+		input   string
+		start   time.Time
+		minutes int
+		label   string
+	}{
+		{"3pm: 45m on #thesis", at(15, 0), 45, "thesis"},
+		{"15:30 writing", at(15, 30), 25, "writing"},
+		{"9:00PM 1h30m Client A", at(21, 0), 90, "Client A"},
+		{"13 50", at(13, 0), 50, ""},
+	} {
+		plan, err := parsePlannedSession(tc.input, now, 25)
+		if err != nil {
+			t.Errorf("parsePlannedSession(%q) err = %v", tc.input, err)
+			continue
+		}
+		if !plan.Start.Equal(tc.start) || plan.Minutes != tc.minutes || plan.Label != tc.label {
+			t.Errorf("parsePlannedSession(%q) = %v %dm %q; want %v %dm %q",
+				tc.input, plan.Start, plan.Minutes, plan.Label, tc.start, tc.minutes, tc.label)
+		}
+	}
+	for _, input := range []string{"", "soon 45m", "9am 30m", "4pm 0m", "4pm 9h"} {
+		if _, err := parsePlannedSession(input, now, 25); err == nil {
+			t.Errorf("parsePlannedSession(%q) should fail", input)
+		}
+	}
+}
+
+// TestFocusPlannedSession follows a planned block from its due tick to
+// the completed session counted in the plan stats.
+func TestFocusPlannedSession(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	plan := &models.PlannedSession{Start: time.Now(), Minutes: 45, Label: "thesis"}
+	if err := m.store.CreatePlannedSession(plan); err != nil {
+		t.Fatalf("CreatePlannedSession() err = %v", err)
+	}
+	m.SchedulePlans()
+	if !strings.Contains(m.View(), "Planned today:") {
+		t.Fatalf("expected today's blocks under the timer, got:\n%s", m.View())
+	}
+
+	// A tick from an earlier load is ignored
+	m, cmd := m.Update(PlannedSessionDueMsg{ID: plan.ID, seq: m.planSeq - 1})
+	if cmd != nil || m.duePlan != nil {
+		t.Fatal("expected a stale tick to be ignored")
+	}
+	m, cmd = m.Update(PlannedSessionDueMsg{ID: plan.ID, seq: m.planSeq})
+	if cmd == nil || m.duePlan == nil || !strings.Contains(m.View(), "is due") {
+		t.Fatalf("expected the due block to be offered, got:\n%s", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.mode != FocusModeRunning || m.remaining != 45*time.Minute || m.label != "thesis" {
+		t.Fatalf("expected a 45m thesis session, got mode %v, %v, %q", m.mode, m.remaining, m.label)
+	}
+
+	// Fast-forward to the end of the session
+	m.remaining = time.Second
+	m, _ = m.Update(FocusTickMsg(time.Now()))
+	if m.plans[0].SessionID == nil {
+		t.Fatal("expected the block to be linked to its session")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc}) // Skip the break
+	m = typeKeys(m, "h")
+	if m.planStats.Planned != 1 || m.planStats.Done != 1 || m.planStats.DoneMinutes != 45 {
+		t.Fatalf("planStats = %+v, want 1 planned and done, 45m", m.planStats)
+	}
+	if view := m.View(); !strings.Contains(view, "Planned this week:") {
+		t.Errorf("expected the plan stats in the history, got:\n%s", view)
+	}
+}
+
+func TestFocusPlanPrompt(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m = typeKeys(m, "a")
+	if !m.InputActive() {
+		t.Fatal("expected the plan prompt to take keys")
+	}
+	m = typeKeys(m, "soon")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showPlanInput || !strings.Contains(m.View(), "invalid time") {
+		t.Fatalf("expected an error for a bad time, got:\n%s", m.View())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showPlanInput {
+		t.Fatal("expected Esc to close the prompt")
+	}
+
+	// A block later today is saved and listed; A cancels it again
+	later := time.Now().Add(time.Minute)
+	if startOfDay(later) != startOfDay(time.Now()) {
+		t.Skip("too close to midnight to plan later today")
+	}
+	m = typeKeys(m, "a")
+	m = typeKeys(m, later.Format("15:04")+" 30m reading")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(m.plans) != 1 || m.plans[0].Label != "reading" || m.plans[0].Minutes != 30 {
+		t.Fatalf("expected the block to be saved, got %+v", m.plans)
+	}
+	if !strings.Contains(m.View(), datefmt.Clock(m.plans[0].Start)+" · 30m on reading") {
+		t.Errorf("expected the block listed, got:\n%s", m.View())
+	}
+	m = typeKeys(m, "A")
+	if len(m.plans) != 0 {
+		t.Fatalf("expected A to cancel the block, got %+v", m.plans)
+	}
+}
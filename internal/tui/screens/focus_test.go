@@ -2,13 +2,16 @@ package screens
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
 )
 
 func newTestFocusModel(t *testing.T) FocusModel {
@@ -303,6 +306,25 @@ func TestFocusDurationPickerVisualFeedback(t *testing.T) {
 	}
 }
 
+// Not parallel: reduced motion is a global setting.
+func TestFocusDurationPickerReducedMotion(t *testing.T) {
+	styles.SetReducedMotion(true)
+	t.Cleanup(func() { styles.SetReducedMotion(false) })
+
+	m := newTestFocusModel(t)
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = mm
+
+	mm, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m = mm
+	if cmd != nil {
+		t.Fatal("expected no feedback or auto-exit timers with reduced motion")
+	}
+	if !m.durationJustChanged || m.mode != FocusModeDuration {
+		t.Fatalf("expected the picker to stay open showing Saved, got mode %v saved %v", m.mode, m.durationJustChanged)
+	}
+}
+
 func TestFocusDurationPickerShowsBothValues(t *testing.T) {
 	t.Parallel()
 
@@ -491,6 +513,161 @@ func TestFocusTimerViewContainsASCIIArt(t *testing.T) {
 	}
 }
 
+// TestFocusTimerCompactFallback verifies narrow terminals get the small
+// boxed timer instead of the big digits.
+func TestFocusTimerCompactFallback(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m.remaining = 25 * time.Minute
+
+	if timer := m.renderLargeTimer(); !containsString(timer, "█") {
+		t.Fatalf("expected big ASCII digits at width %d, got:\n%s", m.width, timer)
+	}
+
+	m.SetSize(38, 40)
+	timer := m.renderLargeTimer()
+	if containsString(timer, "█") {
+		t.Errorf("expected no big digits at width 38, got:\n%s", timer)
+	}
+	if !containsString(timer, "25:00") || !containsString(timer, "╭") {
+		t.Errorf("expected the compact boxed time at width 38, got:\n%s", timer)
+	}
+}
+
+// TestFocusBreakChecklist verifies break activities render during breaks,
+// toggle with number keys and reset for the next break.
+func TestFocusBreakChecklist(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m.SetBreakActivities([]string{"Stand up", "Drink water"})
+
+	if containsString(m.View(), "Break checklist") {
+		t.Fatalf("expected no checklist outside break mode")
+	}
+
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = mm
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	m = mm
+	if m.mode != FocusModeBreak {
+		t.Fatalf("expected FocusModeBreak, got %v", m.mode)
+	}
+	if view := m.View(); !containsString(view, "Break checklist 0/2") || !containsString(view, "[ ] Drink water") {
+		t.Fatalf("expected unchecked checklist in break view, got:\n%s", view)
+	}
+
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	m = mm
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}}) // out of range: ignored
+	m = mm
+	if view := m.View(); !containsString(view, "Break checklist 1/2") || !containsString(view, "[✓] Drink water") {
+		t.Errorf("expected Drink water ticked, got:\n%s", view)
+	}
+
+	// The next break starts with a clean checklist
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = mm
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m = mm
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	m = mm
+	if !containsString(m.View(), "Break checklist 0/2") {
+		t.Errorf("expected the checklist to reset for a new break")
+	}
+}
+
+// TestFocusSessionLabel verifies a label typed at start is saved on the
+// session, kept for the next one, and filters history.
+func TestFocusSessionLabel(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m = typeKeys(m, "l")
+	if !m.InputActive() {
+		t.Fatalf("expected the label prompt to take input")
+	}
+	m = typeKeys(m, "#writing")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.mode != FocusModeRunning || m.currentSession == nil || m.currentSession.Label != "writing" {
+		t.Fatalf("expected a running session labelled writing, got mode %v session %+v", m.mode, m.currentSession)
+	}
+	if !containsString(m.View(), "🏷 writing") {
+		t.Errorf("expected the label in the running view")
+	}
+	m = typeKeys(m, "b") // complete early and save
+
+	// An unlabelled session for the filter to skip
+	if err := m.store.CreateSession(&models.FocusSession{StartTime: time.Now(), Duration: 600, Status: models.SessionStatusCompleted}); err != nil {
+		t.Fatalf("CreateSession() err = %v", err)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = typeKeys(m, "s")
+	if m.currentSession.Label != "writing" {
+		t.Errorf("expected the label to carry over to the next session, got %q", m.currentSession.Label)
+	}
+	m = typeKeys(m, "c")
+
+	m = typeKeys(m, "h")
+	if got := historySessionCount(m); got != 2 {
+		t.Fatalf("expected 2 sessions in history, got %d", got)
+	}
+	if !containsString(m.View(), "writing 1 · 0h 25m") {
+		t.Errorf("expected per-label stats in history, got:\n%s", m.View())
+	}
+	m = typeKeys(m, "f")
+	if m.historyLabel != "writing" || historySessionCount(m) != 1 {
+		t.Errorf("expected the writing filter to leave 1 session, got %q with %d", m.historyLabel, historySessionCount(m))
+	}
+	m = typeKeys(m, "f")
+	if m.historyLabel != "" || historySessionCount(m) != 2 {
+		t.Errorf("expected f to wrap back to all sessions")
+	}
+}
+
+func TestFocusEnergyPrompt(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m = typeKeys(m, "sb") // complete early without the prompt
+	if m.rateSessionID != 0 {
+		t.Fatalf("expected no energy prompt while it is off")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	m.SetEnergyPrompt(true)
+	m = typeKeys(m, "sb")
+	if m.rateSessionID == 0 || !containsString(m.View(), "How was your energy?") {
+		t.Fatalf("expected the energy prompt after the session, got:\n%s", m.View())
+	}
+	id := m.rateSessionID
+	m = typeKeys(m, "4")
+	if m.rateSessionID != 0 {
+		t.Errorf("expected a rating to close the prompt")
+	}
+	if m.mode != FocusModeBreak {
+		t.Errorf("expected the break to keep running, got mode %v", m.mode)
+	}
+	session, err := m.store.GetSession(id)
+	if err != nil || session == nil || session.Energy != 4 {
+		t.Fatalf("GetSession(%d) = %+v, %v; want energy 4", id, session, err)
+	}
+
+	// Esc skips the rating first, then the break
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = typeKeys(m, "sb")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.rateSessionID != 0 || m.mode != FocusModeBreak {
+		t.Fatalf("expected Esc to skip the rating and leave the break, got mode %v", m.mode)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = typeKeys(m, "h")
+	if !containsString(m.View(), "Energy by time of day:") || !containsString(m.View(), "energy 4/5") {
+		t.Errorf("expected energy stats and the rating in history, got:\n%s", m.View())
+	}
+}
+
 // TestFocusProgressRingDisplay verifies progress ring is shown.
 func TestFocusProgressRingDisplay(t *testing.T) {
 	t.Parallel()
@@ -523,3 +700,94 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+// runFocusToCompletion ticks the current phase down to zero.
+func runFocusToCompletion(m FocusModel) (FocusModel, tea.Cmd) {
+	m.remaining = time.Second
+	return m.Update(FocusTickMsg(time.Now()))
+}
+
+// TestFocusManualBreakStart verifies a break waits for confirmation when
+// auto-start is off, and the bell rings when the phase ends.
+func TestFocusManualBreakStart(t *testing.T) {
+	t.Parallel()
+
+	var bell strings.Builder
+	m := newTestFocusModel(t)
+	m.SetAutoStart(false, false, true)
+	m.bell = &bell
+
+	m = typeKeys(m, "s")
+	m, cmd := runFocusToCompletion(m)
+	if m.mode != FocusModeBreakPending {
+		t.Fatalf("expected FocusModeBreakPending after work, got %v", m.mode)
+	}
+	runBatch(cmd)
+	if bell.String() != "\a" {
+		t.Errorf("expected one bell, got %q", bell.String())
+	}
+	if sessions, _ := m.store.ListSessions(); len(sessions) != 1 {
+		t.Errorf("expected the completed work session saved, got %d", len(sessions))
+	}
+	if !containsString(m.View(), "W O R K   C O M P L E T E") {
+		t.Errorf("expected the work complete header")
+	}
+
+	// A tick while pending changes nothing
+	remaining := m.remaining
+	m, _ = m.Update(FocusTickMsg(time.Now()))
+	if m.remaining != remaining {
+		t.Errorf("expected the pending break not to count down")
+	}
+
+	m = typeKeys(m, "s")
+	if m.mode != FocusModeBreak {
+		t.Fatalf("expected s to start the break, got %v", m.mode)
+	}
+	m, _ = runFocusToCompletion(m)
+	if m.mode != FocusModeIdle || !containsString(m.View(), "Break over") {
+		t.Errorf("expected idle with a break-over notice, got mode %v", m.mode)
+	}
+	m = typeKeys(m, "h")
+	m = typeKeys(m, "h")
+	if containsString(m.View(), "Break over") {
+		t.Errorf("expected the notice cleared by the next key")
+	}
+}
+
+// TestFocusAutoStartWork verifies the next work session starts on its own
+// after a break when configured.
+func TestFocusAutoStartWork(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m.SetAutoStart(true, true, false)
+
+	m = typeKeys(m, "l")
+	m = typeKeys(m, "writing")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = runFocusToCompletion(m)
+	if m.mode != FocusModeBreak {
+		t.Fatalf("expected the break to auto-start, got %v", m.mode)
+	}
+	m, cmd := runFocusToCompletion(m)
+	if m.mode != FocusModeRunning || cmd == nil {
+		t.Fatalf("expected the next work session to auto-start, got %v", m.mode)
+	}
+	if m.currentSession == nil || m.currentSession.Label != "writing" {
+		t.Errorf("expected the auto-started session to keep the label")
+	}
+}
+
+// runBatch runs cmd, expanding batches, and discards timer commands'
+// results; only side effects such as the bell matter.
+func runBatch(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	if batch, ok := cmd().(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runBatch(c)
+		}
+	}
+}
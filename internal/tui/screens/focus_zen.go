@@ -0,0 +1,60 @@
+package screens
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Zen view (Phase 5: Focus Sessions).
+//
+// Pressing "z" during a session hides the header, stats, help bar and the
+// app's status bar, leaving only the large timer and its progress bar
+// centered on a dimmed background. Every timer key keeps working; zen
+// stays on for later sessions until "z" is pressed again.
+
+// Zen reports whether the zen view is showing, so the app can drop its
+// own chrome around it.
+func (m *FocusModel) Zen() bool {
+	if !m.zen {
+		return false
+	}
+	switch m.mode {
+	case FocusModeRunning, FocusModePaused, FocusModeBreak:
+		return true
+	}
+	return false
+}
+
+// toggleZen turns the zen view on or off. It only applies to a running,
+// paused or break session.
+func (m *FocusModel) toggleZen() bool {
+	switch m.mode {
+	case FocusModeRunning, FocusModePaused, FocusModeBreak:
+		m.zen = !m.zen
+		return true
+	}
+	return false
+}
+
+// renderZen renders the timer and progress alone, centered.
+func (m *FocusModel) renderZen() string {
+	content := lipgloss.JoinVertical(
+		lipgloss.Center,
+		m.renderLargeTimer(),
+		"",
+		m.renderProgressBar(),
+	)
+	if m.mode == FocusModePaused {
+		paused := lipgloss.NewStyle().Foreground(styles.WarningColor).Render("⏸ paused")
+		content = lipgloss.JoinVertical(lipgloss.Center, content, "", paused)
+	}
+
+	// Leave a line for toasts, such as a side timer ending
+	height := m.height - 1
+	if height < lipgloss.Height(content) {
+		height = lipgloss.Height(content)
+	}
+	return lipgloss.Place(m.width, height, lipgloss.Center, lipgloss.Center, content,
+		lipgloss.WithWhitespaceBackground(styles.BackgroundColor))
+}
@@ -0,0 +1,58 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFocusZenView(t *testing.T) {
+	t.Parallel()
+
+	key := func(r rune) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}} }
+
+	m := newTestFocusModel(t)
+	m, _ = m.Update(key('z'))
+	if m.Zen() {
+		t.Fatal("z should do nothing while idle")
+	}
+
+	m, _ = m.Update(key('s'))
+	m, _ = m.Update(key('z'))
+	if !m.Zen() {
+		t.Fatal("expected z to turn on zen during a session")
+	}
+	view := m.View()
+	for _, chrome := range []string{"Focus Sessions", "W O R K", "[p]"} {
+		if strings.Contains(view, chrome) {
+			t.Errorf("zen view should hide %q", chrome)
+		}
+	}
+	if !strings.Contains(view, "0%") {
+		t.Error("zen view should keep the progress bar")
+	}
+
+	// Timer keys keep working.
+	m, _ = m.Update(key('p'))
+	if m.mode != FocusModePaused || !m.Zen() {
+		t.Fatalf("expected a paused zen session, got mode %v zen %v", m.mode, m.Zen())
+	}
+	if !strings.Contains(m.View(), "paused") {
+		t.Error("zen view should show that the session is paused")
+	}
+
+	// Cancelling returns to the full idle view; zen comes back next session.
+	m, _ = m.Update(key('c'))
+	if m.Zen() || !strings.Contains(m.View(), "Focus Sessions") {
+		t.Fatal("expected the full view when idle")
+	}
+	m, _ = m.Update(key('s'))
+	if !m.Zen() {
+		t.Fatal("expected zen to stay on for the next session")
+	}
+	m, _ = m.Update(key('z'))
+	if m.Zen() {
+		t.Fatal("expected z to turn zen off")
+	}
+}
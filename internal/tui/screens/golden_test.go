@@ -0,0 +1,225 @@
+package screens
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Golden-file snapshots of every screen's View() at canonical sizes.
+//
+// Output is ANSI-stripped and scrubbed of timestamps and other
+// clock-dependent text (replaced by fixed placeholders). After an intended
+// visual change, regenerate with:
+//
+//	go test ./internal/tui/screens/ -run TestGolden -update
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata/golden")
+
+// goldenSizes are the canonical terminal sizes snapshotted per screen.
+var goldenSizes = []struct{ width, height int }{
+	{80, 24},
+	{120, 40},
+}
+
+// goldenNow pins the planner week so day columns do not move.
+var goldenNow = time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local) // Monday
+
+var (
+	ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+	scrubbers   = []struct {
+		pattern     *regexp.Regexp
+		replacement string
+	}{
+		{regexp.MustCompile(`\d{4}-\d{2}-\d{2}`), "YYYY-MM-DD"},
+		{regexp.MustCompile(`\b\d{1,2}:\d{2}( [AP]M)?\b`), "HH:MM"},
+		// Seeded due dates sit in goldenNow's (past) week, so only the count drifts
+		{regexp.MustCompile(`Overdue \d+ days`), "Overdue N days"},
+		{regexp.MustCompile(`\b\d+(m|h|d|w|mo) ago\b`), "N ago"},
+		{regexp.MustCompile(`\b(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) ( \d|\d\d)\b`), "Mmm DD"},
+	}
+)
+
+// normalizeView strips ANSI codes, scrubs timestamps and trailing spaces.
+func normalizeView(view string) string {
+	view = ansiPattern.ReplaceAllString(view, "")
+	for _, s := range scrubbers {
+		view = s.pattern.ReplaceAllString(view, s.replacement)
+	}
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// newGoldenStore returns a store seeded with a fixed set of notes, todos
+// and links, and a semantic searcher over it.
+func newGoldenStore(t *testing.T) (*sqlite.Store, *search.SemanticSearch) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{
+		DbPath:    filepath.Join(tmpDir, "test.db"),
+		ModelPath: filepath.Join(tmpDir, "models"),
+	}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	notes := []*models.Note{
+		{Title: "Project kickoff", Body: "Agenda for the #work kickoff\n- scope\n- timeline", Tags: []string{"work"}},
+		{Title: "Reading list", Body: "Books to read @later: Deep Work, Atomic Habits", Tags: []string{"later"}},
+		{Title: "Call the bank", Body: "about the mortgage", Tags: []string{"quick", InboxTag}},
+	}
+	for _, note := range notes {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+
+	day := func(offset int) *time.Time {
+		d := goldenNow.AddDate(0, 0, offset)
+		return &d
+	}
+	kickoff := notes[0].ID
+	todos := []*models.Todo{
+		{Title: "Ship release #work", Description: "Tag and publish v1.0", Status: models.TodoStatusPending, Priority: models.TodoPriorityHigh, Project: "Launch", DueDate: day(0), EstimateMinutes: 90},
+		{Title: "Plan sprint", Status: models.TodoStatusInProgress, Priority: models.TodoPriorityMedium, Project: "Launch", DueDate: day(2), EstimateMinutes: 30, NoteID: &kickoff},
+		{Title: "Answer email", Status: models.TodoStatusCompleted, Priority: models.TodoPriorityLow},
+		{Title: "Water plants", Status: models.TodoStatusPending, Priority: models.TodoPriorityLow},
+	}
+	for _, todo := range todos {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	if err := store.CreateLink(&models.Link{SourceType: "note", SourceID: notes[0].ID, TargetType: "note", TargetID: notes[1].ID, LinkType: "related"}); err != nil {
+		t.Fatalf("CreateLink() err = %v", err)
+	}
+
+	emb, err := embeddings.New(cfg)
+	if err != nil {
+		t.Fatalf("embeddings.New() err = %v", err)
+	}
+	semantic := search.New(emb, store)
+	if err := semantic.IndexAllNotes(); err != nil {
+		t.Fatalf("IndexAllNotes() err = %v", err)
+	}
+	return store, semantic
+}
+
+// goldenScreens builds each screen from the seeded store at a size.
+var goldenScreens = []struct {
+	name   string
+	render func(t *testing.T, store *sqlite.Store, semantic *search.SemanticSearch, width, height int) string
+}{
+	{"notes", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewNotesListModel(store)
+		m.SetSize(w, h)
+		if err := m.LoadNotes(); err != nil {
+			t.Fatalf("LoadNotes() err = %v", err)
+		}
+		return m.View()
+	}},
+	{"todos", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewTodosListModel(store)
+		m.SetSize(w, h)
+		if err := m.LoadTodos(); err != nil {
+			t.Fatalf("LoadTodos() err = %v", err)
+		}
+		return m.View()
+	}},
+	{"focus", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewFocusModel(store)
+		m.SetSize(w, h)
+		return m.View()
+	}},
+	{"search", func(t *testing.T, store *sqlite.Store, semantic *search.SemanticSearch, w, h int) string {
+		m := NewSearchModel(store, semantic)
+		m.SetSize(w, h)
+		m.query.SetValue("kickoff agenda")
+		m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m, _ = m.Update(cmd())
+		return m.View()
+	}},
+	{"mindmap", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewMindMapModel(store)
+		m.SetSize(w, h)
+		if err := m.LoadGraph(); err != nil {
+			t.Fatalf("LoadGraph() err = %v", err)
+		}
+		return m.View()
+	}},
+	{"planner", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewPlannerModel(store)
+		m.SetSize(w, h)
+		if err := m.loadFrom(goldenNow); err != nil {
+			t.Fatalf("loadFrom() err = %v", err)
+		}
+		return m.View()
+	}},
+	{"projects", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewProjectsModel(store)
+		m.SetSize(w, h)
+		if err := m.LoadProjects(); err != nil {
+			t.Fatalf("LoadProjects() err = %v", err)
+		}
+		return m.View()
+	}},
+	{"inbox", func(t *testing.T, store *sqlite.Store, _ *search.SemanticSearch, w, h int) string {
+		m := NewInboxModel(store)
+		m.SetSize(w, h)
+		if err := m.LoadInbox(); err != nil {
+			t.Fatalf("LoadInbox() err = %v", err)
+		}
+		return m.View()
+	}},
+}
+
+func TestGoldenScreens(t *testing.T) {
+	store, semantic := newGoldenStore(t)
+
+	for _, screen := range goldenScreens {
+		for _, size := range goldenSizes {
+			name := fmt.Sprintf("%s_%dx%d", screen.name, size.width, size.height)
+			t.Run(name, func(t *testing.T) {
+				got := normalizeView(screen.render(t, store, semantic, size.width, size.height))
+				path := filepath.Join("testdata", "golden", name+".golden")
+
+				if *updateGolden {
+					if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+						t.Fatalf("create golden dir: %v", err)
+					}
+					if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+						t.Fatalf("write golden file: %v", err)
+					}
+					return
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("read golden file (run with -update to create it): %v", err)
+				}
+				if got != string(want) {
+					t.Errorf("%s differs from %s (run with -update if the change is intended)\n--- got ---\n%s--- want ---\n%s", name, path, got, want)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Long-note navigation in the body editor (Phase 4: UX Overhaul).
+//
+// editor_line_numbers in config.json numbers the body's lines. Ctrl+J asks
+// for a line number and jumps there; Ctrl+Home and Ctrl+End jump to the
+// start and end of the body, from the title field too.
+
+// SetLineNumbers shows or hides line numbers in the body editor.
+func (m *NotesListModel) SetLineNumbers(show bool) {
+	m.bodyInput.SetShowLineNumbers(show)
+}
+
+// openGoToLine focuses the body and prompts for a line to jump to.
+func (m *NotesListModel) openGoToLine() {
+	m.titleInput.Blur()
+	m.bodyInput.Focus()
+	m.lineInput = components.NewTextInput("line number")
+	m.lineInput.Focus()
+	m.showGoToLine = true
+}
+
+// updateGoToLine handles keys while the go-to-line prompt is open.
+func (m *NotesListModel) updateGoToLine(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.showGoToLine = false
+		if line, err := strconv.Atoi(strings.TrimSpace(m.lineInput.Value())); err == nil {
+			m.bodyInput.GoToLine(line)
+		}
+		return nil
+	case "esc", "ctrl+j":
+		m.showGoToLine = false
+		return nil
+	}
+	// Only digits are typed; anything else would never parse
+	if msg.Type == tea.KeyRunes && strings.Trim(string(msg.Runes), "0123456789") != "" {
+		return nil
+	}
+	var cmd tea.Cmd
+	m.lineInput, cmd = m.lineInput.Update(msg)
+	return cmd
+}
+
+// renderGoToLine renders the go-to-line prompt shown under the body.
+func (m *NotesListModel) renderGoToLine() string {
+	label := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).
+		Render(fmt.Sprintf("Go to line (1-%d):", m.bodyInput.LineCount()))
+	return lipgloss.JoinHorizontal(lipgloss.Center, label, " ", m.lineInput.View(), "  ",
+		styles.HelpStyle.Render("[Enter] Go  [Esc] Cancel"))
+}
+
+// jumpToEdge moves to the start or end of the body for Ctrl+Home/End,
+// focusing the body first when the title has focus.
+func (m *NotesListModel) jumpToEdge(msg tea.KeyMsg) tea.Cmd {
+	m.titleInput.Blur()
+	m.bodyInput.Focus()
+	var cmd tea.Cmd
+	m.bodyInput, cmd = m.bodyInput.Update(msg)
+	return cmd
+}
@@ -0,0 +1,400 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Habits (Phase 5: Habits).
+//
+// The Habits screen (h on Home) is a grid of the month: one row per
+// habit, one column per day, ■ where the habit was checked off. Space
+// checks the selected habit off for the selected day, today unless h/l
+// moved it, and checks it back on a second press. Each row ends with the
+// habit's current streak (days in a row up to today, or up to yesterday
+// while today is still open, as for focus streaks) and its best streak.
+
+// maxHabitNameLength caps habit names, which share a row with the grid.
+const maxHabitNameLength = 40
+
+// HabitsModel is the habits tracker screen.
+type HabitsModel struct {
+	store    *sqlite.Store
+	habits   []models.Habit
+	checks   map[int64]map[time.Time]bool // Checked days per habit, as local midnights
+	streaks  map[int64][2]int             // Current and best streak per habit
+	selected int
+	day      time.Time // Selected day; the grid shows its month
+	err      error
+
+	input     components.TextInputModel
+	showInput bool
+	renaming  bool // The input renames the selected habit rather than adding one
+	inputErr  string
+
+	confirmDelete components.ConfirmModal
+	header        components.Header
+	helpBar       components.HelpBar
+	width         int
+	height        int
+}
+
+// habitsChangedMsg reports a confirmed delete, so the screen reloads
+// after the confirmation's callback has run on a copy of the model.
+type habitsChangedMsg struct{}
+
+// NewHabitsModel creates the habits screen.
+func NewHabitsModel(store *sqlite.Store) HabitsModel {
+	return HabitsModel{
+		store:         store,
+		day:           startOfDay(time.Now()),
+		confirmDelete: components.NewConfirmModal(),
+		header:        components.NewHeader("🔥", "Habits"),
+		helpBar:       components.NewHelpBar(components.HabitsHints),
+	}
+}
+
+func (m *HabitsModel) Init() tea.Cmd { return nil }
+
+func (m *HabitsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+	m.confirmDelete.SetWidth(width - 8)
+}
+
+// HelpSections returns the Habits screen's keys for the help modal.
+func (m *HabitsModel) HelpSections() []components.HelpSection {
+	return components.HabitsHelp
+}
+
+// InputActive reports whether a habit name is being typed.
+func (m *HabitsModel) InputActive() bool {
+	return m.showInput
+}
+
+// LoadHabits reads the habits and their check-offs, and moves the
+// selected day back to today.
+func (m *HabitsModel) LoadHabits() error {
+	m.day = startOfDay(time.Now())
+	return m.reload()
+}
+
+// reload reads the habits and their check-offs, keeping the selection.
+func (m *HabitsModel) reload() error {
+	habits, err := m.store.ListHabits()
+	if err != nil {
+		m.err = err
+		return err
+	}
+	days, err := m.store.ListHabitChecks()
+	if err != nil {
+		m.err = err
+		return err
+	}
+	m.err = nil
+	m.habits = habits
+	m.checks = make(map[int64]map[time.Time]bool, len(days))
+	m.streaks = make(map[int64][2]int, len(days))
+	today := startOfDay(time.Now())
+	for id, list := range days {
+		m.checks[id] = make(map[time.Time]bool, len(list))
+		for _, day := range list {
+			m.checks[id][startOfDay(day)] = true
+		}
+		m.streaks[id] = [2]int{currentStreak(m.checks[id], today), bestStreak(list)}
+	}
+	m.selected = max(min(m.selected, len(m.habits)-1), 0)
+	return nil
+}
+
+func (m *HabitsModel) Update(msg tea.Msg) (HabitsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case habitsChangedMsg:
+		m.reload()
+		return *m, nil
+	case tea.KeyMsg:
+		if m.confirmDelete.IsOpen() {
+			return *m, m.confirmDelete.Update(msg)
+		}
+		if m.showInput {
+			return *m, m.updateInput(msg)
+		}
+		return *m, m.updateGrid(msg)
+	}
+	return *m, nil
+}
+
+// updateGrid handles keys on the grid.
+func (m *HabitsModel) updateGrid(msg tea.KeyMsg) tea.Cmd {
+	today := startOfDay(time.Now())
+	switch msg.String() {
+	case "j", "down":
+		if m.selected < len(m.habits)-1 {
+			m.selected++
+		}
+	case "k", "up":
+		if m.selected > 0 {
+			m.selected--
+		}
+	case "h", "left":
+		m.day = m.day.AddDate(0, 0, -1)
+	case "l", "right":
+		if m.day.Before(today) {
+			m.day = m.day.AddDate(0, 0, 1)
+		}
+	case "t":
+		m.day = today
+	case " ", "x":
+		return m.toggle()
+	case "n":
+		m.openInput(false)
+	case "e":
+		if len(m.habits) > 0 {
+			m.openInput(true)
+		}
+	case "d":
+		if len(m.habits) > 0 {
+			habit := m.habits[m.selected]
+			store := m.store
+			m.confirmDelete.OpenDanger(fmt.Sprintf("Delete %q?", habit.Name), "Its check-offs and streaks are deleted too.", func() tea.Cmd {
+				store.DeleteHabit(habit.ID)
+				return func() tea.Msg { return habitsChangedMsg{} }
+			}, nil)
+		}
+	case "r":
+		m.reload()
+		return toastCmd("Habits reloaded")
+	case "esc":
+		return goBack
+	}
+	return nil
+}
+
+// toggle checks the selected habit off for the selected day, or back on.
+func (m *HabitsModel) toggle() tea.Cmd {
+	if len(m.habits) == 0 {
+		return nil
+	}
+	habit := m.habits[m.selected]
+	checked, err := m.store.ToggleHabitCheck(habit.ID, m.day)
+	if err != nil {
+		return toastCmd("Could not check off the habit: " + err.Error())
+	}
+	m.reload()
+	if !checked {
+		return nil
+	}
+	if streak := m.streaks[habit.ID][0]; streak > 1 {
+		return toastCmd(fmt.Sprintf("%s: %d days in a row", habit.Name, streak))
+	}
+	return nil
+}
+
+// openInput opens the name prompt to add a habit, or to rename the
+// selected one.
+func (m *HabitsModel) openInput(rename bool) {
+	m.input = components.NewTextInput("Habit, e.g. Read 20 pages")
+	m.renaming = rename
+	if rename {
+		m.input.SetValue(m.habits[m.selected].Name)
+	}
+	m.inputErr = ""
+	m.showInput = true
+}
+
+// updateInput handles keys while a habit name is being typed.
+func (m *HabitsModel) updateInput(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.showInput = false
+		return nil
+	case "enter":
+		name := strings.Join(strings.Fields(m.input.Value()), " ")
+		switch n := utf8.RuneCountInString(name); {
+		case n == 0:
+			m.inputErr = "Type a name for the habit"
+			return nil
+		case n > maxHabitNameLength:
+			m.inputErr = fmt.Sprintf("Name is too long (%d of %d characters)", n, maxHabitNameLength)
+			return nil
+		}
+		var err error
+		if m.renaming {
+			err = m.store.RenameHabit(m.habits[m.selected].ID, name)
+		} else {
+			err = m.store.CreateHabit(&models.Habit{Name: name})
+		}
+		if err != nil {
+			m.inputErr = err.Error()
+			return nil
+		}
+		m.showInput = false
+		m.reload()
+		if !m.renaming {
+			m.selected = len(m.habits) - 1
+		}
+		return nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.inputErr = ""
+	return cmd
+}
+
+func (m *HabitsModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+	if m.confirmDelete.IsOpen() {
+		return panel.Render(m.confirmDelete.View())
+	}
+
+	m.header.SetItemCount(len(m.habits))
+	sections := []string{m.header.View(), ""}
+	switch {
+	case m.err != nil:
+		sections = append(sections, components.FieldError("Could not load habits: "+m.err.Error()))
+	case len(m.habits) == 0:
+		sections = append(sections,
+			styles.SubtitleStyle.Render("No habits yet."),
+			"",
+			styles.HelpStyle.Render("Press [n] to add one, then [Space] each day you do it"),
+		)
+	default:
+		sections = append(sections, m.gridView())
+	}
+	if m.showInput {
+		label := "New habit:"
+		if m.renaming {
+			label = "Rename habit:"
+		}
+		prompt := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).Render(label) + " " + m.input.View()
+		if m.inputErr != "" {
+			prompt += "\n" + components.FieldError(m.inputErr)
+		}
+		sections = append(sections, "", prompt)
+	}
+	sections = append(sections, "", m.helpBar.View())
+	return panel.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// gridView renders the month of the selected day, a column per day.
+func (m *HabitsModel) gridView() string {
+	today := startOfDay(time.Now())
+	first := time.Date(m.day.Year(), m.day.Month(), 1, 0, 0, 0, 0, m.day.Location())
+	days := first.AddDate(0, 1, -1).Day()
+	muted := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	cursor := lipgloss.NewStyle().Reverse(true)
+
+	nameWidth := 0
+	for _, h := range m.habits {
+		nameWidth = max(nameWidth, lipgloss.Width(h.Name))
+	}
+	nameWidth = min(nameWidth, 20)
+	// Two columns a day when they fit next to the names and streaks
+	cell := 2
+	if 2+nameWidth+1+days*2+16 > m.width-4 {
+		cell = 1
+	}
+
+	tens, ones := strings.Builder{}, strings.Builder{}
+	for d := 1; d <= days; d++ {
+		ten := " "
+		if d >= 10 {
+			ten = fmt.Sprint(d / 10)
+		}
+		tens.WriteString(fmt.Sprintf("%-*s", cell, ten))
+		ones.WriteString(fmt.Sprintf("%-*d", cell, d%10))
+	}
+	pad := strings.Repeat(" ", 2+nameWidth+1)
+	rows := []string{
+		styles.SelectedItemStyle.Render(datefmt.Month(first)),
+		"",
+		pad + muted.Render(tens.String()),
+		pad + muted.Render(ones.String()),
+	}
+
+	for i, habit := range m.habits {
+		var grid strings.Builder
+		for d := 1; d <= days; d++ {
+			day := first.AddDate(0, 0, d-1)
+			mark := muted.Render("·")
+			switch {
+			case day.After(today):
+				mark = " "
+			case m.checks[habit.ID][day]:
+				mark = lipgloss.NewStyle().Foreground(styles.SecondaryColor).Render("■")
+			}
+			if i == m.selected && day.Equal(m.day) {
+				mark = cursor.Render(mark)
+			}
+			grid.WriteString(mark + strings.Repeat(" ", cell-1))
+		}
+
+		name := habit.Name
+		if utf8.RuneCountInString(name) > nameWidth {
+			name = string([]rune(name)[:nameWidth-1]) + "…"
+		}
+		name = lipgloss.NewStyle().Width(nameWidth).Render(name)
+		prefix := "  "
+		if i == m.selected {
+			prefix = styles.SelectedItemStyle.Render("▶ ")
+			name = styles.SelectedItemStyle.Render(name)
+		}
+		streak := m.streaks[habit.ID]
+		rows = append(rows, prefix+name+" "+grid.String()+muted.Render(fmt.Sprintf(" 🔥%d  best %d", streak[0], streak[1])))
+	}
+
+	checked := 0
+	for _, habit := range m.habits {
+		if m.checks[habit.ID][today] {
+			checked++
+		}
+	}
+	rows = append(rows, "", styles.SubtitleStyle.Render(fmt.Sprintf("Today: %d of %d done", checked, len(m.habits))))
+	if !m.day.Equal(today) {
+		rows = append(rows, muted.Render("Checking off "+datefmt.Day(m.day)+" · t back to today"))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// currentStreak counts the checked days in a row ending today, or ending
+// yesterday when today is not checked yet.
+func currentStreak(checked map[time.Time]bool, today time.Time) int {
+	day := today
+	if !checked[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	n := 0
+	for checked[day] {
+		n++
+		day = day.AddDate(0, 0, -1)
+	}
+	return n
+}
+
+// bestStreak returns the longest run of consecutive days in days, which
+// is sorted newest first.
+func bestStreak(days []time.Time) int {
+	best, run := 0, 0
+	for i, day := range days {
+		if i > 0 && days[i-1].AddDate(0, 0, -1).Equal(day) {
+			run++
+		} else {
+			run = 1
+		}
+		best = max(best, run)
+	}
+	return best
+}
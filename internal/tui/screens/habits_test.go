@@ -0,0 +1,104 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestHabitStreaks(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2026, 3, 11, 0, 0, 0, 0, time.Local)
+	day := func(n int) time.Time { return today.AddDate(0, 0, -n) }
+	// Newest first: yesterday and the two days before, then a 4-day run.
+	days := []time.Time{day(1), day(2), day(3), day(6), day(7), day(8), day(9)}
+	checked := make(map[time.Time]bool)
+	for _, d := range days {
+		checked[d] = true
+	}
+
+	if got := currentStreak(checked, today); got != 3 {
+		t.Errorf("currentStreak() with today open = %d, want 3", got)
+	}
+	checked[today] = true
+	if got := currentStreak(checked, today); got != 4 {
+		t.Errorf("currentStreak() with today checked = %d, want 4", got)
+	}
+	if got := currentStreak(checked, today.AddDate(0, 0, 2)); got != 0 {
+		t.Errorf("currentStreak() after a missed day = %d, want 0", got)
+	}
+	if got := bestStreak(days); got != 4 {
+		t.Errorf("bestStreak() = %d, want 4", got)
+	}
+}
+
+func TestHabitsCheckOff(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	m := NewHabitsModel(store)
+	m.SetSize(120, 40)
+	if err := m.LoadHabits(); err != nil {
+		t.Fatalf("LoadHabits() err = %v", err)
+	}
+	if !strings.Contains(m.View(), "No habits yet") {
+		t.Fatal("expected the empty state")
+	}
+
+	key := func(s string) {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+		switch s {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case " ":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		}
+		m.Update(msg)
+	}
+	key("n")
+	if !m.InputActive() {
+		t.Fatal("expected the name prompt after n")
+	}
+	for _, r := range "Read" {
+		key(string(r))
+	}
+	key("enter")
+	if m.InputActive() || len(m.habits) != 1 || m.habits[0].Name != "Read" {
+		t.Fatalf("habits after adding = %+v", m.habits)
+	}
+
+	// Check off yesterday, then today: a two-day streak.
+	key("h")
+	key(" ")
+	key("t")
+	key(" ")
+	if got := m.streaks[m.habits[0].ID]; got != [2]int{2, 2} {
+		t.Errorf("streaks = %v, want current 2, best 2", got)
+	}
+	if v := m.View(); !strings.Contains(v, "Today: 1 of 1 done") || !strings.Contains(v, time.Now().Format("January 2006")) {
+		t.Errorf("view missing today's count or the month:\n%s", v)
+	}
+	checked, total, err := store.CountHabitsChecked(time.Now())
+	if err != nil || checked != 1 || total != 1 {
+		t.Errorf("CountHabitsChecked() = %d of %d, %v", checked, total, err)
+	}
+
+	key("d")
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	m.Update(cmd())
+	if len(m.habits) != 0 {
+		t.Errorf("habits after delete = %+v", m.habits)
+	}
+}
@@ -0,0 +1,164 @@
+package screens
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Syntax highlighting for fenced code blocks in the markdown preview.
+//
+// Code is lexed with chroma, so any language it knows is highlighted;
+// its token types are mapped onto the theme's code styles rather than a
+// chroma style, so keywords, strings, comments, numbers and JSON keys
+// follow the palette. Unknown or missing language hints fall back to
+// plain CodeStyle.
+
+// fenceAliases maps fence language hints chroma does not know to one it
+// does.
+var fenceAliases = map[string]string{
+	"sqlite": "sql",
+	"jsonc":  "json",
+}
+
+// fenceLanguage returns the language hint of an opening fence ("```go").
+func fenceLanguage(fence string) string {
+	hint := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(fence), "`"))
+	if i := strings.IndexFunc(hint, unicode.IsSpace); i >= 0 {
+		hint = hint[:i]
+	}
+	return strings.ToLower(hint)
+}
+
+// codeLexer returns the lexer for a fence language hint, or nil.
+func codeLexer(lang string) chroma.Lexer {
+	if lang == "" {
+		return nil
+	}
+	if alias, ok := fenceAliases[lang]; ok {
+		lang = alias
+	}
+	lexer := lexers.Get(lang)
+	if lexer == nil {
+		return nil
+	}
+	return chroma.Coalesce(lexer)
+}
+
+// codeClass is how a token is coloured: one of the theme's code styles.
+type codeClass int
+
+const (
+	codePlain codeClass = iota
+	codeKeyword
+	codeComment
+	codeString
+	codeNumber
+	codeKey
+)
+
+// tokenClass returns the class of a chroma token type.
+func tokenClass(t chroma.TokenType) codeClass {
+	switch {
+	case t == chroma.NameTag:
+		return codeKey // JSON keys
+	case t.InCategory(chroma.Keyword):
+		return codeKeyword
+	case t.InCategory(chroma.Comment):
+		return codeComment
+	case t.InSubCategory(chroma.LiteralString):
+		return codeString
+	case t.InSubCategory(chroma.LiteralNumber):
+		return codeNumber
+	}
+	return codePlain
+}
+
+// style returns the theme style of c.
+func (c codeClass) style() lipgloss.Style {
+	switch c {
+	case codeKeyword:
+		return styles.CodeKeywordStyle
+	case codeComment:
+		return styles.CodeCommentStyle
+	case codeString:
+		return styles.CodeStringStyle
+	case codeNumber:
+		return styles.CodeNumberStyle
+	case codeKey:
+		return styles.CodeKeyStyle
+	}
+	return styles.CodeBlockStyle
+}
+
+// highlightCodeBlock renders the lines of a fenced code block. Lines are
+// tab-expanded and padded like styles.CodeStyle.
+func highlightCodeBlock(lang string, lines []string) []string {
+	out := make([]string, len(lines))
+	expanded := make([]string, len(lines))
+	for i, line := range lines {
+		expanded[i] = expandTabs(line)
+	}
+	lexer := codeLexer(lang)
+	var tokens []chroma.Token
+	if lexer != nil {
+		// The whole block is lexed at once so comments and strings can
+		// span lines.
+		it, err := lexer.Tokenise(nil, strings.Join(expanded, "\n"))
+		if err == nil {
+			tokens = it.Tokens()
+		} else {
+			lexer = nil
+		}
+	}
+	if lexer == nil {
+		for i, line := range expanded {
+			out[i] = styles.CodeStyle.Render(line)
+		}
+		return out
+	}
+
+	pad := styles.CodeBlockStyle.Render(" ")
+	var b strings.Builder
+	var run strings.Builder // Text awaiting its style
+	var runClass codeClass
+	line := 0
+	flush := func() {
+		if run.Len() > 0 {
+			b.WriteString(runClass.style().Render(run.String()))
+			run.Reset()
+		}
+	}
+	endLine := func() {
+		flush()
+		if line < len(out) {
+			out[line] = pad + b.String() + pad
+		}
+		b.Reset()
+		line++
+	}
+	for _, tok := range tokens {
+		for j, part := range strings.Split(tok.Value, "\n") {
+			if j > 0 {
+				endLine()
+			}
+			if part == "" {
+				continue
+			}
+			if class := tokenClass(tok.Type); class != runClass {
+				flush()
+				runClass = class
+			}
+			run.WriteString(part)
+		}
+	}
+	for line < len(out) {
+		endLine()
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package screens
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+func TestHighlightCodeBlock(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	pad := styles.CodeBlockStyle.Render(" ")
+	plain := styles.CodeBlockStyle.Render
+	kw := styles.CodeKeywordStyle.Render
+	str := styles.CodeStringStyle.Render
+	num := styles.CodeNumberStyle.Render
+	comment := styles.CodeCommentStyle.Render
+
+	tests := []struct {
+		name  string
+		fence string
+		lines []string
+		want  []string
+	}{
+		{
+			name:  "go",
+			fence: "```go",
+			lines: []string{`return "a // b", 42 // done`},
+			want:  []string{pad + kw("return") + plain(" ") + str(`"a // b"`) + plain(", ") + num("42") + plain(" ") + comment("// done") + pad},
+		},
+		{
+			name:  "sql is case-insensitive with -- comments",
+			fence: "```SQL",
+			lines: []string{"SELECT id from notes -- all"},
+			want:  []string{pad + kw("SELECT") + plain(" id ") + kw("from") + plain(" notes ") + comment("-- all") + pad},
+		},
+		{
+			name:  "json keys and literals",
+			fence: "``` json",
+			lines: []string{`{"done": true, "n": 1.5}`},
+			want:  []string{pad + plain("{") + styles.CodeKeyStyle.Render(`"done"`) + plain(": ") + kw("true") + plain(", ") + styles.CodeKeyStyle.Render(`"n"`) + plain(": ") + num("1.5") + plain("}") + pad},
+		},
+		{
+			name:  "block comments span lines",
+			fence: "```go",
+			lines: []string{"x /* start", "still */ y"},
+			want: []string{
+				pad + plain("x ") + comment("/* start") + pad,
+				pad + comment("still */") + plain(" y") + pad,
+			},
+		},
+		{
+			name:  "any language chroma knows",
+			fence: "```rust",
+			lines: []string{"let x = 1;"},
+			want:  []string{pad + kw("let") + plain(" x = ") + num("1") + plain(";") + pad},
+		},
+		{
+			name:  "unknown language falls back to plain code",
+			fence: "```nosuchlang",
+			lines: []string{"return 1"},
+			want:  []string{styles.CodeStyle.Render("return 1")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := highlightCodeBlock(fenceLanguage(tt.fence), tt.lines)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d lines, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("line %d = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
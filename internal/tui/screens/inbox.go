@@ -0,0 +1,401 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// InboxTag marks a note as an unprocessed capture. Quick capture adds it;
+// triage removes it (or removes the note entirely).
+const InboxTag = "inbox"
+
+// OpenLinkMsg is emitted to open the link modal for an item.
+type OpenLinkMsg struct {
+	ItemType string
+	ItemID   int64
+	Title    string
+}
+
+// InboxModel is the GTD-style inbox with a triage flow.
+//
+// Phase 6: Inbox
+//   - Collects notes tagged #inbox (every quick capture), oldest first
+//   - Shows one item at a time; each action processes it and advances
+//   - t: make todo (note becomes a todo and is removed)
+//   - n: keep as note (drops the #inbox tag)
+//   - l: link (keeps as note, then opens the link modal)
+//   - d: delete (with confirmation)
+//   - j/k: skip forward/back without processing
+//
+// Phase 6: Reminders
+//   - Notes whose resurface day (R in the note preview) has come are
+//     listed too, by that day; triaging one clears its reminder
+type InboxModel struct {
+	store *sqlite.Store
+
+	items      []models.Note
+	resurfaced map[int64]time.Time // Items here because their reminder is due, by note
+	index      int
+	processed  int  // Items triaged this visit, for the progress line
+	confirming bool // Delete confirmation visible
+	showHelp   bool
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewInboxModel creates the inbox screen.
+func NewInboxModel(store *sqlite.Store) InboxModel {
+	return InboxModel{
+		store:   store,
+		header:  components.NewHeader("📥", "Inbox"),
+		helpBar: components.NewHelpBar(components.InboxHints),
+	}
+}
+
+func (m *InboxModel) Init() tea.Cmd { return nil }
+
+func (m *InboxModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// LoadInbox refreshes unprocessed captures and resurfaced notes from the
+// database.
+func (m *InboxModel) LoadInbox() error {
+	notes, err := m.store.ListNotes()
+	if err != nil {
+		return err
+	}
+	due, err := m.store.ListDueReminders(time.Now())
+	if err != nil {
+		return err
+	}
+
+	m.items = m.items[:0]
+	m.resurfaced = due
+	for _, note := range notes {
+		_, resurfaced := due[note.ID]
+		if resurfaced || hasTag(note.Tags, InboxTag) {
+			// The card shows the whole body, which ListNotes truncates
+			full, err := m.store.GetNote(note.ID)
+			if err != nil {
+				return err
+			}
+			if full != nil {
+				m.items = append(m.items, *full)
+			}
+		}
+	}
+	sort.SliceStable(m.items, func(i, j int) bool {
+		return m.arrived(m.items[i]).Before(m.arrived(m.items[j]))
+	})
+	m.clampIndex()
+	return nil
+}
+
+// arrived returns when note came into the inbox: the day it resurfaced,
+// or when it was captured.
+func (m *InboxModel) arrived(note models.Note) time.Time {
+	if day, ok := m.resurfaced[note.ID]; ok {
+		return day
+	}
+	return note.CreatedAt
+}
+
+// Count returns the number of unprocessed items.
+func (m *InboxModel) Count() int {
+	return len(m.items)
+}
+
+// current returns the item being triaged, or nil when the inbox is empty.
+func (m *InboxModel) current() *models.Note {
+	if m.index < 0 || m.index >= len(m.items) {
+		return nil
+	}
+	return &m.items[m.index]
+}
+
+func (m *InboxModel) Update(msg tea.Msg) (InboxModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Handle help modal
+		if m.showHelp {
+			// Any key closes help
+			m.showHelp = false
+			return *m, nil
+		}
+
+		// Handle delete confirmation
+		if m.confirming {
+			switch msg.String() {
+			case "y", "Y":
+				if note := m.current(); note != nil {
+					if err := m.store.DeleteNote(note.ID); err == nil {
+						m.finish()
+					}
+				}
+				m.confirming = false
+			case "n", "N", "esc":
+				m.confirming = false
+			}
+			return *m, nil
+		}
+
+		note := m.current()
+		switch msg.String() {
+		case "esc":
+			return *m, goBack
+		case "?":
+			m.showHelp = true
+		case "j", "down", "s":
+			if m.index < len(m.items)-1 {
+				m.index++
+			}
+		case "k", "up":
+			if m.index > 0 {
+				m.index--
+			}
+		case "r":
+			m.LoadInbox()
+		case "t":
+			if note != nil {
+				m.makeTodo(note)
+			}
+		case "n":
+			if note != nil {
+				m.keepAsNote(note)
+			}
+		case "l":
+			if note != nil {
+				id, title := note.ID, note.Title
+				if m.keepAsNote(note) {
+					return *m, func() tea.Msg {
+						return OpenLinkMsg{ItemType: "note", ItemID: id, Title: title}
+					}
+				}
+			}
+		case "d":
+			if note != nil {
+				m.confirming = true
+			}
+		}
+	}
+
+	return *m, nil
+}
+
+// fullNote fetches the complete note; list queries truncate the body.
+func (m *InboxModel) fullNote(note *models.Note) *models.Note {
+	full, err := m.store.GetNote(note.ID)
+	if err != nil || full == nil {
+		return nil
+	}
+	return full
+}
+
+// makeTodo converts the capture into a pending todo and removes the note.
+func (m *InboxModel) makeTodo(note *models.Note) bool {
+	if note = m.fullNote(note); note == nil {
+		return false
+	}
+	todo := &models.Todo{
+		Title:       note.Title,
+		Description: note.Body,
+		Status:      models.TodoStatusPending,
+		Priority:    models.TodoPriorityMedium,
+	}
+	// Both or neither, so a failure cannot lose the capture or copy it
+	err := m.store.WithTx(func(tx *sqlite.Tx) error {
+		if err := tx.CreateTodo(todo); err != nil {
+			return err
+		}
+		return tx.DeleteNote(note.ID)
+	})
+	if err != nil {
+		return false
+	}
+	m.finish()
+	return true
+}
+
+// keepAsNote files the capture as a regular note by dropping #inbox and
+// clearing its reminder.
+func (m *InboxModel) keepAsNote(note *models.Note) bool {
+	if hasTag(note.Tags, InboxTag) {
+		full := m.fullNote(note)
+		if full == nil {
+			return false
+		}
+		kept := *full
+		kept.Tags = make([]string, 0, len(note.Tags))
+		for _, tag := range note.Tags {
+			if tag != InboxTag {
+				kept.Tags = append(kept.Tags, tag)
+			}
+		}
+		if err := m.store.UpdateNote(&kept); err != nil {
+			return false
+		}
+	}
+	if _, ok := m.resurfaced[note.ID]; ok {
+		if err := m.store.SetNoteReminder(note.ID, nil); err != nil {
+			return false
+		}
+	}
+	m.finish()
+	return true
+}
+
+// finish removes the current item from the queue after it was processed.
+func (m *InboxModel) finish() {
+	m.items = append(m.items[:m.index], m.items[m.index+1:]...)
+	m.processed++
+	m.clampIndex()
+}
+
+func (m *InboxModel) clampIndex() {
+	if m.index >= len(m.items) {
+		m.index = len(m.items) - 1
+	}
+	if m.index < 0 {
+		m.index = 0
+	}
+}
+
+func (m *InboxModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	if m.showHelp {
+		return panel.Render(m.helpView())
+	}
+
+	m.header.SetItemCount(len(m.items))
+
+	note := m.current()
+	if note == nil {
+		zero := lipgloss.JoinVertical(
+			lipgloss.Center,
+			styles.TitleStyle.Render("✨ Inbox Zero ✨"),
+			"",
+			styles.SubtitleStyle.Render("Nothing left to process."),
+			styles.HelpStyle.Render("Capture anything with Ctrl+X; it lands here."),
+		)
+		return panel.Render(lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.header.View(),
+			"",
+			zero,
+			"",
+			m.helpBar.View(),
+		))
+	}
+
+	progress := fmt.Sprintf("Item %d of %d", m.index+1, len(m.items))
+	if m.processed > 0 {
+		progress += fmt.Sprintf(" • %d processed", m.processed)
+	}
+
+	body := note.Body
+	if body == "" {
+		body = lipgloss.NewStyle().Foreground(styles.MutedColor).Italic(true).Render("No details")
+	}
+
+	var tags []string
+	for _, tag := range note.Tags {
+		if tag != InboxTag {
+			tags = append(tags, tag)
+		}
+	}
+
+	arrival := "Captured " + datefmt.ShortDateTime(note.CreatedAt)
+	if day, ok := m.resurfaced[note.ID]; ok {
+		arrival = "⏰ Resurfaced " + datefmt.Day(day)
+	}
+	cardParts := []string{
+		styles.TitleStyle.Render(note.Title),
+		styles.SubtitleStyle.Render(arrival),
+	}
+	if len(tags) > 0 {
+		cardParts = append(cardParts, styles.FormatTags(tags))
+	}
+	cardParts = append(cardParts, "", lipgloss.NewStyle().Width(m.width-14).Render(body))
+	card := styles.CardActiveStyle.Width(m.width - 8).Render(lipgloss.JoinVertical(lipgloss.Left, cardParts...))
+
+	actions := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.KeyHint("t", "Make todo")+"   "+styles.KeyHint("n", "Keep as note"),
+		styles.KeyHint("l", "Keep & link")+"   "+styles.KeyHint("d", "Delete"),
+	)
+
+	parts := []string{
+		m.header.View(),
+		styles.HelpStyle.Render(progress),
+		"",
+		card,
+		"",
+		actions,
+	}
+	if m.confirming {
+		parts = append(parts, "", lipgloss.NewStyle().Foreground(styles.WarningColor).Bold(true).Render("Delete this capture? (y/n)"))
+	}
+	parts = append(parts, "", m.helpBar.View())
+
+	return panel.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+func (m *InboxModel) helpView() string {
+	title := styles.TitleStyle.Render("📥 INBOX - Help")
+
+	helpText := `Every quick capture (Ctrl+X) lands here until you decide what it is.
+Work through items one at a time until you reach Inbox Zero.
+
+` + styles.SelectedItemStyle.Render("Triage:") + `
+• ` + styles.NeonStyle.Render("t") + `: Make todo (the capture becomes a todo)
+• ` + styles.NeonStyle.Render("n") + `: Keep as note (files it out of the inbox)
+• ` + styles.NeonStyle.Render("l") + `: Keep as note and open the link modal
+• ` + styles.NeonStyle.Render("d") + `: Delete (asks for confirmation)
+
+` + styles.SelectedItemStyle.Render("Navigation:") + `
+• ` + styles.NeonStyle.Render("j/k") + `: Skip forward/back without processing
+• ` + styles.NeonStyle.Render("r") + `: Reload
+
+` + styles.SelectedItemStyle.Render("Tips:") + `
+• Add #inbox to any note to send it back for triage
+• Press R in a note's preview to have it resurface here on a later day`
+
+	help := styles.HelpStyle.Render("Press any key to close")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		helpText,
+		"",
+		help,
+	)
+}
+
+// hasTag reports whether tags contains tag.
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
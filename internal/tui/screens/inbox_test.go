@@ -0,0 +1,171 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestInboxTriage(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Now().Add(-time.Hour)
+	for i, title := range []string{"Call dentist", "Idea for blog", "Read later", "Junk"} {
+		note := &models.Note{
+			Title:     title,
+			Body:      strings.Repeat("details ", 30),
+			Tags:      []string{"quick", InboxTag},
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := store.CreateNote(&models.Note{Title: "Filed", Tags: []string{"work"}}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	m := NewInboxModel(store)
+	m.SetSize(100, 30)
+	if err := m.LoadInbox(); err != nil {
+		t.Fatalf("LoadInbox() err = %v", err)
+	}
+	if m.Count() != 4 {
+		t.Fatalf("Count() = %d, want 4", m.Count())
+	}
+	if body := m.current().Body; body != strings.Repeat("details ", 30) {
+		t.Fatalf("card body = %q, want the whole capture", body)
+	}
+
+	key := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	// t: make todo
+	m, _ = m.Update(key("t"))
+	todos, err := store.ListTodos()
+	if err != nil {
+		t.Fatalf("ListTodos() err = %v", err)
+	}
+	if len(todos) != 1 || todos[0].Title != "Call dentist" {
+		t.Fatalf("expected todo from capture, got %#v", todos)
+	}
+	if todos[0].Description != strings.Repeat("details ", 30) {
+		t.Fatalf("expected full capture body in todo, got %q", todos[0].Description)
+	}
+
+	// n: keep as note
+	m, _ = m.Update(key("n"))
+
+	// l: keep as note and request the link modal
+	m, cmd := m.Update(key("l"))
+	if cmd == nil {
+		t.Fatalf("expected a command on l")
+	}
+	if msg, ok := cmd().(OpenLinkMsg); !ok || msg.Title != "Read later" || msg.ItemType != "note" {
+		t.Fatalf("expected OpenLinkMsg for Read later, got %#v", cmd())
+	}
+
+	// d: delete requires confirmation
+	m, _ = m.Update(key("d"))
+	if !strings.Contains(m.View(), "Delete this capture?") {
+		t.Fatalf("expected delete confirmation in view")
+	}
+	m, _ = m.Update(key("y"))
+
+	if m.Count() != 0 || !strings.Contains(m.View(), "Inbox Zero") {
+		t.Fatalf("expected Inbox Zero, count = %d", m.Count())
+	}
+
+	notes, err := store.ListNotes()
+	if err != nil {
+		t.Fatalf("ListNotes() err = %v", err)
+	}
+	titles := map[string]bool{}
+	for _, note := range notes {
+		if hasTag(note.Tags, InboxTag) {
+			t.Fatalf("note %q still tagged #inbox", note.Title)
+		}
+		titles[note.Title] = true
+	}
+	for _, note := range notes {
+		if note.Title == "Idea for blog" {
+			full, _ := store.GetNote(note.ID)
+			if full.Body != strings.Repeat("details ", 30) {
+				t.Fatalf("expected kept note body to be preserved, got %q", full.Body)
+			}
+		}
+	}
+	for _, want := range []string{"Idea for blog", "Read later", "Filed"} {
+		if !titles[want] {
+			t.Fatalf("expected note %q to be kept, got %v", want, titles)
+		}
+	}
+	if titles["Call dentist"] || titles["Junk"] {
+		t.Fatalf("expected converted and deleted captures to be gone, got %v", titles)
+	}
+}
+
+func TestInboxResurfacedNotes(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	capture := &models.Note{Title: "Fresh capture", Tags: []string{InboxTag}}
+	reread := &models.Note{Title: "Essay to re-read", Body: "long read", Tags: []string{"reading"}}
+	later := &models.Note{Title: "Not yet"}
+	for _, note := range []*models.Note{capture, reread, later} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	today := time.Now()
+	nextWeek := today.AddDate(0, 0, 7)
+	if err := store.SetNoteReminder(reread.ID, &today); err != nil {
+		t.Fatalf("SetNoteReminder() err = %v", err)
+	}
+	if err := store.SetNoteReminder(later.ID, &nextWeek); err != nil {
+		t.Fatalf("SetNoteReminder() err = %v", err)
+	}
+
+	m := NewInboxModel(store)
+	m.SetSize(100, 30)
+	if err := m.LoadInbox(); err != nil {
+		t.Fatalf("LoadInbox() err = %v", err)
+	}
+	if m.Count() != 2 {
+		t.Fatalf("Count() = %d, want the capture and the resurfaced note", m.Count())
+	}
+	// Resurfaced at the start of today, so ahead of the capture
+	if view := m.View(); !strings.Contains(view, "Essay to re-read") || !strings.Contains(view, "Resurfaced") {
+		t.Fatalf("expected the resurfaced note first, got:\n%s", view)
+	}
+
+	// n: keep as note clears the reminder without touching the note
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if day, err := store.GetNoteReminder(reread.ID); err != nil || day != nil {
+		t.Fatalf("GetNoteReminder() = %v, %v; want cleared", day, err)
+	}
+	kept, err := store.GetNote(reread.ID)
+	if err != nil || kept == nil || !kept.UpdatedAt.Equal(reread.UpdatedAt) || kept.Body != "long read" {
+		t.Fatalf("expected the note unchanged, got %#v (err %v)", kept, err)
+	}
+	if m.Count() != 1 || !strings.Contains(m.View(), "Fresh capture") {
+		t.Fatalf("expected only the capture left, count = %d", m.Count())
+	}
+}
@@ -0,0 +1,181 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/graph"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Graph insights (Phase 7: Visualization).
+//
+// Pressing "i" on the Mind Map swaps the canvas for a gardening report:
+// orphan notes with no links, the most linked hubs and small clusters cut
+// off from the rest of the graph. Rows open their note with Enter.
+
+// insightHubCount is how many hubs the report lists.
+const insightHubCount = 10
+
+// insightRow is one line of the insights report. Section headings have
+// no key and cannot be selected.
+type insightRow struct {
+	key  string
+	text string
+}
+
+// loadInsights analyzes the current graph and builds the report rows.
+func (m *MindMapModel) loadInsights() error {
+	notes, err := m.store.ListNotes()
+	if err != nil {
+		return err
+	}
+	todos, err := m.store.ListTodos()
+	if err != nil {
+		return err
+	}
+
+	labels := make(map[string]string, len(notes)+len(todos))
+	keys := make([]string, 0, len(notes))
+	for _, n := range notes {
+		key := graph.NodeKey("note", n.ID)
+		labels[key] = n.Title
+		if n.IsPlaceholder() {
+			labels[key] = "👻 " + n.Title
+		}
+		keys = append(keys, key)
+	}
+	for _, t := range todos {
+		labels[graph.NodeKey("todo", t.ID)] = "☐ " + t.Title
+	}
+	label := func(key string) string {
+		if l, ok := labels[key]; ok && l != "" {
+			return l
+		}
+		return key
+	}
+
+	in := graph.Analyze(m.g, keys, insightHubCount)
+	var rows []insightRow
+
+	rows = append(rows, insightRow{text: fmt.Sprintf("Orphans · %d %s without links", len(in.Orphans), pluralize(len(in.Orphans), "note", "notes"))})
+	for _, key := range in.Orphans {
+		rows = append(rows, insightRow{key: key, text: label(key)})
+	}
+
+	rows = append(rows, insightRow{text: fmt.Sprintf("Hubs · top %d by links", len(in.Hubs))})
+	for _, h := range in.Hubs {
+		rows = append(rows, insightRow{key: h.Key, text: fmt.Sprintf("%s (%d %s)", label(h.Key), h.Degree, pluralize(h.Degree, "link", "links"))})
+	}
+
+	rows = append(rows, insightRow{text: fmt.Sprintf("Clusters · %d cut off from the main graph", len(in.Clusters))})
+	for _, comp := range in.Clusters {
+		names := make([]string, len(comp))
+		for i, key := range comp {
+			names[i] = label(key)
+		}
+		rows = append(rows, insightRow{key: comp[0], text: strings.Join(names, " · ")})
+	}
+
+	m.insightRows = rows
+	m.insightSel = 0
+	m.moveInsight(1)
+	return nil
+}
+
+// toggleInsights switches between the canvas and the insights report.
+func (m *MindMapModel) toggleInsights() tea.Cmd {
+	if m.showInsights {
+		m.showInsights = false
+		m.helpBar = components.NewHelpBar(components.MindMapHints)
+		m.helpBar.SetWidth(m.width - 4)
+		return nil
+	}
+	if err := m.loadInsights(); err != nil {
+		return toastCmd("⚠️ Could not load insights: " + err.Error())
+	}
+	m.showInsights = true
+	m.helpBar = components.NewHelpBar(components.InsightsHints)
+	m.helpBar.SetWidth(m.width - 4)
+	return nil
+}
+
+// moveInsight moves the selection by delta, skipping section headings.
+// The selection stays put when no row lies in that direction.
+func (m *MindMapModel) moveInsight(delta int) {
+	for i := m.insightSel + delta; i >= 0 && i < len(m.insightRows); i += delta {
+		if m.insightRows[i].key != "" {
+			m.insightSel = i
+			return
+		}
+	}
+}
+
+// updateInsights handles keys while the insights report is shown.
+func (m *MindMapModel) updateInsights(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "i", "esc":
+		return m.toggleInsights()
+	case "?":
+		m.showHelp = true
+	case "j", "down":
+		m.moveInsight(1)
+	case "k", "up":
+		m.moveInsight(-1)
+	case "enter":
+		if m.insightSel >= len(m.insightRows) {
+			return nil
+		}
+		key := m.insightRows[m.insightSel].key
+		if strings.HasPrefix(key, "note:") {
+			id, _ := strconv.ParseInt(strings.TrimPrefix(key, "note:"), 10, 64)
+			return func() tea.Msg { return OpenNoteMsg{NoteID: id} }
+		}
+	}
+	return nil
+}
+
+// insightsView renders the report, scrolled to keep the selection visible.
+func (m *MindMapModel) insightsView() string {
+	_, height := m.canvasSize()
+	width := m.width - 8
+	if width < 10 {
+		width = 10
+	}
+
+	start := 0
+	if m.insightSel >= height {
+		start = m.insightSel - height + 1
+	}
+	end := start + height
+	if end > len(m.insightRows) {
+		end = len(m.insightRows)
+	}
+
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		row := m.insightRows[i]
+		switch {
+		case row.key == "":
+			lines = append(lines, styles.SectionHeaderStyle.Render(row.text))
+		case i == m.insightSel:
+			lines = append(lines, styles.SelectedItemStyle.Render("▶ "+truncate(row.text, width)))
+		default:
+			lines = append(lines, "  "+truncate(row.text, width))
+		}
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		strings.Join(lines, "\n"),
+		"",
+		m.helpBar.View(),
+	)
+}
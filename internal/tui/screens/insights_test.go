@@ -0,0 +1,79 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestMindMapInsights(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	notes := map[string]*models.Note{}
+	for _, title := range []string{"Hub", "Leaf A", "Leaf B", "Island 1", "Island 2", "Lonely"} {
+		n := &models.Note{Title: title}
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		notes[title] = n
+	}
+	link := func(a, b string) {
+		l := &models.Link{SourceType: "note", SourceID: notes[a].ID, TargetType: "note", TargetID: notes[b].ID, LinkType: models.LinkTypeRelated}
+		if err := store.CreateLink(l); err != nil {
+			t.Fatalf("CreateLink() err = %v", err)
+		}
+	}
+	link("Hub", "Leaf A")
+	link("Hub", "Leaf B")
+	link("Island 1", "Island 2")
+
+	m := NewMindMapModel(store)
+	m.SetSize(100, 40)
+	if err := m.LoadGraph(); err != nil {
+		t.Fatalf("LoadGraph() err = %v", err)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if !m.showInsights {
+		t.Fatal("expected i to open insights")
+	}
+
+	view := m.View()
+	for _, want := range []string{"Orphans · 1 note", "Lonely", "Hub (2 links)", "Clusters · 1", "Island 1 · Island 2"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("insights view missing %q", want)
+		}
+	}
+
+	// The first selectable row is the orphan; Enter opens it.
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected Enter to open the orphan")
+	}
+	if msg, ok := cmd().(OpenNoteMsg); !ok || msg.NoteID != notes["Lonely"].ID {
+		t.Fatalf("Enter = %#v, want OpenNoteMsg for Lonely", msg)
+	}
+
+	// j skips the Hubs heading to the first hub.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if got := m.insightRows[m.insightSel].key; got != "note:1" {
+		t.Fatalf("selection after j = %q, want note:1", got)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showInsights {
+		t.Fatal("expected Esc to return to the map")
+	}
+}
@@ -0,0 +1,182 @@
+package screens
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Wikilink completion for the note body editor (Phase 4).
+//
+// Typing "[[" in the body opens a list of note titles under the editor,
+// narrowed as the link text is typed: titles starting with it come first,
+// then titles containing it. ↑/↓ move, Enter or Tab insert the title and
+// the closing "]]", Esc closes the list for that link. Links typed to a
+// title no note has still make a placeholder note on save.
+
+// maxLinkCompletions caps the completion list.
+const maxLinkCompletions = 8
+
+// linkCompletion is the open completion list for the "[[" being typed.
+type linkCompletion struct {
+	active  bool
+	row     int      // Line of the "[[" in the body
+	start   int      // Column just after the "[["
+	titles  []string // Every note title, loaded when the list opens
+	matches []string
+	index   int
+
+	// The "[[" whose list was closed with Esc; it stays closed
+	dismissed    bool
+	dismissedRow int
+	dismissedCol int
+}
+
+// open reports whether the list is showing.
+func (c *linkCompletion) open() bool {
+	return c.active && len(c.matches) > 0
+}
+
+// wikilinkQuery finds an unclosed "[[" before col in line. It returns the
+// column after the brackets and the text typed since.
+func wikilinkQuery(line []rune, col int) (start int, query string, ok bool) {
+	col = min(col, len(line))
+	before := string(line[:col])
+	i := strings.LastIndex(before, "[[")
+	if i < 0 {
+		return 0, "", false
+	}
+	query = before[i+2:]
+	if strings.ContainsAny(query, "[]") {
+		return 0, "", false
+	}
+	return len([]rune(before[:i])) + 2, query, true
+}
+
+// matchLinkTitles returns up to limit titles matching query, ignoring
+// case: those starting with it first, then those containing it. exclude
+// (the note being edited) is left out.
+func matchLinkTitles(titles []string, query, exclude string, limit int) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	var prefix, contains []string
+	for _, title := range titles {
+		lower := strings.ToLower(title)
+		if title == "" || strings.EqualFold(title, exclude) {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(lower, query):
+			prefix = append(prefix, title)
+		case strings.Contains(lower, query):
+			contains = append(contains, title)
+		}
+	}
+	matches := append(prefix, contains...)
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// refreshLinkCompletion opens, narrows or closes the completion list for
+// the text around the body cursor.
+func (m *NotesListModel) refreshLinkCompletion() {
+	c := &m.links
+	if !m.bodyInput.Focused() {
+		c.active = false
+		return
+	}
+	row, col := m.bodyInput.Cursor()
+	lines := strings.Split(m.bodyInput.Value(), "\n")
+	if row >= len(lines) {
+		c.active = false
+		return
+	}
+	start, query, ok := wikilinkQuery([]rune(lines[row]), col)
+	if !ok {
+		*c = linkCompletion{}
+		return
+	}
+	if c.dismissed && c.dismissedRow == row && c.dismissedCol == start {
+		c.active = false
+		return
+	}
+	if !c.active || c.row != row || c.start != start {
+		c.titles, _ = m.store.ListNoteTitles()
+		c.row, c.start, c.index = row, start, 0
+	}
+	c.active = true
+	c.matches = matchLinkTitles(c.titles, query, m.titleInput.Value(), maxLinkCompletions)
+	c.index = min(c.index, max(len(c.matches)-1, 0))
+}
+
+// updateLinkCompletion handles keys while the list is open. It reports
+// whether the key was used; other keys go on to the editor.
+func (m *NotesListModel) updateLinkCompletion(msg tea.KeyMsg) bool {
+	c := &m.links
+	switch msg.String() {
+	case "up", "ctrl+p":
+		c.index = (c.index - 1 + len(c.matches)) % len(c.matches)
+	case "down", "ctrl+n":
+		c.index = (c.index + 1) % len(c.matches)
+	case "enter", "tab":
+		m.insertLink(c.matches[c.index])
+	case "esc":
+		c.active = false
+		c.dismissed, c.dismissedRow, c.dismissedCol = true, c.row, c.start
+	default:
+		return false
+	}
+	return true
+}
+
+// insertLink replaces the link text typed so far with title and closes
+// the link, reusing a "]]" already after the cursor.
+func (m *NotesListModel) insertLink(title string) {
+	row, col := m.bodyInput.Cursor()
+	lines := strings.Split(m.bodyInput.Value(), "\n")
+	end := col
+	if row < len(lines) {
+		if runes := []rune(lines[row]); col+2 <= len(runes) && string(runes[col:col+2]) == "]]" {
+			end += 2
+		}
+	}
+	m.bodyInput.ReplaceRange(m.links.start, end, title+"]]")
+	m.links = linkCompletion{}
+}
+
+// renderLinkCompletion renders the completion list under the editor.
+func (m *NotesListModel) renderLinkCompletion() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(styles.PrimaryColor).
+		Bold(true)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+
+	normalStyle := lipgloss.NewStyle().
+		Foreground(styles.TextColor).
+		Padding(0, 1)
+
+	lines := []string{titleStyle.Render("🔗 Link to note")}
+	for i, title := range m.links.matches {
+		if i == m.links.index {
+			lines = append(lines, selectedStyle.Render("▶ "+title))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+title))
+		}
+	}
+	lines = append(lines, styles.HelpStyle.Render("[↑/↓] Move  [Enter/Tab] Insert  [Esc] Close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentColor).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,115 @@
+package screens
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestWikilinkQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line  string
+		col   int
+		start int
+		query string
+		ok    bool
+	}{
+		{"See [[", 6, 6, "", true},
+		{"See [[Alp", 9, 6, "Alp", true},
+		{"See [[Alp", 5, 0, "", false},
+		{"[[Done]] and [[né", 17, 15, "né", true},
+		{"[[Done]] and", 12, 0, "", false},
+		{"a [single", 9, 0, "", false},
+	}
+	for _, tt := range tests {
+		start, query, ok := wikilinkQuery([]rune(tt.line), tt.col)
+		if start != tt.start || query != tt.query || ok != tt.ok {
+			t.Errorf("wikilinkQuery(%q, %d) = %d, %q, %v; want %d, %q, %v",
+				tt.line, tt.col, start, query, ok, tt.start, tt.query, tt.ok)
+		}
+	}
+}
+
+func TestMatchLinkTitles(t *testing.T) {
+	t.Parallel()
+
+	titles := []string{"Project Alpha", "Alpha Centauri", "Beta", "Draft"}
+	got := matchLinkTitles(titles, "alp", "Draft", 5)
+	want := []string{"Alpha Centauri", "Project Alpha"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("matchLinkTitles() = %q, want %q", got, want)
+	}
+	if got := matchLinkTitles(titles, "", "Draft", 2); len(got) != 2 {
+		t.Errorf("matchLinkTitles(limit 2) = %q, want 2 titles", got)
+	}
+}
+
+func TestNotesWikilinkCompletion(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	for _, title := range []string{"Project Alpha", "Alpha Centauri", "Beta"} {
+		if err := m.store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	update := func(msg tea.Msg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	typeText := func(s string) {
+		for _, r := range s {
+			if r == ' ' {
+				update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+				continue
+			}
+			update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	typeText("Draft")
+	update(tea.KeyMsg{Type: tea.KeyTab})
+
+	typeText("See [[")
+	if !m.links.open() || len(m.links.matches) != 3 {
+		t.Fatalf("expected every title offered after [[, got %q", m.links.matches)
+	}
+	typeText("alp")
+	if got := m.links.matches; !reflect.DeepEqual(got, []string{"Alpha Centauri", "Project Alpha"}) {
+		t.Fatalf("matches = %q", got)
+	}
+	if view := m.View(); !strings.Contains(view, "Link to note") || !strings.Contains(view, "▶ Alpha Centauri") {
+		t.Fatalf("expected the completion list under the editor, got:\n%s", view)
+	}
+
+	// Down then Tab inserts the second title and closes the link
+	update(tea.KeyMsg{Type: tea.KeyDown})
+	update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := m.bodyInput.Value(); got != "See [[Project Alpha]]" {
+		t.Fatalf("body = %q, want the completed link", got)
+	}
+	if m.links.open() || !m.bodyInput.Focused() {
+		t.Fatal("expected the list closed and the body still focused")
+	}
+
+	// Esc closes the list for that link without leaving the editor
+	typeText(" and [[B")
+	if !m.links.open() {
+		t.Fatal("expected the list to open for the second link")
+	}
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	typeText("e")
+	if m.links.open() || !m.showCreate {
+		t.Fatal("expected Esc to close the list and keep the editor open")
+	}
+	if got := m.bodyInput.Value(); got != "See [[Project Alpha]] and [[Be" {
+		t.Fatalf("body = %q", got)
+	}
+}
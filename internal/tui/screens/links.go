@@ -17,6 +17,7 @@ import (
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/models"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
 )
 
@@ -56,6 +57,7 @@ type LinkModel struct {
 	width        int
 	height       int
 	showModal    bool
+	confirm      components.ConfirmModal
 }
 
 // NewLinkModel creates a new link management model.
@@ -78,6 +80,7 @@ func NewLinkModel(store *sqlite.Store) LinkModel {
 		targetList: targetList,
 		linkList:   linkList,
 		showModal:  false,
+		confirm:    components.NewConfirmModal(),
 	}
 }
 
@@ -87,6 +90,7 @@ func (m *LinkModel) SetSize(width, height int) {
 	m.height = height
 	m.targetList.SetSize(width-10, height-15)
 	m.linkList.SetSize(width-10, height-15)
+	m.confirm.SetWidth(width - 10)
 }
 
 // Open opens the link modal for a specific source item.
@@ -102,6 +106,7 @@ func (m *LinkModel) Open(sourceType string, sourceID int64, sourceTitle string)
 // Close closes the link modal.
 func (m *LinkModel) Close() {
 	m.showModal = false
+	m.confirm.Close()
 	m.mode = LinkModeViewLinks
 }
 
@@ -169,6 +174,16 @@ func (m *LinkModel) Update(msg tea.Msg) (LinkModel, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.confirm.IsOpen() {
+			cmd := m.confirm.Update(msg)
+			if !m.confirm.IsOpen() {
+				// The model is copied on every update, so reload here
+				// rather than in the callback
+				m.loadLinks()
+			}
+			return *m, cmd
+		}
+
 		switch m.mode {
 		case LinkModeViewLinks:
 			switch msg.String() {
@@ -182,8 +197,12 @@ func (m *LinkModel) Update(msg tea.Msg) (LinkModel, tea.Cmd) {
 			case "d": // Delete selected link
 				if len(m.linkList.Items()) > 0 {
 					if selected, ok := m.linkList.SelectedItem().(LinkItem); ok {
-						m.store.DeleteLink(selected.link.ID)
-						m.loadLinks()
+						store, id := m.store, selected.link.ID
+						message := fmt.Sprintf("Unlinks %s. Both items are kept.", selected.Title())
+						m.confirm.OpenDanger("Delete Link?", message, func() tea.Cmd {
+							store.DeleteLink(id)
+							return nil
+						}, nil)
 					}
 				}
 				return *m, nil
@@ -260,6 +279,10 @@ func (m *LinkModel) View() string {
 		return ""
 	}
 
+	if m.confirm.IsOpen() {
+		return m.confirm.View()
+	}
+
 	var content string
 
 	switch m.mode {
@@ -0,0 +1,62 @@
+package screens
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// TestLinkDeleteConfirms verifies d asks before deleting a link, and that
+// the answer reaches the model even though it is copied on every update.
+func TestLinkDeleteConfirms(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	store, err := sqlite.New(&config.Config{
+		DbPath:    filepath.Join(tmpDir, "test.db"),
+		ModelPath: filepath.Join(tmpDir, "models"),
+	})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	source := &models.Note{Title: "Source"}
+	target := &models.Note{Title: "Target"}
+	for _, n := range []*models.Note{source, target} {
+		if err := store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	link := &models.Link{SourceType: "note", SourceID: source.ID, TargetType: "note", TargetID: target.ID, LinkType: models.LinkTypeRelated}
+	if err := store.CreateLink(link); err != nil {
+		t.Fatalf("CreateLink() err = %v", err)
+	}
+
+	m := NewLinkModel(store)
+	m.SetSize(100, 40)
+	m.Open("note", source.ID, source.Title)
+	press := func(s string) {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+	}
+
+	press("d")
+	if !containsString(m.View(), "Delete Link?") || !containsString(m.View(), "Target") {
+		t.Fatalf("expected a delete confirmation naming the target, got:\n%s", m.View())
+	}
+	press("n")
+	if len(m.links) != 1 {
+		t.Fatalf("expected n to keep the link")
+	}
+
+	press("d")
+	press("y")
+	if len(m.links) != 0 || containsString(m.View(), "Delete Link?") {
+		t.Errorf("expected y to delete the link and close the confirmation, got %d links", len(m.links))
+	}
+}
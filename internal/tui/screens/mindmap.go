@@ -25,6 +25,10 @@ type MindMapModel struct {
 	zoom     int
 	showHelp bool // Help modal state
 
+	showInsights bool // Insights report instead of the canvas
+	insightRows  []insightRow
+	insightSel   int
+
 	header  components.Header
 	helpBar components.HelpBar
 	width   int
@@ -97,8 +101,15 @@ func (m *MindMapModel) Update(msg tea.Msg) (MindMapModel, tea.Cmd) {
 			m.showHelp = false
 			return *m, nil
 		}
+		if m.showInsights {
+			return *m, m.updateInsights(msg)
+		}
 
 		switch msg.String() {
+		case "esc":
+			return *m, goBack
+		case "i":
+			return *m, m.toggleInsights()
 		case "?":
 			m.showHelp = true
 			return *m, nil
@@ -151,6 +162,9 @@ func (m *MindMapModel) View() string {
 	if m.showHelp {
 		return panel.Render(m.helpView())
 	}
+	if m.showInsights {
+		return panel.Render(m.insightsView())
+	}
 
 	canvasW, canvasH := m.canvasSize()
 
@@ -181,6 +195,7 @@ func (m *MindMapModel) helpView() string {
 • ` + styles.NeonStyle.Render("h/j/k/l") + ` or Arrow Keys: Pan the view
 • ` + styles.NeonStyle.Render("+/-") + ` or Scroll: Zoom in/out
 • ` + styles.NeonStyle.Render("Enter") + `: Open the selected note
+• ` + styles.NeonStyle.Render("i") + `: Toggle insights (orphans, hubs, clusters)
 • ` + styles.NeonStyle.Render("Esc") + `: Return to notes list
 
 ` + styles.SelectedItemStyle.Render("Visual Elements:") + `
@@ -193,6 +208,7 @@ func (m *MindMapModel) helpView() string {
 • Notes with more links appear larger
 • Cyan highlight shows current selection
 • Clusters indicate related topics
+• Insights list notes to link up: orphans and cut-off clusters
 • Use zoom to see more detail`
 
 	help := styles.HelpStyle.Render("Press any key to close")
@@ -208,15 +224,15 @@ func (m *MindMapModel) helpView() string {
 }
 
 func (m *MindMapModel) canvasSize() (int, int) {
-	// Leave room for header + help bar; keep inside the panel padding (2 on each side).
+	// Keep inside the panel padding (2 on each side, 1 above and below)
+	// and fill the height the header and help bar leave.
 	w := m.width - 4
-	h := m.height - 10
 	if w < 20 {
 		w = 20
 	}
-	if h < 6 {
-		h = 6
-	}
+	top := lipgloss.JoinVertical(lipgloss.Left, m.header.View(), "")
+	bottom := lipgloss.JoinVertical(lipgloss.Left, "", m.helpBar.View())
+	h := components.FillHeight(m.height-components.AppChrome-2, 6, top, bottom)
 	// Zoom increases the layout space, but we still clamp to terminal size.
 	return w, h
 }
@@ -0,0 +1,393 @@
+package screens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Model downloads (Phase 5: Semantic Search).
+//
+// The Model screen (m on Home) downloads the embedding model with a
+// progress bar. The first time the app starts with embeddings on and no
+// model, it opens on this screen to offer the download; Esc skips it.
+// A download keeps going when you leave the screen and says when it is
+// done. Cancelling keeps what has arrived, so the next download resumes
+// it. The file is checked against model_sha256 when that is set, and u
+// points a single download at a mirror or a local copy instead of
+// model_url (see embeddings.Download).
+//
+// The screen also shows which model the search index was built with.
+// When embedding_model has changed since, the index's vectors no longer
+// fit and the app opens here asking to rebuild it; r asks again later.
+//
+// Keyboard Shortcuts:
+//   - Enter: Download the model, or resume a cancelled download
+//   - u: Download from another URL or path
+//   - x: Cancel the download
+//   - r: Rebuild the search index with the configured model
+//   - Esc: Back (the download continues)
+
+// ModelProgressMsg reports how far a model download has got.
+type ModelProgressMsg struct {
+	Done, Total int64 // Total is -1 when unknown
+}
+
+// ModelDownloadedMsg reports the end of a model download.
+type ModelDownloadedMsg struct {
+	Err error
+}
+
+// ModelIndexRebuiltMsg reports the end of a search index rebuild.
+type ModelIndexRebuiltMsg struct {
+	Err error
+}
+
+// ModelModel is the Model screen.
+type ModelModel struct {
+	embedder *embeddings.Embedder
+	semantic *search.SemanticSearch
+	cfg      *config.Config
+
+	firstRun    bool
+	downloading bool
+	done, total int64
+	source      string // Where the download in progress comes from
+	err         error
+	cancel      context.CancelFunc
+	events      chan tea.Msg
+
+	editing  bool // The URL prompt is open
+	urlInput components.TextInputModel
+
+	confirmRebuild components.ConfirmModal
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewModelModel creates the Model screen.
+func NewModelModel(embedder *embeddings.Embedder, cfg *config.Config) ModelModel {
+	return ModelModel{
+		embedder:       embedder,
+		cfg:            cfg,
+		confirmRebuild: components.NewConfirmModal(),
+		header:         components.NewHeader("🧠", "Semantic Model"),
+		helpBar:        components.NewHelpBar(components.ModelHints),
+	}
+}
+
+// SetSearchIndex sets the search index whose model the screen shows and
+// rebuilds.
+func (m *ModelModel) SetSearchIndex(semantic *search.SemanticSearch) {
+	m.semantic = semantic
+}
+
+func (m *ModelModel) Init() tea.Cmd { return nil }
+
+func (m *ModelModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+	m.confirmRebuild.SetWidth(width - 8)
+}
+
+// SetFirstRun shows the screen as the first-run offer to download.
+func (m *ModelModel) SetFirstRun(firstRun bool) {
+	m.firstRun = firstRun
+}
+
+// HelpSections returns the Model screen's keys for the help modal.
+func (m *ModelModel) HelpSections() []components.HelpSection {
+	return components.ModelHelp
+}
+
+// InputActive reports whether a URL is being typed.
+func (m *ModelModel) InputActive() bool {
+	return m.editing
+}
+
+// Downloading reports whether a download is in progress.
+func (m *ModelModel) Downloading() bool {
+	return m.downloading
+}
+
+// defaultSource returns the configured download source.
+func (m *ModelModel) defaultSource() string {
+	if m.cfg != nil {
+		return m.cfg.ModelURL
+	}
+	return ""
+}
+
+func (m *ModelModel) Update(msg tea.Msg) (ModelModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ModelProgressMsg:
+		m.done, m.total = msg.Done, msg.Total
+		return *m, m.waitForDownload()
+	case ModelDownloadedMsg:
+		return *m, m.finishDownload(msg.Err)
+	case ModelIndexRebuiltMsg:
+		if msg.Err != nil {
+			m.err = msg.Err
+			return *m, toastCmd("Search index rebuild failed: " + msg.Err.Error())
+		}
+		m.err = nil
+		return *m, toastCmd("🧠 Search index rebuilt with " + m.embedder.GetModelInfo().Name)
+	case tea.KeyMsg:
+		if m.confirmRebuild.IsOpen() {
+			return *m, m.confirmRebuild.Update(msg)
+		}
+		if m.editing {
+			return *m, m.updateURLPrompt(msg)
+		}
+		switch msg.String() {
+		case "enter":
+			return *m, m.startDownload(m.defaultSource())
+		case "u":
+			if m.downloading {
+				return *m, nil
+			}
+			m.urlInput = components.NewTextInput("https://mirror.example/model.onnx or /path/to/model.onnx")
+			m.urlInput.SetValue(m.embedder.ModelURL(m.defaultSource()))
+			m.urlInput.Focus()
+			m.editing = true
+		case "x":
+			if m.downloading {
+				m.cancel()
+			}
+		case "r":
+			m.OfferRebuild(false)
+		case "esc":
+			m.firstRun = false
+			return *m, goBack
+		}
+	}
+	return *m, nil
+}
+
+// OfferRebuild asks whether to rebuild the search index with the
+// configured model. atStartup says the app opened the screen for it, so
+// declining explains that semantic search waits for the rebuild.
+func (m *ModelModel) OfferRebuild(atStartup bool) {
+	if m.semantic == nil {
+		return
+	}
+	index, stale, err := m.semantic.NeedsRebuild()
+	if err != nil {
+		m.err = err
+		return
+	}
+	info := m.embedder.GetModelInfo()
+	message := fmt.Sprintf("Every note is embedded again with %s (%d dimensions).", info.Name, info.Dimensions)
+	if stale {
+		message = fmt.Sprintf("The index holds %d %s from %s (%d dimensions),\nwhich cannot be compared with %s (%d dimensions).\n%s",
+			index.Vectors, pluralize(index.Vectors, "note", "notes"), index.Model, index.Dimensions,
+			info.Name, info.Dimensions, "They are deleted and every note is embedded again.")
+	}
+	semantic := m.semantic
+	var onNo func() tea.Cmd
+	if atStartup {
+		onNo = func() tea.Cmd {
+			return tea.Batch(goBack, toastCmd("Semantic search is off until the index is rebuilt; r on the Model screen does it"))
+		}
+	}
+	m.confirmRebuild.OpenDanger("Rebuild the search index?", message, func() tea.Cmd {
+		return tea.Batch(toastCmd("Rebuilding the search index…"), func() tea.Msg {
+			return ModelIndexRebuiltMsg{Err: semantic.RebuildIndex()}
+		})
+	}, onNo)
+}
+
+// updateURLPrompt handles keys while the URL prompt is open.
+func (m *ModelModel) updateURLPrompt(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.editing = false
+		return nil
+	case "enter":
+		m.editing = false
+		return m.startDownload(m.urlInput.Value())
+	}
+	var cmd tea.Cmd
+	m.urlInput, cmd = m.urlInput.Update(msg)
+	return cmd
+}
+
+// startDownload downloads the model from source in the background.
+func (m *ModelModel) startDownload(source string) tea.Cmd {
+	switch {
+	case m.downloading:
+		return nil
+	case m.cfg != nil && m.cfg.SafeMode:
+		return toastCmd("Safe mode: model downloads are off")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan tea.Msg, 1)
+	m.downloading, m.cancel, m.events = true, cancel, events
+	m.done, m.total, m.err = m.embedder.PartialSize(), -1, nil
+	m.source = m.embedder.ModelURL(source)
+	opts := embeddings.DownloadOptions{
+		URL: source,
+		Progress: func(done, total int64) {
+			// Drop updates the screen has not caught up with
+			select {
+			case events <- ModelProgressMsg{Done: done, Total: total}:
+			default:
+			}
+		},
+	}
+	if m.cfg != nil {
+		opts.SHA256 = m.cfg.ModelSHA256
+	}
+	embedder := m.embedder
+	go func() {
+		err := embedder.Download(ctx, opts)
+		if err != nil && ctx.Err() != nil {
+			err = context.Canceled
+		}
+		events <- ModelDownloadedMsg{Err: err}
+	}()
+	return m.waitForDownload()
+}
+
+// waitForDownload waits for the next message from the download.
+func (m *ModelModel) waitForDownload() tea.Cmd {
+	events := m.events
+	if events == nil {
+		return nil
+	}
+	return func() tea.Msg { return <-events }
+}
+
+// finishDownload records how the download ended and toasts it, since the
+// screen may not be showing.
+func (m *ModelModel) finishDownload(err error) tea.Cmd {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.downloading, m.cancel, m.events = false, nil, nil
+	switch {
+	case errors.Is(err, context.Canceled):
+		return toastCmd("Model download paused; Enter on the Model screen resumes it")
+	case err != nil:
+		m.err = err
+		return toastCmd("Model download failed: " + err.Error())
+	}
+	m.firstRun = false
+	return toastCmd("🧠 Semantic model downloaded")
+}
+
+func (m *ModelModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+	if m.confirmRebuild.IsOpen() {
+		return panel.Render(m.confirmRebuild.View())
+	}
+	return panel.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		strings.Join(m.lines(), "\n"),
+		"",
+		m.helpBar.View(),
+	))
+}
+
+// lines renders the model's state, the download and the URL prompt.
+func (m *ModelModel) lines() []string {
+	var lines []string
+	row := func(label, value string) {
+		lines = append(lines, "  "+styles.SubtitleStyle.Render(fmt.Sprintf("%-10s", label))+" "+value)
+	}
+	if m.firstRun {
+		lines = append(lines,
+			styles.SelectedItemStyle.Render("Welcome! Semantic search works best with a local embedding model."),
+			styles.HelpStyle.Render("Press Enter to download it now, or Esc to skip; m on Home brings you back here."),
+			"")
+	}
+
+	info := m.embedder.GetModelInfo()
+	row("Model", fmt.Sprintf("%s (%d dimensions, about %s)", info.Name, info.Dimensions, info.ModelSize))
+	row("File", m.embedder.ModelFilePath())
+	status := "Not downloaded"
+	switch partial := m.embedder.PartialSize(); {
+	case m.downloading:
+		status = "Downloading…"
+	case m.embedder.HasModel():
+		status = "Installed"
+	case partial > 0:
+		status = "Partly downloaded (" + sqlite.FormatSize(partial) + "); Enter resumes"
+	}
+	row("Status", status)
+	source := m.source
+	if !m.downloading {
+		source = m.embedder.ModelURL(m.defaultSource())
+	}
+	row("Source", source)
+	checksum := "not checked (set model_sha256 to verify downloads)"
+	if m.cfg != nil && m.cfg.ModelSHA256 != "" {
+		checksum = "must match " + m.cfg.ModelSHA256
+	} else if !m.downloading && m.embedder.HasModel() {
+		if sum, err := m.embedder.ModelChecksum(); err == nil {
+			checksum = "not checked; this file's is " + sum
+		}
+	}
+	row("SHA-256", checksum)
+	if m.semantic != nil {
+		row("Index", m.indexStatus(info))
+	}
+
+	if m.downloading {
+		lines = append(lines, "", "  "+m.renderProgress())
+	}
+	if m.err != nil {
+		lines = append(lines, "", components.FieldError(m.err.Error()))
+	}
+	if m.editing {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+		lines = append(lines, "", labelStyle.Render("Download from:")+" "+m.urlInput.View())
+	}
+	return lines
+}
+
+// indexStatus describes the search index and whether it needs a rebuild.
+func (m *ModelModel) indexStatus(info embeddings.ModelInfo) string {
+	index, stale, err := m.semantic.NeedsRebuild()
+	switch {
+	case err != nil:
+		return "unknown (" + err.Error() + ")"
+	case index.Model == "":
+		return "not built yet"
+	case stale:
+		return fmt.Sprintf("%d %s from %s (%d dimensions); r rebuilds it for %s",
+			index.Vectors, pluralize(index.Vectors, "note", "notes"), index.Model, index.Dimensions, info.Name)
+	}
+	return fmt.Sprintf("%d %s", index.Vectors, pluralize(index.Vectors, "note", "notes"))
+}
+
+// renderProgress renders the progress bar with the bytes received.
+func (m *ModelModel) renderProgress() string {
+	received := sqlite.FormatSize(m.done)
+	if m.total <= 0 {
+		return styles.HelpStyle.Render(received + " received")
+	}
+	progress := float64(m.done) / float64(m.total)
+	width := max(min(m.width-40, 50), 10)
+	return styles.VaporwaveProgressBar(progress, width) +
+		fmt.Sprintf(" %s of %s (%d%%)", received, sqlite.FormatSize(m.total), int(progress*100))
+}
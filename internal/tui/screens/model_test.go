@@ -0,0 +1,106 @@
+package screens
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+)
+
+// runModelDownload feeds the download's messages back to m until it ends
+// and returns the final toast.
+func runModelDownload(t *testing.T, m *ModelModel, cmd tea.Cmd) string {
+	t.Helper()
+	for cmd != nil {
+		msg := cmd()
+		*m, cmd = m.Update(msg)
+		if _, ok := msg.(ModelDownloadedMsg); ok {
+			return cmd().(ToastMsg).Text
+		}
+	}
+	t.Fatal("the download ended without ModelDownloadedMsg")
+	return ""
+}
+
+func TestModelScreenDownload(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mirror := filepath.Join(dir, "mirror.onnx")
+	if err := os.WriteFile(mirror, []byte(strings.Repeat("m", 100_000)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &config.Config{ModelPath: filepath.Join(dir, "models"), ModelURL: mirror, ModelSHA256: strings.Repeat("0", 64)}
+	e, err := embeddings.New(cfg)
+	if err != nil {
+		t.Fatalf("embeddings.New() err = %v", err)
+	}
+	m := NewModelModel(e, cfg)
+	m.SetSize(120, 40)
+	m.SetFirstRun(true)
+	if view := m.View(); !strings.Contains(view, "Welcome!") || !strings.Contains(view, "Not downloaded") || !strings.Contains(view, mirror) {
+		t.Fatalf("first-run view:\n%s", view)
+	}
+
+	// The mirror does not match the pinned checksum
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.Downloading() {
+		t.Fatal("Enter did not start the download")
+	}
+	if toast := runModelDownload(t, &m, cmd); !strings.Contains(toast, "checksum mismatch") {
+		t.Fatalf("toast = %q, want a checksum mismatch", toast)
+	}
+	if e.HasModel() || !strings.Contains(m.View(), "checksum mismatch") {
+		t.Fatalf("a mismatching model was kept:\n%s", m.View())
+	}
+
+	// With the right checksum it installs, with the progress on the way
+	sum := sha256.Sum256([]byte(strings.Repeat("m", 100_000)))
+	cfg.ModelSHA256 = hex.EncodeToString(sum[:])
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if toast := runModelDownload(t, &m, cmd); toast != "🧠 Semantic model downloaded" {
+		t.Fatalf("toast = %q", toast)
+	}
+	if !e.HasModel() || m.Downloading() {
+		t.Fatalf("HasModel() = %v, Downloading() = %v", e.HasModel(), m.Downloading())
+	}
+	if m.total != 100_000 {
+		t.Errorf("progress total = %d, want 100000", m.total)
+	}
+	if view := m.View(); !strings.Contains(view, "Installed") || strings.Contains(view, "Welcome!") {
+		t.Errorf("view after the download:\n%s", view)
+	}
+}
+
+func TestModelScreenURLAndSafeMode(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := &config.Config{ModelPath: filepath.Join(dir, "models"), SafeMode: true}
+	e, err := embeddings.New(cfg)
+	if err != nil {
+		t.Fatalf("embeddings.New() err = %v", err)
+	}
+	m := NewModelModel(e, cfg)
+	m.SetSize(120, 40)
+
+	// u prefills the default source for editing
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	if !m.InputActive() || !strings.HasSuffix(m.urlInput.Value(), "/resolve/main/model.onnx") {
+		t.Fatalf("URL prompt = %v %q", m.InputActive(), m.urlInput.Value())
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.InputActive() || m.Downloading() {
+		t.Fatalf("safe mode started a download")
+	}
+	if toast := cmd().(ToastMsg).Text; !strings.Contains(toast, "Safe mode") {
+		t.Errorf("toast = %q", toast)
+	}
+}
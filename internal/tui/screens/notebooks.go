@@ -0,0 +1,255 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Notebooks (Phase 6: Organization).
+//
+// Notebooks are an optional, folder-style grouping for people who prefer
+// filing notes to tagging them. b opens the switcher: All notes, Unfiled,
+// then every notebook with its note count; typing filters the list or
+// names a new notebook to create, and Ctrl+D deletes the highlighted
+// notebook, leaving its notes unfiled. While a notebook is shown the list
+// holds only its notes and new notes are filed in it. m moves the
+// selected note to another notebook, or out of any.
+
+// notebookOption is one row in the notebook picker.
+type notebookOption struct {
+	label string
+	id    int64  // Notebook to show or move to; 0 = all / none, sqlite.NoNotebook = unfiled
+	name  string // Name of the notebook
+	isNew bool   // Create the notebook called name first
+}
+
+// notebookPickerOptions lists the rows for the typed text. The switcher
+// starts with All notes and Unfiled; moving ends with No notebook.
+func (m *NotesListModel) notebookPickerOptions() []notebookOption {
+	query := strings.TrimSpace(m.notebookInput.Value())
+	lower := strings.ToLower(query)
+
+	var options []notebookOption
+	if !m.notebookMoving && query == "" {
+		options = append(options,
+			notebookOption{label: fmt.Sprintf("📚 All notes (%d)", m.notebookAll)},
+			notebookOption{label: fmt.Sprintf("∅ Unfiled (%d)", m.notebookUnfiled), id: sqlite.NoNotebook},
+		)
+	}
+	exact := false
+	for _, nb := range m.notebooks {
+		if lower != "" && !strings.Contains(strings.ToLower(nb.Name), lower) {
+			continue
+		}
+		if strings.EqualFold(nb.Name, query) {
+			exact = true
+		}
+		options = append(options, notebookOption{
+			label: fmt.Sprintf("📓 %s (%d)", nb.Name, nb.Notes),
+			id:    nb.ID,
+			name:  nb.Name,
+		})
+	}
+	if query != "" && !exact {
+		options = append(options, notebookOption{label: fmt.Sprintf("+ Create %q", query), name: query, isNew: true})
+	}
+	if m.notebookMoving {
+		options = append(options, notebookOption{label: "∅ No notebook"})
+	}
+	return options
+}
+
+// openNotebookPicker loads the notebooks and opens the switcher, or, for
+// a note, the move picker, starting on the current notebook.
+func (m *NotesListModel) openNotebookPicker(note *models.Note) {
+	notebooks, err := m.store.ListNotebooks()
+	if err != nil {
+		return
+	}
+	m.notebooks = notebooks
+	m.notebookAll, _ = m.store.CountNotes(sqlite.NoteQuery{})
+	m.notebookUnfiled, _ = m.store.CountNotes(sqlite.NoteQuery{Notebook: sqlite.NoNotebook})
+
+	current := m.notebook
+	m.notebookMoving = note != nil
+	m.notebookMoveID = 0
+	if note != nil {
+		m.notebookMoveID = note.ID
+		current = note.NotebookID
+	}
+	m.showNotebookPicker = true
+	m.notebookInput.SetValue("")
+	m.notebookInput.Focus()
+	m.notebookIndex = 0
+	for i, opt := range m.notebookPickerOptions() {
+		if opt.id == current {
+			m.notebookIndex = i
+			break
+		}
+	}
+}
+
+func (m *NotesListModel) closeNotebookPicker() {
+	m.showNotebookPicker = false
+	m.notebookMoveID = 0
+	m.notebookInput.SetValue("")
+	m.notebookInput.Blur()
+}
+
+// updateNotebookPicker handles keys while the notebook picker is open.
+func (m *NotesListModel) updateNotebookPicker(msg tea.KeyMsg) tea.Cmd {
+	options := m.notebookPickerOptions()
+	switch msg.String() {
+	case "esc":
+		m.closeNotebookPicker()
+		return nil
+	case "up", "ctrl+k":
+		if m.notebookIndex > 0 {
+			m.notebookIndex--
+		}
+		return nil
+	case "down", "ctrl+j":
+		if m.notebookIndex < len(options)-1 {
+			m.notebookIndex++
+		}
+		return nil
+	case "ctrl+d":
+		if m.notebookMoving || m.notebookIndex >= len(options) {
+			return nil
+		}
+		return m.deleteNotebook(options[m.notebookIndex])
+	case "enter":
+		if m.notebookIndex >= len(options) {
+			return nil
+		}
+		opt := options[m.notebookIndex]
+		if opt.isNew {
+			nb, err := m.store.CreateNotebook(opt.name)
+			if err != nil {
+				return toastCmd("Could not create notebook: " + err.Error())
+			}
+			opt.id, opt.name = nb.ID, nb.Name
+		}
+		moving, noteID := m.notebookMoving, m.notebookMoveID
+		m.closeNotebookPicker()
+		if moving {
+			return m.moveNote(noteID, opt)
+		}
+		m.showNotebook(opt.id, opt.name)
+		return nil
+	}
+	var cmd tea.Cmd
+	m.notebookInput, cmd = m.notebookInput.Update(msg)
+	m.notebookIndex = 0
+	return cmd
+}
+
+// showNotebook shows the notes in notebook id (0 for all, sqlite.NoNotebook
+// for unfiled ones), called name.
+func (m *NotesListModel) showNotebook(id int64, name string) {
+	m.notebook = id
+	m.notebookName = name
+	if id == sqlite.NoNotebook {
+		m.notebookName = "Unfiled"
+	}
+	m.LoadNotes()
+	m.list.Select(0)
+}
+
+// moveNote files the note in the chosen notebook and reports where it went.
+func (m *NotesListModel) moveNote(noteID int64, opt notebookOption) tea.Cmd {
+	note, err := m.store.GetNote(noteID)
+	if err != nil || note == nil {
+		return nil
+	}
+	if err := m.store.MoveNote(noteID, opt.id); err != nil {
+		return toastCmd("Could not move note: " + err.Error())
+	}
+	m.LoadNotes()
+	if opt.id == 0 {
+		return toastCmd(fmt.Sprintf("📓 %q is no longer in a notebook", note.Title))
+	}
+	return toastCmd(fmt.Sprintf("📓 Moved %q to %s", note.Title, opt.name))
+}
+
+// deleteNotebook deletes the notebook in opt, unfiling its notes, and
+// refreshes the picker. The All notes and Unfiled rows cannot be deleted.
+func (m *NotesListModel) deleteNotebook(opt notebookOption) tea.Cmd {
+	if opt.id <= 0 || opt.isNew {
+		return nil
+	}
+	if err := m.store.DeleteNotebook(opt.id); err != nil {
+		return toastCmd("Could not delete notebook: " + err.Error())
+	}
+	if m.notebook == opt.id {
+		m.showNotebook(0, "")
+	}
+	m.openNotebookPicker(nil)
+	return toastCmd(fmt.Sprintf("Deleted notebook %s; its notes are unfiled", opt.name))
+}
+
+// newNoteNotebook is the notebook new notes are filed in: the one shown.
+func (m *NotesListModel) newNoteNotebook() int64 {
+	if m.notebook > 0 {
+		return m.notebook
+	}
+	return 0
+}
+
+// toastCmd shows text as a toast.
+func toastCmd(text string) tea.Cmd {
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
+
+// renderNotebookPicker renders the notebook switcher or move picker.
+func (m *NotesListModel) renderNotebookPicker() string {
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+
+	normalStyle := lipgloss.NewStyle().
+		Foreground(styles.TextColor).
+		Padding(0, 1)
+
+	var lines []string
+	for i, opt := range m.notebookPickerOptions() {
+		if i == m.notebookIndex {
+			lines = append(lines, selectedStyle.Render("▶ "+opt.label))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+opt.label))
+		}
+	}
+
+	title := "📓 Notebooks"
+	subtitle := "Show one notebook's notes; new notes are filed in it"
+	hints := components.NotebookSwitchHints
+	if m.notebookMoving {
+		title = "📓 Move to Notebook"
+		subtitle = "Notebooks group notes like folders; #tags still work across them"
+		hints = components.NotebookMoveHints
+	}
+	m.helpBar.SetHints(hints)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.TitleStyle.Render(title),
+		styles.SubtitleStyle.Render(subtitle),
+		"",
+		m.notebookInput.View(),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines...),
+		"",
+		m.helpBar.View(),
+	)
+	return styles.PanelStyle.Render(content)
+}
@@ -0,0 +1,95 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestNotesNotebooks(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	for _, title := range []string{"groceries", "standup"} {
+		if err := m.store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	m.LoadNotes()
+	update := func(msg tea.KeyMsg) tea.Cmd {
+		mm, cmd := m.Update(msg)
+		m = *mm.(*NotesListModel)
+		return cmd
+	}
+	typeText := func(s string) {
+		for _, r := range s {
+			update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+	enter := func() tea.Cmd { return update(tea.KeyMsg{Type: tea.KeyEnter}) }
+
+	// Move "standup" (newest, selected) into a new Work notebook
+	if got := m.GetSelectedNote(); got == nil || got.Title != "standup" {
+		t.Fatalf("selected = %+v, want standup", got)
+	}
+	typeText("m")
+	if !m.InputActive() {
+		t.Fatal("InputActive() = false with the move picker open")
+	}
+	typeText("Work")
+	if view := m.View(); !strings.Contains(view, `+ Create "Work"`) {
+		t.Fatalf("move picker does not offer to create Work:\n%s", view)
+	}
+	cmd := enter()
+	if msg, ok := cmd().(ToastMsg); !ok || !strings.Contains(msg.Text, `Moved "standup" to Work`) {
+		t.Fatalf("move toast = %#v", cmd())
+	}
+	notebooks, err := m.store.ListNotebooks()
+	if err != nil || len(notebooks) != 1 || notebooks[0].Notes != 1 {
+		t.Fatalf("ListNotebooks() = %+v, %v; want Work with 1 note", notebooks, err)
+	}
+	work := notebooks[0].ID
+
+	// The switcher lists counts; picking Work shows only its notes
+	typeText("b")
+	view := m.View()
+	for _, want := range []string{"All notes (2)", "Unfiled (1)", "Work (1)"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("switcher missing %q:\n%s", want, view)
+		}
+	}
+	typeText("wo")
+	enter()
+	if len(m.list.Items()) != 1 || m.GetSelectedNote().Title != "standup" {
+		t.Fatalf("Work shows %d notes, want standup only", len(m.list.Items()))
+	}
+	if view := m.View(); !strings.Contains(view, "Notes · 📓 Work") {
+		t.Fatalf("header does not name the notebook:\n%s", view)
+	}
+	if got := m.FilterLabel(); got != "📓Work" {
+		t.Fatalf("FilterLabel() = %q, want 📓Work", got)
+	}
+
+	// New notes are filed in the shown notebook
+	typeText("c")
+	typeText("retro")
+	enter()
+	if n, _ := m.store.CountNotes(sqlite.NoteQuery{Notebook: work}); n != 2 {
+		t.Fatalf("Work holds %d notes after creating one there, want 2", n)
+	}
+
+	// Unfiled shows notes outside any notebook
+	typeText("b")
+	for range 3 { // To All notes, then down one to Unfiled
+		update(tea.KeyMsg{Type: tea.KeyUp})
+	}
+	update(tea.KeyMsg{Type: tea.KeyDown})
+	enter()
+	if len(m.list.Items()) != 1 || m.GetSelectedNote().Title != "groceries" {
+		t.Fatalf("Unfiled shows %d notes, want groceries only", len(m.list.Items()))
+	}
+}
@@ -8,15 +8,20 @@
 package screens
 
 import (
+	"context"
 	"fmt"
-	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
 	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/spellcheck"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/keymap"
@@ -37,6 +42,10 @@ import (
 //   - c: Create new note
 //   - e: Edit selected note
 //   - d: Delete selected note
+//   - D: Toggle compact/comfortable rows
+//   - S: Share selected note via share_command (also in preview)
+//   - y: Copy a [[wikilink]] to the selected note (also in preview; see copyref.go)
+//   - A: Add a summary from summarize_command (in preview; see summarize.go)
 //   - j/down: Move selection down
 //   - k/up: Move selection up
 //   - esc: Cancel/create mode
@@ -45,17 +54,18 @@ import (
 // Keyboard Shortcuts (when creating/editing):
 //   - enter: Save and return to list
 //   - esc: Cancel and return to list
+//
 // SortMode defines how notes are sorted
 type SortMode int
 
 const (
-	SortByDate SortMode = iota // Default: newest first
-	SortByTitle                // Alphabetical by title
-	SortByDateAsc              // Oldest first
+	SortByDate    SortMode = iota // Default: newest first
+	SortByTitle                   // Alphabetical by title
+	SortByDateAsc                 // Oldest first
 )
 
 type NotesListModel struct {
-	list             list.Model
+	list             components.VirtualList
 	store            *sqlite.Store
 	filter           string
 	filterInput      components.TextInputModel
@@ -63,12 +73,20 @@ type NotesListModel struct {
 	selectedTags     []string // Tags to filter by
 	sortMode         SortMode // Current sort mode
 	showCreate       bool
-	showPreview      bool         // Preview mode (read-only markdown from list)
-	previewNote      *models.Note // Note being previewed
-	editingID        int64        // 0 = creating new, >0 = editing existing
-	editPreview      bool         // Toggle preview while editing (Ctrl+E)
-	confirmingDelete bool
-	deleteTargetID   int64
+	showPreview      bool            // Preview mode (read-only markdown from list)
+	previewNote      *models.Note    // Note being previewed
+	previewTodos     []models.Todo   // Todos linked to the previewed note
+	previewTodoIndex int             // Highlighted todo in the preview Tasks section
+	previewFields    []models.Field  // Custom fields of the previewed note (Phase 6)
+	fieldEditor      fieldEditor     // F in the preview: set a field
+	previewReminder  *time.Time      // Day the previewed note resurfaces (Phase 6)
+	resurfaceEditor  resurfaceEditor // R in the preview: set that day
+	editingID        int64           // 0 = creating new, >0 = editing existing
+	editPreview      bool            // Toggle preview while editing (Ctrl+E)
+	titleErr         string          // Inline validation errors (Phase 4: Robustness)
+	bodyErr          string
+	saveAttempted    bool // Required fields are checked once a save is tried
+	confirmDelete    components.ConfirmModal
 	titleInput       components.TextInputModel
 	bodyInput        components.TextAreaModel
 	header           components.Header
@@ -76,45 +94,115 @@ type NotesListModel struct {
 	width            int
 	height           int
 
+	// Phase 4: Performance - search-as-you-type loads run as tea.Cmds;
+	// each keystroke cancels the previous, now stale, load.
+	loadID     int                // Incremented per load; stale results are dropped
+	loadCancel context.CancelFunc // Cancels the in-flight filter load, if any
+
 	// Quick-Tag picker (Phase 6)
 	showTagPicker     bool     // Tag picker modal visible
 	availableTags     []string // All tags from all notes
 	tagPickerIndex    int      // Currently highlighted tag
 	tagPickerSelected []string // Tags selected in picker (for multi-select)
 	tagPickerMode     string   // "add" for adding to note, "filter" for filtering list
+
+	// Tag suggestions (Phase 6) for a note saved without tags; see
+	// tagsuggest.go. suggester is nil when they are off.
+	suggester      *search.SemanticSearch
+	showTagSuggest bool
+	suggestNoteID  int64
+	suggestTitle   string
+	suggestTags    []string
+	suggestKeep    []bool // Ticked suggestions, parallel to suggestTags
+	suggestIndex   int
+
+	bodyShrink int // Lines the body textarea gives up with Ctrl+Down
+
+	writing bool // Writing mode (F8); see typewriter.go
+
+	links linkCompletion // Wikilink completion while typing "[["; see linkcomplete.go
+
+	// Go-to-line prompt (Ctrl+J); see gotoline.go
+	showGoToLine bool
+	lineInput    components.TextInputModel
+
+	// Preview scrolling and outline (o); see outline.go
+	previewScroll     int // First body line shown
+	previewBodyHeight int // Body lines shown, as last rendered
+	showOutline       bool
+	outlineIndex      int           // Selected heading, an index into parseHeadings
+	outlineCollapsed  map[int]bool  // Headings whose subheadings are hidden
+	previewSearch     previewSearch // / searches the previewed body; see preview_find.go
+
+	// Notebooks (b switches, m moves a note); see notebooks.go
+	notebook           int64  // Shown notebook: 0 = all, sqlite.NoNotebook = unfiled
+	notebookName       string // Name of the shown notebook, "" for all
+	showNotebookPicker bool
+	notebookMoving     bool  // Picker moves a note rather than switching
+	notebookMoveID     int64 // Note being moved
+	notebookInput      components.TextInputModel
+	notebookIndex      int
+	notebooks          []sqlite.NotebookStats // As of opening the picker
+	notebookAll        int                    // Count of all notes, for the switcher
+	notebookUnfiled    int                    // Count of unfiled notes
+
+	// Staleness (a shows stale notes only); see stale.go
+	staleAfter time.Duration // Untouched this long is stale; 0 = off
+	staleOnly  bool
+
+	previewAfterCreate bool // Open a new note's preview once it is saved
+
+	// Batch retag of the filtered results (#); see retag.go
+	retag retagPrompt
+
+	// Find & replace bar (Ctrl+F); see find.go
+	showFind      bool
+	findInput     components.TextInputModel
+	replaceInput  components.TextInputModel
+	findOnReplace bool // Replace field has focus
+	findIndex     int  // Selected match
+
+	shareCommand     string // Command notes are piped to by S; "" disables sharing
+	summarizeCommand string // Command or URL A sends a note to; "" disables summaries
+
+	// Spell-check (Phase 3), nil when disabled in config
+	spell            *spellcheck.Checker
+	showSpellPopup   bool
+	spellWord        spellcheck.Word // Word under the cursor being corrected
+	spellSuggestions []string
+	spellIndex       int // Highlighted popup entry; the last adds to the dictionary
 }
 
 // NewNotesListModel creates a new notes list screen.
 func NewNotesListModel(store *sqlite.Store) NotesListModel {
-	items := []list.Item{}
-	delegate := list.NewDefaultDelegate()
-
-	l := list.New(items, delegate, 0, 0)
-	l.Title = ""
-	l.SetShowHelp(false) // We'll use our own help bar
-	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false) // We handle filtering ourselves
+	// Phase 4: Performance - Only visible rows are rendered
+	l := components.NewVirtualList()
+	l.SetDelegate(noteDelegate{})
+	l.SetItemKey(noteItemKey)
 
 	filterInput := components.NewTextInput("Type to filter...")
 	filterInput.Blur()
 
 	return NotesListModel{
-		list:             l,
-		store:            store,
-		filter:           "",
-		filterInput:      filterInput,
-		showFilter:       false,
-		selectedTags:     []string{},
-		showCreate:       false,
-		showPreview:      false,
-		previewNote:      nil,
-		editingID:        0,
-		confirmingDelete: false,
-		deleteTargetID:   0,
-		titleInput:       components.NewTextInput("Note title"),
-		bodyInput:        components.NewTextArea("Note body"),
-		header:           components.NewHeader("📝", "Notes"),
-		helpBar:          components.NewHelpBar(components.NotesListHints),
+		list:          l,
+		store:         store,
+		filter:        "",
+		filterInput:   filterInput,
+		showFilter:    false,
+		selectedTags:  []string{},
+		showCreate:    false,
+		showPreview:   false,
+		previewNote:   nil,
+		editingID:     0,
+		confirmDelete: components.NewConfirmModal(),
+		titleInput:    components.NewTextInput("Note title"),
+		bodyInput:     components.NewTextArea("Note body"),
+		header:        components.NewHeader("📝", "Notes"),
+		helpBar:       components.NewHelpBar(components.NotesListHints),
+		notebookInput: components.NewTextInput("Type to filter or name a new notebook"),
+		findInput:     components.NewTextInput("find"),
+		replaceInput:  components.NewTextInput("replace with"),
+		retag:         newRetagPrompt(),
 	}
 }
 
@@ -127,9 +215,84 @@ func (m *NotesListModel) Init() tea.Cmd {
 func (m *NotesListModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.list.SetSize(width-4, height-14) // Account for header and help bar
+	m.list.SetSize(width-4, height) // View fits it between header and help bar
 	m.header.SetWidth(width - 4)
 	m.helpBar.SetWidth(width - 4)
+	m.confirmDelete.SetWidth(width - 4)
+}
+
+// Editor layout (Phase 4: UX Overhaul). The body textarea fills the
+// height the rest of the editor leaves free; Ctrl+Down shrinks it and
+// Ctrl+Up grows it back, one line at a time.
+
+// minBodyHeight is the smallest the body textarea gets.
+const minBodyHeight = 3
+
+// minListRows is the fewest rows a screen's list is given, however much
+// the header, filters and help bar take.
+const minListRows = 3
+
+// resizeBody grows (delta > 0) or shrinks the body textarea by delta
+// lines; editorView keeps it within the free height.
+func (m *NotesListModel) resizeBody(delta int) {
+	m.bodyShrink = max(m.bodyShrink-delta, 0)
+}
+
+// editorPopup renders the popup shown under the body editor: spelling
+// suggestions, go to line, find & replace or wikilink completion, or ""
+// when none is open.
+func (m *NotesListModel) editorPopup() string {
+	switch {
+	case m.showSpellPopup:
+		return m.renderSpellPopup()
+	case m.showGoToLine:
+		return m.renderGoToLine()
+	case m.showFind:
+		return m.renderFind()
+	case m.links.open():
+		return m.renderLinkCompletion()
+	}
+	return ""
+}
+
+// editorView lays out the editor: top (title field and labels), the body
+// textarea sized to the free height with its validation error, extra
+// below it (e.g. the spelling popup, or "") and the help bar pinned to the
+// bottom.
+func (m *NotesListModel) editorView(top, extra string) string {
+	help := m.helpBar.View()
+	// Panel border and padding plus the app's toast and status lines
+	inner := m.height - 6 - lipgloss.Height(help)
+	parts := []string{top}
+	free := inner - lipgloss.Height(top) - 1 // Blank line above the help bar
+	if extra != "" {
+		free -= lipgloss.Height(extra)
+	}
+	bodyErr := components.FieldError(m.bodyErr)
+	if bodyErr != "" {
+		free -= lipgloss.Height(bodyErr)
+	}
+	free = max(free, minBodyHeight)
+	m.bodyShrink = min(m.bodyShrink, free-minBodyHeight)
+	m.bodyInput.SetHeight(free - m.bodyShrink)
+	parts = append(parts, m.bodyEditorView())
+	if bodyErr != "" {
+		parts = append(parts, bodyErr)
+	}
+	if extra != "" {
+		parts = append(parts, extra)
+	}
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		lipgloss.NewStyle().Height(inner).Render(lipgloss.JoinVertical(lipgloss.Left, parts...)),
+		help,
+	)
+}
+
+// SetListDensity sets the configured default row density; a density
+// chosen with D and stored in the database takes precedence.
+func (m *NotesListModel) SetListDensity(compact bool) {
+	loadListDensity(m.store, &m.list, "notes", compact)
 }
 
 // GetSelectedNote returns the currently selected note, or nil if none selected.
@@ -143,6 +306,49 @@ func (m *NotesListModel) GetSelectedNote() *models.Note {
 	return nil
 }
 
+// InputActive reports whether a text field has focus, so the app leaves
+// single-letter keys such as q and ? to the screen.
+func (m *NotesListModel) InputActive() bool {
+	return m.showFilter || m.showCreate || m.showTagSuggest || m.showNotebookPicker || m.retag.active ||
+		m.fieldEditor.open || m.resurfaceEditor.open || m.previewSearch.typing
+}
+
+// SetShareCommand sets the command S pipes a note's markdown to; its
+// output should end with the shared URL.
+func (m *NotesListModel) SetShareCommand(command string) {
+	m.shareCommand = command
+}
+
+// SetSummarizeCommand sets the command or URL that A sends a note to for
+// a summary.
+func (m *NotesListModel) SetSummarizeCommand(command string) {
+	m.summarizeCommand = command
+}
+
+// HelpSections returns every notes key, grouped for the help modal.
+func (m *NotesListModel) HelpSections() []components.HelpSection {
+	return components.NotesHelp
+}
+
+// FilterLabel describes the active filters, e.g. `#work "standup"`, or ""
+// when the list is unfiltered.
+func (m *NotesListModel) FilterLabel() string {
+	label := filterLabel("", m.selectedTags, m.filter)
+	if m.staleOnly {
+		label = strings.TrimSpace("stale " + label)
+	}
+	if m.notebookName != "" {
+		label = strings.TrimSpace("📓" + m.notebookName + " " + label)
+	}
+	return label
+}
+
+// SetPreviewAfterCreate sets whether saving a new note opens its preview.
+// The new note is selected either way.
+func (m *NotesListModel) SetPreviewAfterCreate(preview bool) {
+	m.previewAfterCreate = preview
+}
+
 // SelectNoteByID selects a note in the list by its ID (best-effort).
 func (m *NotesListModel) SelectNoteByID(id int64) {
 	items := m.list.Items()
@@ -154,76 +360,118 @@ func (m *NotesListModel) SelectNoteByID(id int64) {
 	}
 }
 
+// PreviewNote opens the preview of the note with id, whether or not it is
+// listed. It does nothing, and returns false, while a note is open in the
+// editor or when the note does not exist.
+func (m *NotesListModel) PreviewNote(id int64) bool {
+	if m.showCreate {
+		return false
+	}
+	note, err := m.store.GetNote(id)
+	if err != nil || note == nil {
+		return false
+	}
+	m.showPreview = true
+	m.previewNote = note
+	m.resetPreviewScroll()
+	m.loadPreviewTodos()
+	m.previewFields = loadFields(m.store, "note", note.ID)
+	m.previewReminder = loadReminder(m.store, note.ID)
+	return true
+}
+
+// OpenedNoteID returns the note being previewed or edited, or 0 when
+// none is (a new note has no ID yet).
+func (m *NotesListModel) OpenedNoteID() int64 {
+	switch {
+	case m.showCreate:
+		return m.editingID
+	case m.showPreview && m.previewNote != nil:
+		return m.previewNote.ID
+	}
+	return 0
+}
+
+// confirmNoteDelete asks before deleting the note with the given ID.
+func (m *NotesListModel) confirmNoteDelete(id int64) {
+	m.confirmDelete.OpenDanger("Delete Note?", "This action cannot be undone.", func() tea.Cmd {
+		m.store.DeleteNote(id)
+		m.LoadNotes()
+		return nil
+	}, nil)
+}
+
+// notesLoadedMsg carries the result of an async filter load.
+type notesLoadedMsg struct {
+	id    int
+	notes []models.Note
+	err   error
+}
+
 // LoadNotes refreshes the note list from the database.
+//
+// Phase 4: Performance - Text, tag filters and sorting run in SQL.
 func (m *NotesListModel) LoadNotes() error {
-	notes, err := m.store.ListNotes()
+	m.cancelLoad()
+	notes, err := m.store.QueryNotes(m.noteQuery())
 	if err != nil {
 		return err
 	}
+	m.setNotes(notes)
+	return nil
+}
 
-	// Apply filters
-	filtered := make([]models.Note, 0)
-	for _, note := range notes {
-		// Filter by search text
-		if m.filter != "" {
-			searchText := strings.ToLower(m.filter)
-			titleMatch := strings.Contains(strings.ToLower(note.Title), searchText)
-			bodyMatch := strings.Contains(strings.ToLower(note.Body), searchText)
-			if !titleMatch && !bodyMatch {
-				continue
-			}
-		}
-
-		// Filter by selected tags
-		if len(m.selectedTags) > 0 {
-			hasAllTags := true
-			for _, selectedTag := range m.selectedTags {
-				found := false
-				for _, noteTag := range note.Tags {
-					if noteTag == selectedTag {
-						found = true
-						break
-					}
-				}
-				if !found {
-					hasAllTags = false
-					break
-				}
-			}
-			if !hasAllTags {
-				continue
-			}
-		}
+// loadNotesCmd runs the current query as a tea.Cmd, cancelling any load
+// still in flight. Used for search-as-you-type.
+func (m *NotesListModel) loadNotesCmd() tea.Cmd {
+	m.cancelLoad()
+	ctx, cancel := context.WithTimeout(context.Background(), sqlite.QueryTimeout)
+	m.loadCancel = cancel
+	id, store, query := m.loadID, m.store, m.noteQuery()
+	return func() tea.Msg {
+		defer cancel()
+		notes, err := store.QueryNotesContext(ctx, query)
+		return notesLoadedMsg{id: id, notes: notes, err: err}
+	}
+}
 
-		filtered = append(filtered, note)
+// cancelLoad stops the in-flight load and marks its result stale.
+func (m *NotesListModel) cancelLoad() {
+	if m.loadCancel != nil {
+		m.loadCancel()
+		m.loadCancel = nil
 	}
+	m.loadID++
+}
+
+// noteQuery builds the store query for the current filters and sort.
+func (m *NotesListModel) noteQuery() sqlite.NoteQuery {
+	text, fields := sqlite.ParseFieldFilters(m.filter)
+	query := sqlite.NoteQuery{
+		Text:     text,
+		Tags:     m.selectedTags,
+		Notebook: m.notebook,
+		Fields:   fields,
 
-	// Apply sort based on sortMode
+		UpdatedBefore: staleCutoff(m.staleOnly, m.staleAfter),
+	}
 	switch m.sortMode {
-	case SortByDate:
-		// Newest first (default)
-		sort.Slice(filtered, func(i, j int) bool {
-			return filtered[i].UpdatedAt.After(filtered[j].UpdatedAt)
-		})
 	case SortByTitle:
-		// Alphabetical by title
-		sort.Slice(filtered, func(i, j int) bool {
-			return strings.ToLower(filtered[i].Title) < strings.ToLower(filtered[j].Title)
-		})
+		query.Sort = sqlite.NoteSortTitle
 	case SortByDateAsc:
-		// Oldest first
-		sort.Slice(filtered, func(i, j int) bool {
-			return filtered[i].UpdatedAt.Before(filtered[j].UpdatedAt)
-		})
+		query.Sort = sqlite.NoteSortUpdatedAsc
+	default:
+		query.Sort = sqlite.NoteSortUpdatedDesc
 	}
+	return query
+}
 
-	items := make([]list.Item, 0, len(filtered))
-	for _, note := range filtered {
+func (m *NotesListModel) setNotes(notes []models.Note) {
+	items := make([]list.Item, 0, len(notes))
+	for _, note := range notes {
 		items = append(items, NoteItem{note: note})
 	}
-
 	m.list.SetItems(items)
-	return nil
 }
 
 // Update handles messages for the notes screen.
@@ -237,6 +485,21 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case notesLoadedMsg:
+		if msg.id == m.loadID {
+			m.loadCancel = nil
+			if msg.err == nil {
+				m.setNotes(msg.notes)
+			}
+		}
+		return m, nil
+	case noteSharedMsg:
+		return m, handleNoteShared(msg)
+	case noteSummarizedMsg:
+		return m, m.handleNoteSummarized(msg)
+	case tagSuggestionsMsg:
+		m.openTagSuggestions(msg)
+		return m, nil
 	case tea.KeyMsg:
 		// Handle filter input with search-as-you-type
 		if m.showFilter {
@@ -259,12 +522,30 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filterInput, cmd = m.filterInput.Update(msg)
 				// Search-as-you-type: update filter and reload on every keystroke
 				m.filter = m.filterInput.Value()
-				m.LoadNotes()
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, cmd, m.loadNotesCmd())
 				return m, tea.Batch(cmds...)
 			}
 		}
 
+		// Handle the batch retag prompt (Phase 6)
+		if m.retag.active {
+			cmd, applied := m.retag.update(msg, m.store)
+			if applied {
+				m.LoadNotes()
+			}
+			return m, cmd
+		}
+
+		// Handle the notebook switcher / move picker (Phase 6)
+		if m.showNotebookPicker {
+			return m, m.updateNotebookPicker(msg)
+		}
+
+		// Handle tag suggestions for a just-saved note (Phase 6)
+		if m.showTagSuggest {
+			return m, m.handleTagSuggestKey(msg)
+		}
+
 		// Handle Quick-Tag picker (Phase 6)
 		if m.showTagPicker {
 			switch msg.String() {
@@ -308,10 +589,119 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle preview mode
 		if m.showPreview {
+			if m.showOutline && m.previewNote != nil {
+				return m, m.updateOutline(msg)
+			}
+			if m.fieldEditor.open && m.previewNote != nil {
+				saved, cmd := m.fieldEditor.Update(m.store, "note", m.previewNote.ID, msg)
+				if saved {
+					m.previewFields = loadFields(m.store, "note", m.previewNote.ID)
+				}
+				return m, cmd
+			}
+			if m.resurfaceEditor.open && m.previewNote != nil {
+				saved, cmd := m.resurfaceEditor.Update(m.store, m.previewNote.ID, msg)
+				if saved {
+					m.previewReminder = loadReminder(m.store, m.previewNote.ID)
+				}
+				return m, cmd
+			}
+			if m.previewSearch.typing && m.previewNote != nil {
+				return m, m.updatePreviewSearch(msg)
+			}
 			switch msg.String() {
+			case "/":
+				// Search within the note
+				if m.previewNote != nil {
+					m.openPreviewSearch()
+				}
+				return m, nil
+			case "n", "N":
+				// Next/previous match of the search
+				if m.previewSearch.active() {
+					if msg.String() == "n" {
+						m.stepPreviewMatch(1)
+					} else {
+						m.stepPreviewMatch(-1)
+					}
+				}
+				return m, nil
+			case "o":
+				if m.previewNote != nil {
+					return m, m.openOutline()
+				}
+				return m, nil
+			case "pgdown":
+				m.scrollPreview(m.previewBodyHeight)
+				return m, nil
+			case "pgup":
+				m.scrollPreview(-m.previewBodyHeight)
+				return m, nil
 			case "esc", "p", "q":
+				if msg.String() == "esc" && m.previewSearch.active() {
+					// Esc clears the search before it closes the preview
+					m.closePreviewSearch()
+					return m, nil
+				}
 				m.showPreview = false
 				m.previewNote = nil
+				m.previewTodos = nil
+				return m, nil
+			case "j", "down":
+				if m.previewTodoIndex < len(m.previewTodos)-1 {
+					m.previewTodoIndex++
+				}
+				return m, nil
+			case "k", "up":
+				if m.previewTodoIndex > 0 {
+					m.previewTodoIndex--
+				}
+				return m, nil
+			case " ":
+				// Toggle the highlighted linked todo without leaving the preview
+				return m, m.togglePreviewTodo()
+			case "S":
+				// Share the previewed note
+				if m.previewNote != nil {
+					return m, m.shareNote(m.previewNote.ID)
+				}
+				return m, nil
+			case "y":
+				// Copy a wikilink to the previewed note
+				if m.previewNote != nil {
+					return m, copyReference(noteReference(m.previewNote))
+				}
+				return m, nil
+			case "A":
+				// Summarize the previewed note
+				if m.previewNote != nil {
+					return m, m.summarizeNote(m.previewNote.ID)
+				}
+				return m, nil
+			case "T":
+				// Create a todo linked to the previewed note
+				if m.previewNote != nil {
+					note := m.previewNote
+					return m, func() tea.Msg {
+						return CreateTodoForNoteMsg{NoteID: note.ID, Title: note.Title}
+					}
+				}
+				return m, nil
+			case "F":
+				// Set a custom field (Phase 6)
+				if m.previewNote != nil {
+					m.fieldEditor.Open()
+				}
+				return m, nil
+			case "R":
+				// Resurface the note in the inbox on a later day (Phase 6)
+				if m.previewNote != nil {
+					m.resurfaceEditor.Open(m.previewReminder)
+				}
+				return m, nil
+			case "f":
+				// Fill in a wikilink placeholder (Phase 3)
+				m.fillPlaceholder()
 				return m, nil
 			case "e":
 				// Edit directly from preview
@@ -331,24 +721,54 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Handle delete confirmation dialog
-		if m.confirmingDelete {
+		if m.confirmDelete.IsOpen() {
+			return m, m.confirmDelete.Update(msg)
+		}
+
+		// Handle keys when in create/edit mode
+		if m.showCreate {
+			// Spelling suggestions popup is modal (Phase 3)
+			if m.showSpellPopup {
+				m.updateSpellPopup(msg)
+				return m, nil
+			}
+			if msg.String() == "f7" && m.openSpellPopup() {
+				return m, nil
+			}
+			if m.links.open() && m.bodyInput.Focused() && m.updateLinkCompletion(msg) {
+				return m, nil
+			}
+			if m.showGoToLine {
+				return m, m.updateGoToLine(msg)
+			}
+			if m.showFind {
+				return m, m.updateFind(msg)
+			}
 			switch msg.String() {
-			case "y", "Y":
-				m.store.DeleteNote(m.deleteTargetID)
-				m.confirmingDelete = false
-				m.deleteTargetID = 0
-				m.LoadNotes()
+			case "f8":
+				m.toggleWriting()
+				return m, nil
+			case "ctrl+f":
+				m.openFind()
 				return m, nil
-			case "n", "N", "esc":
-				m.confirmingDelete = false
-				m.deleteTargetID = 0
+			case "ctrl+j":
+				m.openGoToLine()
 				return m, nil
+			case "ctrl+home", "ctrl+end":
+				return m, m.jumpToEdge(msg)
+			}
+
+			// Writing mode keeps the body focused; Esc leaves it first
+			if m.writing {
+				switch msg.String() {
+				case "esc":
+					m.writing = false
+					return m, nil
+				case "tab", "shift+tab":
+					return m, nil
+				}
 			}
-			return m, nil
-		}
 
-		// Handle keys when in create/edit mode
-		if m.showCreate {
 			// Handle tab to switch between fields
 			if msg.String() == "tab" || msg.String() == "shift+tab" {
 				if m.titleInput.Focused() {
@@ -374,94 +794,29 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Handle enter only when title is focused (to save)
 			// When body is focused, let enter pass through to textarea for newlines
 			if msg.String() == "enter" && m.titleInput.Focused() {
-				title := strings.TrimSpace(m.titleInput.Value())
-				body := strings.TrimSpace(m.bodyInput.Value())
-				if title != "" {
-					tags := extractTags(title + " " + body)
-					wikilinks := parseWikilinks(body)
-
-					if m.editingID > 0 {
-						// Update existing note
-						note := &models.Note{
-							ID:    m.editingID,
-							Title: title,
-							Body:  body,
-							Tags:  tags,
-						}
-						if err := m.store.UpdateNote(note); err != nil {
-							return m, nil
-						}
-						// Create wikilinks
-						m.createWikilinks(note.ID, wikilinks)
-					} else {
-						// Create new note
-						note := &models.Note{
-							Title: title,
-							Body:  body,
-							Tags:  tags,
-						}
-						if err := m.store.CreateNote(note); err != nil {
-							return m, nil
-						}
-						// Create wikilinks
-						m.createWikilinks(note.ID, wikilinks)
-					}
-					m.showCreate = false
-					m.editingID = 0
-					m.titleInput.SetValue("")
-					m.bodyInput.SetValue("")
-					m.LoadNotes()
-				}
-				return m, nil
+				return m, m.saveNote()
 			}
 
 			// Check for cross-platform save shortcut
 			if keymap.IsModS(msg) {
 				// Alternative save shortcut
-				title := strings.TrimSpace(m.titleInput.Value())
-				body := strings.TrimSpace(m.bodyInput.Value())
-				if title != "" {
-					tags := extractTags(title + " " + body)
-					wikilinks := parseWikilinks(body)
-
-					if m.editingID > 0 {
-						// Update existing note
-						note := &models.Note{
-							ID:    m.editingID,
-							Title: title,
-							Body:  body,
-							Tags:  tags,
-						}
-						if err := m.store.UpdateNote(note); err != nil {
-							return m, nil
-						}
-						// Create wikilinks
-						m.createWikilinks(note.ID, wikilinks)
-					} else {
-						// Create new note
-						note := &models.Note{
-							Title: title,
-							Body:  body,
-							Tags:  tags,
-						}
-						if err := m.store.CreateNote(note); err != nil {
-							return m, nil
-						}
-						// Create wikilinks
-						m.createWikilinks(note.ID, wikilinks)
-					}
-					m.showCreate = false
-					m.editingID = 0
-					m.titleInput.SetValue("")
-					m.bodyInput.SetValue("")
-					m.LoadNotes()
-				}
+				return m, m.saveNote()
+			}
+
+			// Resize the body textarea (Ctrl+Up/Down)
+			switch msg.String() {
+			case "ctrl+up":
+				m.resizeBody(1)
+				return m, nil
+			case "ctrl+down":
+				m.resizeBody(-1)
 				return m, nil
 			}
 
 			// Toggle markdown preview while editing (Ctrl+E)
 			if keymap.IsModE(msg) {
 				m.editPreview = !m.editPreview
+				m.writing = false
 				return m, nil
 			}
 
@@ -484,8 +839,11 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.showCreate = false
 				m.editingID = 0
 				m.editPreview = false
+				m.writing = false
+				m.links = linkCompletion{}
 				m.titleInput.SetValue("")
 				m.bodyInput.SetValue("")
+				m.resetNoteErrors()
 				return m, nil
 			}
 
@@ -495,13 +853,17 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.titleInput, cmd = m.titleInput.Update(msg)
 			} else {
 				m.bodyInput, cmd = m.bodyInput.Update(msg)
+				m.refreshLinkCompletion()
 			}
+			m.validateNote()
 			cmds = append(cmds, cmd)
 			return m, tea.Batch(cmds...)
 		}
 
 		// Handle keys when viewing list - process BEFORE passing to list
 		switch msg.String() {
+		case "esc":
+			return m, goBack
 		case "/":
 			// Open filter input
 			m.showFilter = true
@@ -510,14 +872,16 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		case "p":
 			// Preview selected note
-			if len(m.list.VisibleItems()) > 0 {
-				if selected, ok := m.list.SelectedItem().(NoteItem); ok {
-					fullNote, err := m.store.GetNote(selected.note.ID)
-					if err != nil || fullNote == nil {
-						return m, nil
-					}
-					m.showPreview = true
-					m.previewNote = fullNote
+			if selected := m.GetSelectedNote(); selected != nil {
+				m.PreviewNote(selected.ID)
+			}
+			return m, nil
+		case "T":
+			// Create a todo linked to the selected note
+			if selected := m.GetSelectedNote(); selected != nil {
+				note := *selected
+				return m, func() tea.Msg {
+					return CreateTodoForNoteMsg{NoteID: note.ID, Title: note.Title}
 				}
 			}
 			return m, nil
@@ -533,6 +897,54 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				copy(m.tagPickerSelected, m.selectedTags)
 			}
 			return m, nil
+		case "b":
+			// Switch notebook (Phase 6)
+			m.openNotebookPicker(nil)
+			return m, nil
+		case "m":
+			// Move the selected note to a notebook (Phase 6)
+			if selected := m.GetSelectedNote(); selected != nil {
+				note := *selected
+				m.openNotebookPicker(&note)
+			}
+			return m, nil
+		case "*":
+			// Star or unstar the selected note (Phase 6)
+			if selected := m.GetSelectedNote(); selected != nil {
+				cmd := toggleNoteStar(m.store, selected)
+				m.LoadNotes()
+				return m, cmd
+			}
+			return m, nil
+		case "#":
+			// Tag or untag every filtered note (Phase 6)
+			return m, m.openRetag()
+		case "a":
+			// Show only stale notes (Phase 6)
+			if m.staleAfter > 0 {
+				m.staleOnly = !m.staleOnly
+				m.LoadNotes()
+				m.list.Select(0)
+			}
+			return m, nil
+		case "H":
+			// Pin the active filter to Home (Phase 10)
+			return m, m.togglePin()
+		case "D":
+			// Toggle compact/comfortable rows
+			return m, toggleListDensity(m.store, &m.list, "notes")
+		case "S":
+			// Share the selected note
+			if selected := m.GetSelectedNote(); selected != nil {
+				return m, m.shareNote(selected.ID)
+			}
+			return m, nil
+		case "y":
+			// Copy a wikilink to the selected note
+			if selected := m.GetSelectedNote(); selected != nil {
+				return m, copyReference(noteReference(selected))
+			}
+			return m, nil
 		case "s":
 			// Cycle through sort modes: Date (newest) -> Title -> Date (oldest) -> Date (newest)
 			switch m.sortMode {
@@ -554,7 +966,7 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.bodyInput.Blur()
 			return m, nil // Return early to prevent list from processing
 		case "e":
-			if len(m.list.VisibleItems()) > 0 {
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(NoteItem); ok {
 					// Phase 4: Performance - Fetch full note content
 					fullNote, err := m.store.GetNote(selected.note.ID)
@@ -572,10 +984,9 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		case "d":
-			if len(m.list.VisibleItems()) > 0 {
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(NoteItem); ok {
-					m.confirmingDelete = true
-					m.deleteTargetID = selected.note.ID
+					m.confirmNoteDelete(selected.note.ID)
 				}
 			}
 			return m, nil
@@ -586,6 +997,7 @@ func (m *NotesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Reset all filters
 			m.filter = ""
 			m.selectedTags = []string{}
+			m.staleOnly = false
 			m.LoadNotes()
 			return m, nil
 		}
@@ -616,6 +1028,20 @@ func (m *NotesListModel) View() string {
 		return m.renderTagPicker()
 	}
 
+	// Tag suggestions for a just-saved note
+	if m.showTagSuggest {
+		return m.renderTagSuggestions()
+	}
+
+	// Notebook switcher / move picker
+	if m.showNotebookPicker {
+		return m.renderNotebookPicker()
+	}
+
+	if m.retag.active {
+		return m.retag.view(&m.helpBar, m.FilterLabel())
+	}
+
 	// Preview mode
 	if m.showPreview {
 		return m.renderPreview()
@@ -645,20 +1071,14 @@ func (m *NotesListModel) View() string {
 	}
 
 	// Delete confirmation dialog
-	if m.confirmingDelete {
-		m.helpBar.SetHints(components.ConfirmHints)
-		confirmDialog := lipgloss.JoinVertical(
-			lipgloss.Center,
-			styles.TitleStyle.Render("⚠️ Delete Note?"),
-			"",
-			styles.SubtitleStyle.Render("This action cannot be undone."),
-			"",
-			m.helpBar.View(),
-		)
-		return styles.PanelStyle.Render(confirmDialog)
+	if m.confirmDelete.IsOpen() {
+		return m.confirmDelete.View()
 	}
 
 	if m.showCreate {
+		if m.Writing() {
+			return m.renderWriting()
+		}
 		mod := keymap.ModKeyDisplay()
 
 		// Dynamic title for create vs edit
@@ -705,8 +1125,12 @@ func (m *NotesListModel) View() string {
 			{Key: "Tab", Description: "Switch Field"},
 			{Key: mod + "+S", Description: "Save", Primary: true},
 			{Key: mod + "+B", Description: "Bold"},
+			{Key: "F8", Description: "Writing"},
 			{Key: "Esc", Description: "Cancel"},
 		}
+		if m.spell != nil {
+			editHints = append(editHints, components.HelpHint{Key: "F7", Description: "Spelling"})
+		}
 		m.helpBar.SetHints(editHints)
 
 		// Show different layouts based on which field is focused
@@ -716,18 +1140,15 @@ func (m *NotesListModel) View() string {
 			titleLabel := styles.SelectedItemStyle.Render("▶ Title")
 			bodyLabel := styles.SubtitleStyle.Render("Body (use #tags and [[links]])")
 
-			form = lipgloss.JoinVertical(
+			form = m.editorView(lipgloss.JoinVertical(
 				lipgloss.Left,
 				styles.TitleStyle.Render(formTitle),
 				"",
 				titleLabel,
 				m.titleInput.View(),
-				"",
+				components.FieldError(m.titleErr),
 				bodyLabel,
-				m.bodyInput.View(),
-				"",
-				m.helpBar.View(),
-			)
+			), "")
 		} else {
 			// Body is focused: show title as inline header, hide title input
 			titleDisplay := styles.TitleStyle.Render(m.titleInput.Value())
@@ -736,26 +1157,25 @@ func (m *NotesListModel) View() string {
 			}
 			bodyLabel := styles.SelectedItemStyle.Render("▶ Body (use #tags and [[links]])")
 
-			form = lipgloss.JoinVertical(
+			form = m.editorView(lipgloss.JoinVertical(
 				lipgloss.Left,
 				styles.TitleStyle.Render(formTitle),
 				"",
 				titleDisplay,
-				"",
+				components.FieldError(m.titleErr),
 				bodyLabel,
-				m.bodyInput.View(),
-				"",
-				m.helpBar.View(),
-			)
+			), m.editorPopup())
 		}
 		return styles.PanelStyle.Render(form)
 	}
 
-	// Update header with item count and active filters
+	// Update header with item count, notebook and active filters
 	m.header.SetItemCount(len(m.list.Items()))
-
-	// Update help hints to include preview and filter (with platform-appropriate mod key)
-	mod := keymap.ModKeyDisplay()
+	if m.notebookName != "" {
+		m.header.SetTitle("📝", "Notes · 📓 "+m.notebookName)
+	} else {
+		m.header.SetTitle("📝", "Notes")
+	}
 
 	// Get current sort mode display
 	var sortDesc string
@@ -768,22 +1188,18 @@ func (m *NotesListModel) View() string {
 		sortDesc = "Date↑"
 	}
 
-	listHints := []components.HelpHint{
-		{Key: "c", Description: "Create", Primary: true},
-		{Key: "e", Description: "Edit"},
-		{Key: "p", Description: "Preview"},
-		{Key: "d", Description: "Delete"},
-		{Key: "/", Description: "Filter"},
-		{Key: "s", Description: "Sort:" + sortDesc},
-		{Key: "t", Description: "Tag"},
-		{Key: mod + "+H", Description: "Home"},
-	}
+	listHints := components.WithDescriptions(components.NotesListHints, map[string]string{
+		"s": "Sort:" + sortDesc,
+	})
 	m.helpBar.SetHints(listHints)
 
 	// Show active filters
 	var filterStatus string
-	if m.filter != "" || len(m.selectedTags) > 0 {
+	if m.filter != "" || len(m.selectedTags) > 0 || m.staleOnly {
 		filterParts := []string{}
+		if m.staleOnly {
+			filterParts = append(filterParts, "stale")
+		}
 		if m.filter != "" {
 			filterParts = append(filterParts, fmt.Sprintf("search:%q", m.filter))
 		}
@@ -802,8 +1218,12 @@ func (m *NotesListModel) View() string {
 	// Empty state
 	if len(m.list.Items()) == 0 {
 		emptyMsg := "No notes yet. Start capturing your thoughts!"
-		if m.filter != "" || len(m.selectedTags) > 0 {
+		if m.staleOnly && m.filter == "" && len(m.selectedTags) == 0 {
+			emptyMsg = "No stale notes. Press [a] to show all notes."
+		} else if m.filter != "" || len(m.selectedTags) > 0 || m.staleOnly {
 			emptyMsg = "No notes match your filters. Press [Ctrl+R] to reset."
+		} else if m.notebookName != "" {
+			emptyMsg = "No notes in " + m.notebookName + " yet. Press [b] to switch notebook."
 		}
 		emptyState := lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -818,23 +1238,15 @@ func (m *NotesListModel) View() string {
 		return styles.PanelStyle.Render(emptyState)
 	}
 
-	// Regular list view with header and help bar
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		m.header.View(),
-		"",
-	)
+	// Regular list view: the list fills what the header, filter line and
+	// help bar leave
+	top := lipgloss.JoinVertical(lipgloss.Left, m.header.View(), "")
 	if filterStatus != "" {
-		content = lipgloss.JoinVertical(lipgloss.Left, content, filterStatus, "")
+		top = lipgloss.JoinVertical(lipgloss.Left, top, filterStatus, "")
 	}
-	content = lipgloss.JoinVertical(
-		lipgloss.Left,
-		content,
-		m.list.View(),
-		"",
-		m.helpBar.View(),
-	)
-	return content
+	bottom := lipgloss.JoinVertical(lipgloss.Left, "", m.helpBar.View())
+	m.list.SetSize(m.width-4, components.FillHeight(m.height-components.AppChrome, minListRows, top, bottom))
+	return lipgloss.JoinVertical(lipgloss.Left, top, m.list.View(), bottom)
 }
 
 // NoteItem implements list.Item for displaying notes in the list.
@@ -847,13 +1259,26 @@ type NoteItem struct {
 	note models.Note
 }
 
+// noteItemKey keeps the selected note selected when the list reloads.
+func noteItemKey(item list.Item) (int64, bool) {
+	n, ok := item.(NoteItem)
+	return n.note.ID, ok
+}
+
 func (n NoteItem) Title() string {
-	date := n.note.UpdatedAt.Format("2006-01-02")
+	date := datefmt.Age(n.note.UpdatedAt)
 	tags := ""
 	if len(n.note.Tags) > 0 {
 		tags = " [" + strings.Join(n.note.Tags, ", ") + "]"
 	}
-	return fmt.Sprintf("%s %s%s", date, n.note.Title, tags)
+	star := ""
+	if n.note.Starred {
+		star = "★ "
+	}
+	if n.note.IsPlaceholder() {
+		star += "👻 "
+	}
+	return fmt.Sprintf("%s %s%s%s", date, star, n.note.Title, tags)
 }
 
 func (n NoteItem) Description() string {
@@ -914,40 +1339,193 @@ func (m *NotesListModel) renderPreview() string {
 	title := titleStyle.Render(m.previewNote.Title)
 
 	// Date
-	date := dateStyle.Render(m.previewNote.UpdatedAt.Format("2006-01-02 15:04"))
+	dateText := datefmt.DateTime(m.previewNote.UpdatedAt)
+	if m.previewReminder != nil {
+		dateText += " · ⏰ resurfaces " + datefmt.Day(*m.previewReminder)
+	}
+	date := dateStyle.Render(dateText)
+
+	// Stable ID and deep link for cross-references from other tools
+	link := dateStyle.Render(fmt.Sprintf("ID %d · %s", m.previewNote.ID, deeplink.URI(deeplink.KindNote, m.previewNote.ID)))
 
 	// Tags
 	var tags string
 	if len(m.previewNote.Tags) > 0 {
 		tagParts := []string{}
 		for _, tag := range m.previewNote.Tags {
-			tagParts = append(tagParts, tagStyle.Render("#"+tag))
+			tagParts = append(tagParts, styles.ColorTag(tagStyle, tag).Render(styles.TagText(tag)))
 		}
 		tags = strings.Join(tagParts, "")
 	}
 
-	// Body with wikilink highlighting
-	body := m.previewNote.Body
-	body = highlightWikilinks(body, wikilinkStyle)
-	body = bodyStyle.Render(body)
-
 	// Use helpbar for consistent styling
-	m.helpBar.SetHints(components.NotesPreviewHints)
+	m.helpBar.SetHints(m.previewHints())
+	help := m.helpBar.View()
+	tasks := m.renderPreviewTasks()
+	fields := renderFields(m.previewFields)
+	if m.fieldEditor.open {
+		fields = lipgloss.JoinVertical(lipgloss.Left, fields, m.fieldEditor.View())
+	}
+	if m.resurfaceEditor.open {
+		fields = lipgloss.JoinVertical(lipgloss.Left, fields, m.resurfaceEditor.View())
+	}
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		date,
-		tags,
-		"",
-		body,
-		"",
-		m.helpBar.View(),
-	)
+	// The body gets the height left over; long bodies scroll (outline.go).
+	// The rest: panel border and padding, the body's padding, the lines
+	// above and below it and the app's toast and status lines.
+	bodyHeight := m.height - 4 - 2 - 6 - 1 - lipgloss.Height(help) - 2
+	if tasks != "" {
+		bodyHeight -= lipgloss.Height(tasks)
+	}
+	if fields != "" {
+		bodyHeight -= lipgloss.Height(fields)
+	}
+	search := m.renderPreviewSearch()
+	if search != "" {
+		bodyHeight -= lipgloss.Height(search)
+	}
+	text, position := m.previewBodyLines(bodyHeight)
+
+	// Body with wikilink and search match highlighting
+	body := bodyStyle.Render(m.highlightPreviewMatches(highlightWikilinks(text, wikilinkStyle), m.previewScroll))
+	if search != "" {
+		body = lipgloss.JoinVertical(lipgloss.Left, body, search)
+	}
+	if position != "" {
+		body = lipgloss.JoinVertical(lipgloss.Left, body, dateStyle.Render(position))
+	}
+	if m.showOutline {
+		body = lipgloss.JoinHorizontal(lipgloss.Top, m.renderOutline(lipgloss.Height(body)), body)
+	}
+
+	parts := []string{title, date, link, tags}
+	if banner := placeholderBanner(m.previewNote); banner != "" {
+		parts = append(parts, banner)
+	}
+	parts = append(parts, "", body)
+	if tasks != "" {
+		parts = append(parts, tasks)
+	}
+	if fields != "" {
+		parts = append(parts, fields)
+	}
+	parts = append(parts, "", help)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, parts...)
 
 	return styles.PanelStyle.Render(content)
 }
 
+// renderPreviewTasks renders the "Tasks" section listing todos linked to the
+// previewed note. Returns an empty string when the note has no linked todos.
+func (m *NotesListModel) renderPreviewTasks() string {
+	if len(m.previewTodos) == 0 {
+		return ""
+	}
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(styles.MutedColor).
+		Bold(true)
+
+	doneStyle := lipgloss.NewStyle().
+		Foreground(styles.MutedColor).
+		Strikethrough(true)
+
+	lines := []string{labelStyle.Render(fmt.Sprintf("Tasks (%d)", len(m.previewTodos)))}
+	for i, todo := range m.previewTodos {
+		line := todoStatusIcon(todo.Status) + " " + todo.Title
+		switch {
+		case i == m.previewTodoIndex:
+			lines = append(lines, styles.SelectedItemStyle.Render("▶ "+line))
+		case todo.Status == models.TodoStatusCompleted:
+			lines = append(lines, "  "+doneStyle.Render(line))
+		default:
+			lines = append(lines, "  "+line)
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// loadPreviewTodos collects todos related to the previewed note, either via
+// the todo's note_id or via an explicit link in either direction.
+func (m *NotesListModel) loadPreviewTodos() {
+	m.previewTodos = nil
+	m.previewTodoIndex = 0
+	if m.previewNote == nil {
+		return
+	}
+
+	seen := make(map[int64]bool)
+	if todos, err := m.store.ListTodosForNote(m.previewNote.ID); err == nil {
+		for _, todo := range todos {
+			seen[todo.ID] = true
+			m.previewTodos = append(m.previewTodos, todo)
+		}
+	}
+
+	links, err := m.store.GetLinksForItem("note", m.previewNote.ID)
+	if err != nil {
+		return
+	}
+	for _, link := range links {
+		var todoID int64
+		switch {
+		case link.TargetType == "todo":
+			todoID = link.TargetID
+		case link.SourceType == "todo":
+			todoID = link.SourceID
+		default:
+			continue
+		}
+		if seen[todoID] {
+			continue
+		}
+		todo, err := m.store.GetTodo(todoID)
+		if err != nil || todo == nil {
+			continue
+		}
+		seen[todoID] = true
+		m.previewTodos = append(m.previewTodos, *todo)
+	}
+}
+
+// RefreshPreview reloads the open preview's linked todos, which may have
+// changed on another screen.
+func (m *NotesListModel) RefreshPreview() {
+	if !m.showPreview {
+		return
+	}
+	index := m.previewTodoIndex
+	m.loadPreviewTodos()
+	if m.previewNote != nil {
+		m.previewFields = loadFields(m.store, "note", m.previewNote.ID)
+		m.previewReminder = loadReminder(m.store, m.previewNote.ID)
+	}
+	if index < len(m.previewTodos) {
+		m.previewTodoIndex = index
+	}
+}
+
+// togglePreviewTodo flips the highlighted linked todo between pending and
+// completed, leaving it as it was and saying so when the save fails.
+func (m *NotesListModel) togglePreviewTodo() tea.Cmd {
+	if m.previewTodoIndex < 0 || m.previewTodoIndex >= len(m.previewTodos) {
+		return nil
+	}
+	todo := &m.previewTodos[m.previewTodoIndex]
+	previous := todo.Status
+	if todo.Status == models.TodoStatusCompleted {
+		todo.Status = models.TodoStatusPending
+	} else {
+		todo.Status = models.TodoStatusCompleted
+	}
+	if err := m.store.UpdateTodo(todo); err != nil {
+		todo.Status = previous
+		return toastCmd("⚠️ Could not update todo: " + err.Error())
+	}
+	return nil
+}
+
 // renderMarkdownPreview renders simple markdown formatting for the edit preview.
 func (m *NotesListModel) renderMarkdownPreview(text string) string {
 	if text == "" {
@@ -990,46 +1568,8 @@ func (m *NotesListModel) renderMarkdownPreview(text string) string {
 	checkboxStyle := lipgloss.NewStyle().
 		Foreground(styles.MutedColor)
 
-	// Process line by line
-	lines := strings.Split(text, "\n")
-	var renderedLines []string
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		// Headers
-		if strings.HasPrefix(trimmed, "### ") {
-			renderedLines = append(renderedLines, headerStyle.Render("   "+trimmed[4:]))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "## ") {
-			renderedLines = append(renderedLines, headerStyle.Render("  "+trimmed[3:]))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "# ") {
-			renderedLines = append(renderedLines, headerStyle.Render(trimmed[2:]))
-			continue
-		}
-
-		// Checkboxes
-		if strings.HasPrefix(trimmed, "- [x] ") || strings.HasPrefix(trimmed, "- [X] ") {
-			renderedLines = append(renderedLines, checkboxDoneStyle.Render("  ✓ "+trimmed[6:]))
-			continue
-		}
-		if strings.HasPrefix(trimmed, "- [ ] ") {
-			renderedLines = append(renderedLines, checkboxStyle.Render("  ☐ "+trimmed[6:]))
-			continue
-		}
-
-		// Bullet lists
-		if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
-			renderedLines = append(renderedLines, listStyle.Render("• "+trimmed[2:]))
-			continue
-		}
-
-		// Process inline formatting
-		rendered := line
-
+	// Inline formatting, applied to paragraph lines and table cells
+	renderInline := func(rendered string) string {
 		// Bold (**text**)
 		for {
 			start := strings.Index(rendered, "**")
@@ -1084,12 +1624,188 @@ func (m *NotesListModel) renderMarkdownPreview(text string) string {
 		// Wikilinks ([[link]])
 		rendered = highlightWikilinks(rendered, wikilinkStyle)
 
-		renderedLines = append(renderedLines, rendered)
+		return rendered
+	}
+
+	// Process line by line
+	lines := strings.Split(text, "\n")
+	var renderedLines []string
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		// Fenced code blocks: no inline formatting, highlighted when the
+		// fence names a known language. An unclosed fence runs to the end
+		// of the note.
+		if strings.HasPrefix(trimmed, "```") {
+			lang := fenceLanguage(trimmed)
+			var code []string
+			for i++; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			renderedLines = append(renderedLines, highlightCodeBlock(lang, code)...)
+			continue
+		}
+
+		// Tables: a header row followed by a |---|---| separator
+		if strings.HasPrefix(trimmed, "|") && i+1 < len(lines) && isTableSeparator(lines[i+1]) {
+			aligns := parseTableAligns(lines[i+1])
+			rows := [][]string{splitTableRow(trimmed)}
+			for i += 2; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|"); i++ {
+				rows = append(rows, splitTableRow(strings.TrimSpace(lines[i])))
+			}
+			i-- // Loop increment moves past the last table row
+			for r := range rows {
+				for c := range rows[r] {
+					rows[r][c] = renderInline(rows[r][c])
+				}
+			}
+			renderedLines = append(renderedLines, renderTable(rows, aligns, headerStyle)...)
+			continue
+		}
+
+		// Headers
+		if strings.HasPrefix(trimmed, "### ") {
+			renderedLines = append(renderedLines, headerStyle.Render("   "+trimmed[4:]))
+			continue
+		}
+		if strings.HasPrefix(trimmed, "## ") {
+			renderedLines = append(renderedLines, headerStyle.Render("  "+trimmed[3:]))
+			continue
+		}
+		if strings.HasPrefix(trimmed, "# ") {
+			renderedLines = append(renderedLines, headerStyle.Render(trimmed[2:]))
+			continue
+		}
+
+		// Checkboxes
+		if strings.HasPrefix(trimmed, "- [x] ") || strings.HasPrefix(trimmed, "- [X] ") {
+			renderedLines = append(renderedLines, checkboxDoneStyle.Render("  ✓ "+trimmed[6:]))
+			continue
+		}
+		if strings.HasPrefix(trimmed, "- [ ] ") {
+			renderedLines = append(renderedLines, checkboxStyle.Render("  ☐ "+trimmed[6:]))
+			continue
+		}
+
+		// Bullet lists
+		if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
+			renderedLines = append(renderedLines, listStyle.Render("• "+trimmed[2:]))
+			continue
+		}
+
+		renderedLines = append(renderedLines, renderInline(line))
 	}
 
 	return strings.Join(renderedLines, "\n")
 }
 
+// expandTabs replaces tabs with four spaces so code keeps its indentation
+// in the terminal.
+func expandTabs(line string) string {
+	return strings.ReplaceAll(line, "\t", "    ")
+}
+
+// splitTableRow splits a markdown table row ("| a | b |") into trimmed cells.
+func splitTableRow(line string) []string {
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+	cells := strings.Split(line, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
+
+// isTableSeparator reports whether line is a table header separator such
+// as "|---|:---:|".
+func isTableSeparator(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "|") {
+		return false
+	}
+	for _, cell := range splitTableRow(trimmed) {
+		cell = strings.Trim(cell, ":")
+		if cell == "" || strings.Trim(cell, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTableAligns reads column alignment from a separator row: ":--:"
+// centers, "--:" aligns right, anything else aligns left.
+func parseTableAligns(line string) []lipgloss.Position {
+	cells := splitTableRow(strings.TrimSpace(line))
+	aligns := make([]lipgloss.Position, len(cells))
+	for i, cell := range cells {
+		switch {
+		case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+			aligns[i] = lipgloss.Center
+		case strings.HasSuffix(cell, ":"):
+			aligns[i] = lipgloss.Right
+		default:
+			aligns[i] = lipgloss.Left
+		}
+	}
+	return aligns
+}
+
+// renderTable lays out table rows (the first being the header) with each
+// column padded to its widest cell.
+func renderTable(rows [][]string, aligns []lipgloss.Position, headerStyle lipgloss.Style) []string {
+	cols := 0
+	for _, row := range rows {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+	widths := make([]int, cols)
+	for _, row := range rows {
+		for c, cell := range row {
+			if w := lipgloss.Width(cell); w > widths[c] {
+				widths[c] = w
+			}
+		}
+	}
+
+	borderStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	sep := borderStyle.Render(" │ ")
+
+	var out []string
+	for r, row := range rows {
+		cells := make([]string, cols)
+		for c := 0; c < cols; c++ {
+			cell := ""
+			if c < len(row) {
+				cell = row[c]
+			}
+			if r == 0 {
+				cell = headerStyle.Render(cell)
+			}
+			align := lipgloss.Left
+			if c < len(aligns) {
+				align = aligns[c]
+			}
+			cells[c] = lipgloss.PlaceHorizontal(widths[c], align, cell)
+		}
+		out = append(out, strings.Join(cells, sep))
+
+		if r == 0 {
+			rules := make([]string, cols)
+			for c, w := range widths {
+				rules[c] = strings.Repeat("─", w)
+			}
+			out = append(out, borderStyle.Render(strings.Join(rules, "─┼─")))
+		}
+	}
+	return out
+}
+
 // highlightWikilinks finds [[text]] patterns and highlights them.
 func highlightWikilinks(text string, style lipgloss.Style) string {
 	// Simple regex-free approach
@@ -1115,7 +1831,7 @@ func highlightWikilinks(text string, style lipgloss.Style) string {
 			continue
 		}
 		if !inLink {
-			result += string(text[i])
+			result += text[i : i+1] // A byte at a time keeps UTF-8 intact
 		}
 	}
 	return result
@@ -1146,55 +1862,155 @@ func parseWikilinks(text string) []string {
 	return links
 }
 
+// Rules for the note editor's fields.
+var (
+	noteTitleRules = []components.Rule{components.Required("Title"), components.MaxLength("Title", models.MaxTitleLength)}
+	noteBodyRules  = []components.Rule{components.MaxLength("Body", models.MaxBodyLength)}
+)
+
+// validateNote checks the editor's fields, setting their inline errors,
+// and reports whether they are valid.
+func (m *NotesListModel) validateNote() bool {
+	m.titleErr = components.Check(m.titleInput.Value(), m.saveAttempted, noteTitleRules...)
+	m.bodyErr = components.Check(m.bodyInput.Value(), m.saveAttempted, noteBodyRules...)
+	return m.titleErr == "" && m.bodyErr == ""
+}
+
+// resetNoteErrors clears the editor's validation state.
+func (m *NotesListModel) resetNoteErrors() {
+	m.titleErr = ""
+	m.bodyErr = ""
+	m.saveAttempted = false
+}
+
+// saveNote saves the note being created or edited and closes the editor.
+// Invalid fields keep the editor open with their errors shown, as does a
+// failed save.
+func (m *NotesListModel) saveNote() tea.Cmd {
+	m.saveAttempted = true
+	if !m.validateNote() {
+		return nil
+	}
+	title := strings.TrimSpace(m.titleInput.Value())
+	body := strings.TrimSpace(m.bodyInput.Value())
+	tags := extractTags(title + " " + body)
+	wikilinks := parseWikilinks(body)
+
+	note := &models.Note{Title: title, Body: body, Tags: tags}
+	renamed := true
+	created := m.editingID == 0
+	if !created {
+		// Update existing note
+		note.ID = m.editingID
+		if old, err := m.store.GetNote(m.editingID); err == nil && old != nil {
+			renamed = !strings.EqualFold(old.Title, title)
+		}
+		if err := m.store.UpdateNote(note); err != nil {
+			return m.saveFailedToast(err)
+		}
+	} else {
+		// Create new note
+		note.NotebookID = m.newNoteNotebook()
+		if err := m.store.CreateNote(note); err != nil {
+			return m.saveFailedToast(err)
+		}
+	}
+	// Create wikilinks
+	m.createWikilinks(note.ID, wikilinks)
+
+	m.showCreate = false
+	m.editingID = 0
+	m.writing = false
+	m.links = linkCompletion{}
+	m.titleInput.SetValue("")
+	m.bodyInput.SetValue("")
+	m.resetNoteErrors()
+	m.LoadNotes()
+	if created {
+		// Follow the new note, wherever the sort put it
+		m.SelectNoteByID(note.ID)
+		if m.previewAfterCreate {
+			m.PreviewNote(note.ID)
+		}
+	}
+
+	cmds := []tea.Cmd{m.suggestTagsCmd(note)}
+	if renamed {
+		cmds = append(cmds, m.titleClashToast(note))
+	}
+	return tea.Batch(cmds...)
+}
+
+// saveFailedToast says why a note could not be saved. The editor stays
+// open with the text, so nothing typed is lost.
+func (m *NotesListModel) saveFailedToast(err error) tea.Cmd {
+	if m.store.ReadOnly() {
+		return toastCmd("🔒 Read-only: another instance holds the database, so the note was not saved")
+	}
+	return toastCmd("Could not save the note: " + err.Error())
+}
+
+// titleClashToast warns when an older note already has note's title, as
+// [[wikilinks]] to that title resolve to the older note.
+func (m *NotesListModel) titleClashToast(note *models.Note) tea.Cmd {
+	other, err := m.store.GetNoteByTitle(note.Title)
+	if err != nil || other == nil || other.ID == note.ID {
+		return nil
+	}
+	return toastCmd(fmt.Sprintf("⚠️ Another note is already titled %q; [[%s]] links go to it", other.Title, other.Title))
+}
+
 // createWikilinks creates links from the current note to notes mentioned in [[...]] syntax.
+//
+// Phase 4: Performance - The titles are resolved in one query, and the
+// placeholder notes and links are created in one transaction.
 func (m *NotesListModel) createWikilinks(sourceNoteID int64, wikilinks []string) {
 	if len(wikilinks) == 0 {
 		return
 	}
 
-	// Get all notes to match titles
-	allNotes, err := m.store.ListNotes()
-	if err != nil {
-		return
-	}
-
-	// For each wikilink, find or create the target note
-	for _, linkTitle := range wikilinks {
-		var targetID int64
-		found := false
-
-		// Search for existing note with this title
-		for _, note := range allNotes {
-			if strings.EqualFold(strings.TrimSpace(note.Title), strings.TrimSpace(linkTitle)) {
-				targetID = note.ID
-				found = true
-				break
+	_ = m.store.WithTx(func(tx *sqlite.Tx) error {
+		existing, err := tx.GetNotesByTitles(wikilinks)
+		if err != nil {
+			return err
+		}
+		targets := make(map[string]int64, len(existing))
+		for _, note := range existing {
+			key := strings.ToLower(strings.TrimSpace(note.Title))
+			if _, ok := targets[key]; !ok {
+				targets[key] = note.ID
 			}
 		}
 
-		// If not found, create a placeholder note
-		if !found {
-			placeholderNote := &models.Note{
-				Title: linkTitle,
-				Body:  "(Created from wikilink)",
-				Tags:  []string{"placeholder"},
-			}
-			if err := m.store.CreateNote(placeholderNote); err != nil {
-				continue
+		links := make([]models.Link, 0, len(wikilinks))
+		for _, linkTitle := range wikilinks {
+			key := strings.ToLower(strings.TrimSpace(linkTitle))
+			targetID, found := targets[key]
+
+			// If not found, create a placeholder note
+			if !found {
+				placeholderNote := &models.Note{
+					Title: linkTitle,
+					Body:  models.PlaceholderBody,
+					Tags:  []string{models.PlaceholderTag},
+				}
+				if err := tx.CreateNote(placeholderNote); err != nil {
+					return err
+				}
+				targetID = placeholderNote.ID
+				targets[key] = targetID
 			}
-			targetID = placeholderNote.ID
-		}
 
-		// Create the link
-		link := &models.Link{
-			SourceType: "note",
-			SourceID:   sourceNoteID,
-			TargetType: "note",
-			TargetID:   targetID,
-			LinkType:   "wikilink",
+			links = append(links, models.Link{
+				SourceType: "note",
+				SourceID:   sourceNoteID,
+				TargetType: "note",
+				TargetID:   targetID,
+				LinkType:   "wikilink",
+			})
 		}
-		m.store.CreateLink(link)
-	}
+		return tx.CreateLinks(links)
+	})
 }
 
 // extractTags finds all #hashtags and @mentions in content and returns them as a slice.
@@ -1247,25 +2063,12 @@ func cleanTag(tag string) string {
 
 // loadAvailableTags loads all unique tags from all notes in the database.
 func (m *NotesListModel) loadAvailableTags() {
-	notes, err := m.store.ListNotes()
+	// Phase 4: Performance - read distinct tags from the note_tags index
+	tags, err := m.store.ListNoteTags()
 	if err != nil {
 		m.availableTags = []string{}
 		return
 	}
-
-	tagSet := make(map[string]struct{})
-	for _, note := range notes {
-		for _, tag := range note.Tags {
-			tagSet[tag] = struct{}{}
-		}
-	}
-
-	// Convert to sorted slice
-	tags := make([]string, 0, len(tagSet))
-	for tag := range tagSet {
-		tags = append(tags, tag)
-	}
-	sort.Strings(tags)
 	m.availableTags = tags
 }
 
@@ -1,12 +1,16 @@
 package screens
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/spellcheck"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 )
 
@@ -57,6 +61,99 @@ func TestNotesCreateMode(t *testing.T) {
 	}
 }
 
+func TestNotesEditorFillsHeight(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t) // 100x40
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = *mm.(*NotesListModel)
+	view := func() []string {
+		t.Helper()
+		lines := strings.Split(m.View(), "\n")
+		// The form leaves exactly the app's toast and status lines free,
+		// with the help bar at its bottom
+		if len(lines) != 38 || !strings.Contains(lines[len(lines)-3], "Cancel") {
+			t.Fatalf("editor is not 38 lines with the help bar at the bottom:\n%s", strings.Join(lines, "\n"))
+		}
+		return lines
+	}
+	view()
+	full := m.bodyInput.Height()
+	if full < 20 {
+		t.Fatalf("body height = %d, want it to fill the free height", full)
+	}
+
+	key := func(k tea.KeyType) {
+		mm, _ := m.Update(tea.KeyMsg{Type: k})
+		m = *mm.(*NotesListModel)
+		view()
+	}
+	key(tea.KeyCtrlDown)
+	key(tea.KeyCtrlDown)
+	if got := m.bodyInput.Height(); got != full-2 {
+		t.Fatalf("body height after shrinking = %d, want %d", got, full-2)
+	}
+	for range 5 {
+		key(tea.KeyCtrlUp)
+	}
+	if got := m.bodyInput.Height(); got != full {
+		t.Fatalf("body height after growing = %d, want %d", got, full)
+	}
+
+	// A small terminal keeps the body usable
+	m.SetSize(100, 10)
+	m.View()
+	if got := m.bodyInput.Height(); got != minBodyHeight {
+		t.Fatalf("body height in a small terminal = %d, want %d", got, minBodyHeight)
+	}
+}
+
+func TestNotesGoToLine(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	m.SetLineNumbers(true)
+	update := func(msg tea.KeyMsg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	lines := make([]string, 50)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	m.bodyInput.SetValue(strings.Join(lines, "\n"))
+
+	// Ctrl+Home from the title focuses the body at its start
+	update(tea.KeyMsg{Type: tea.KeyCtrlHome})
+	if !m.bodyInput.Focused() {
+		t.Fatalf("expected Ctrl+Home to focus the body")
+	}
+	if row, col := m.bodyInput.Cursor(); row != 0 || col != 0 {
+		t.Fatalf("cursor after Ctrl+Home = %d:%d, want 0:0", row, col)
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyCtrlJ})
+	for _, r := range "3x0" {
+		update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if v := m.View(); !strings.Contains(v, "Go to line (1-50)") {
+		t.Fatalf("expected the go-to-line prompt, got:\n%s", v)
+	}
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if row, _ := m.bodyInput.Cursor(); row != 29 {
+		t.Fatalf("cursor row after going to line 30 = %d, want 29", row)
+	}
+	if v := m.View(); !strings.Contains(v, " 30 line 30") {
+		t.Fatalf("expected line 30 numbered and scrolled into view, got:\n%s", v)
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	if row, _ := m.bodyInput.Cursor(); row != 49 {
+		t.Fatalf("cursor row after Ctrl+End = %d, want 49", row)
+	}
+}
+
 func TestNotesEnterInBodyCreatesNewline(t *testing.T) {
 	t.Parallel()
 
@@ -122,6 +219,42 @@ func TestNotesEnterInTitleSaves(t *testing.T) {
 	}
 }
 
+func TestNotesSaveReadOnly(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := sqlite.New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	ro, err := sqlite.New(&config.Config{DbPath: dbPath, ReadOnly: true})
+	if err != nil {
+		t.Fatalf("sqlite.New(ReadOnly) err = %v", err)
+	}
+	t.Cleanup(func() { _ = ro.Close() })
+	m := NewNotesListModel(ro)
+	m.SetSize(100, 40)
+
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = *mm.(*NotesListModel)
+	m.titleInput.SetValue("Unsaved title")
+	m.bodyInput.SetValue("Unsaved body")
+	mm, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	m = *mm.(*NotesListModel)
+
+	if cmd == nil {
+		t.Fatal("expected a toast command")
+	}
+	if toast, ok := cmd().(ToastMsg); !ok || !strings.Contains(toast.Text, "Read-only") {
+		t.Fatalf("toast = %#v, want the read-only notice", cmd())
+	}
+	// The editor keeps the text
+	if !m.showCreate || m.titleInput.Value() != "Unsaved title" || m.bodyInput.Value() != "Unsaved body" {
+		t.Fatalf("expected the editor to stay open with the text")
+	}
+}
+
 func TestNotesCtrlSSaves(t *testing.T) {
 	t.Parallel()
 
@@ -151,6 +284,66 @@ func TestNotesCtrlSSaves(t *testing.T) {
 	}
 }
 
+func TestNotesSaveCreatesWikilinks(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	target := &models.Note{Title: "Roadmap"}
+	if err := m.store.CreateNote(target); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = *mm.(*NotesListModel)
+	m.titleInput.SetValue("Planning")
+	m.bodyInput.SetValue("See [[roadmap]], [[Budget]] and [[budget]] again")
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	m = *mm.(*NotesListModel)
+
+	// One placeholder for Budget, however often it is mentioned
+	placeholders, err := m.store.QueryNotes(sqlite.NoteQuery{Tags: []string{"placeholder"}})
+	if err != nil || len(placeholders) != 1 || placeholders[0].Title != "Budget" {
+		t.Fatalf("placeholders = %+v, %v; want Budget only", placeholders, err)
+	}
+	links, _ := m.store.GetLinksForItem("note", target.ID)
+	if len(links) != 1 || links[0].LinkType != "wikilink" {
+		t.Fatalf("links to Roadmap = %+v, want one wikilink", links)
+	}
+}
+
+func TestNotesSaveWarnsOfTitleClash(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	if err := m.store.CreateNote(&models.Note{Title: "Roadmap"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	save := func(title string) tea.Cmd {
+		m.titleInput.SetValue(title)
+		mm, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		m = *mm.(*NotesListModel)
+		return cmd
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if cmd := save("Ideas"); cmd != nil {
+		t.Fatalf("saving a unique title returned %#v, want no toast", cmd())
+	}
+
+	// Renaming Ideas to an existing title warns where [[links]] go
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	cmd := save("roadmap")
+	if n, _ := m.store.CountNotes(sqlite.NoteQuery{}); n != 2 {
+		t.Fatalf("%d notes after renaming, want 2", n)
+	}
+	if cmd == nil {
+		t.Fatal("renaming to an existing title did not warn")
+	}
+	if msg, ok := cmd().(ToastMsg); !ok || !strings.Contains(msg.Text, `Another note is already titled "Roadmap"`) {
+		t.Fatalf("clash toast = %#v", cmd())
+	}
+}
+
 func TestNotesEscCancels(t *testing.T) {
 	t.Parallel()
 
@@ -295,3 +488,308 @@ func TestExtractTagsFromTitle(t *testing.T) {
 		t.Errorf("expected %d tags, got %d: %v", len(expected), len(tags), tags)
 	}
 }
+
+func TestNotesPreviewShowsLinkedTasks(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+
+	note := &models.Note{Title: "Project Plan", Body: "Ship it"}
+	if err := m.store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	byNoteID := &models.Todo{Title: "Write spec", Status: models.TodoStatusPending, NoteID: &note.ID}
+	byLink := &models.Todo{Title: "Review spec", Status: models.TodoStatusPending}
+	for _, todo := range []*models.Todo{byNoteID, byLink} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	if err := m.store.CreateLink(&models.Link{
+		SourceType: "note", SourceID: note.ID,
+		TargetType: "todo", TargetID: byLink.ID,
+		LinkType: models.LinkTypeContains,
+	}); err != nil {
+		t.Fatalf("CreateLink() err = %v", err)
+	}
+
+	m.LoadNotes()
+	mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = *mm.(*NotesListModel)
+
+	if len(m.previewTodos) != 2 {
+		t.Fatalf("expected 2 linked todos in preview, got %d", len(m.previewTodos))
+	}
+	view := m.View()
+	if !strings.Contains(view, "Tasks") || !strings.Contains(view, "Review spec") {
+		t.Fatalf("expected Tasks section with linked todos in preview")
+	}
+
+	// Move to the second task and toggle it with Space
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = *mm.(*NotesListModel)
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = *mm.(*NotesListModel)
+
+	if !m.showPreview {
+		t.Fatalf("expected to remain in preview after toggling a task")
+	}
+	saved, _ := m.store.GetTodo(byLink.ID)
+	if saved.Status != models.TodoStatusCompleted {
+		t.Fatalf("expected toggled todo to be completed, got %q", saved.Status)
+	}
+
+	// A failed save leaves the task as it was and says so
+	_ = m.store.Close()
+	mm, cmd := m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = *mm.(*NotesListModel)
+	if cmd == nil {
+		t.Fatal("expected a toast when the toggle cannot be saved")
+	}
+	if toast, ok := cmd().(ToastMsg); !ok || !strings.Contains(toast.Text, "Could not update todo") {
+		t.Fatalf("toggle failure cmd = %#v, want a toast", cmd())
+	}
+	if m.previewTodos[1].Status != models.TodoStatusCompleted {
+		t.Fatalf("expected the unsaved toggle to be undone, got %q", m.previewTodos[1].Status)
+	}
+}
+
+func TestNotesCreateTodoKeyEmitsMsg(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+
+	note := &models.Note{Title: "Launch plan", Body: "Ship it"}
+	if err := m.store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'T'}})
+	if cmd == nil {
+		t.Fatalf("expected a command after pressing 'T'")
+	}
+	msg, ok := cmd().(CreateTodoForNoteMsg)
+	if !ok {
+		t.Fatalf("expected CreateTodoForNoteMsg, got %T", cmd())
+	}
+	if msg.NoteID != note.ID || msg.Title != "Launch plan" {
+		t.Fatalf("unexpected msg: %+v", msg)
+	}
+}
+
+func TestNotesFilterDropsStaleLoads(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	for _, title := range []string{"apple", "banana"} {
+		if err := m.store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	m.LoadNotes()
+
+	m.filter = "app"
+	stale := m.loadNotesCmd()
+	m.filter = "ban"
+	fresh := m.loadNotesCmd()
+
+	m.Update(stale())
+	if got := len(m.list.Items()); got != 2 {
+		t.Fatalf("expected stale load to be ignored, got %d items", got)
+	}
+
+	m.Update(fresh())
+	note := m.GetSelectedNote()
+	if len(m.list.Items()) != 1 || note == nil || note.Title != "banana" {
+		t.Fatalf("expected only banana after filter, got %d items", len(m.list.Items()))
+	}
+}
+
+func TestMarkdownPreviewCodeBlock(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	text := "Intro **bold**\n```go\nx := a**b* // #notatag\n\tif y {\n```\nAfter"
+	got := ansiPattern.ReplaceAllString(m.renderMarkdownPreview(text), "")
+
+	if strings.Contains(got, "```") {
+		t.Errorf("expected fences to be hidden, got:\n%s", got)
+	}
+	// Code is left untouched: markers stay, tabs become spaces
+	for _, want := range []string{"x := a**b* // #notatag", "    if y {", "Intro bold", "After"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected preview to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestMarkdownPreviewTable(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	text := "| Name | Qty |\n|------|----:|\n| apples | 3 |\n| **kiwi** | 12 |\nnot | a row"
+	got := ansiPattern.ReplaceAllString(m.renderMarkdownPreview(text), "")
+	lines := strings.Split(got, "\n")
+
+	want := []string{
+		"Name   │ Qty",
+		"───────┼────",
+		"apples │   3",
+		"kiwi   │  12",
+		"not | a row",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(want), got)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestNotesSpellCheck(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	checker, err := spellcheck.Load(filepath.Join(t.TempDir(), "dictionary.txt"))
+	if err != nil {
+		t.Fatalf("spellcheck.Load() err = %v", err)
+	}
+	m.SetSpellChecker(checker)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.bodyInput.SetValue("we recieve #tagz")
+
+	if !strings.Contains(m.View(), underlineOn+"recieve"+underlineOff) {
+		t.Errorf("expected recieve to be underlined")
+	}
+	if strings.Contains(m.View(), underlineOn+"tagz") {
+		t.Errorf("expected #tagz to be skipped")
+	}
+
+	// F7 with the cursor at the end of the line: #tagz is not checked
+	m.Update(tea.KeyMsg{Type: tea.KeyF7})
+	if m.showSpellPopup {
+		t.Fatalf("expected no popup for a skipped word")
+	}
+
+	// Move into "recieve" and take the first suggestion
+	for i := 0; i < len(" #tagz")+2; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyF7})
+	if !m.showSpellPopup || len(m.spellSuggestions) == 0 || m.spellSuggestions[0] != "receive" {
+		t.Fatalf("expected popup suggesting receive, got open=%v %q", m.showSpellPopup, m.spellSuggestions)
+	}
+	if !strings.Contains(m.View(), "+ Add to dictionary") {
+		t.Errorf("expected popup in view")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showSpellPopup {
+		t.Errorf("expected popup to close after applying")
+	}
+	if got := m.bodyInput.Value(); got != "we receive #tagz" {
+		t.Errorf("body = %q, want %q", got, "we receive #tagz")
+	}
+	if !m.showCreate {
+		t.Errorf("expected Enter in the popup not to save the note")
+	}
+}
+
+func TestNotesDeleteConfirms(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	if err := m.store.CreateNote(&models.Note{Title: "Doomed"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+
+	press := func(s string) {
+		mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+		m = *mm.(*NotesListModel)
+	}
+	press("d")
+	if !strings.Contains(m.View(), "Delete Note?") {
+		t.Fatalf("expected a delete confirmation, got:\n%s", m.View())
+	}
+	press("n")
+	if len(m.list.Items()) != 1 {
+		t.Fatalf("expected n to keep the note")
+	}
+	press("d")
+	press("y")
+	if len(m.list.Items()) != 0 || strings.Contains(m.View(), "Delete Note?") {
+		t.Errorf("expected y to delete the note, got %d notes", len(m.list.Items()))
+	}
+}
+
+func TestNotesEditorValidation(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	update := func(msg tea.Msg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showCreate || !strings.Contains(m.View(), "Title is required") {
+		t.Fatalf("expected an empty title to keep the editor open with an error, got:\n%s", m.View())
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Pasted")})
+	update(tea.KeyMsg{Type: tea.KeyTab})
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(strings.Repeat("z", models.MaxBodyLength+1)), Paste: true})
+	if !strings.Contains(m.View(), "Body is too long") {
+		t.Fatalf("expected a too-long body error under the body")
+	}
+	update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if notes, _ := m.store.ListNotes(); !m.showCreate || len(notes) != 0 {
+		t.Errorf("expected a too-long body not to save")
+	}
+}
+
+func TestNotesSelectionFollowsCreate(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	m.sortMode = SortByTitle
+	for _, title := range []string{"Alpha", "Charlie"} {
+		if err := m.store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	m.LoadNotes()
+
+	create := func(title string) {
+		for _, msg := range []tea.Msg{
+			tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}},
+			tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(title)},
+			tea.KeyMsg{Type: tea.KeyEnter},
+		} {
+			mm, _ := m.Update(msg)
+			m = *mm.(*NotesListModel)
+		}
+	}
+
+	create("Bravo")
+	if got := m.GetSelectedNote(); got == nil || got.Title != "Bravo" {
+		t.Fatalf("expected the new note to be selected, got %+v", got)
+	}
+	if m.showPreview {
+		t.Errorf("expected no preview unless preview_after_create is set")
+	}
+
+	m.SetPreviewAfterCreate(true)
+	create("Delta")
+	if got := m.GetSelectedNote(); got == nil || got.Title != "Delta" {
+		t.Fatalf("expected the new note to be selected, got %+v", got)
+	}
+	if !m.showPreview || m.previewNote == nil || m.previewNote.Title != "Delta" {
+		t.Errorf("expected the new note's preview to open")
+	}
+}
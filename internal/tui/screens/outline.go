@@ -0,0 +1,231 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Outline for long notes (Phase 6: Organization).
+//
+// The note preview scrolls with PgUp/PgDn when the body does not fit, and
+// o opens an outline of the note's markdown headings beside it. Moving
+// through the outline with j/k scrolls the body to the heading; ←/→
+// collapse and expand a heading's subheadings, Enter closes the outline
+// where it is.
+
+// maxOutlineWidth caps the outline sidebar's width.
+const maxOutlineWidth = 32
+
+// heading is a markdown heading in a note body.
+type heading struct {
+	level int    // 1 for "#", up to 6
+	text  string // Heading text without the #s
+	line  int    // Body line it is on, from 0
+}
+
+// parseHeadings returns the ATX headings ("## Title") of a markdown body,
+// skipping fenced code blocks.
+func parseHeadings(body string) []heading {
+	var headings []heading
+	inFence := false
+	for i, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		rest := trimmed[level:]
+		if level > 6 || (rest != "" && rest[0] != ' ' && rest[0] != '\t') {
+			continue // "#tag" or "#######" is not a heading
+		}
+		text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(rest), "#"))
+		if text == "" {
+			continue
+		}
+		headings = append(headings, heading{level: level, text: text, line: i})
+	}
+	return headings
+}
+
+// visibleHeadings returns the indexes into headings not hidden under a
+// collapsed heading.
+func visibleHeadings(headings []heading, collapsed map[int]bool) []int {
+	var visible []int
+	hideBelow := 0 // Headings deeper than this level are hidden; 0 = none
+	for i, h := range headings {
+		if hideBelow > 0 && h.level > hideBelow {
+			continue
+		}
+		hideBelow = 0
+		visible = append(visible, i)
+		if collapsed[i] {
+			hideBelow = h.level
+		}
+	}
+	return visible
+}
+
+// hasSubheadings reports whether heading i has deeper headings under it.
+func hasSubheadings(headings []heading, i int) bool {
+	return i+1 < len(headings) && headings[i+1].level > headings[i].level
+}
+
+// resetPreviewScroll starts a newly opened preview at the top, with the
+// outline and search closed.
+func (m *NotesListModel) resetPreviewScroll() {
+	m.previewScroll = 0
+	m.showOutline = false
+	m.outlineIndex = 0
+	m.outlineCollapsed = nil
+	m.closePreviewSearch()
+}
+
+// scrollPreview scrolls the preview body by delta lines.
+func (m *NotesListModel) scrollPreview(delta int) {
+	if m.previewNote == nil {
+		return
+	}
+	lines := strings.Count(m.previewNote.Body, "\n") + 1
+	m.previewScroll = min(max(m.previewScroll+delta, 0), max(lines-m.previewBodyHeight, 0))
+}
+
+// updateOutline handles keys while the outline is open.
+func (m *NotesListModel) updateOutline(msg tea.KeyMsg) tea.Cmd {
+	headings := parseHeadings(m.previewNote.Body)
+	visible := visibleHeadings(headings, m.outlineCollapsed)
+	pos := 0
+	for i, h := range visible {
+		if h == m.outlineIndex {
+			pos = i
+		}
+	}
+	switch msg.String() {
+	case "j", "down":
+		pos = min(pos+1, len(visible)-1)
+	case "k", "up":
+		pos = max(pos-1, 0)
+	case "h", "left":
+		if hasSubheadings(headings, m.outlineIndex) {
+			if m.outlineCollapsed == nil {
+				m.outlineCollapsed = make(map[int]bool)
+			}
+			m.outlineCollapsed[m.outlineIndex] = true
+		}
+		return nil
+	case "l", "right":
+		delete(m.outlineCollapsed, m.outlineIndex)
+		return nil
+	case "enter", "o", "esc":
+		m.showOutline = false
+		return nil
+	case "pgdown":
+		m.scrollPreview(m.previewBodyHeight)
+		return nil
+	case "pgup":
+		m.scrollPreview(-m.previewBodyHeight)
+		return nil
+	default:
+		return nil
+	}
+	if len(visible) > 0 {
+		m.outlineIndex = visible[pos]
+		m.previewScroll = 0
+		m.scrollPreview(headings[m.outlineIndex].line)
+	}
+	return nil
+}
+
+// openOutline shows the outline, on the heading nearest above the top of
+// the visible body. Notes without headings say so instead.
+func (m *NotesListModel) openOutline() tea.Cmd {
+	headings := parseHeadings(m.previewNote.Body)
+	if len(headings) == 0 {
+		return func() tea.Msg { return ToastMsg{Text: "This note has no headings"} }
+	}
+	m.showOutline = true
+	m.outlineIndex = 0
+	for _, i := range visibleHeadings(headings, m.outlineCollapsed) {
+		if headings[i].line <= m.previewScroll {
+			m.outlineIndex = i
+		}
+	}
+	return nil
+}
+
+// renderOutline renders the outline sidebar.
+func (m *NotesListModel) renderOutline(height int) string {
+	headings := parseHeadings(m.previewNote.Body)
+	width := min(maxOutlineWidth, m.width/3)
+	minLevel := 6
+	for _, h := range headings {
+		minLevel = min(minLevel, h.level)
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	selectedStyle := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Bold(true)
+	lines := []string{lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).Render("Outline")}
+	for _, i := range visibleHeadings(headings, m.outlineCollapsed) {
+		h := headings[i]
+		marker := "•"
+		if hasSubheadings(headings, i) {
+			marker = "▾"
+			if m.outlineCollapsed[i] {
+				marker = "▸"
+			}
+		}
+		text := truncate(strings.Repeat("  ", h.level-minLevel)+marker+" "+h.text, width-4)
+		if i == m.outlineIndex {
+			lines = append(lines, selectedStyle.Render("▶ "+text))
+		} else {
+			lines = append(lines, mutedStyle.Render("  "+text))
+		}
+	}
+	return lipgloss.NewStyle().
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Border(lipgloss.NormalBorder(), false, true, false, false).
+		BorderForeground(styles.BorderColor).
+		Render(strings.Join(lines, "\n"))
+}
+
+// previewBodyLines returns the body lines the preview has room for, from
+// the scroll position, and a "lines a-b of n" note when the body does not
+// fit.
+func (m *NotesListModel) previewBodyLines(height int) (string, string) {
+	lines := strings.Split(m.previewNote.Body, "\n")
+	m.previewBodyHeight = max(height, 1)
+	if len(lines) <= m.previewBodyHeight {
+		m.previewScroll = 0
+		return m.previewNote.Body, ""
+	}
+	m.scrollPreview(0)
+	end := min(m.previewScroll+m.previewBodyHeight, len(lines))
+	position := fmt.Sprintf("lines %d-%d of %d · PgUp/PgDn to scroll", m.previewScroll+1, end, len(lines))
+	return strings.Join(lines[m.previewScroll:end], "\n"), position
+}
+
+// previewHints returns the preview's help bar hints, with the outline's
+// keys while it is open.
+func (m *NotesListModel) previewHints() []components.HelpHint {
+	if m.showOutline {
+		return components.NotesOutlineHints
+	}
+	if m.previewSearch.active() {
+		return components.NotesPreviewSearchHints
+	}
+	if m.previewNote != nil && m.previewNote.IsPlaceholder() {
+		return components.PlaceholderPreviewHints
+	}
+	return components.NotesPreviewHints
+}
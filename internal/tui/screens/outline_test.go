@@ -0,0 +1,89 @@
+package screens
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestParseHeadings(t *testing.T) {
+	t.Parallel()
+
+	body := "# Guide\nintro #tag\n## Setup ##\n```\n# not a heading\n```\n####### too deep\n### Linux\n## Usage"
+	got := parseHeadings(body)
+	want := []heading{
+		{level: 1, text: "Guide", line: 0},
+		{level: 2, text: "Setup", line: 2},
+		{level: 3, text: "Linux", line: 7},
+		{level: 2, text: "Usage", line: 8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseHeadings() = %+v, want %+v", got, want)
+	}
+
+	// Collapsing Setup hides Linux; collapsing Guide hides everything under it
+	if got := visibleHeadings(want, map[int]bool{1: true}); !reflect.DeepEqual(got, []int{0, 1, 3}) {
+		t.Fatalf("visibleHeadings(Setup collapsed) = %v", got)
+	}
+	if got := visibleHeadings(want, map[int]bool{0: true}); !reflect.DeepEqual(got, []int{0}) {
+		t.Fatalf("visibleHeadings(Guide collapsed) = %v", got)
+	}
+}
+
+func TestNotesPreviewOutline(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t) // 100x40
+	var lines []string
+	for _, section := range []string{"Alpha", "Beta", "Gamma"} {
+		lines = append(lines, "## "+section)
+		for i := 1; i <= 30; i++ {
+			lines = append(lines, fmt.Sprintf("%s line %d", strings.ToLower(section), i))
+		}
+	}
+	if err := m.store.CreateNote(&models.Note{Title: "Reference", Body: strings.Join(lines, "\n")}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+	update := func(msg tea.KeyMsg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	key := func(s string) { update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}) }
+
+	key("p")
+	view := m.View()
+	if h := lipgloss.Height(view); h > 38 {
+		t.Fatalf("preview is %d lines, taller than the 38 available", h)
+	}
+	if !strings.Contains(view, "lines 1-") || strings.Contains(view, "gamma line 1") {
+		t.Fatalf("expected a long note to show from the top with a position, got:\n%s", view)
+	}
+
+	key("o")
+	key("j")
+	key("j")
+	view = m.View()
+	if !strings.Contains(view, "Outline") || !strings.Contains(view, "gamma line 1") {
+		t.Fatalf("expected the outline open and the body scrolled to Gamma, got:\n%s", view)
+	}
+	if m.previewScroll != 62 {
+		t.Fatalf("previewScroll = %d, want 62 (the Gamma heading)", m.previewScroll)
+	}
+
+	// Enter closes the outline where it is; Esc then closes the preview
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showOutline || !m.showPreview {
+		t.Fatalf("expected Enter to close only the outline")
+	}
+	update(tea.KeyMsg{Type: tea.KeyPgUp})
+	if m.previewScroll >= 62 {
+		t.Fatalf("expected PgUp to scroll up from 62, at %d", m.previewScroll)
+	}
+}
@@ -0,0 +1,189 @@
+package screens
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+// Pinned filters (Phase 10: Home).
+//
+// H on the notes or todos list pins the active filter - search text,
+// tags, notebook, status, priority, project, stale - to the home screen,
+// where 1-9 reopen it with a live count beside it. H on a filter that is
+// already pinned unpins it.
+
+// Pin screens.
+const (
+	PinNotes = "notes"
+	PinTodos = "todos"
+)
+
+// PinFilter returns the active notes filter.
+func (m *NotesListModel) PinFilter() models.PinFilter {
+	return models.PinFilter{
+		Text:     m.filter,
+		Tags:     sortedTags(m.selectedTags),
+		Notebook: m.notebook,
+		Stale:    m.staleOnly,
+	}
+}
+
+// ApplyPin replaces the notes filters with f.
+func (m *NotesListModel) ApplyPin(f models.PinFilter) {
+	m.filter = f.Text
+	m.selectedTags = append([]string{}, f.Tags...)
+	m.staleOnly = f.Stale && m.staleAfter > 0
+	m.notebook, m.notebookName = f.Notebook, ""
+	if f.Notebook > 0 {
+		if nb, err := m.store.GetNotebook(f.Notebook); err == nil && nb != nil {
+			m.notebookName = nb.Name
+		} else {
+			m.notebook = 0
+		}
+	}
+	m.showNotebook(m.notebook, m.notebookName)
+}
+
+// togglePin pins the active filter to Home, or unpins it when it is
+// already pinned.
+func (m *NotesListModel) togglePin() tea.Cmd {
+	return togglePin(m.store, PinNotes, m.FilterLabel(), m.PinFilter())
+}
+
+// PinFilter returns the active todos filter.
+func (m *TodosListModel) PinFilter() models.PinFilter {
+	f := models.PinFilter{
+		Text:    m.filter,
+		Status:  m.statusFilter,
+		Project: m.projectFilter,
+		Stale:   m.staleOnly,
+	}
+	if m.priorityFilter >= 0 {
+		priority := m.priorityFilter
+		f.Priority = &priority
+	}
+	tags := make([]string, 0, len(m.selectedTags))
+	for tag := range m.selectedTags {
+		tags = append(tags, tag)
+	}
+	f.Tags = sortedTags(tags)
+	return f
+}
+
+// ApplyPin replaces the todos filters with f.
+func (m *TodosListModel) ApplyPin(f models.PinFilter) {
+	m.filter = f.Text
+	m.statusFilter = f.Status
+	m.priorityFilter = -1
+	if f.Priority != nil {
+		m.priorityFilter = *f.Priority
+	}
+	m.selectedTags = make(map[string]bool)
+	for _, tag := range f.Tags {
+		m.selectedTags[tag] = true
+	}
+	m.projectFilter = f.Project
+	m.showSnoozed = false
+	m.staleOnly = f.Stale && m.staleAfter > 0
+	m.LoadTodos()
+	m.list.Select(0)
+}
+
+// togglePin pins the active filter to Home, or unpins it when it is
+// already pinned.
+func (m *TodosListModel) togglePin() tea.Cmd {
+	label := m.FilterLabel()
+	if m.statusFilter != "" {
+		label = strings.TrimSpace(label + " status:" + string(m.statusFilter))
+	}
+	if m.priorityFilter >= 0 {
+		label = strings.TrimSpace(label + " priority:" + priorityName(m.priorityFilter))
+	}
+	return togglePin(m.store, PinTodos, label, m.PinFilter())
+}
+
+// priorityName names a todo priority for pin labels.
+func priorityName(p models.TodoPriority) string {
+	switch p {
+	case models.TodoPriorityHigh:
+		return "high"
+	case models.TodoPriorityMedium:
+		return "medium"
+	}
+	return "low"
+}
+
+// sortedTags returns a sorted copy of tags, or nil when there are none,
+// so equal filters compare equal.
+func sortedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// togglePin deletes the pin on screen matching filter, or creates one
+// labelled label when there is none.
+func togglePin(store *sqlite.Store, screen, label string, filter models.PinFilter) tea.Cmd {
+	if reflect.DeepEqual(filter, models.PinFilter{}) {
+		return toastCmd("Filter the list first, then H pins the filter to Home")
+	}
+	pins, err := store.ListPins()
+	if err != nil {
+		return toastCmd("Could not load pins: " + err.Error())
+	}
+	for _, pin := range pins {
+		if pin.Screen == screen && reflect.DeepEqual(pin.Filter, filter) {
+			if err := store.DeletePin(pin.ID); err != nil {
+				return toastCmd("Could not unpin: " + err.Error())
+			}
+			return toastCmd(fmt.Sprintf("📌 Unpinned %s from Home", pin.Label))
+		}
+	}
+	if len(pins) >= models.MaxPins {
+		return toastCmd(fmt.Sprintf("Home already has %d pins; unpin one first", models.MaxPins))
+	}
+	pin := &models.Pin{Screen: screen, Label: label, Filter: filter}
+	if err := store.CreatePin(pin); err != nil {
+		return toastCmd("Could not pin: " + err.Error())
+	}
+	return toastCmd(fmt.Sprintf("📌 Pinned %s to Home (key %d)", label, len(pins)+1))
+}
+
+// CountPin counts the items pin shows, with the stale filter using
+// staleNotes or staleTodos. Snoozed todos are not counted, as the list
+// hides them.
+func CountPin(store *sqlite.Store, pin models.Pin, staleNotes, staleTodos time.Duration) (int, error) {
+	f := pin.Filter
+	if pin.Screen == PinNotes {
+		return store.CountNotes(sqlite.NoteQuery{
+			Text:          f.Text,
+			Tags:          f.Tags,
+			Notebook:      f.Notebook,
+			UpdatedBefore: staleCutoff(f.Stale, staleNotes),
+		})
+	}
+	query := sqlite.TodoQuery{
+		Text:     f.Text,
+		Status:   f.Status,
+		Project:  f.Project,
+		Priority: f.Priority,
+		Tags:     f.Tags,
+		ActiveAt: time.Now(),
+	}
+	if f.Stale && staleTodos > 0 {
+		query.Open = true
+		query.UpdatedBefore = staleCutoff(true, staleTodos)
+	}
+	return store.CountTodos(query)
+}
@@ -0,0 +1,53 @@
+package screens
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Placeholders (Phase 3: Linking System).
+//
+// A [[wikilink]] to a title with no note creates a placeholder note so the
+// link has somewhere to point. Placeholders are dimmed in the list behind
+// a 👻, and their preview offers f to fill them in: the editor opens on
+// the body, without the stub text. Saving drops the placeholder tag, as
+// tags are re-extracted from the text. "flowState placeholders" lists, and
+// with --delete removes, placeholders nothing links to any more.
+
+// fillPlaceholder opens the previewed placeholder in the editor, ready to
+// write its body.
+func (m *NotesListModel) fillPlaceholder() {
+	note := m.previewNote
+	if note == nil || !note.IsPlaceholder() {
+		return
+	}
+	full, err := m.store.GetNote(note.ID)
+	if err != nil || full == nil {
+		return
+	}
+	m.showPreview = false
+	m.previewNote = nil
+	m.showCreate = true
+	m.editingID = full.ID
+	m.titleInput.SetValue(full.Title)
+	m.titleInput.Blur()
+	m.bodyInput.SetValue(strings.TrimSpace(strings.Replace(full.Body, models.PlaceholderBody, "", 1)))
+	m.bodyInput.Focus()
+}
+
+// placeholderBanner explains a placeholder at the top of its preview, or
+// returns "" for other notes.
+func placeholderBanner(note *models.Note) string {
+	if note == nil || !note.IsPlaceholder() {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(styles.MutedColor).
+		Italic(true).
+		Padding(0, 1).
+		Render("👻 Placeholder created for a [[wikilink]]. Press [f] to fill it in.")
+}
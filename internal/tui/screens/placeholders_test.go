@@ -0,0 +1,46 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNotesFillPlaceholder(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	placeholder := &models.Note{Title: "Budget", Body: models.PlaceholderBody, Tags: []string{models.PlaceholderTag}}
+	if err := m.store.CreateNote(placeholder); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+	update := func(msg tea.KeyMsg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+
+	if view := m.View(); !strings.Contains(view, "👻") || !strings.Contains(view, "not written yet") {
+		t.Fatalf("placeholder is not marked in the list:\n%s", view)
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if view := m.View(); !strings.Contains(view, "Press [f] to fill it in") {
+		t.Fatalf("preview does not offer to fill in the placeholder:\n%s", view)
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if !m.showCreate || m.editingID != placeholder.ID || m.bodyInput.Value() != "" {
+		t.Fatalf("f opened editor = %v for %d with body %q; want the placeholder, stub removed", m.showCreate, m.editingID, m.bodyInput.Value())
+	}
+	m.bodyInput.SetValue("Q3 numbers")
+	update(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	got, _ := m.store.GetNote(placeholder.ID)
+	if got.IsPlaceholder() || got.Body != "Q3 numbers" {
+		t.Fatalf("filled note = %q %v, want an ordinary note", got.Body, got.Tags)
+	}
+}
@@ -0,0 +1,425 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// plannerDays is the number of day columns shown (today + 6).
+const plannerDays = 7
+
+// PlannerModel is the weekly planner screen.
+//
+// Phase 6: Week Planning
+//   - Column 0 is the backlog (pending todos without a due date)
+//   - Columns 1-7 are today and the next six days
+//   - Overdue todos are shown in today's column
+//   - h/l move between columns, j/k move within a column
+//   - H/L move the selected todo to the previous/next column,
+//     updating its due date (moving into the backlog clears it)
+//   - The day a new week begins (datefmt.WeekStart) is marked with ▸
+//   - Each day sums its todo estimates against the daily capacity
+//     (configured work hours) and warns when over-planned
+//   - Focus blocks planned on the focus screen (a) are listed at the top
+//     of their day
+type PlannerModel struct {
+	store    *sqlite.Store
+	capacity int // Daily capacity in minutes
+
+	start   time.Time                 // Midnight of the first day column
+	columns [][]models.Todo           // [0] backlog, [1..plannerDays] days
+	blocks  [][]models.PlannedSession // Planned focus blocks, by column like columns
+	col     int
+	row     int
+
+	showHelp bool
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewPlannerModel creates the weekly planner screen.
+func NewPlannerModel(store *sqlite.Store) PlannerModel {
+	return PlannerModel{
+		store:    store,
+		capacity: config.DefaultWorkHoursPerDay * 60,
+		columns:  make([][]models.Todo, plannerDays+1),
+		col:      1,
+		header:   components.NewHeader("🗓", "Week Planner"),
+		helpBar:  components.NewHelpBar(components.PlannerHints),
+	}
+}
+
+func (m *PlannerModel) Init() tea.Cmd { return nil }
+
+// SetCapacity sets the daily capacity in minutes.
+func (m *PlannerModel) SetCapacity(minutes int) {
+	if minutes > 0 {
+		m.capacity = minutes
+	}
+}
+
+// plannedMinutes sums the estimates of the todos in a column.
+func (m *PlannerModel) plannedMinutes(col int) int {
+	total := 0
+	for _, todo := range m.columns[col] {
+		total += todo.EstimateMinutes
+	}
+	return total
+}
+
+func (m *PlannerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// LoadTodos buckets pending todos into the backlog and day columns,
+// starting from today.
+func (m *PlannerModel) LoadTodos() error {
+	return m.loadFrom(time.Now())
+}
+
+func (m *PlannerModel) loadFrom(now time.Time) error {
+	todos, err := m.store.ListTodos()
+	if err != nil {
+		return err
+	}
+
+	m.start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	m.columns = make([][]models.Todo, plannerDays+1)
+
+	plans, err := m.store.ListPlannedSessions(m.start, m.start.AddDate(0, 0, plannerDays))
+	if err != nil {
+		return err
+	}
+	m.blocks = make([][]models.PlannedSession, plannerDays+1)
+	for _, plan := range plans {
+		if offset := models.DaysUntil(plan.Start, m.start); offset >= 0 && offset < plannerDays {
+			m.blocks[offset+1] = append(m.blocks[offset+1], plan)
+		}
+	}
+
+	for _, todo := range todos {
+		if todo.Status == models.TodoStatusCompleted {
+			continue
+		}
+		col := m.columnFor(todo)
+		if col < 0 {
+			continue
+		}
+		m.columns[col] = append(m.columns[col], todo)
+	}
+
+	for _, column := range m.columns {
+		sort.SliceStable(column, func(i, j int) bool {
+			if column[i].Priority != column[j].Priority {
+				return column[i].Priority > column[j].Priority
+			}
+			return column[i].ID < column[j].ID
+		})
+	}
+
+	m.clampRow()
+	return nil
+}
+
+// columnFor returns the column index for a todo, or -1 when it is due
+// beyond the planning window.
+func (m *PlannerModel) columnFor(todo models.Todo) int {
+	if todo.DueDate == nil {
+		return 0
+	}
+	offset := models.DaysUntil(*todo.DueDate, m.start)
+	if offset < 0 {
+		// Overdue work belongs on today's plate
+		return 1
+	}
+	if offset >= plannerDays {
+		return -1
+	}
+	return offset + 1
+}
+
+// dayFor returns midnight of the day shown in a day column (1-based).
+func (m *PlannerModel) dayFor(col int) time.Time {
+	return m.start.AddDate(0, 0, col-1)
+}
+
+// SelectedTodo returns the highlighted todo, or nil if the column is empty.
+func (m *PlannerModel) SelectedTodo() *models.Todo {
+	column := m.columns[m.col]
+	if m.row < 0 || m.row >= len(column) {
+		return nil
+	}
+	return &column[m.row]
+}
+
+func (m *PlannerModel) Update(msg tea.Msg) (PlannerModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Handle help modal
+		if m.showHelp {
+			// Any key closes help
+			m.showHelp = false
+			return *m, nil
+		}
+
+		switch msg.String() {
+		case "esc":
+			return *m, goBack
+		case "?":
+			m.showHelp = true
+		case "h", "left":
+			if m.col > 0 {
+				m.col--
+				m.clampRow()
+			}
+		case "l", "right":
+			if m.col < plannerDays {
+				m.col++
+				m.clampRow()
+			}
+		case "k", "up":
+			if m.row > 0 {
+				m.row--
+			}
+		case "j", "down":
+			if m.row < len(m.columns[m.col])-1 {
+				m.row++
+			}
+		case "H", "shift+left":
+			m.moveSelected(-1)
+		case "L", "shift+right":
+			m.moveSelected(1)
+		case "r":
+			m.LoadTodos()
+		}
+	}
+
+	return *m, nil
+}
+
+// moveSelected shifts the highlighted todo one column left or right and
+// persists the new due date. The selection follows the todo.
+func (m *PlannerModel) moveSelected(delta int) {
+	selected := m.SelectedTodo()
+	if selected == nil {
+		return
+	}
+	target := m.col + delta
+	if target < 0 || target > plannerDays {
+		return
+	}
+
+	todo := *selected
+	if target == 0 {
+		todo.DueDate = nil
+	} else {
+		// A due time of day moves with the todo
+		day := m.dayFor(target)
+		due := day
+		if todo.DueDate != nil {
+			old := todo.DueDate.In(day.Location())
+			due = time.Date(day.Year(), day.Month(), day.Day(), old.Hour(), old.Minute(), 0, 0, day.Location())
+		}
+		todo.DueDate = &due
+	}
+	if err := m.store.UpdateTodo(&todo); err != nil {
+		return
+	}
+
+	m.loadFrom(m.start)
+	m.col = target
+	for i, t := range m.columns[target] {
+		if t.ID == todo.ID {
+			m.row = i
+			break
+		}
+	}
+}
+
+func (m *PlannerModel) clampRow() {
+	if n := len(m.columns[m.col]); m.row >= n {
+		m.row = n - 1
+	}
+	if m.row < 0 {
+		m.row = 0
+	}
+}
+
+func (m *PlannerModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	if m.showHelp {
+		return panel.Render(m.helpView())
+	}
+
+	colWidth := (m.width - 4) / (plannerDays + 1)
+	if colWidth < 12 {
+		colWidth = 12
+	}
+
+	rendered := make([]string, 0, plannerDays+1)
+	for col := range m.columns {
+		rendered = append(rendered, m.renderColumn(col, colWidth))
+	}
+
+	board := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		board,
+		"",
+		m.helpBar.View(),
+	)
+	return panel.Render(content)
+}
+
+// renderColumn draws one column: a heading, the load line, then todos.
+func (m *PlannerModel) renderColumn(col, width int) string {
+	inner := width - 2
+	var title string
+	if col == 0 {
+		title = "Backlog"
+	} else {
+		day := m.dayFor(col)
+		title = day.Format("Mon 2")
+		if col == 1 {
+			title = "Today"
+		} else if day.Weekday() == datefmt.WeekStart() {
+			title = "▸ " + title // A new week starts here
+		}
+	}
+
+	titleStyle := styles.SubtitleStyle
+	if col == m.col {
+		titleStyle = styles.TitleStyle
+	}
+
+	count := len(m.columns[col])
+	planned := m.plannedMinutes(col)
+	var load string
+	if col == 0 {
+		load = lipgloss.NewStyle().Foreground(styles.MutedColor).Render(fmt.Sprintf("%d todos", count))
+	} else {
+		loadStyle := lipgloss.NewStyle().Foreground(styles.SuccessColor)
+		prefix := ""
+		if planned > m.capacity {
+			loadStyle = lipgloss.NewStyle().Foreground(styles.ErrorColor)
+			prefix = "⚠ "
+		} else if planned*10 >= m.capacity*9 {
+			loadStyle = lipgloss.NewStyle().Foreground(styles.WarningColor)
+		}
+		used := models.FormatMinutes(planned)
+		if used == "" {
+			used = "0m"
+		}
+		load = loadStyle.Render(fmt.Sprintf("%s%s/%s", prefix, used, models.FormatMinutes(m.capacity)))
+	}
+
+	lines := []string{titleStyle.Render(title), load}
+	if col < len(m.blocks) {
+		blockStyle := lipgloss.NewStyle().Foreground(styles.AccentColor)
+		for _, plan := range m.blocks[col] {
+			text := "🍅 " + describePlan(plan)
+			if plan.SessionID != nil {
+				text += " ✓"
+			}
+			lines = append(lines, "  "+blockStyle.Render(truncate(text, inner-2)))
+		}
+	}
+	lines = append(lines, "")
+	for i, todo := range m.columns[col] {
+		text := todoStatusIcon(todo.Status) + " " + todo.Title
+		if todo.DueDate != nil && models.DueHasTime(*todo.DueDate) {
+			text += " · " + datefmt.Clock(*todo.DueDate)
+		}
+		if est := models.FormatMinutes(todo.EstimateMinutes); est != "" {
+			text += " · " + est
+		}
+		label := truncate(text, inner-2)
+		if col == 1 && todo.DueDate != nil && todo.DueDate.Before(m.start) {
+			label = lipgloss.NewStyle().Foreground(styles.ErrorColor).Render(label)
+		}
+		if col == m.col && i == m.row {
+			lines = append(lines, styles.SelectedItemStyle.Render("▶ "+label))
+		} else {
+			lines = append(lines, "  "+label)
+		}
+	}
+
+	border := styles.BorderColor
+	if col == m.col {
+		border = styles.PrimaryColor
+	}
+	return lipgloss.NewStyle().
+		Width(inner).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m *PlannerModel) helpView() string {
+	title := styles.TitleStyle.Render("🗓 WEEK PLANNER - Help")
+
+	helpText := `Plan the coming week by moving todos between days.
+
+` + styles.SelectedItemStyle.Render("Navigation:") + `
+• ` + styles.NeonStyle.Render("h/l") + `: Move between columns
+• ` + styles.NeonStyle.Render("j/k") + `: Move within a column
+• ` + styles.NeonStyle.Render("H/L") + `: Move the selected todo to the previous/next day
+• ` + styles.NeonStyle.Render("r") + `: Reload todos
+
+` + styles.SelectedItemStyle.Render("Columns:") + `
+• ` + styles.NeonStyle.Render("Backlog") + `: Pending todos without a due date
+• ` + styles.NeonStyle.Render("Today") + `: Due today, plus anything overdue (red)
+• ` + styles.NeonStyle.Render("Days") + `: Moving a todo sets its due date to that day
+
+` + styles.SelectedItemStyle.Render("Capacity:") + `
+• Each day sums todo estimates against your work hours (` + models.FormatMinutes(m.capacity) + `)
+• Yellow means nearly full, red (⚠) means over-planned
+• Set estimates in the todo form; set work_hours_per_day in config.json
+
+` + styles.SelectedItemStyle.Render("Focus blocks:") + `
+• 🍅 lines are focus blocks planned with a on the focus screen (✓ when done)`
+
+	help := styles.HelpStyle.Render("Press any key to close")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		helpText,
+		"",
+		help,
+	)
+}
+
+// truncate shortens s to at most width runes, adding an ellipsis.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if width <= 0 || len(runes) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+	return strings.TrimSpace(string(runes[:width-1])) + "…"
+}
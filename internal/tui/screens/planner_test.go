@@ -0,0 +1,134 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func newTestPlannerModel(t *testing.T) *PlannerModel {
+	t.Helper()
+
+	cfg := &config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")}
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	model := NewPlannerModel(store)
+	model.SetSize(140, 40)
+	return &model
+}
+
+func TestPlannerBucketsTodos(t *testing.T) {
+	t.Parallel()
+
+	m := newTestPlannerModel(t)
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local)
+	day := func(offset int) *time.Time {
+		d := now.AddDate(0, 0, offset)
+		return &d
+	}
+
+	for _, todo := range []*models.Todo{
+		{Title: "Backlog item", Status: models.TodoStatusPending},
+		{Title: "Overdue item", Status: models.TodoStatusPending, DueDate: day(-3)},
+		{Title: "Thursday item", Status: models.TodoStatusPending, DueDate: day(2)},
+		{Title: "Next month", Status: models.TodoStatusPending, DueDate: day(30)},
+		{Title: "Done item", Status: models.TodoStatusCompleted, DueDate: day(0)},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	if err := m.loadFrom(now); err != nil {
+		t.Fatalf("loadFrom() err = %v", err)
+	}
+
+	if got := len(m.columns[0]); got != 1 {
+		t.Errorf("expected 1 backlog todo, got %d", got)
+	}
+	if got := len(m.columns[1]); got != 1 || m.columns[1][0].Title != "Overdue item" {
+		t.Errorf("expected overdue todo in today's column, got %+v", m.columns[1])
+	}
+	if got := len(m.columns[3]); got != 1 || m.columns[3][0].Title != "Thursday item" {
+		t.Errorf("expected todo two days out in column 3, got %+v", m.columns[3])
+	}
+
+	v := m.View()
+	if !strings.Contains(v, "Backlog") || !strings.Contains(v, "Today") {
+		t.Errorf("expected column headings in view")
+	}
+}
+
+func TestPlannerMoveTodoSetsDueDate(t *testing.T) {
+	t.Parallel()
+
+	m := newTestPlannerModel(t)
+	todo := &models.Todo{Title: "Plan me", Status: models.TodoStatusPending}
+	if err := m.store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	if err := m.LoadTodos(); err != nil {
+		t.Fatalf("LoadTodos() err = %v", err)
+	}
+
+	// Select the backlog column, then move the todo two days forward
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+
+	if m.col != 2 {
+		t.Fatalf("expected selection to follow todo to column 2, got %d", m.col)
+	}
+	saved, _ := m.store.GetTodo(todo.ID)
+	if saved.DueDate == nil {
+		t.Fatalf("expected due date to be set")
+	}
+	want := m.dayFor(2)
+	if got := saved.DueDate.In(want.Location()); got.Year() != want.Year() || got.YearDay() != want.YearDay() {
+		t.Fatalf("expected due %v, got %v", want, got)
+	}
+
+	// Moving back into the backlog clears the due date
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	saved, _ = m.store.GetTodo(todo.ID)
+	if saved.DueDate != nil {
+		t.Fatalf("expected due date cleared in backlog, got %v", saved.DueDate)
+	}
+}
+
+func TestPlannerWarnsWhenDayOverCapacity(t *testing.T) {
+	t.Parallel()
+
+	m := newTestPlannerModel(t)
+	m.SetCapacity(60)
+
+	now := time.Now()
+	for _, est := range []int{45, 30} {
+		todo := &models.Todo{Title: "Task", Status: models.TodoStatusPending, DueDate: &now, EstimateMinutes: est}
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	if err := m.LoadTodos(); err != nil {
+		t.Fatalf("LoadTodos() err = %v", err)
+	}
+
+	if got := m.plannedMinutes(1); got != 75 {
+		t.Fatalf("plannedMinutes(today) = %d, want 75", got)
+	}
+	if v := m.View(); !strings.Contains(v, "⚠ 1h15m/1h") {
+		t.Fatalf("expected over-capacity warning in view")
+	}
+}
@@ -0,0 +1,205 @@
+package screens
+
+import (
+	"context"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/plugins"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Plugins (Phase 10: Integrations).
+//
+// The Plugins screen (p on Home) lists the plugins discovered in
+// ~/.config/flowState/plugins at startup, with their actions and the
+// events they subscribe to, plus any plugin that failed to describe
+// itself. Enter runs the selected action in the background; its reply
+// comes back as a toast. See the plugins package for the protocol.
+
+// pluginAction is one runnable row: an action of a plugin.
+type pluginAction struct {
+	plugin *plugins.Plugin
+	action plugins.Action
+}
+
+// PluginsModel is the Plugins screen.
+type PluginsModel struct {
+	host    *plugins.Host
+	actions []pluginAction
+	cursor  int
+	offset  int // First line shown, when the list is taller than the screen
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewPluginsModel creates the Plugins screen for the discovered plugins;
+// host may be nil.
+func NewPluginsModel(host *plugins.Host) PluginsModel {
+	m := PluginsModel{
+		host:    host,
+		header:  components.NewHeader("🧩", "Plugins"),
+		helpBar: components.NewHelpBar(components.PluginsHints),
+	}
+	if host != nil {
+		for _, p := range host.Plugins {
+			for _, a := range p.Actions {
+				m.actions = append(m.actions, pluginAction{plugin: p, action: a})
+			}
+		}
+	}
+	return m
+}
+
+func (m *PluginsModel) Init() tea.Cmd { return nil }
+
+func (m *PluginsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// HelpSections returns the Plugins screen's keys for the help modal.
+func (m *PluginsModel) HelpSections() []components.HelpSection {
+	return components.PluginsHelp
+}
+
+// Count returns how many plugins were discovered.
+func (m *PluginsModel) Count() int {
+	if m == nil || m.host == nil {
+		return 0
+	}
+	return len(m.host.Plugins)
+}
+
+func (m *PluginsModel) Update(msg tea.Msg) (PluginsModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return *m, nil
+	}
+	switch key.String() {
+	case "j", "down":
+		m.cursor = min(m.cursor+1, max(len(m.actions)-1, 0))
+	case "k", "up":
+		m.cursor = max(m.cursor-1, 0)
+	case "g", "home":
+		m.cursor = 0
+	case "enter":
+		if m.cursor < len(m.actions) {
+			return *m, m.runAction(m.actions[m.cursor])
+		}
+	case "esc":
+		return *m, goBack
+	}
+	return *m, nil
+}
+
+// runAction runs a plugin action in the background and toasts its reply.
+func (m *PluginsModel) runAction(pa pluginAction) tea.Cmd {
+	return tea.Sequence(toastCmd("Running "+pa.action.Title+"…"), pluginActionCmd(m.host, pa))
+}
+
+// pluginActionCmd runs a plugin action and returns its reply as a toast.
+func pluginActionCmd(host *plugins.Host, pa pluginAction) tea.Cmd {
+	return func() tea.Msg {
+		reply, err := host.RunAction(context.Background(), pa.plugin, pa.action.ID)
+		if err != nil {
+			return ToastMsg{Text: "Plugin failed: " + err.Error()}
+		}
+		if reply.Message == "" {
+			return ToastMsg{Text: pa.action.Title + " done"}
+		}
+		return ToastMsg{Text: reply.Message}
+	}
+}
+
+// bodyHeight is how many lines fit between header and help bar.
+func (m *PluginsModel) bodyHeight() int {
+	return max(m.height-2-lipgloss.Height(m.header.View())-lipgloss.Height(m.helpBar.View())-2, 1)
+}
+
+func (m *PluginsModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	lines, selected := m.lines()
+	// Keep the selected action on screen
+	if selected >= 0 {
+		m.offset = min(m.offset, selected)
+		m.offset = max(m.offset, selected-m.bodyHeight()+1)
+	}
+	m.offset = max(min(m.offset, len(lines)-m.bodyHeight()), 0)
+	end := min(m.offset+m.bodyHeight(), len(lines))
+
+	return panel.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.header.View(),
+		"",
+		strings.Join(lines[m.offset:end], "\n"),
+		"",
+		m.helpBar.View(),
+	))
+}
+
+// lines renders the plugins and their actions a line at a time, and
+// returns the line of the selected action (-1 for none).
+func (m *PluginsModel) lines() ([]string, int) {
+	selected := -1
+	dir := "~/.config/flowState/plugins"
+	if m.host != nil && m.host.Dir != "" {
+		dir = m.host.Dir
+	}
+	if m.Count() == 0 && (m.host == nil || len(m.host.Errors) == 0) {
+		return []string{
+			styles.SubtitleStyle.Render("No plugins installed"),
+			"",
+			styles.HelpStyle.Render("Put executables in " + dir + " and restart flowState."),
+			styles.HelpStyle.Render("Each is run with a JSON request on stdin, starting with"),
+			styles.HelpStyle.Render(`{"type":"describe"}, and replies with its actions as JSON.`),
+		}, selected
+	}
+
+	var lines []string
+	i := 0
+	for _, p := range m.host.Plugins {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, styles.SelectedItemStyle.Render(p.Name))
+		if len(p.Events) > 0 {
+			lines = append(lines, "  "+styles.HelpStyle.Render("Events: "+strings.Join(p.Events, ", ")))
+		}
+		if len(p.Actions) == 0 {
+			lines = append(lines, "  "+styles.SubtitleStyle.Render("No actions"))
+		}
+		for _, a := range p.Actions {
+			line := "  " + a.Title
+			if a.Description != "" {
+				line += " " + styles.SubtitleStyle.Render("— "+truncate(a.Description, max(m.width-lipgloss.Width(a.Title)-14, 10)))
+			}
+			if i == m.cursor {
+				selected = len(lines)
+				line = styles.SelectedItemStyle.Render("▶") + line[1:]
+			}
+			lines = append(lines, line)
+			i++
+		}
+	}
+
+	if len(m.host.Errors) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, styles.SelectedItemStyle.Render("Not loaded"))
+		for _, err := range m.host.Errors {
+			lines = append(lines, "  "+components.FieldError(err.Error()))
+		}
+	}
+	return lines, selected
+}
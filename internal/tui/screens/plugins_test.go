@@ -0,0 +1,69 @@
+package screens
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/plugins"
+)
+
+func TestPluginsScreen(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	m := NewPluginsModel(nil)
+	m.SetSize(100, 30)
+	if m.Count() != 0 || !containsString(m.View(), "No plugins installed") {
+		t.Fatalf("expected the empty state, got:\n%s", m.View())
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+read req
+case "$req" in
+*describe*) echo '{"name":"Gist","actions":[{"id":"sync","title":"Sync gists"},{"id":"open","title":"Open page"}],"events":["startup"]}' ;;
+*) echo '{"message":"3 gists synced"}' ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(dir, "gist"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken"), []byte("#!/bin/sh\nexit 3\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	host := plugins.Discover(context.Background(), dir, 0)
+	t.Cleanup(func() { host.Close(time.Second) })
+
+	m = NewPluginsModel(host)
+	m.SetSize(100, 30)
+	view := m.View()
+	for _, want := range []string{"Gist", "Events: startup", "Sync gists", "Open page", "Not loaded", "broken failed"} {
+		if !containsString(view, want) {
+			t.Errorf("expected %q in the view, got:\n%s", want, view)
+		}
+	}
+	if m.Count() != 1 {
+		t.Errorf("Count() = %d, want 1", m.Count())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if m.cursor != 1 {
+		t.Errorf("cursor = %d, want it to stop on the last action", m.cursor)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Errorf("expected Enter to run the action")
+	}
+
+	if got := pluginActionCmd(host, m.actions[0])(); got != (ToastMsg{Text: "3 gists synced"}) {
+		t.Errorf("action reply = %#v, want the plugin's message", got)
+	}
+}
@@ -0,0 +1,191 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Search within a note (Phase 6: Organization).
+//
+// / in the note preview opens a search bar under the body. Matches are
+// case-insensitive, as in the editor's find bar (find.go), and
+// highlighted as you type; the body scrolls to the first match from the
+// top of the visible lines. Enter closes the bar and keeps the matches:
+// n and N then move to the next and previous one, wrapping around, and
+// Esc clears them.
+
+const (
+	currentMatchOn  = "\x1b[1;4;7m"
+	currentMatchOff = "\x1b[22;24;27m"
+)
+
+// previewSearch is the state of a search within the previewed note.
+type previewSearch struct {
+	input  components.TextInputModel
+	typing bool // The search bar has focus
+	index  int  // Current match, an index into the matches
+}
+
+// active reports whether a query is being typed or its matches are shown.
+func (s *previewSearch) active() bool {
+	return s.typing || s.input.Value() != ""
+}
+
+// openPreviewSearch shows the search bar, keeping a query whose matches
+// are shown.
+func (m *NotesListModel) openPreviewSearch() {
+	if !m.previewSearch.active() {
+		m.previewSearch.input = components.NewTextInput("Search this note")
+	}
+	m.previewSearch.typing = true
+	m.previewSearch.input.Focus()
+	m.previewSearch.index = m.firstVisibleMatch()
+	m.scrollToMatch()
+}
+
+// closePreviewSearch hides the search bar and clears the query.
+func (m *NotesListModel) closePreviewSearch() {
+	m.previewSearch = previewSearch{}
+}
+
+// updatePreviewSearch handles keys while the search bar has focus.
+func (m *NotesListModel) updatePreviewSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.closePreviewSearch()
+		return nil
+	case "enter":
+		m.previewSearch.typing = false
+		m.previewSearch.input.Blur()
+		if m.previewSearch.input.Value() != "" && len(m.previewMatches()) == 0 {
+			return func() tea.Msg { return ToastMsg{Text: "No matches in this note"} }
+		}
+		return nil
+	case "down":
+		m.stepPreviewMatch(1)
+		return nil
+	case "up":
+		m.stepPreviewMatch(-1)
+		return nil
+	}
+
+	query := m.previewSearch.input.Value()
+	var cmd tea.Cmd
+	m.previewSearch.input, cmd = m.previewSearch.input.Update(msg)
+	if m.previewSearch.input.Value() != query {
+		// The query changed: start again from the top of the view
+		m.previewSearch.index = m.firstVisibleMatch()
+		m.scrollToMatch()
+	}
+	return cmd
+}
+
+// previewMatches returns where the query occurs in the previewed body, in
+// order.
+func (m *NotesListModel) previewMatches() []textMatch {
+	query := []rune(strings.ToLower(m.previewSearch.input.Value()))
+	if len(query) == 0 || m.previewNote == nil {
+		return nil
+	}
+	var matches []textMatch
+	for i, line := range strings.Split(m.previewNote.Body, "\n") {
+		for _, col := range indexAllFold([]rune(line), query) {
+			matches = append(matches, textMatch{line: i, col: col})
+		}
+	}
+	return matches
+}
+
+// firstVisibleMatch returns the index of the first match at or below the
+// top line of the visible body.
+func (m *NotesListModel) firstVisibleMatch() int {
+	for i, match := range m.previewMatches() {
+		if match.line >= m.previewScroll {
+			return i
+		}
+	}
+	return 0
+}
+
+// stepPreviewMatch moves to the next (delta 1) or previous (-1) match,
+// wrapping around the ends.
+func (m *NotesListModel) stepPreviewMatch(delta int) {
+	matches := m.previewMatches()
+	if len(matches) == 0 {
+		return
+	}
+	m.previewSearch.index = ((m.previewSearch.index+delta)%len(matches) + len(matches)) % len(matches)
+	m.scrollToMatch()
+}
+
+// scrollToMatch scrolls the body so the current match's line shows, a
+// third of the way down when it has to move.
+func (m *NotesListModel) scrollToMatch() {
+	matches := m.previewMatches()
+	if len(matches) == 0 {
+		return
+	}
+	line := matches[min(m.previewSearch.index, len(matches)-1)].line
+	if line >= m.previewScroll && line < m.previewScroll+m.previewBodyHeight {
+		return
+	}
+	m.previewScroll = 0
+	m.scrollPreview(line - m.previewBodyHeight/3)
+}
+
+// highlightPreviewMatches highlights the query in the visible body lines,
+// which start at body line first; the current match stands out.
+func (m *NotesListModel) highlightPreviewMatches(view string, first int) string {
+	query := []rune(strings.ToLower(m.previewSearch.input.Value()))
+	matches := m.previewMatches()
+	if len(matches) == 0 {
+		return view
+	}
+	current := matches[min(m.previewSearch.index, len(matches)-1)]
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		visible, runeAt := visibleRunes(line)
+		var spans, currentSpan [][2]int
+		for _, start := range indexAllFold(visible, query) {
+			span := [2]int{start, start + len(query)}
+			if first+i == current.line && start == current.col {
+				currentSpan = append(currentSpan, span)
+			} else {
+				spans = append(spans, span)
+			}
+		}
+		line = wrapSpans(line, runeAt, spans, highlightOn, highlightOff)
+		if len(currentSpan) > 0 {
+			visible, runeAt = visibleRunes(line)
+			line = wrapSpans(line, runeAt, currentSpan, currentMatchOn, currentMatchOff)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderPreviewSearch renders the search bar, or the query and match count
+// once it is closed, or "" when there is no search.
+func (m *NotesListModel) renderPreviewSearch() string {
+	if !m.previewSearch.active() {
+		return ""
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	count := "No matches"
+	if matches := m.previewMatches(); len(matches) > 0 {
+		count = fmt.Sprintf("%d/%d", min(m.previewSearch.index, len(matches)-1)+1, len(matches))
+	} else if m.previewSearch.input.Value() == "" {
+		count = ""
+	}
+	if m.previewSearch.typing {
+		return lipgloss.JoinHorizontal(lipgloss.Center,
+			labelStyle.Render("Search:"), " ", m.previewSearch.input.View(), "  ", styles.HelpStyle.Render(count))
+	}
+	return labelStyle.Render("/"+m.previewSearch.input.Value()) + "  " + styles.HelpStyle.Render(count)
+}
@@ -0,0 +1,77 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNotesPreviewSearch(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t) // 100x40
+	var lines []string
+	for i := 0; i < 90; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	lines[5] = "a needle in [[Hay]]"
+	lines[50] = "café NEEDLE"
+	lines[80] = "needle, needle"
+	if err := m.store.CreateNote(&models.Note{Title: "Haystack", Body: strings.Join(lines, "\n")}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+	update := func(msg tea.KeyMsg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+	key := func(s string) { update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}) }
+
+	key("p")
+	m.View()
+	key("/")
+	if !m.InputActive() {
+		t.Fatalf("expected the search bar to take keys")
+	}
+	for _, r := range "needle" {
+		key(string(r))
+	}
+	view := m.View()
+	if !strings.Contains(view, "1/4") || !strings.Contains(view, currentMatchOn+"needle"+currentMatchOff) {
+		t.Fatalf("expected the first of 4 matches highlighted, got:\n%s", view)
+	}
+
+	// Enter keeps the matches; n and N step through them, scrolling
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.InputActive() || !m.previewSearch.active() {
+		t.Fatalf("expected Enter to close the bar and keep the search")
+	}
+	key("n")
+	view = m.View()
+	if !strings.Contains(view, "2/4") || !strings.Contains(view, "café "+currentMatchOn+"NEEDLE"+currentMatchOff) {
+		t.Fatalf("expected the body scrolled to the second match, got:\n%s", view)
+	}
+	key("N")
+	key("N")
+	view = m.View()
+	if !strings.Contains(view, "4/4") || !strings.Contains(view, highlightOn+"needle"+highlightOff+", "+currentMatchOn+"needle") {
+		t.Fatalf("expected N to wrap around to the last match, got:\n%s", view)
+	}
+
+	// Esc clears the search first, then closes the preview
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.previewSearch.active() || !m.showPreview {
+		t.Fatalf("expected Esc to clear only the search")
+	}
+	if strings.Contains(m.View(), highlightOn) {
+		t.Fatalf("expected no highlights once the search is cleared")
+	}
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showPreview {
+		t.Fatalf("expected the second Esc to close the preview")
+	}
+}
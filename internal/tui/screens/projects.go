@@ -0,0 +1,298 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// ShowTodosMsg is emitted by the Projects screen to return to the Todos
+// screen. A non-empty Project filters the list to that project, a
+// non-empty Tag to that tag.
+type ShowTodosMsg struct {
+	Project string
+	Tag     string
+}
+
+// OpenProjectsMsg is emitted by the Todos screen to open the projects
+// overview.
+type OpenProjectsMsg struct{}
+
+// ProjectsModel is the projects overview screen.
+//
+// Phase 6: Projects
+//   - Lists every project with a completion progress bar
+//   - Enter shows the project's todos; Esc returns to all todos
+//   - t lists todo tags instead of projects
+//   - b charts a burndown of the selected project or tag
+type ProjectsModel struct {
+	store *sqlite.Store
+
+	projects []sqlite.ProjectStats // Projects, or tags when showTags is set
+	selected int
+	showHelp bool
+	showTags bool
+
+	showBurndown bool
+	weeks        int // Weeks the burndown covers
+	burndown     []burndownPoint
+	burndownErr  error
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewProjectsModel creates the projects overview screen.
+func NewProjectsModel(store *sqlite.Store) ProjectsModel {
+	return ProjectsModel{
+		store:   store,
+		weeks:   defaultBurndownWeeks,
+		header:  components.NewHeader("📁", "Projects"),
+		helpBar: components.NewHelpBar(components.ProjectsHints),
+	}
+}
+
+func (m *ProjectsModel) Init() tea.Cmd { return nil }
+
+func (m *ProjectsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// LoadProjects refreshes project (or tag) statistics from the database.
+func (m *ProjectsModel) LoadProjects() error {
+	list := m.store.ListProjects
+	if m.showTags {
+		list = m.store.ListTodoTagStats
+	}
+	projects, err := list()
+	if err != nil {
+		return err
+	}
+	m.projects = projects
+	if m.selected >= len(m.projects) {
+		m.selected = len(m.projects) - 1
+	}
+	if m.selected < 0 {
+		m.selected = 0
+	}
+	m.loadBurndown()
+	return nil
+}
+
+// loadBurndown rebuilds the burndown of the selected project or tag when
+// the chart is shown.
+func (m *ProjectsModel) loadBurndown() {
+	m.burndown, m.burndownErr = nil, nil
+	if !m.showBurndown || len(m.projects) == 0 {
+		return
+	}
+	q := sqlite.TodoQuery{Project: m.projects[m.selected].Name}
+	if m.showTags {
+		q = sqlite.TodoQuery{Tags: []string{m.projects[m.selected].Name}}
+	}
+	todos, err := m.store.QueryTodos(q)
+	if err != nil {
+		m.burndownErr = err
+		return
+	}
+	m.burndown = burndown(todos, m.weeks, time.Now())
+}
+
+// selectedName is the selected project, or #tag, as shown in the list.
+func (m *ProjectsModel) selectedName() string {
+	name := m.projects[m.selected].Name
+	if m.showTags {
+		name = "#" + name
+	}
+	return name
+}
+
+func (m *ProjectsModel) Update(msg tea.Msg) (ProjectsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Handle help modal
+		if m.showHelp {
+			// Any key closes help
+			m.showHelp = false
+			return *m, nil
+		}
+
+		switch msg.String() {
+		case "?":
+			m.showHelp = true
+		case "k", "up":
+			if m.selected > 0 {
+				m.selected--
+				m.loadBurndown()
+			}
+		case "j", "down":
+			if m.selected < len(m.projects)-1 {
+				m.selected++
+				m.loadBurndown()
+			}
+		case "t":
+			m.showTags = !m.showTags
+			m.selected = 0
+			if m.showTags {
+				m.header.SetTitle("🏷", "Todo Tags")
+			} else {
+				m.header.SetTitle("📁", "Projects")
+			}
+			m.LoadProjects()
+		case "b":
+			m.showBurndown = !m.showBurndown
+			m.loadBurndown()
+		case "+", "=":
+			if m.showBurndown && m.weeks < maxBurndownWeeks {
+				m.weeks++
+				m.loadBurndown()
+			}
+		case "-":
+			if m.showBurndown && m.weeks > minBurndownWeeks {
+				m.weeks--
+				m.loadBurndown()
+			}
+		case "r":
+			m.LoadProjects()
+		case "enter":
+			if len(m.projects) > 0 {
+				msg := ShowTodosMsg{Project: m.projects[m.selected].Name}
+				if m.showTags {
+					msg = ShowTodosMsg{Tag: m.projects[m.selected].Name}
+				}
+				return *m, func() tea.Msg { return msg }
+			}
+		case "esc":
+			return *m, goBack
+		}
+	}
+
+	return *m, nil
+}
+
+func (m *ProjectsModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	if m.showHelp {
+		return panel.Render(m.helpView())
+	}
+
+	m.header.SetItemCount(len(m.projects))
+
+	if len(m.projects) == 0 {
+		empty, hint := "No projects yet.", "Press [P] on a todo to assign it to a project"
+		if m.showTags {
+			empty, hint = "No tagged todos yet.", "Add a #tag to a todo's title or description"
+		}
+		return panel.Render(lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.header.View(),
+			"",
+			styles.SubtitleStyle.Render(empty),
+			"",
+			styles.HelpStyle.Render(hint),
+			"",
+			m.helpBar.View(),
+		))
+	}
+
+	nameWidth := 0
+	for _, p := range m.projects {
+		if w := lipgloss.Width(p.Name) + m.tagPrefixWidth(); w > nameWidth {
+			nameWidth = w
+		}
+	}
+	if nameWidth > 24 {
+		nameWidth = 24
+	}
+
+	barWidth := m.width - nameWidth - 30
+	if barWidth > 40 {
+		barWidth = 40
+	}
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	rows := make([]string, 0, len(m.projects))
+	for i, p := range m.projects {
+		progress := 0.0
+		if p.Total > 0 {
+			progress = float64(p.Completed) / float64(p.Total)
+		}
+		label := p.Name
+		if m.showTags {
+			label = "#" + label
+		}
+		name := lipgloss.NewStyle().Width(nameWidth).Render(truncate(label, nameWidth))
+		stats := fmt.Sprintf("%d/%d (%d%%)", p.Completed, p.Total, int(progress*100))
+		row := name + "  " + styles.VaporwaveProgressBar(progress, barWidth) + "  " + stats
+
+		if i == m.selected {
+			rows = append(rows, styles.SelectedItemStyle.Render("▶ ")+row)
+		} else {
+			rows = append(rows, "  "+row)
+		}
+	}
+
+	sections := []string{m.header.View(), "", lipgloss.JoinVertical(lipgloss.Left, rows...), ""}
+	if m.showBurndown {
+		if m.burndownErr != nil {
+			sections = append(sections, components.FieldError("Could not load the burndown: "+m.burndownErr.Error()), "")
+		} else {
+			sections = append(sections, renderBurndown(m.selectedName(), m.burndown, m.width-4), "")
+		}
+	}
+	sections = append(sections, m.helpBar.View())
+	return panel.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// tagPrefixWidth is the width of the # shown before tag names.
+func (m *ProjectsModel) tagPrefixWidth() int {
+	if m.showTags {
+		return 1
+	}
+	return 0
+}
+
+func (m *ProjectsModel) helpView() string {
+	title := styles.TitleStyle.Render("📁 PROJECTS - Help")
+
+	helpText := `Projects group todos by outcome, separately from #tags (contexts and topics).
+
+` + styles.SelectedItemStyle.Render("Navigation:") + `
+• ` + styles.NeonStyle.Render("j/k") + `: Move between projects
+• ` + styles.NeonStyle.Render("Enter") + `: Show the project's todos
+• ` + styles.NeonStyle.Render("t") + `: List todo tags instead of projects
+• ` + styles.NeonStyle.Render("b") + `: Burndown: open todos at the end of each week
+• ` + styles.NeonStyle.Render("+/-") + `: Chart more or fewer weeks
+• ` + styles.NeonStyle.Render("Esc") + `: Back to all todos
+• ` + styles.NeonStyle.Render("r") + `: Reload
+
+` + styles.SelectedItemStyle.Render("On the Todos screen:") + `
+• ` + styles.NeonStyle.Render("P") + `: Assign the selected todo to a project
+• ` + styles.NeonStyle.Render("b") + `: Group the list by project, then by linked note
+• ` + styles.NeonStyle.Render("O") + `: Open this overview`
+
+	help := styles.HelpStyle.Render("Press any key to close")
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		helpText,
+		"",
+		help,
+	)
+}
@@ -0,0 +1,117 @@
+package screens
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestProjectsOverview(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	for _, todo := range []*models.Todo{
+		{Title: "Draft", Status: models.TodoStatusCompleted, Project: "Website"},
+		{Title: "Deploy", Status: models.TodoStatusPending, Project: "Website"},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	m := NewProjectsModel(store)
+	m.SetSize(100, 30)
+	if err := m.LoadProjects(); err != nil {
+		t.Fatalf("LoadProjects() err = %v", err)
+	}
+
+	v := m.View()
+	if !strings.Contains(v, "Website") || !strings.Contains(v, "1/2 (50%)") {
+		t.Fatalf("expected project progress in view:\n%s", v)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("expected a command on Enter")
+	}
+	msg, ok := cmd().(ShowTodosMsg)
+	if !ok || msg.Project != "Website" {
+		t.Fatalf("expected ShowTodosMsg for Website, got %#v", cmd())
+	}
+}
+
+func TestProjectsBurndown(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	for _, todo := range []*models.Todo{
+		{Title: "Draft #launch", Status: models.TodoStatusCompleted, Project: "Website"},
+		{Title: "Deploy #launch", Status: models.TodoStatusPending, Project: "Website"},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	m := NewProjectsModel(store)
+	m.SetSize(100, 40)
+	if err := m.LoadProjects(); err != nil {
+		t.Fatalf("LoadProjects() err = %v", err)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if v := m.View(); !strings.Contains(v, "Open todos in Website, last 8 weeks") {
+		t.Fatalf("expected the burndown in view:\n%s", v)
+	}
+	if got := m.burndown[len(m.burndown)-1].open; got != 1 {
+		t.Errorf("open this week = %d, want 1", got)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'-'}})
+	if v := m.View(); !strings.Contains(v, "#launch") || !strings.Contains(v, "Open todos in #launch, last 7 weeks") {
+		t.Fatalf("expected the tag burndown in view:\n%s", v)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(ShowTodosMsg); !ok || msg.Tag != "launch" || msg.Project != "" {
+		t.Fatalf("expected ShowTodosMsg for #launch, got %#v", cmd())
+	}
+}
+
+func TestBurndown(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 11, 12, 0, 0, 0, time.Local)
+	weeksAgo := func(n int) time.Time { return now.AddDate(0, 0, -7*n) }
+	done := weeksAgo(1)
+	todos := []models.Todo{
+		{CreatedAt: weeksAgo(5), Status: models.TodoStatusPending},
+		{CreatedAt: weeksAgo(3), Status: models.TodoStatusCompleted, CompletedAt: &done},
+		{CreatedAt: now.Add(-time.Hour), Status: models.TodoStatusInProgress},
+	}
+
+	var got []int
+	for _, p := range burndown(todos, 4, now) {
+		got = append(got, p.open)
+	}
+	if want := []int{2, 2, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("burndown = %v, want %v", got, want)
+	}
+}
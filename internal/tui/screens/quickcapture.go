@@ -5,6 +5,7 @@
 //   - Accessible via Ctrl+X global shortcut
 //   - Auto-extracts title from first line
 //   - Auto-tags with #quick for easy filtering
+//   - Auto-tags with #inbox so captures land in the Inbox (Phase 6)
 package screens
 
 import (
@@ -41,6 +42,7 @@ func NewQuickCaptureModel(store *sqlite.Store) QuickCaptureModel {
 	ta.SetHeight(5)
 	ta.SetWidth(50)
 	ta.CharLimit = 5000
+	ta.Cursor.SetMode(components.CursorMode())
 
 	return QuickCaptureModel{
 		store:   store,
@@ -136,17 +138,13 @@ func (m *QuickCaptureModel) saveNote() {
 	// Extract tags from content
 	tags := extractQuickTags(content)
 
-	// Always add #quick tag for filtering
-	hasQuick := false
-	for _, t := range tags {
-		if t == "quick" {
-			hasQuick = true
-			break
+	// Always add #quick tag for filtering, and #inbox so the capture waits
+	// on the Inbox screen until it is triaged
+	for _, required := range []string{"quick", InboxTag} {
+		if !hasTag(tags, required) {
+			tags = append(tags, required)
 		}
 	}
-	if !hasQuick {
-		tags = append(tags, "quick")
-	}
 
 	note := &models.Note{
 		Title: title,
@@ -242,7 +240,7 @@ func (m *QuickCaptureModel) helpView() string {
 • The first line becomes the note title
 • Everything after becomes the note body
 • Use #hashtags anywhere to add tags
-• Notes are automatically tagged with #quick
+• Notes are automatically tagged with #quick and #inbox
 
 ` + styles.SelectedItemStyle.Render("Keyboard Shortcuts:") + `
 • ` + styles.NeonStyle.Render("Ctrl+S") + ` or ` + styles.NeonStyle.Render("Ctrl+Enter") + `: Save note
@@ -252,7 +250,7 @@ func (m *QuickCaptureModel) helpView() string {
 ` + styles.SelectedItemStyle.Render("Tips:") + `
 • Access from anywhere with Ctrl+X
 • Perfect for fleeting thoughts
-• Filter notes by #quick to find captures later`
+• Process captures later from the Inbox (Ctrl+O)`
 
 	help := styles.HelpStyle.Render("Press any key to close")
 
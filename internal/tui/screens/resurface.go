@@ -0,0 +1,93 @@
+package screens
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Note reminders (Phase 6: Inbox).
+//
+// R in the note preview asks for the day the note should resurface: a
+// date, or +3 / +2w for days or weeks from today. On that day the note
+// shows up in the inbox (Ctrl+O) until it is triaged, like a tickler
+// file of things to re-read later. An empty answer clears the reminder.
+
+// resurfaceEditor is the prompt for the previewed note's resurface day.
+type resurfaceEditor struct {
+	input   components.TextInputModel
+	open    bool
+	errText string
+}
+
+// Open shows the prompt, filled with the current day if there is one.
+func (e *resurfaceEditor) Open(current *time.Time) {
+	e.input = components.NewTextInput("YYYY-MM-DD, +3 or +2w (empty clears)")
+	if current != nil {
+		e.input.SetValue(current.Format("2006-01-02"))
+	}
+	e.input.Focus()
+	e.open = true
+	e.errText = ""
+}
+
+// Update handles keys while the prompt is open. On Enter it saves the
+// note's reminder and reports true, so the caller reloads it.
+func (e *resurfaceEditor) Update(store *sqlite.Store, noteID int64, msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		e.open = false
+		return false, nil
+	case "enter":
+		var day *time.Time
+		if value := strings.TrimSpace(e.input.Value()); value != "" {
+			parsed, err := models.ParseResurfaceDate(value, time.Now())
+			if err != nil {
+				e.errText = err.Error()
+				return false, nil
+			}
+			day = &parsed
+		}
+		if err := store.SetNoteReminder(noteID, day); err != nil {
+			e.errText = "Failed to save: " + err.Error()
+			return false, nil
+		}
+		e.open = false
+		if day == nil {
+			return true, toastCmd("Reminder cleared")
+		}
+		return true, toastCmd("Resurfaces in the inbox " + datefmt.Day(*day))
+	}
+	var cmd tea.Cmd
+	e.input, cmd = e.input.Update(msg)
+	return false, cmd
+}
+
+// View renders the prompt and any error under it.
+func (e *resurfaceEditor) View() string {
+	label := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).Render("Resurface on:")
+	view := lipgloss.JoinHorizontal(lipgloss.Center, label, " ", e.input.View(), "  ",
+		styles.HelpStyle.Render("[Enter] Save  [Esc] Cancel"))
+	if e.errText != "" {
+		view = lipgloss.JoinVertical(lipgloss.Left, view, components.FieldError(e.errText))
+	}
+	return view
+}
+
+// loadReminder returns the note's resurface day; a failed read shows
+// none.
+func loadReminder(store *sqlite.Store, noteID int64) *time.Time {
+	day, err := store.GetNoteReminder(noteID)
+	if err != nil {
+		return nil
+	}
+	return day
+}
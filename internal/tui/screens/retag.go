@@ -0,0 +1,192 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Batch retag (Phase 6: Organization).
+//
+// While a filter is active on the notes or todos list, # tags every
+// result at once: type a tag to add it, or -tag to remove it, then
+// confirm the count with y. The whole batch is one transaction (see
+// sqlite.Store.AddTag), so it either applies to every result or none.
+
+// retagPrompt is the # prompt shared by the notes and todos lists.
+type retagPrompt struct {
+	active     bool
+	confirming bool // Asking y/n for the typed tag
+	input      components.TextInputModel
+	noteIDs    []int64 // Results being retagged
+	todoIDs    []int64
+	noun       string // "note" or "todo"
+	tag        string // Typed tag, without # or -
+	remove     bool   // Remove the tag rather than add it
+}
+
+func newRetagPrompt() retagPrompt {
+	input := components.NewTextInput("tag to add, or -tag to remove")
+	input.Blur()
+	return retagPrompt{input: input}
+}
+
+// open starts retagging the listed results.
+func (p *retagPrompt) open(noteIDs, todoIDs []int64, noun string) {
+	p.active = true
+	p.confirming = false
+	p.noteIDs, p.todoIDs = noteIDs, todoIDs
+	p.noun = noun
+	p.input.SetValue("")
+	p.input.Focus()
+}
+
+func (p *retagPrompt) close() {
+	p.active = false
+	p.confirming = false
+	p.noteIDs, p.todoIDs = nil, nil
+	p.input.SetValue("")
+	p.input.Blur()
+}
+
+// count is how many results are being retagged.
+func (p *retagPrompt) count() int {
+	return len(p.noteIDs) + len(p.todoIDs)
+}
+
+// update handles a key while the prompt is open. applied reports that the
+// tags changed, so the list should reload.
+func (p *retagPrompt) update(msg tea.KeyMsg, store *sqlite.Store) (cmd tea.Cmd, applied bool) {
+	if p.confirming {
+		switch msg.String() {
+		case "y", "Y":
+			cmd := p.apply(store)
+			p.close()
+			return cmd, true
+		case "n", "N":
+			p.confirming = false
+			p.input.Focus()
+		case "esc":
+			p.close()
+		}
+		return nil, false
+	}
+
+	switch msg.String() {
+	case "esc":
+		p.close()
+		return nil, false
+	case "enter":
+		value := strings.TrimSpace(p.input.Value())
+		p.remove = strings.HasPrefix(value, "-")
+		p.tag = strings.ToLower(strings.TrimLeft(value, "-+#@"))
+		if p.tag == "" || strings.ContainsAny(p.tag, " \t") {
+			return nil, false
+		}
+		p.confirming = true
+		p.input.Blur()
+		return nil, false
+	}
+	p.input, cmd = p.input.Update(msg)
+	return cmd, false
+}
+
+// apply adds or removes the tag on every result and reports how many
+// changed.
+func (p *retagPrompt) apply(store *sqlite.Store) tea.Cmd {
+	retag, verb := store.AddTag, "Added #%s to %d %s"
+	if p.remove {
+		retag, verb = store.RemoveTag, "Removed #%s from %d %s"
+	}
+	n, err := retag(p.noteIDs, p.todoIDs, p.tag)
+	if err != nil {
+		return toastCmd("Could not retag: " + err.Error())
+	}
+	return toastCmd("🏷️ " + fmt.Sprintf(verb, p.tag, n, pluralize(n, p.noun, p.noun+"s")))
+}
+
+// view renders the prompt, or the y/n confirmation once a tag is typed.
+func (p *retagPrompt) view(helpBar *components.HelpBar, filter string) string {
+	n := p.count()
+	results := fmt.Sprintf("%d %s", n, pluralize(n, p.noun, p.noun+"s"))
+
+	if p.confirming {
+		question := fmt.Sprintf("Add #%s to %s?", p.tag, results)
+		if p.remove {
+			question = fmt.Sprintf("Remove #%s from %s?", p.tag, results)
+		}
+		helpBar.SetHints(components.ConfirmHints)
+		return styles.PanelStyle.Render(lipgloss.JoinVertical(
+			lipgloss.Center,
+			styles.TitleStyle.Render("🏷️ "+question),
+			"",
+			styles.SubtitleStyle.Render("Every result matching "+filter+" is updated at once."),
+			"",
+			helpBar.View(),
+		))
+	}
+
+	helpBar.SetHints(components.RetagHints)
+	return styles.PanelStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.TitleStyle.Render("🏷️ Retag "+results),
+		styles.SubtitleStyle.Render("Results matching "+filter),
+		"",
+		p.input.View(),
+		"",
+		helpBar.View(),
+	))
+}
+
+// filtered reports whether any filter narrows the notes list.
+func (m *NotesListModel) filtered() bool {
+	return m.filter != "" || len(m.selectedTags) > 0 || m.staleOnly || m.notebook != 0
+}
+
+// openRetag opens the retag prompt for the listed notes.
+func (m *NotesListModel) openRetag() tea.Cmd {
+	if !m.filtered() {
+		return toastCmd("Filter the notes first ([/] or [t]) to retag the results")
+	}
+	var ids []int64
+	for _, item := range m.list.Items() {
+		if ni, ok := item.(NoteItem); ok {
+			ids = append(ids, ni.note.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return toastCmd("No notes match the filter")
+	}
+	m.retag.open(ids, nil, "note")
+	return nil
+}
+
+// filtered reports whether any filter narrows the todos list.
+func (m *TodosListModel) filtered() bool {
+	return m.filter != "" || m.statusFilter != "" || m.priorityFilter >= 0 ||
+		len(m.selectedTags) > 0 || m.projectFilter != "" || m.staleOnly
+}
+
+// openRetag opens the retag prompt for the listed todos.
+func (m *TodosListModel) openRetag() tea.Cmd {
+	if !m.filtered() {
+		return toastCmd("Filter the todos first ([/] or [t]) to retag the results")
+	}
+	var ids []int64
+	for _, item := range m.list.Items() {
+		if ti, ok := item.(TodoItem); ok {
+			ids = append(ids, ti.todo.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return toastCmd("No todos match the filter")
+	}
+	m.retag.open(nil, ids, "todo")
+	return nil
+}
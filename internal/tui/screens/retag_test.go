@@ -0,0 +1,101 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestNotesRetag(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	for _, title := range []string{"standup monday", "standup tuesday", "groceries"} {
+		if err := m.store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	m.LoadNotes()
+	update := func(msg tea.KeyMsg) tea.Cmd {
+		mm, cmd := m.Update(msg)
+		m = *mm.(*NotesListModel)
+		return cmd
+	}
+	typeText := func(s string) {
+		for _, r := range s {
+			update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	// Unfiltered, # only explains itself
+	cmd := update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	if m.retag.active || cmd == nil {
+		t.Fatal("# opened the retag prompt without a filter")
+	}
+
+	m.filter = "standup"
+	m.LoadNotes()
+	typeText("#")
+	if !m.InputActive() {
+		t.Fatal("InputActive() = false with the retag prompt open")
+	}
+	typeText("meeting")
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := m.View(); !strings.Contains(view, "Add #meeting to 2 notes?") {
+		t.Fatalf("confirmation does not show the count:\n%s", view)
+	}
+	cmd = update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if msg, ok := cmd().(ToastMsg); !ok || !strings.Contains(msg.Text, "Added #meeting to 2 notes") {
+		t.Fatalf("retag toast = %#v", cmd())
+	}
+	if n, _ := m.store.CountNotes(sqlite.NoteQuery{Tags: []string{"meeting"}}); n != 2 {
+		t.Fatalf("%d notes tagged #meeting, want 2", n)
+	}
+
+	// -tag removes it again; n backs out of the confirmation first
+	typeText("#-meeting")
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	typeText("n")
+	if !m.retag.active || m.retag.confirming {
+		t.Fatal("n did not return to the tag prompt")
+	}
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+	typeText("y")
+	if n, _ := m.store.CountNotes(sqlite.NoteQuery{Tags: []string{"meeting"}}); n != 0 {
+		t.Fatalf("%d notes still tagged #meeting, want 0", n)
+	}
+}
+
+func TestTodosRetag(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, todo := range []*models.Todo{
+		{Title: "write report", Status: models.TodoStatusPending},
+		{Title: "book flights", Status: models.TodoStatusCompleted},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.statusFilter = models.TodoStatusPending
+	m.LoadTodos()
+
+	for _, r := range "#q3" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := m.View(); !strings.Contains(view, "Add #q3 to 1 todo?") {
+		t.Fatalf("confirmation does not show the count:\n%s", view)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	todos, err := m.store.QueryTodos(sqlite.TodoQuery{Tags: []string{"q3"}})
+	if err != nil || len(todos) != 1 || todos[0].Title != "write report" {
+		t.Fatalf("todos tagged #q3 = %+v, %v; want write report only", todos, err)
+	}
+}
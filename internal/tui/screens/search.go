@@ -1,6 +1,8 @@
 package screens
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,6 +20,13 @@ type OpenNoteMsg struct {
 	NoteID int64
 }
 
+// CreateTodoForNoteMsg is emitted by the Notes screen to open the todo
+// create form pre-linked to a note.
+type CreateTodoForNoteMsg struct {
+	NoteID int64
+	Title  string
+}
+
 type searchMode int
 
 const (
@@ -35,6 +44,12 @@ type SearchModel struct {
 	selected int
 	loading  bool
 	errText  string
+
+	// Phase 4: Performance - the in-flight search runs as a tea.Cmd and is
+	// cancelled when a newer query replaces it.
+	searchID int                // Incremented per search; stale results are dropped
+	cancel   context.CancelFunc // Cancels the in-flight search, if any
+
 	showHelp bool // Help modal state
 
 	header  components.Header
@@ -44,6 +59,7 @@ type SearchModel struct {
 }
 
 type searchCompletedMsg struct {
+	id      int
 	results []search.SearchResult
 	err     error
 }
@@ -75,9 +91,17 @@ func (m *SearchModel) SetSize(width, height int) {
 func (m *SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case searchCompletedMsg:
+		if msg.id != m.searchID {
+			// Superseded by a newer query
+			return *m, nil
+		}
 		m.loading = false
+		m.cancel = nil
 		if msg.err != nil {
 			m.errText = msg.err.Error()
+			if errors.Is(msg.err, context.DeadlineExceeded) {
+				m.errText = "Search timed out"
+			}
 			m.results = nil
 			m.mode = searchModeInput
 			m.query.Focus()
@@ -107,22 +131,20 @@ func (m *SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 		switch m.mode {
 		case searchModeInput:
 			switch msg.String() {
+			case "esc":
+				return *m, goBack
 			case "enter":
-				if m.loading {
-					return *m, nil
-				}
 				q := strings.TrimSpace(m.query.Value())
 				m.errText = ""
 				if q == "" {
+					m.cancelSearch()
 					m.results = nil
 					return *m, nil
 				}
-				m.loading = true
-				return *m, func() tea.Msg {
-					results, err := m.semantic.Search(q, 20)
-					return searchCompletedMsg{results: results, err: err}
-				}
+				return *m, m.startSearch(q)
 			default:
+				// Editing the query makes any in-flight search stale
+				m.cancelSearch()
 				var cmd tea.Cmd
 				m.query, cmd = m.query.Update(msg)
 				return *m, cmd
@@ -157,6 +179,42 @@ func (m *SearchModel) Update(msg tea.Msg) (SearchModel, tea.Cmd) {
 	return *m, nil
 }
 
+// InputActive reports whether the query field has focus, so the app
+// leaves single-letter keys such as q and ? to the screen.
+func (m *SearchModel) InputActive() bool {
+	return m.mode == searchModeInput
+}
+
+// startSearch cancels any in-flight search and runs q as a tea.Cmd
+// bounded by sqlite.QueryTimeout.
+func (m *SearchModel) startSearch(q string) tea.Cmd {
+	m.cancelSearch()
+	m.searchID++
+	m.loading = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), sqlite.QueryTimeout)
+	m.cancel = cancel
+	id, semantic := m.searchID, m.semantic
+	return func() tea.Msg {
+		defer cancel()
+		text, fields := sqlite.ParseFieldFilters(q)
+		results, err := semantic.SearchWithFields(ctx, text, 20, fields)
+		return searchCompletedMsg{id: id, results: results, err: err}
+	}
+}
+
+// cancelSearch stops the in-flight search; its result will be dropped.
+func (m *SearchModel) cancelSearch() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+	if m.loading {
+		m.loading = false
+		m.searchID++
+	}
+}
+
 func (m *SearchModel) View() string {
 	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
 
@@ -163,3 +163,40 @@ func TestOpenNoteFromSearch(t *testing.T) {
 		t.Fatalf("expected note_id %d, got %d", m.results[m.selected].NoteID, open.NoteID)
 	}
 }
+
+func TestStaleSearchResultsDropped(t *testing.T) {
+	t.Parallel()
+
+	m := newTestSearchModel(t)
+	m.query.SetValue("first")
+	mm, stale := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = mm
+	if stale == nil || !m.loading {
+		t.Fatalf("expected search to start")
+	}
+
+	// Typing makes the in-flight search stale and cancels it
+	mm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m = mm
+	if m.loading {
+		t.Fatalf("expected typing to cancel the in-flight search")
+	}
+
+	mm, fresh := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = mm
+	if fresh == nil {
+		t.Fatalf("expected new search cmd")
+	}
+
+	mm, _ = m.Update(stale())
+	m = mm
+	if !m.loading || m.errText != "" || m.mode != searchModeInput {
+		t.Fatalf("expected stale result to be ignored, loading=%v err=%q mode=%v", m.loading, m.errText, m.mode)
+	}
+
+	mm, _ = m.Update(fresh())
+	m = mm
+	if m.loading || m.mode != searchModeResults {
+		t.Fatalf("expected fresh result to apply, loading=%v mode=%v err=%q", m.loading, m.mode, m.errText)
+	}
+}
@@ -0,0 +1,101 @@
+package screens
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/clipboard"
+	"github.com/Jericoz-JC/flowState-CLI/internal/hooks"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Note sharing (Phase 10: Sharing).
+//
+// S in the notes list or preview pipes the note as markdown to the
+// share_command from config.json, e.g. "gh gist create -f note.md -". The
+// command does the publishing with its own credentials; flowState only
+// reads the URL it prints, shows it in a toast and copies it to the
+// clipboard.
+
+// shareTimeout bounds the share command; uploads take longer than hooks.
+const shareTimeout = 30 * time.Second
+
+// noteSharedMsg carries the result of a share command.
+type noteSharedMsg struct {
+	url string
+	err error
+}
+
+// noteMarkdown renders a note as a markdown document.
+func noteMarkdown(note *models.Note) string {
+	return "# " + note.Title + "\n\n" + strings.TrimSpace(note.Body) + "\n"
+}
+
+// shareURL picks the URL from a share command's output: the last line
+// that starts with http, or else the last non-empty line.
+func shareURL(output string) (string, error) {
+	var last string
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "http://") || strings.HasPrefix(line, "https://") {
+			return line, nil
+		}
+		if last == "" {
+			last = line
+		}
+	}
+	if last == "" {
+		return "", errors.New("share command printed nothing")
+	}
+	return last, nil
+}
+
+// shareNoteCmd runs command with the note's markdown on stdin.
+func shareNoteCmd(command string, note *models.Note) tea.Cmd {
+	markdown := noteMarkdown(note)
+	return func() tea.Msg {
+		out, err := hooks.Pipe(context.Background(), command, markdown, shareTimeout)
+		if err != nil {
+			return noteSharedMsg{err: err}
+		}
+		url, err := shareURL(out)
+		return noteSharedMsg{url: url, err: err}
+	}
+}
+
+// shareNote starts sharing the note with id, or explains how to set up
+// sharing when no command is configured.
+func (m *NotesListModel) shareNote(id int64) tea.Cmd {
+	if strings.TrimSpace(m.shareCommand) == "" {
+		return func() tea.Msg {
+			return ToastMsg{Text: "Set share_command in config.json to share notes"}
+		}
+	}
+	note, err := m.store.GetNote(id)
+	if err != nil || note == nil {
+		return nil
+	}
+	return tea.Batch(
+		func() tea.Msg { return ToastMsg{Text: "🔗 Sharing " + note.Title + "…"} },
+		shareNoteCmd(m.shareCommand, note),
+	)
+}
+
+// handleNoteShared copies the shared URL and reports the result.
+func handleNoteShared(msg noteSharedMsg) tea.Cmd {
+	text := "Share failed: "
+	if msg.err != nil {
+		text += msg.err.Error()
+	} else {
+		text = "🔗 " + msg.url
+		if clipboard.Copy(msg.url) == nil {
+			text += " (copied)"
+		}
+	}
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
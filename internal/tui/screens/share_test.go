@@ -0,0 +1,102 @@
+//go:build !windows
+
+package screens
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/clipboard"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestShareURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		out     string
+		want    string
+		wantErr bool
+	}{
+		{"- Creating gist\nhttps://gist.github.com/abc\n", "https://gist.github.com/abc", false},
+		{"https://a.example\nuploaded\n", "https://a.example", false},
+		{"paste id 42\n", "paste id 42", false},
+		{"\n  \n", "", true},
+	}
+	for _, tt := range tests {
+		got, err := shareURL(tt.out)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("shareURL(%q) = %q, %v; want %q, err %v", tt.out, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+// runShareCmd runs the commands a share key press returns and the
+// resulting noteSharedMsg, collecting the toasts.
+func runShareCmd(m *NotesListModel, cmd tea.Cmd) []string {
+	var toasts []string
+	var run func(tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			for _, c := range msg {
+				run(c)
+			}
+		case ToastMsg:
+			toasts = append(toasts, msg.Text)
+		case noteSharedMsg:
+			_, next := m.Update(msg)
+			run(next)
+		}
+	}
+	run(cmd)
+	return toasts
+}
+
+func TestNotesShare(t *testing.T) {
+	var copied bytes.Buffer
+	old := clipboard.Output
+	clipboard.Output = &copied
+	defer func() { clipboard.Output = old }()
+
+	m := newTestNotesModel(t)
+	if err := m.store.CreateNote(&models.Note{Title: "Trip plan", Body: "Pack #travel"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+	share := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}}
+
+	// Without a command, S explains how to set one up
+	_, cmd := m.Update(share)
+	if toasts := runShareCmd(&m, cmd); len(toasts) != 1 || !strings.Contains(toasts[0], "share_command") {
+		t.Fatalf("expected a setup hint, got %q", toasts)
+	}
+
+	out := filepath.Join(t.TempDir(), "shared.md")
+	m.SetShareCommand("cat > " + out + "; echo https://paste.example/1")
+	_, cmd = m.Update(share)
+	toasts := runShareCmd(&m, cmd)
+	if len(toasts) != 2 || toasts[1] != "🔗 https://paste.example/1 (copied)" {
+		t.Fatalf("unexpected toasts %q", toasts)
+	}
+	if data, _ := os.ReadFile(out); string(data) != "# Trip plan\n\nPack #travel\n" {
+		t.Errorf("command got %q, want the note as markdown", data)
+	}
+	if copied.String() != clipboard.Sequence("https://paste.example/1") {
+		t.Errorf("expected the URL on the clipboard, got %q", copied.String())
+	}
+
+	m.SetShareCommand("echo no token >&2; exit 1")
+	_, cmd = m.Update(share)
+	if toasts := runShareCmd(&m, cmd); len(toasts) != 2 || !strings.Contains(toasts[1], "Share failed") || !strings.Contains(toasts[1], "no token") {
+		t.Fatalf("expected a failure toast, got %q", toasts)
+	}
+}
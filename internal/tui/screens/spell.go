@@ -0,0 +1,203 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/spellcheck"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Spell-check for the note body editor (Phase 3).
+//
+// When a checker is set (spell_check in config.json), misspelled words in
+// the body are underlined and F7 opens a suggestions popup for the word
+// under the cursor: pick a replacement, or add the word to the user
+// dictionary.
+
+// maxSpellSuggestions caps the popup list.
+const maxSpellSuggestions = 5
+
+const (
+	underlineOn  = "\x1b[4m"
+	underlineOff = "\x1b[24m"
+)
+
+// SetSpellChecker enables spell-check in the body editor; nil disables it.
+func (m *NotesListModel) SetSpellChecker(c *spellcheck.Checker) {
+	m.spell = c
+}
+
+// openSpellPopup offers corrections for the misspelled word under the
+// cursor. It reports whether the popup opened.
+func (m *NotesListModel) openSpellPopup() bool {
+	if m.spell == nil || !m.bodyInput.Focused() {
+		return false
+	}
+	row, col := m.bodyInput.Cursor()
+	lines := strings.Split(m.bodyInput.Value(), "\n")
+	if row >= len(lines) {
+		return false
+	}
+	for _, w := range spellcheck.Words(lines[row]) {
+		if col < w.Start || col > w.End {
+			continue
+		}
+		if m.spell.Correct(w.Text) {
+			return false
+		}
+		m.spellWord = w
+		m.spellSuggestions = m.spell.Suggest(w.Text, maxSpellSuggestions)
+		m.spellIndex = 0
+		m.showSpellPopup = true
+		return true
+	}
+	return false
+}
+
+// updateSpellPopup handles keys while the suggestions popup is open. The
+// last entry adds the word to the dictionary.
+func (m *NotesListModel) updateSpellPopup(msg tea.KeyMsg) {
+	options := len(m.spellSuggestions) + 1
+	switch msg.String() {
+	case "up", "k":
+		m.spellIndex = (m.spellIndex - 1 + options) % options
+	case "down", "j", "tab":
+		m.spellIndex = (m.spellIndex + 1) % options
+	case "enter":
+		if m.spellIndex < len(m.spellSuggestions) {
+			m.bodyInput.ReplaceRange(m.spellWord.Start, m.spellWord.End, m.spellSuggestions[m.spellIndex])
+		} else {
+			_ = m.spell.Add(m.spellWord.Text)
+		}
+		m.showSpellPopup = false
+	case "esc", "f7":
+		m.showSpellPopup = false
+	}
+}
+
+// renderSpellPopup renders the suggestions list for the word being fixed.
+func (m *NotesListModel) renderSpellPopup() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(styles.PrimaryColor).
+		Bold(true)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+
+	normalStyle := lipgloss.NewStyle().
+		Foreground(styles.TextColor).
+		Padding(0, 1)
+
+	lines := []string{titleStyle.Render(fmt.Sprintf("Spelling: %q", m.spellWord.Text))}
+	if len(m.spellSuggestions) == 0 {
+		emptyStyle := lipgloss.NewStyle().Foreground(styles.MutedColor).Italic(true)
+		lines = append(lines, emptyStyle.Render("No suggestions"))
+	}
+	options := append(append([]string{}, m.spellSuggestions...), "+ Add to dictionary")
+	for i, opt := range options {
+		if i == m.spellIndex {
+			lines = append(lines, selectedStyle.Render("▶ "+opt))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+opt))
+		}
+	}
+	lines = append(lines, styles.HelpStyle.Render("[j/k] Move  [Enter] Apply  [Esc] Close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentColor).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+}
+
+// bodyEditorView renders the body textarea, with find matches highlighted
+// while the find bar is open and misspelled words underlined when
+// spell-check is on.
+func (m *NotesListModel) bodyEditorView() string {
+	view := m.bodyInput.View()
+	if m.showFind {
+		view = m.highlightMatches(view)
+	}
+	if m.spell == nil {
+		return view
+	}
+	bad := m.spell.Misspelled(m.bodyInput.Value())
+	if len(bad) == 0 {
+		return view
+	}
+	set := make(map[string]bool, len(bad))
+	for _, w := range bad {
+		set[w] = true
+	}
+	return underlineWords(view, set)
+}
+
+// underlineWords underlines every word of a rendered (ANSI-styled) view
+// that is in words. Escape sequences are kept as they are; words are
+// matched on the visible text of each line.
+func underlineWords(view string, words map[string]bool) string {
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		visible, runeAt := visibleRunes(line)
+		var spans [][2]int
+		for _, w := range spellcheck.Words(string(visible)) {
+			if words[w.Text] {
+				spans = append(spans, [2]int{w.Start, w.End})
+			}
+		}
+		lines[i] = wrapSpans(line, runeAt, spans, underlineOn, underlineOff)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapSpans wraps spans of a rendered line's visible runes (start and end
+// indices, end exclusive) in the on and off escape sequences. runeAt maps
+// visible runes to line, as returned by visibleRunes.
+func wrapSpans(line string, runeAt []int, spans [][2]int, on, off string) string {
+	if len(spans) == 0 {
+		return line
+	}
+	starts := make(map[int]bool)
+	ends := make(map[int]bool)
+	for _, span := range spans {
+		starts[runeAt[span[0]]] = true
+		ends[runeAt[span[1]-1]] = true
+	}
+	var b strings.Builder
+	for j, r := range []rune(line) {
+		if starts[j] {
+			b.WriteString(on)
+		}
+		b.WriteRune(r)
+		if ends[j] {
+			b.WriteString(off)
+		}
+	}
+	return b.String()
+}
+
+// visibleRunes strips CSI escape sequences from line, returning the visible
+// runes and, for each, its rune index in line.
+func visibleRunes(line string) ([]rune, []int) {
+	runes := []rune(line)
+	var visible []rune
+	var at []int
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '\x1b' && i+1 < len(runes) && runes[i+1] == '[' {
+			// Skip to the final byte (@ through ~)
+			for i += 2; i < len(runes) && (runes[i] < '@' || runes[i] > '~'); i++ {
+			}
+			continue
+		}
+		visible = append(visible, runes[i])
+		at = append(at, i)
+	}
+	return visible, at
+}
@@ -0,0 +1,54 @@
+package screens
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Staleness (Phase 6: Organization).
+//
+// Notes left untouched for stale_note_days (90 by default) and open todos
+// unchanged for stale_todo_days (30) carry a muted "stale" marker with
+// their age, so rot is visible without getting in the way. a on either
+// list shows only the stale items, ready to be reviewed, archived or
+// deleted; Ctrl+R clears it with the other filters. Completed todos are
+// never stale.
+
+// staleBadge returns the muted marker for an item last updated at updated,
+// or "" when it changed within after (or after is 0).
+func staleBadge(updated time.Time, after time.Duration) string {
+	if after <= 0 || updated.IsZero() {
+		return ""
+	}
+	age := time.Since(updated)
+	if age < after {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(styles.MutedColor).Italic(true).Render("⌛ stale " + formatAge(age))
+}
+
+// SetStaleAfter sets how long a note goes untouched before it is marked
+// stale; 0 turns the marker and the stale filter off.
+func (m *NotesListModel) SetStaleAfter(d time.Duration) {
+	m.staleAfter = d
+	m.list.SetDelegate(noteDelegate{staleAfter: d})
+}
+
+// SetStaleAfter sets how long an open todo goes unchanged before it is
+// marked stale; 0 turns the marker and the stale filter off.
+func (m *TodosListModel) SetStaleAfter(d time.Duration) {
+	m.staleAfter = d
+	m.list.SetDelegate(todoDelegate{staleAfter: d})
+}
+
+// staleCutoff is the UpdatedBefore bound for the stale filter, or the zero
+// time when the filter is off.
+func staleCutoff(on bool, after time.Duration) time.Time {
+	if !on || after <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-after)
+}
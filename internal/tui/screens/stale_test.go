@@ -0,0 +1,79 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestNotesStale(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	if err := m.store.CreateNote(&models.Note{Title: "groceries"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	press := func() {
+		mm, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+		m = *mm.(*NotesListModel)
+	}
+
+	// Nothing has aged past a generous threshold
+	m.SetStaleAfter(time.Hour)
+	m.LoadNotes()
+	if view := m.View(); strings.Contains(view, "stale") {
+		t.Fatalf("fresh note marked stale:\n%s", view)
+	}
+	press()
+	if len(m.list.Items()) != 0 || !strings.Contains(m.View(), "No stale notes") {
+		t.Fatalf("stale filter shows %d notes, want none", len(m.list.Items()))
+	}
+	if got := m.FilterLabel(); got != "stale" {
+		t.Fatalf("FilterLabel() = %q, want stale", got)
+	}
+
+	// Past the threshold the note is marked and filtered in
+	m.SetStaleAfter(time.Nanosecond)
+	m.LoadNotes()
+	if len(m.list.Items()) != 1 || !strings.Contains(m.View(), "⌛ stale") {
+		t.Fatalf("stale filter shows %d notes, want groceries marked stale:\n%s", len(m.list.Items()), m.View())
+	}
+	press()
+	if m.staleOnly {
+		t.Fatal("a did not turn the stale filter off")
+	}
+}
+
+func TestTodosStale(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, todo := range []*models.Todo{
+		{Title: "file taxes", Status: models.TodoStatusPending},
+		{Title: "renew passport", Status: models.TodoStatusCompleted},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.SetStaleAfter(time.Nanosecond)
+	m.LoadTodos()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if len(m.list.Items()) != 1 || m.GetSelectedTodo().Title != "file taxes" {
+		t.Fatalf("stale filter shows %d todos, want file taxes only", len(m.list.Items()))
+	}
+	if view := m.View(); !strings.Contains(view, "⌛ stale") || !strings.Contains(view, "stale [") {
+		t.Fatalf("stale todo not marked, or filter not shown:\n%s", view)
+	}
+
+	// Ctrl+R clears the stale filter with the others
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if m.staleOnly || len(m.list.Items()) != 2 {
+		t.Fatalf("after reset staleOnly = %v with %d todos, want off with 2", m.staleOnly, len(m.list.Items()))
+	}
+}
@@ -0,0 +1,218 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Stars (Phase 6: Organization).
+//
+// * stars or unstars the selected note or todo. Starred items carry a ★ in
+// the lists and are gathered on the Starred screen (Alt+S), most recently
+// updated first, as a quick way back to what the current project needs.
+// Enter opens the item on its own screen; * there unstars it.
+
+// OpenTodoMsg is emitted to show a todo on the Todos screen.
+type OpenTodoMsg struct {
+	TodoID int64
+}
+
+// starBadge returns the ★ put before a starred item's title, or "".
+func starBadge(starred bool) string {
+	if !starred {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(styles.WarningColor).Render("★") + " "
+}
+
+// starToast reports a star toggled on title.
+func starToast(title string, starred bool) tea.Cmd {
+	text := fmt.Sprintf("★ Starred %q", title)
+	if !starred {
+		text = fmt.Sprintf("☆ Unstarred %q", title)
+	}
+	return toastCmd(text)
+}
+
+// toggleNoteStar stars note, or unstars it if it is starred.
+func toggleNoteStar(store *sqlite.Store, note *models.Note) tea.Cmd {
+	if err := store.SetNoteStarred(note.ID, !note.Starred); err != nil {
+		return toastCmd("Could not star note: " + err.Error())
+	}
+	return starToast(note.Title, !note.Starred)
+}
+
+// toggleTodoStar stars todo, or unstars it if it is starred.
+func toggleTodoStar(store *sqlite.Store, todo *models.Todo) tea.Cmd {
+	if err := store.SetTodoStarred(todo.ID, !todo.Starred); err != nil {
+		return toastCmd("Could not star todo: " + err.Error())
+	}
+	return starToast(todo.Title, !todo.Starred)
+}
+
+// starredDelegate draws starred notes and todos like their own lists do.
+type starredDelegate struct{}
+
+func (starredDelegate) Render(item list.Item, selected bool, width int) []string {
+	switch item.(type) {
+	case NoteItem:
+		return noteDelegate{}.Render(item, selected, width)
+	case TodoItem:
+		return todoDelegate{}.Render(item, selected, width)
+	}
+	return nil
+}
+
+// StarredModel is the Starred smart view.
+//
+// Phase 6: Organization
+//   - Lists starred notes and todos together, most recently updated first
+//   - Enter opens the note or todo on its screen; * unstars it
+type StarredModel struct {
+	store *sqlite.Store
+
+	list    components.VirtualList
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewStarredModel creates the Starred screen.
+func NewStarredModel(store *sqlite.Store) StarredModel {
+	l := components.NewVirtualList()
+	l.SetDelegate(starredDelegate{})
+	return StarredModel{
+		store:   store,
+		list:    l,
+		header:  components.NewHeader("⭐", "Starred"),
+		helpBar: components.NewHelpBar(components.StarredHints),
+	}
+}
+
+func (m *StarredModel) Init() tea.Cmd { return nil }
+
+func (m *StarredModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.list.SetSize(width-4, height) // View fits it between header and help bar
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// HelpSections returns the Starred screen's keys for the help modal.
+func (m *StarredModel) HelpSections() []components.HelpSection {
+	return components.StarredHelp
+}
+
+// LoadStarred refreshes the starred notes and todos from the database.
+func (m *StarredModel) LoadStarred() error {
+	notes, err := m.store.QueryNotes(sqlite.NoteQuery{Starred: true})
+	if err != nil {
+		return err
+	}
+	todos, err := m.store.QueryTodos(sqlite.TodoQuery{Starred: true})
+	if err != nil {
+		return err
+	}
+
+	items := make([]list.Item, 0, len(notes)+len(todos))
+	for _, note := range notes {
+		items = append(items, NoteItem{note: note})
+	}
+	for _, todo := range todos {
+		items = append(items, TodoItem{todo: todo})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return starredUpdatedAt(items[i]).After(starredUpdatedAt(items[j]))
+	})
+	m.list.SetItems(items)
+	return nil
+}
+
+// Count returns the number of starred items.
+func (m *StarredModel) Count() int {
+	return len(m.list.Items())
+}
+
+func (m *StarredModel) Update(msg tea.Msg) (StarredModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return *m, goBack
+		case "r":
+			_ = m.LoadStarred()
+			return *m, nil
+		case "enter":
+			switch item := m.list.SelectedItem().(type) {
+			case NoteItem:
+				id := item.note.ID
+				return *m, func() tea.Msg { return OpenNoteMsg{NoteID: id} }
+			case TodoItem:
+				id := item.todo.ID
+				return *m, func() tea.Msg { return OpenTodoMsg{TodoID: id} }
+			}
+			return *m, nil
+		case "*":
+			var cmd tea.Cmd
+			switch item := m.list.SelectedItem().(type) {
+			case NoteItem:
+				cmd = toggleNoteStar(m.store, &item.note)
+			case TodoItem:
+				cmd = toggleTodoStar(m.store, &item.todo)
+			}
+			_ = m.LoadStarred()
+			return *m, cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return *m, cmd
+}
+
+func (m *StarredModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+	m.header.SetItemCount(len(m.list.Items()))
+
+	if len(m.list.Items()) == 0 {
+		return panel.Render(lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.header.View(),
+			"",
+			styles.SubtitleStyle.Render("Nothing starred yet."),
+			"",
+			styles.HelpStyle.Render("Press [*] on a note or todo to star it"),
+			"",
+			m.helpBar.View(),
+		))
+	}
+
+	top := lipgloss.JoinVertical(lipgloss.Left, m.header.View(), "")
+	bottom := lipgloss.JoinVertical(lipgloss.Left, "", m.helpBar.View())
+	free := m.height - components.AppChrome - panel.GetVerticalFrameSize()
+	m.list.SetSize(m.width-4, components.FillHeight(free, minListRows, top, bottom))
+	return panel.Render(lipgloss.JoinVertical(lipgloss.Left, top, m.list.View(), bottom))
+}
+
+// starredUpdatedAt returns when a starred note or todo last changed.
+func starredUpdatedAt(item list.Item) time.Time {
+	switch item := item.(type) {
+	case NoteItem:
+		return item.note.UpdatedAt
+	case TodoItem:
+		return item.todo.UpdatedAt
+	}
+	return time.Time{}
+}
@@ -0,0 +1,193 @@
+package screens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/hooks"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Note summaries (Phase 10: Integrations).
+//
+// A in the note preview sends the note's markdown to summarize_command
+// from config.json and puts the reply under a "## Summary" heading at the
+// top of the note, replacing an earlier summary. The setting is either a
+// shell command that reads the note on stdin and prints the summary (e.g.
+// "ollama run llama3 'Summarize this note:'"), or an http(s) URL the note
+// is POSTed to as plain text; a JSON reply's "summary", "response" or
+// "text" field is used, any other reply as it is.
+
+// summarizeTimeout bounds a summary request; language models are slow.
+const summarizeTimeout = 2 * time.Minute
+
+// maxSummaryBytes caps how much of an endpoint's reply is read.
+const maxSummaryBytes = 1 << 20
+
+// summaryHeading marks the summary section in a note body.
+const summaryHeading = "## Summary"
+
+// noteSummarizedMsg carries the result of a summary request.
+type noteSummarizedMsg struct {
+	noteID  int64
+	summary string
+	err     error
+}
+
+// summarizeNoteCmd sends the note, without any earlier summary, to target.
+func summarizeNoteCmd(target string, note *models.Note) tea.Cmd {
+	input := noteMarkdown(&models.Note{Title: note.Title, Body: withoutSummary(note.Body)})
+	id := note.ID
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), summarizeTimeout)
+		defer cancel()
+
+		var out string
+		var err error
+		if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+			out, err = postForSummary(ctx, target, input)
+		} else {
+			out, err = hooks.Pipe(ctx, target, input, summarizeTimeout)
+		}
+		if err == nil && strings.TrimSpace(out) == "" {
+			err = errors.New("summarizer returned nothing")
+		}
+		return noteSummarizedMsg{noteID: id, summary: strings.TrimSpace(out), err: err}
+	}
+}
+
+// postForSummary POSTs text to endpoint and returns the summary it replies
+// with.
+func postForSummary(ctx context.Context, endpoint, text string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(text))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSummaryBytes))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("summarizer returned %s", resp.Status)
+	}
+
+	var reply map[string]any
+	if json.Unmarshal(bytes.TrimSpace(body), &reply) == nil {
+		for _, key := range []string{"summary", "response", "text"} {
+			if s, ok := reply[key].(string); ok {
+				return s, nil
+			}
+		}
+	}
+	return string(body), nil
+}
+
+// summarySection finds the summary section in body: the heading line and
+// everything up to the next heading of level 1 or 2. It returns -1, -1
+// when there is none.
+func summarySection(body string) (start, end int) {
+	lines := strings.SplitAfter(body, "\n")
+	offset := 0
+	start = -1
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if start < 0 {
+			if strings.EqualFold(trimmed, summaryHeading) {
+				start = offset
+			}
+		} else if strings.HasPrefix(trimmed, "# ") || strings.HasPrefix(trimmed, "## ") {
+			return start, offset
+		}
+		offset += len(line)
+	}
+	if start < 0 {
+		return -1, -1
+	}
+	return start, len(body)
+}
+
+// withoutSummary returns body with its summary section removed.
+func withoutSummary(body string) string {
+	start, end := summarySection(body)
+	if start < 0 {
+		return body
+	}
+	return strings.TrimSpace(body[:start] + body[end:])
+}
+
+// withSummary returns body with summary under the summary heading,
+// replacing an earlier summary in place or else starting the note.
+func withSummary(body, summary string) string {
+	section := summaryHeading + "\n\n" + strings.TrimSpace(summary) + "\n"
+	start, end := summarySection(body)
+	if start < 0 {
+		if strings.TrimSpace(body) == "" {
+			return strings.TrimSpace(section)
+		}
+		return section + "\n" + strings.TrimSpace(body)
+	}
+	rest := strings.TrimLeft(body[end:], "\n")
+	if rest != "" {
+		section += "\n"
+	}
+	return strings.TrimSpace(body[:start] + section + rest)
+}
+
+// summarizeNote starts summarizing the note with id, or explains how to
+// set up summaries when no summarizer is configured.
+func (m *NotesListModel) summarizeNote(id int64) tea.Cmd {
+	if strings.TrimSpace(m.summarizeCommand) == "" {
+		return func() tea.Msg {
+			return ToastMsg{Text: "Set summarize_command in config.json to summarize notes"}
+		}
+	}
+	note, err := m.store.GetNote(id)
+	if err != nil || note == nil {
+		return nil
+	}
+	return tea.Batch(
+		func() tea.Msg { return ToastMsg{Text: "✨ Summarizing " + note.Title + "…"} },
+		summarizeNoteCmd(strings.TrimSpace(m.summarizeCommand), note),
+	)
+}
+
+// handleNoteSummarized saves the summary into the note and reports the
+// result.
+func (m *NotesListModel) handleNoteSummarized(msg noteSummarizedMsg) tea.Cmd {
+	toast := func(text string) tea.Cmd {
+		return func() tea.Msg { return ToastMsg{Text: text} }
+	}
+	if msg.err != nil {
+		return toast("Summary failed: " + msg.err.Error())
+	}
+	note, err := m.store.GetNote(msg.noteID)
+	if err != nil || note == nil {
+		return toast("Summary failed: note no longer exists")
+	}
+	note.Body = withSummary(note.Body, msg.summary)
+	note.Tags = extractTags(note.Title + " " + note.Body)
+	if err := m.store.UpdateNote(note); err != nil {
+		return toast("Summary failed: " + err.Error())
+	}
+	if m.previewNote != nil && m.previewNote.ID == note.ID {
+		m.previewNote = note
+	}
+	m.LoadNotes()
+	return toast("✨ Summary added to " + note.Title)
+}
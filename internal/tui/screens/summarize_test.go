@@ -0,0 +1,117 @@
+//go:build !windows
+
+package screens
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestWithSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		body, want string
+	}{
+		{"", "## Summary\n\nShort."},
+		{"Body text", "## Summary\n\nShort.\n\nBody text"},
+		{"## Summary\n\nOld.\n\n## Notes\nBody", "## Summary\n\nShort.\n\n## Notes\nBody"},
+		{"Intro\n\n## Summary\nOld.\n### Detail\nmore", "Intro\n\n## Summary\n\nShort."},
+	}
+	for _, tt := range tests {
+		if got := withSummary(tt.body, " Short. \n"); got != tt.want {
+			t.Errorf("withSummary(%q) = %q, want %q", tt.body, got, tt.want)
+		}
+	}
+	if got := withoutSummary("## Summary\n\nOld.\n\n## Notes\nBody"); got != "## Notes\nBody" {
+		t.Errorf("withoutSummary() = %q", got)
+	}
+}
+
+// runSummarizeCmd runs the commands a summarize key press returns and the
+// resulting noteSummarizedMsg, collecting the toasts.
+func runSummarizeCmd(m *NotesListModel, cmd tea.Cmd) []string {
+	var toasts []string
+	var run func(tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			for _, c := range msg {
+				run(c)
+			}
+		case ToastMsg:
+			toasts = append(toasts, msg.Text)
+		case noteSummarizedMsg:
+			_, next := m.Update(msg)
+			run(next)
+		}
+	}
+	run(cmd)
+	return toasts
+}
+
+func TestNotesSummarize(t *testing.T) {
+	m := newTestNotesModel(t)
+	note := &models.Note{Title: "Retro", Body: "## Summary\n\nstale\n\n## Notes\nShipped on time #work"}
+	if err := m.store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	m.LoadNotes()
+	preview := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}}
+	summarize := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}}
+	m.Update(preview)
+
+	// Without a command, A explains how to set one up
+	_, cmd := m.Update(summarize)
+	if toasts := runSummarizeCmd(&m, cmd); len(toasts) != 1 || !strings.Contains(toasts[0], "summarize_command") {
+		t.Fatalf("expected a setup hint, got %q", toasts)
+	}
+
+	// The command sees the note without the old summary
+	m.SetSummarizeCommand(`grep -q stale && echo leaked || echo "It went well."`)
+	_, cmd = m.Update(summarize)
+	if toasts := runSummarizeCmd(&m, cmd); len(toasts) != 2 || toasts[1] != "✨ Summary added to Retro" {
+		t.Fatalf("unexpected toasts %q", toasts)
+	}
+	want := "## Summary\n\nIt went well.\n\n## Notes\nShipped on time #work"
+	if m.previewNote == nil || m.previewNote.Body != want {
+		t.Fatalf("preview body = %q, want %q", m.previewNote.Body, want)
+	}
+	if saved, _ := m.store.GetNote(note.ID); saved.Body != want {
+		t.Errorf("saved body = %q", saved.Body)
+	}
+
+	// An endpoint gets the note POSTed and may reply with JSON
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		got = string(data)
+		_, _ = io.WriteString(w, `{"response": "From the endpoint."}`)
+	}))
+	defer srv.Close()
+	m.SetSummarizeCommand(srv.URL)
+	_, cmd = m.Update(summarize)
+	runSummarizeCmd(&m, cmd)
+	if got != "# Retro\n\n## Notes\nShipped on time #work\n" {
+		t.Errorf("endpoint got %q", got)
+	}
+	if !strings.HasPrefix(m.previewNote.Body, "## Summary\n\nFrom the endpoint.\n\n## Notes") {
+		t.Errorf("preview body = %q", m.previewNote.Body)
+	}
+
+	m.SetSummarizeCommand("exit 1")
+	_, cmd = m.Update(summarize)
+	if toasts := runSummarizeCmd(&m, cmd); len(toasts) != 2 || !strings.Contains(toasts[1], "Summary failed") {
+		t.Fatalf("expected a failure toast, got %q", toasts)
+	}
+}
@@ -0,0 +1,333 @@
+package screens
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Tags (Phase 6: Organization).
+//
+// The Tags screen (t on Home) lists every tag with how many notes and
+// todos carry it, and sets per-tag defaults: c a color and i an icon the
+// tag is drawn with everywhere, p a default priority that todos tagged
+// with it are raised to when saved (#urgent → high). x clears them all.
+// The app applies the looks when it gets TagStylesChangedMsg.
+
+// colorPattern is a color lipgloss understands: hex or an ANSI number.
+var colorPattern = regexp.MustCompile(`^(#[0-9a-fA-F]{6}|#[0-9a-fA-F]{3}|[0-9]{1,3})$`)
+
+// TagStylesChangedMsg carries the tag styles after one changed.
+type TagStylesChangedMsg struct {
+	Styles []models.TagStyle
+}
+
+// tagRow is one tag on the screen.
+type tagRow struct {
+	count sqlite.TagCount
+	style models.TagStyle
+}
+
+// TagsModel is the Tags screen.
+type TagsModel struct {
+	store    *sqlite.Store
+	rows     []tagRow
+	selected int
+	offset   int // First row shown, when the list is taller than the screen
+	err      error
+
+	input     components.TextInputModel
+	inputKind string // "color" or "icon" while the prompt is open
+	inputErr  string
+
+	header  components.Header
+	helpBar components.HelpBar
+	width   int
+	height  int
+}
+
+// NewTagsModel creates the Tags screen.
+func NewTagsModel(store *sqlite.Store) TagsModel {
+	return TagsModel{
+		store:   store,
+		header:  components.NewHeader("🏷️", "Tags"),
+		helpBar: components.NewHelpBar(components.TagsHints),
+	}
+}
+
+func (m *TagsModel) Init() tea.Cmd { return nil }
+
+func (m *TagsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.header.SetWidth(width - 4)
+	m.helpBar.SetWidth(width - 4)
+}
+
+// HelpSections returns the Tags screen's keys for the help modal.
+func (m *TagsModel) HelpSections() []components.HelpSection {
+	return components.TagsHelp
+}
+
+// InputActive reports whether a color or icon is being typed.
+func (m *TagsModel) InputActive() bool {
+	return m.inputKind != ""
+}
+
+// LoadTags reads the tags with their counts and styles, keeping the
+// selection.
+func (m *TagsModel) LoadTags() error {
+	counts, err := m.store.ListTagCounts()
+	if err != nil {
+		m.err = err
+		return err
+	}
+	tagStyles, err := m.store.ListTagStyles()
+	if err != nil {
+		m.err = err
+		return err
+	}
+	m.err = nil
+
+	byTag := make(map[string]models.TagStyle, len(tagStyles))
+	for _, ts := range tagStyles {
+		byTag[ts.Tag] = ts
+	}
+	m.rows = m.rows[:0]
+	for _, c := range counts {
+		style, ok := byTag[c.Tag]
+		if !ok {
+			style = models.TagStyle{Tag: c.Tag}
+		}
+		delete(byTag, c.Tag)
+		m.rows = append(m.rows, tagRow{count: c, style: style})
+	}
+	// Styled tags nothing carries any more, so their settings can be cleared
+	for _, ts := range tagStyles {
+		if _, ok := byTag[ts.Tag]; ok {
+			m.rows = append(m.rows, tagRow{count: sqlite.TagCount{Tag: ts.Tag}, style: ts})
+		}
+	}
+	m.selected = max(min(m.selected, len(m.rows)-1), 0)
+	return nil
+}
+
+func (m *TagsModel) Update(msg tea.Msg) (TagsModel, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return *m, nil
+	}
+	if m.inputKind != "" {
+		return *m, m.updateInput(key)
+	}
+	switch key.String() {
+	case "j", "down":
+		m.selected = min(m.selected+1, max(len(m.rows)-1, 0))
+	case "k", "up":
+		m.selected = max(m.selected-1, 0)
+	case "g", "home":
+		m.selected = 0
+	case "c", "i":
+		if len(m.rows) > 0 {
+			m.openInput(map[string]string{"c": "color", "i": "icon"}[key.String()])
+		}
+	case "p":
+		if len(m.rows) > 0 {
+			style := m.rows[m.selected].style
+			style.Priority = nextDefaultPriority(style.Priority)
+			return *m, m.save(style, "Default priority: "+defaultPriorityName(style.Priority))
+		}
+	case "x":
+		if len(m.rows) > 0 {
+			tag := m.rows[m.selected].count.Tag
+			return *m, m.save(models.TagStyle{Tag: tag}, "Cleared #"+tag)
+		}
+	case "r":
+		m.LoadTags()
+		return *m, toastCmd("Tags reloaded")
+	case "esc":
+		return *m, goBack
+	}
+	return *m, nil
+}
+
+// nextDefaultPriority cycles none → low → medium → high → none.
+func nextDefaultPriority(p *models.TodoPriority) *models.TodoPriority {
+	var next models.TodoPriority
+	switch {
+	case p == nil:
+		next = models.TodoPriorityLow
+	case *p == models.TodoPriorityHigh:
+		return nil
+	default:
+		next = *p + 1
+	}
+	return &next
+}
+
+// defaultPriorityName names a tag's default priority for the screen and
+// its toasts.
+func defaultPriorityName(p *models.TodoPriority) string {
+	if p == nil {
+		return "none"
+	}
+	return priorityName(*p)
+}
+
+// openInput opens the color or icon prompt for the selected tag.
+func (m *TagsModel) openInput(kind string) {
+	style := m.rows[m.selected].style
+	if kind == "color" {
+		m.input = components.NewTextInput("#ff5f87 or 0-255 (empty for the theme's)")
+		m.input.SetValue(style.Color)
+	} else {
+		m.input = components.NewTextInput("An emoji or symbol, e.g. 🔥 (empty for none)")
+		m.input.SetValue(style.Icon)
+	}
+	m.input.Focus()
+	m.inputKind = kind
+	m.inputErr = ""
+}
+
+// updateInput handles keys while a color or icon is being typed.
+func (m *TagsModel) updateInput(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.inputKind = ""
+		return nil
+	case "enter":
+		value := strings.TrimSpace(m.input.Value())
+		style := m.rows[m.selected].style
+		if m.inputKind == "color" {
+			if value != "" && !colorPattern.MatchString(value) {
+				m.inputErr = "Use a hex color such as #ff5f87 or an ANSI number 0-255"
+				return nil
+			}
+			style.Color = value
+		} else {
+			if lipgloss.Width(value) > 2 {
+				m.inputErr = "Use a single emoji or symbol"
+				return nil
+			}
+			style.Icon = value
+		}
+		kind := m.inputKind
+		m.inputKind = ""
+		return m.save(style, "Saved #"+style.Tag+" "+kind)
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.inputErr = ""
+	return cmd
+}
+
+// save stores style, reloads the list and tells the app the styles
+// changed.
+func (m *TagsModel) save(style models.TagStyle, toast string) tea.Cmd {
+	if err := m.store.SetTagStyle(style); err != nil {
+		return toastCmd("Could not save the tag: " + err.Error())
+	}
+	m.LoadTags()
+	tagStyles, err := m.store.ListTagStyles()
+	if err != nil {
+		return toastCmd(toast)
+	}
+	return tea.Batch(toastCmd(toast), func() tea.Msg { return TagStylesChangedMsg{Styles: tagStyles} })
+}
+
+// bodyHeight is how many rows fit between header and help bar.
+func (m *TagsModel) bodyHeight() int {
+	return max(m.height-2-lipgloss.Height(m.header.View())-lipgloss.Height(m.helpBar.View())-6, 1)
+}
+
+func (m *TagsModel) View() string {
+	panel := lipgloss.NewStyle().Padding(1, 2).Width(m.width).Height(m.height)
+
+	m.header.SetItemCount(len(m.rows))
+	sections := []string{m.header.View(), ""}
+	switch {
+	case m.err != nil:
+		sections = append(sections, components.FieldError("Could not load tags: "+m.err.Error()))
+	case len(m.rows) == 0:
+		sections = append(sections,
+			styles.SubtitleStyle.Render("No tags yet."),
+			"",
+			styles.HelpStyle.Render("Write #tags in notes and todos to see them here"),
+		)
+	default:
+		sections = append(sections, m.listView())
+	}
+	if m.inputKind != "" {
+		label := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true).
+			Render(fmt.Sprintf("#%s %s:", m.rows[m.selected].count.Tag, m.inputKind))
+		prompt := label + " " + m.input.View()
+		if m.inputErr != "" {
+			prompt += "\n" + components.FieldError(m.inputErr)
+		}
+		sections = append(sections, "", prompt)
+	}
+	sections = append(sections, "", m.helpBar.View())
+	return panel.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// listView renders the visible tag rows: the tag as it is drawn, its
+// counts and its settings.
+func (m *TagsModel) listView() string {
+	m.offset = min(m.offset, m.selected)
+	m.offset = max(m.offset, m.selected-m.bodyHeight()+1)
+	end := min(m.offset+m.bodyHeight(), len(m.rows))
+	muted := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	tagWidth := 0
+	for _, row := range m.rows {
+		tagWidth = max(tagWidth, lipgloss.Width(previewTag(row.style))+2)
+	}
+
+	lines := make([]string, 0, end-m.offset)
+	for i := m.offset; i < end; i++ {
+		row := m.rows[i]
+		tag := previewTag(row.style)
+		tag += strings.Repeat(" ", max(tagWidth-lipgloss.Width(tag), 0))
+
+		var settings []string
+		if row.style.Color != "" {
+			settings = append(settings, "color "+row.style.Color)
+		}
+		if row.style.Priority != nil {
+			settings = append(settings, "priority "+defaultPriorityName(row.style.Priority))
+		}
+		line := tag + muted.Render(fmt.Sprintf("%3d notes  %3d todos", row.count.Notes, row.count.Todos))
+		if len(settings) > 0 {
+			line += "  " + styles.SubtitleStyle.Render(strings.Join(settings, " · "))
+		}
+
+		prefix := "  "
+		if i == m.selected {
+			prefix = styles.SelectedItemStyle.Render("▶ ")
+		}
+		lines = append(lines, prefix+line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// previewTag draws a tag with its own style's color and icon, which the
+// app may not have applied yet.
+func previewTag(style models.TagStyle) string {
+	text := "#" + style.Tag
+	if style.Icon != "" {
+		text = style.Icon + " " + text
+	}
+	pill := styles.TagStyle.UnsetMarginRight()
+	if style.Color != "" {
+		pill = pill.Foreground(lipgloss.Color(style.Color))
+	}
+	return pill.Render(text)
+}
@@ -0,0 +1,74 @@
+package screens
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+)
+
+func TestTagsScreen(t *testing.T) {
+	t.Parallel()
+
+	store, err := sqlite.New(&config.Config{DbPath: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	if err := store.CreateNote(&models.Note{Title: "Plan", Tags: []string{"work"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.CreateTodo(&models.Todo{Title: "Fix outage #urgent", Status: models.TodoStatusPending}); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewTagsModel(store)
+	m.SetSize(100, 30)
+	if err := m.LoadTags(); err != nil {
+		t.Fatalf("LoadTags() err = %v", err)
+	}
+	if len(m.rows) != 2 || m.rows[0].count.Tag != "urgent" {
+		t.Fatalf("rows = %+v, want urgent and work", m.rows)
+	}
+
+	// p cycles the default priority: low, medium, high
+	var cmd tea.Cmd
+	for range 3 {
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	}
+	if cmd == nil {
+		t.Fatal("expected p to report the change")
+	}
+	if p := m.rows[0].style.Priority; p == nil || *p != models.TodoPriorityHigh {
+		t.Errorf("urgent priority = %v, want high", p)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if !m.InputActive() {
+		t.Fatal("expected c to open the color prompt")
+	}
+	for _, r := range "pink" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.inputErr == "" || !containsString(m.View(), "Use a hex color") {
+		t.Errorf("expected an invalid color to be refused, got:\n%s", m.View())
+	}
+	m.input.SetValue("#ff5f87")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.InputActive() || m.rows[0].style.Color != "#ff5f87" {
+		t.Errorf("color = %q, want #ff5f87 saved", m.rows[0].style.Color)
+	}
+	if view := m.View(); !containsString(view, "color #ff5f87 · priority high") || !containsString(view, "1 notes") {
+		t.Errorf("expected the settings and counts in the view, got:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if styles, _ := store.ListTagStyles(); len(styles) != 0 {
+		t.Errorf("ListTagStyles() after x = %+v, want none", styles)
+	}
+}
@@ -0,0 +1,166 @@
+package screens
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Tag suggestions (Phase 6: Organization).
+//
+// Saving a note without any #tags looks for likely tags among the ones
+// already in use (see search.SuggestTags) in the background. If it finds
+// some, a small picker offers them, all ticked: Enter adds the ticked tags
+// to the end of the note, Space unticks one, Esc keeps the note untagged.
+
+// maxTagSuggestions is how many tags the picker offers.
+const maxTagSuggestions = 5
+
+// suggestTimeout bounds the background suggestion lookup.
+const suggestTimeout = 5 * time.Second
+
+// tagSuggestionsMsg carries the suggestions for a saved note.
+type tagSuggestionsMsg struct {
+	noteID int64
+	title  string
+	tags   []string
+}
+
+// SetTagSuggester sets where tag suggestions come from; nil turns them off.
+func (m *NotesListModel) SetTagSuggester(s *search.SemanticSearch) {
+	m.suggester = s
+}
+
+// suggestTagsCmd looks up tags for note if it has none.
+func (m *NotesListModel) suggestTagsCmd(note *models.Note) tea.Cmd {
+	if m.suggester == nil || note == nil || len(note.Tags) > 0 {
+		return nil
+	}
+	suggester := m.suggester
+	id, title, text := note.ID, note.Title, note.Title+"\n"+note.Body
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), suggestTimeout)
+		defer cancel()
+		tags, err := suggester.SuggestTags(ctx, id, text, maxTagSuggestions)
+		if err != nil || len(tags) == 0 {
+			return nil
+		}
+		return tagSuggestionsMsg{noteID: id, title: title, tags: tags}
+	}
+}
+
+// openTagSuggestions shows the picker, unless the user has moved on to
+// editing another note.
+func (m *NotesListModel) openTagSuggestions(msg tagSuggestionsMsg) {
+	if m.showCreate || m.showTagPicker {
+		return
+	}
+	m.showTagSuggest = true
+	m.suggestNoteID = msg.noteID
+	m.suggestTitle = msg.title
+	m.suggestTags = msg.tags
+	m.suggestKeep = make([]bool, len(msg.tags))
+	for i := range m.suggestKeep {
+		m.suggestKeep[i] = true
+	}
+	m.suggestIndex = 0
+}
+
+// handleTagSuggestKey handles keys while the suggestion picker is open.
+func (m *NotesListModel) handleTagSuggestKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "up", "k":
+		if m.suggestIndex > 0 {
+			m.suggestIndex--
+		}
+	case "down", "j":
+		if m.suggestIndex < len(m.suggestTags)-1 {
+			m.suggestIndex++
+		}
+	case " ":
+		m.suggestKeep[m.suggestIndex] = !m.suggestKeep[m.suggestIndex]
+	case "enter":
+		m.showTagSuggest = false
+		return m.applyTagSuggestions()
+	case "esc", "n":
+		m.showTagSuggest = false
+	}
+	return nil
+}
+
+// applyTagSuggestions adds the ticked tags to the end of the note.
+func (m *NotesListModel) applyTagSuggestions() tea.Cmd {
+	var kept []string
+	for i, tag := range m.suggestTags {
+		if m.suggestKeep[i] {
+			kept = append(kept, "#"+tag)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	note, err := m.store.GetNote(m.suggestNoteID)
+	if err != nil || note == nil {
+		return nil
+	}
+	line := strings.Join(kept, " ")
+	if body := strings.TrimSpace(note.Body); body != "" {
+		note.Body = body + "\n\n" + line
+	} else {
+		note.Body = line
+	}
+	note.Tags = extractTags(note.Title + " " + note.Body)
+	if err := m.store.UpdateNote(note); err != nil {
+		return nil
+	}
+	m.LoadNotes()
+	return func() tea.Msg {
+		return ToastMsg{Text: "🏷️ Tagged " + note.Title + ": " + line}
+	}
+}
+
+// renderTagSuggestions renders the suggestion picker.
+func (m *NotesListModel) renderTagSuggestions() string {
+	titleStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+	normalStyle := lipgloss.NewStyle().Foreground(styles.TextColor).Padding(0, 1)
+	checkedStyle := lipgloss.NewStyle().Foreground(styles.SuccessColor)
+	uncheckedStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+
+	lines := make([]string, len(m.suggestTags))
+	for i, tag := range m.suggestTags {
+		checkbox := uncheckedStyle.Render("[ ]")
+		if m.suggestKeep[i] {
+			checkbox = checkedStyle.Render("[✓]")
+		}
+		if i == m.suggestIndex {
+			lines[i] = selectedStyle.Render("▶ " + checkbox + " #" + tag)
+		} else {
+			lines[i] = normalStyle.Render("  " + checkbox + " #" + tag)
+		}
+	}
+
+	m.helpBar.SetHints(components.TagSuggestHints)
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleStyle.Render("🏷️ Suggested Tags"),
+		styles.SubtitleStyle.Render("\""+m.suggestTitle+"\" has no tags yet. Keep the ones that fit:"),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines...),
+		"",
+		m.helpBar.View(),
+	)
+	return styles.PanelStyle.Render(content)
+}
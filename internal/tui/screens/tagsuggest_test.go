@@ -0,0 +1,69 @@
+package screens
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	embeddings "github.com/Jericoz-JC/flowState-CLI/internal/embeddings"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+)
+
+func TestNotesTagSuggestions(t *testing.T) {
+	m := newTestNotesModel(t)
+	emb, err := embeddings.New(&config.Config{ModelPath: filepath.Join(t.TempDir(), "models")})
+	if err != nil {
+		t.Fatalf("embeddings.New() err = %v", err)
+	}
+	m.SetTagSuggester(search.New(emb, m.store))
+	for _, n := range []*models.Note{
+		{Title: "Garden", Body: "Plant tomatoes #garden", Tags: []string{"garden"}},
+		{Title: "Taxes", Body: "File the return #finance", Tags: []string{"finance"}},
+	} {
+		if err := m.store.CreateNote(n); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	m.LoadNotes()
+
+	// Save an untagged note that mentions both tags
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.titleInput.SetValue("Weekend")
+	m.bodyInput.SetValue("Garden chores, then finance paperwork")
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("saving an untagged note should look up tag suggestions")
+	}
+	msg, ok := cmd().(tagSuggestionsMsg)
+	if !ok || len(msg.tags) != 2 {
+		t.Fatalf("suggestions = %#v, want finance and garden", msg)
+	}
+	m.Update(msg)
+	if !m.showTagSuggest || !m.InputActive() {
+		t.Fatal("expected the suggestion picker to open")
+	}
+	if v := m.View(); !strings.Contains(v, "Suggested Tags") || !strings.Contains(v, "#garden") {
+		t.Errorf("picker view missing suggestions:\n%s", v)
+	}
+
+	// Drop the first suggestion, accept the rest
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showTagSuggest || cmd == nil {
+		t.Fatal("Enter should close the picker and report the tags")
+	}
+	note, _ := m.store.GetNote(msg.noteID)
+	want := "#" + msg.tags[1]
+	if !strings.HasSuffix(note.Body, "\n\n"+want) || len(note.Tags) != 1 || note.Tags[0] != msg.tags[1] {
+		t.Errorf("note = %q %v, want only %s added", note.Body, note.Tags, want)
+	}
+
+	// Tagged notes get no suggestions
+	if cmd := m.suggestTagsCmd(note); cmd != nil {
+		t.Error("a tagged note should not get suggestions")
+	}
+}
@@ -0,0 +1,205 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Side timers for the focus screen (Phase 5).
+//
+// Named countdowns ("check oven in 40m") that run alongside the pomodoro
+// without touching session tracking. Each timer keeps an absolute
+// deadline, so it stays accurate while the user is on other screens; the
+// app routes FocusTimerTickMsg and FocusTimerDoneMsg here from anywhere
+// and shows a toast when a timer finishes.
+//
+// Keyboard Shortcuts (any timer mode):
+//   - t: Add a timer, e.g. "40m check oven", "1h30m laundry" or "5 tea"
+//   - x: Cancel the timer that ends soonest
+
+// maxSideTimers caps how many side timers can run at once.
+const maxSideTimers = 5
+
+// sideTimer is a named countdown running next to the focus session.
+type sideTimer struct {
+	id       int
+	label    string
+	deadline time.Time
+}
+
+// FocusTimerTickMsg refreshes the side timer countdowns once a second
+// while any are running.
+type FocusTimerTickMsg time.Time
+
+// FocusTimerDoneMsg is sent when a side timer reaches zero.
+type FocusTimerDoneMsg struct {
+	ID    int
+	Label string
+}
+
+func timerTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return FocusTimerTickMsg(t)
+	})
+}
+
+// parseSideTimer parses "<duration> [label]". The duration is a Go
+// duration ("40m", "1h30m") or a bare number of minutes.
+func parseSideTimer(input string) (time.Duration, string, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return 0, "", fmt.Errorf("enter a duration, e.g. 40m check oven")
+	}
+	d, err := time.ParseDuration(fields[0])
+	if err != nil {
+		minutes, convErr := strconv.Atoi(fields[0])
+		if convErr != nil {
+			return 0, "", fmt.Errorf("invalid duration %q", fields[0])
+		}
+		d = time.Duration(minutes) * time.Minute
+	}
+	if d < time.Second || d > 24*time.Hour {
+		return 0, "", fmt.Errorf("duration must be between 1s and 24h")
+	}
+	label := strings.Join(fields[1:], " ")
+	if label == "" {
+		label = "Timer"
+	}
+	return d, label, nil
+}
+
+// addSideTimer starts a timer and returns the commands that end it and,
+// for the first timer, start the refresh tick.
+func (m *FocusModel) addSideTimer(d time.Duration, label string) tea.Cmd {
+	m.nextTimerID++
+	t := sideTimer{id: m.nextTimerID, label: label, deadline: time.Now().Add(d)}
+	m.sideTimers = append(m.sideTimers, t)
+	sort.SliceStable(m.sideTimers, func(i, j int) bool {
+		return m.sideTimers[i].deadline.Before(m.sideTimers[j].deadline)
+	})
+
+	done := tea.Tick(d, func(time.Time) tea.Msg {
+		return FocusTimerDoneMsg{ID: t.id, Label: t.label}
+	})
+	if m.timersTicking {
+		return done
+	}
+	m.timersTicking = true
+	return tea.Batch(done, timerTickCmd())
+}
+
+// removeSideTimer drops the timer with id, reporting whether it was running.
+func (m *FocusModel) removeSideTimer(id int) bool {
+	for i, t := range m.sideTimers {
+		if t.id == id {
+			m.sideTimers = append(m.sideTimers[:i], m.sideTimers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// updateSideTimers handles side timer messages. It reports whether msg
+// was one of them.
+func (m *FocusModel) updateSideTimers(msg tea.Msg) (tea.Cmd, bool) {
+	switch msg := msg.(type) {
+	case FocusTimerTickMsg:
+		if len(m.sideTimers) == 0 {
+			m.timersTicking = false
+			return nil, true
+		}
+		return timerTickCmd(), true
+
+	case FocusTimerDoneMsg:
+		m.removeSideTimer(msg.ID)
+		return nil, true
+	}
+	return nil, false
+}
+
+// handleTimerPrompt handles keys while the new timer prompt is open.
+func (m *FocusModel) handleTimerPrompt(msg tea.KeyMsg) (FocusModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showTimerInput = false
+		m.timerInputErr = ""
+		return *m, nil
+	case "enter":
+		d, label, err := parseSideTimer(m.timerInput.Value())
+		if err != nil {
+			m.timerInputErr = err.Error()
+			return *m, nil
+		}
+		m.showTimerInput = false
+		m.timerInputErr = ""
+		return *m, m.addSideTimer(d, label)
+	}
+	var cmd tea.Cmd
+	m.timerInput, cmd = m.timerInput.Update(msg)
+	return *m, cmd
+}
+
+// openTimerPrompt opens the new timer prompt, if another timer fits.
+func (m *FocusModel) openTimerPrompt() {
+	if len(m.sideTimers) >= maxSideTimers {
+		m.timerInputErr = fmt.Sprintf("At most %d timers can run at once", maxSideTimers)
+		return
+	}
+	m.timerInput = components.NewTextInput("40m check oven")
+	m.timerInputErr = ""
+	m.showTimerInput = true
+}
+
+// InputActive reports whether a prompt (new timer, session label, planned
+// block, history jump or cleanup) has focus, so the app leaves
+// single-letter keys such as q and ? to the screen.
+func (m *FocusModel) InputActive() bool {
+	return m.showTimerInput || m.showLabelInput || m.showPlanInput || m.showHistoryJump || m.showPurge
+}
+
+// renderSideTimers renders the prompt and the running side timers, or ""
+// when there is neither.
+func (m *FocusModel) renderSideTimers() string {
+	var lines []string
+	if m.showTimerInput {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.PrimaryColor).Bold(true)
+		lines = append(lines, labelStyle.Render("New timer (duration and label):")+" "+m.timerInput.View())
+	}
+	if m.timerInputErr != "" {
+		lines = append(lines, components.FieldError(m.timerInputErr))
+	}
+
+	if len(m.sideTimers) > 0 {
+		labelStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+		timeStyle := lipgloss.NewStyle().Foreground(styles.AccentColor).Bold(true)
+		now := time.Now()
+		items := make([]string, 0, len(m.sideTimers))
+		for _, t := range m.sideTimers {
+			items = append(items, labelStyle.Render(t.label+" ")+timeStyle.Render(formatCountdown(t.deadline.Sub(now))))
+		}
+		lines = append(lines, "⏲ "+strings.Join(items, labelStyle.Render("  ·  ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatCountdown renders a remaining duration as MM:SS, or H:MM:SS from
+// an hour up.
+func formatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	secs := int((d + time.Second - 1) / time.Second) // round up: 0:00 only when done
+	if secs >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	}
+	return fmt.Sprintf("%02d:%02d", secs/60, secs%60)
+}
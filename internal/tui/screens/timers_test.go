@@ -0,0 +1,104 @@
+package screens
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseSideTimer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		wantDur   time.Duration
+		wantLabel string
+		wantErr   bool
+	}{
+		{"40m check oven", 40 * time.Minute, "check oven", false},
+		{"1h30m laundry", 90 * time.Minute, "laundry", false},
+		{"5 tea", 5 * time.Minute, "tea", false},
+		{"90s", 90 * time.Second, "Timer", false},
+		{"", 0, "", true},
+		{"soon tea", 0, "", true},
+		{"48h nap", 0, "", true},
+	}
+	for _, tt := range tests {
+		d, label, err := parseSideTimer(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSideTimer(%q) err = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if d != tt.wantDur || label != tt.wantLabel {
+			t.Errorf("parseSideTimer(%q) = %v, %q; want %v, %q", tt.input, d, label, tt.wantDur, tt.wantLabel)
+		}
+	}
+}
+
+func typeKeys(m FocusModel, s string) FocusModel {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+// TestFocusSideTimers verifies side timers are added from the prompt,
+// shown with the session, and leave session tracking alone.
+func TestFocusSideTimers(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m = typeKeys(m, "s") // start a work session
+	remaining := m.remaining
+
+	m = typeKeys(m, "t")
+	if !m.InputActive() {
+		t.Fatalf("expected the timer prompt to take input")
+	}
+	m = typeKeys(m, "40m check oven")
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("expected commands to run the timer")
+	}
+	if m.InputActive() || len(m.sideTimers) != 1 {
+		t.Fatalf("expected one timer and a closed prompt, got %d timers", len(m.sideTimers))
+	}
+	if view := m.View(); !containsString(view, "check oven") || !containsString(view, "40:00") {
+		t.Errorf("expected the side timer in the view, got:\n%s", view)
+	}
+
+	// Side timer ticks don't advance the focus countdown
+	m, _ = m.Update(FocusTimerTickMsg(time.Now()))
+	if m.remaining != remaining || m.mode != FocusModeRunning {
+		t.Errorf("side timer tick changed the session: remaining %v, mode %v", m.remaining, m.mode)
+	}
+
+	m, _ = m.Update(FocusTimerDoneMsg{ID: m.sideTimers[0].id, Label: "check oven"})
+	if len(m.sideTimers) != 0 {
+		t.Errorf("expected the finished timer to be removed")
+	}
+	if _, cmd := m.Update(FocusTimerTickMsg(time.Now())); cmd != nil || m.timersTicking {
+		t.Errorf("expected the refresh tick to stop with no timers left")
+	}
+}
+
+func TestFocusSideTimerCancelAndErrors(t *testing.T) {
+	t.Parallel()
+
+	m := newTestFocusModel(t)
+	m = typeKeys(m, "t")
+	m = typeKeys(m, "later")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.InputActive() || !containsString(m.View(), "invalid duration") {
+		t.Fatalf("expected the prompt to stay open with an error")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	m.addSideTimer(10*time.Minute, "tea")
+	m.addSideTimer(5*time.Minute, "call")
+	m = typeKeys(m, "x")
+	if len(m.sideTimers) != 1 || m.sideTimers[0].label != "tea" {
+		t.Errorf("expected x to cancel the soonest timer, left %+v", m.sideTimers)
+	}
+}
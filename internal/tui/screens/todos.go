@@ -14,21 +14,25 @@
 package screens
 
 import (
+	"context"
 	"fmt"
-	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
 	"github.com/Jericoz-JC/flowState-CLI/internal/models"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/components"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/keymap"
 	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
 )
 
 // TodoSortMode defines how todos are sorted.
@@ -66,24 +70,11 @@ func (s TodoSortMode) String() string {
 }
 
 // tagPattern matches #hashtags in text
-var tagPattern = regexp.MustCompile(`#(\w+)`)
+var tagPattern = models.HashtagPattern
 
 // extractTagsFromTodo extracts #hashtags from todo title and description.
 func extractTagsFromTodo(todo *models.Todo) []string {
-	text := todo.Title + " " + todo.Description
-	matches := tagPattern.FindAllStringSubmatch(text, -1)
-	seen := make(map[string]bool)
-	var tags []string
-	for _, match := range matches {
-		if len(match) > 1 {
-			tag := strings.ToLower(match[1])
-			if !seen[tag] {
-				seen[tag] = true
-				tags = append(tags, tag)
-			}
-		}
-	}
-	return tags
+	return models.ExtractHashtags(todo.Title + " " + todo.Description)
 }
 
 // TodosListModel implements the todos management screen.
@@ -95,7 +86,8 @@ func extractTagsFromTodo(todo *models.Todo) []string {
 //   - Edit existing todos
 //   - Delete todos
 //   - Toggle completion with space bar
-//   - Visual priority indicators (🔴 high, 🟢 low)
+//   - Visual priority indicators (🔴 high, 🟢 low); the list draws them as
+//     colored row stripes (see delegates.go)
 //
 // Phase 3: Notion-Inspired Overhaul (v0.1.6)
 //   - Sort modes: 's' key cycles through Date↓ → Priority → Date↑ → A-Z → Due Date
@@ -103,6 +95,11 @@ func extractTagsFromTodo(todo *models.Todo) []string {
 //   - Priority filter: 'p' key cycles through priority levels
 //   - Preview mode: 'v' key shows full todo details
 //
+// Phase 6: Due times
+//   - The form's Due field takes a date with an optional time of day
+//     ("2026-03-09 17:00", "tomorrow 5pm", "+3"); timed todos sort before
+//     the day's untimed ones, and the list and preview count down to them
+//
 // Keyboard Shortcuts (when viewing list):
 //   - c: Create new todo
 //   - e: Edit selected todo
@@ -112,6 +109,11 @@ func extractTagsFromTodo(todo *models.Todo) []string {
 //   - t: Toggle tag filter
 //   - p: Cycle priority filter
 //   - v: Toggle preview mode
+//   - z: Snooze selected todo (Phase 6); Z shows/hides snoozed todos
+//   - +/-: Shift the due date a day later/earlier; w: a week later; 0: clear it
+//   - D: Toggle compact/comfortable rows
+//   - y: Copy the selected todo's flowstate://todo/ID link (also in preview)
+//   - T: Toggle the sortable table view (Phase 9, see todos_table.go)
 //   - j/down: Move selection down
 //   - k/up: Move selection up
 //   - esc: Cancel/create mode
@@ -122,64 +124,114 @@ func extractTagsFromTodo(todo *models.Todo) []string {
 //   - [~] In progress
 //   - [x] Completed
 type TodosListModel struct {
-	list             list.Model
-	store            *sqlite.Store
-	filter           string
-	filterInput      components.TextInputModel
-	showFilter       bool
-	statusFilter     models.TodoStatus // Filter by status: "", "pending", "completed", "in_progress"
-	showCreate       bool
-	editingID        int64 // 0 = creating new, >0 = editing existing
-	confirmingDelete bool
-	deleteTargetID   int64
-	titleInput       components.TextInputModel
-	descInput        components.TextAreaModel
-	header           components.Header
-	helpBar          components.HelpBar
-	width            int
-	height           int
+	list          components.VirtualList
+	store         *sqlite.Store
+	filter        string
+	filterInput   components.TextInputModel
+	showFilter    bool
+	statusFilter  models.TodoStatus // Filter by status: "", "pending", "completed", "in_progress"
+	showCreate    bool
+	editingID     int64  // 0 = creating new, >0 = editing existing
+	linkNoteID    int64  // Note the new todo will be linked to (0 = none)
+	linkNoteTitle string // Title of linkNoteID, shown in the create form
+	confirmDelete components.ConfirmModal
+	titleInput    components.TextInputModel
+	estimateInput components.TextInputModel // Phase 6: effort estimate ("30m", "1h30m")
+	dueInput      components.TextInputModel // Due date, optionally with a time ("tomorrow 5pm")
+	descInput     components.TextAreaModel
+	titleErr      string // Inline validation errors (Phase 4: Robustness)
+	estimateErr   string
+	dueErr        string
+	descErr       string
+	saveAttempted bool // Required and parsed fields are checked once a save is tried
+	header        components.Header
+	helpBar       components.HelpBar
+	width         int
+	height        int
 
 	// Phase 3: Notion-inspired features
-	sortMode       TodoSortMode           // Current sort mode
-	allTags        []string               // All unique tags across todos
-	selectedTags   map[string]bool        // Selected tags for filtering
-	priorityFilter models.TodoPriority    // Filter by priority: -1 = all, 0-2 = specific
-	showPreview    bool                   // Whether preview mode is active
-	previewTodo    *models.Todo           // Todo being previewed
+	sortMode       TodoSortMode        // Current sort mode
+	allTags        []string            // All unique tags across todos
+	selectedTags   map[string]bool     // Selected tags for filtering
+	priorityFilter models.TodoPriority // Filter by priority: -1 = all, 0-2 = specific
+	showPreview    bool                // Whether preview mode is active
+	previewTodo    *models.Todo        // Todo being previewed
+	previewFields  []models.Field      // Custom fields of the previewed todo (Phase 6)
+	fieldEditor    fieldEditor         // F in the preview: set a field
 
 	// Phase 10: Help modal
 	showHelp bool // Help modal state
+
+	// Phase 6: Projects
+	projectFilter      string                    // Show only this project ("" = all)
+	grouping           todoGrouping              // Group list items under project or linked note headers
+	collapsedGroups    map[string]bool           // Collapsed group headers (see todos_group.go)
+	allProjects        []string                  // All known project names
+	showProjectPicker  bool                      // Project picker modal visible
+	projectInput       components.TextInputModel // Filter / new project name
+	projectPickerIndex int                       // Highlighted picker option
+	projectTargetID    int64                     // Todo receiving the project
+
+	// Phase 6: Snooze
+	showSnoozed     bool                      // Include snoozed todos in the list
+	snoozedCount    int                       // Snoozed todos hidden by the last load
+	showSnooze      bool                      // Snooze picker modal visible
+	snoozeIndex     int                       // Highlighted snooze option
+	snoozeTarget    *models.Todo              // Todo being snoozed, loaded when the picker opens
+	snoozePicking   bool                      // Typing a custom date
+	snoozeDateInput components.TextInputModel // Custom snooze date (YYYY-MM-DD)
+	snoozeErr       string                    // Invalid custom date
+
+	// Phase 6: Staleness (a shows stale todos only); see stale.go
+	staleAfter time.Duration // Open and unchanged this long is stale; 0 = off
+	staleOnly  bool
+
+	previewAfterCreate bool // Open a new todo's preview once it is saved
+
+	// Phase 6: Batch retag of the filtered results (#); see retag.go
+	retag retagPrompt
+
+	// Phase 9: Table view
+	showTable     bool          // Show the table instead of the card list
+	table         table.Model   // Rows mirror the listed todos
+	tableTodos    []models.Todo // Todo behind each table row
+	tableRows     int           // Rows for the table and its scroll hint, measured in View
+	tableSort     todoColumn    // Sort column (todoColNone = list order)
+	tableSortDesc bool          // Reverse the column's natural order
+	tableScroll   int           // Columns after Title scrolled out on the left
+
+	// Phase 4: Performance - search-as-you-type loads run as tea.Cmds;
+	// each keystroke cancels the previous, now stale, load.
+	loadID     int                // Incremented per load; stale results are dropped
+	loadCancel context.CancelFunc // Cancels the in-flight filter load, if any
 }
 
 // NewTodosListModel creates a new todos list screen.
 func NewTodosListModel(store *sqlite.Store) TodosListModel {
-	items := []list.Item{}
-	delegate := list.NewDefaultDelegate()
-
-	l := list.New(items, delegate, 0, 0)
-	l.Title = ""
-	l.SetShowHelp(false) // We'll use our own help bar
-	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false) // We handle filtering ourselves
+	// Phase 4: Performance - Only visible rows are rendered
+	l := components.NewVirtualList()
+	l.SetDelegate(todoDelegate{})
+	l.SetItemKey(todoItemKey)
 
 	filterInput := components.NewTextInput("Type to filter...")
 	filterInput.Blur()
 
 	return TodosListModel{
-		list:             l,
-		store:            store,
-		filter:           "",
-		filterInput:      filterInput,
-		showFilter:       false,
-		statusFilter:     "",
-		showCreate:       false,
-		editingID:        0,
-		confirmingDelete: false,
-		deleteTargetID:   0,
-		titleInput:       components.NewTextInput("Todo title"),
-		descInput:        components.NewTextArea("Description (optional, supports #tags)"),
-		header:           components.NewHeader("✅", "Todos"),
-		helpBar:          components.NewHelpBar(components.TodosListHints),
+		list:          l,
+		store:         store,
+		filter:        "",
+		filterInput:   filterInput,
+		showFilter:    false,
+		statusFilter:  "",
+		showCreate:    false,
+		editingID:     0,
+		confirmDelete: components.NewConfirmModal(),
+		titleInput:    components.NewTextInput("Todo title"),
+		estimateInput: components.NewTextInput("Estimate (optional, e.g. 30m, 1h30m)"),
+		dueInput:      components.NewTextInput("Due (optional, e.g. 2026-03-09, tomorrow 5pm, +3)"),
+		descInput:     components.NewTextArea("Description (optional, supports #tags)"),
+		header:        components.NewHeader("✅", "Todos"),
+		helpBar:       components.NewHelpBar(components.TodosListHints),
 		// Phase 3: Notion-inspired features
 		sortMode:       TodoSortByDate,
 		allTags:        []string{},
@@ -187,6 +239,15 @@ func NewTodosListModel(store *sqlite.Store) TodosListModel {
 		priorityFilter: -1, // -1 = all priorities
 		showPreview:    false,
 		previewTodo:    nil,
+		// Phase 6: Projects
+		projectInput: components.NewTextInput("Type to filter or name a new project"),
+		// Phase 6: Snooze
+		snoozeDateInput: components.NewTextInput("YYYY-MM-DD"),
+		// Phase 9: Table view
+		table:     newTodoTable(),
+		tableSort: todoColNone,
+		// Phase 6: Batch retag
+		retag: newRetagPrompt(),
 	}
 }
 
@@ -199,9 +260,19 @@ func (m *TodosListModel) Init() tea.Cmd {
 func (m *TodosListModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.list.SetSize(width-4, height-14) // Account for header and help bar
+	m.list.SetSize(width-4, height) // View fits it between header and help bar
 	m.header.SetWidth(width - 4)
 	m.helpBar.SetWidth(width - 4)
+	m.confirmDelete.SetWidth(width - 4)
+	if m.showTable {
+		m.layoutTable()
+	}
+}
+
+// SetListDensity sets the configured default row density; a density
+// chosen with D and stored in the database takes precedence.
+func (m *TodosListModel) SetListDensity(compact bool) {
+	loadListDensity(m.store, &m.list, "todos", compact)
 }
 
 // GetSelectedTodo returns the currently selected todo, or nil if none selected.
@@ -215,113 +286,247 @@ func (m *TodosListModel) GetSelectedTodo() *models.Todo {
 	return nil
 }
 
+// ToastMsg asks the app to show a short confirmation above the status bar.
+type ToastMsg struct {
+	Text string
+}
+
+// BackMsg asks the app to return to the previous screen and selection.
+// Screens send it for Esc when they have nothing left to close.
+type BackMsg struct{}
+
+// goBack is the command screens return to send BackMsg.
+func goBack() tea.Msg { return BackMsg{} }
+
+// confirmTodoDelete asks before deleting the todo with the given ID.
+func (m *TodosListModel) confirmTodoDelete(id int64) {
+	m.confirmDelete.OpenDanger("Delete Todo?", "This action cannot be undone.", func() tea.Cmd {
+		m.store.DeleteTodo(id)
+		m.LoadTodos()
+		return nil
+	}, nil)
+}
+
+// todosLoadedMsg carries the result of an async filter load.
+type todosLoadedMsg struct {
+	id      int
+	todos   []models.Todo
+	snoozed int
+	err     error
+}
+
 // LoadTodos refreshes the todo list from the database.
+//
+// Phase 4: Performance - Filters and sorting run in SQL; only the tag
+// and project lists for the pickers are gathered separately.
 func (m *TodosListModel) LoadTodos() error {
-	todos, err := m.store.ListTodos()
+	m.cancelLoad()
+
+	tags, err := m.store.ListTodoTags()
 	if err != nil {
 		return err
 	}
+	m.allTags = tags
 
-	// Collect all unique tags for the tag filter UI
-	allTagsSet := make(map[string]bool)
-	for _, todo := range todos {
-		for _, tag := range extractTagsFromTodo(&todo) {
-			allTagsSet[tag] = true
-		}
+	projects, err := m.store.ListProjects()
+	if err != nil {
+		return err
 	}
-	m.allTags = make([]string, 0, len(allTagsSet))
-	for tag := range allTagsSet {
-		m.allTags = append(m.allTags, tag)
+	m.allProjects = make([]string, 0, len(projects))
+	for _, project := range projects {
+		m.allProjects = append(m.allProjects, project.Name)
 	}
-	sort.Strings(m.allTags)
-
-	// Apply filters
-	filtered := make([]models.Todo, 0)
-	for _, todo := range todos {
-		// Filter by search text
-		if m.filter != "" {
-			searchText := strings.ToLower(m.filter)
-			titleMatch := strings.Contains(strings.ToLower(todo.Title), searchText)
-			descMatch := strings.Contains(strings.ToLower(todo.Description), searchText)
-			if !titleMatch && !descMatch {
-				continue
-			}
-		}
 
-		// Filter by status
-		if m.statusFilter != "" && todo.Status != m.statusFilter {
-			continue
-		}
-
-		// Filter by priority (Phase 3)
-		if m.priorityFilter >= 0 && todo.Priority != m.priorityFilter {
-			continue
-		}
+	todos, snoozed, err := fetchTodos(context.Background(), m.store, m.todoQuery(), m.showSnoozed)
+	if err != nil {
+		return err
+	}
+	m.setTodos(todos, snoozed)
+	return nil
+}
 
-		// Filter by selected tags (Phase 3)
-		if len(m.selectedTags) > 0 {
-			todoTags := extractTagsFromTodo(&todo)
-			hasMatchingTag := false
-			for _, tag := range todoTags {
-				if m.selectedTags[tag] {
-					hasMatchingTag = true
-					break
-				}
-			}
-			if !hasMatchingTag {
-				continue
-			}
-		}
+// loadTodosCmd runs the current query as a tea.Cmd, cancelling any load
+// still in flight. Used for search-as-you-type; the picker lists do not
+// depend on the text filter and are left as they are.
+func (m *TodosListModel) loadTodosCmd() tea.Cmd {
+	m.cancelLoad()
+	ctx, cancel := context.WithTimeout(context.Background(), sqlite.QueryTimeout)
+	m.loadCancel = cancel
+	id, store, query, showSnoozed := m.loadID, m.store, m.todoQuery(), m.showSnoozed
+	return func() tea.Msg {
+		defer cancel()
+		todos, snoozed, err := fetchTodos(ctx, store, query, showSnoozed)
+		return todosLoadedMsg{id: id, todos: todos, snoozed: snoozed, err: err}
+	}
+}
 
-		filtered = append(filtered, todo)
+// cancelLoad stops the in-flight load and marks its result stale.
+func (m *TodosListModel) cancelLoad() {
+	if m.loadCancel != nil {
+		m.loadCancel()
+		m.loadCancel = nil
 	}
+	m.loadID++
+}
 
-	// Apply sorting (Phase 3)
+// todoQuery builds the store query for the current filters and sort.
+func (m *TodosListModel) todoQuery() sqlite.TodoQuery {
+	text, fields := sqlite.ParseFieldFilters(m.filter)
+	query := sqlite.TodoQuery{
+		Text:    text,
+		Status:  m.statusFilter,
+		Project: m.projectFilter,
+		Fields:  fields,
+	}
+	if m.staleOnly {
+		query.Open = true
+		query.UpdatedBefore = staleCutoff(true, m.staleAfter)
+	}
+	if m.priorityFilter >= 0 {
+		priority := m.priorityFilter
+		query.Priority = &priority
+	}
+	for tag := range m.selectedTags {
+		query.Tags = append(query.Tags, tag)
+	}
 	switch m.sortMode {
-	case TodoSortByDate:
-		sort.Slice(filtered, func(i, j int) bool {
-			return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-		})
-	case TodoSortByDateAsc:
-		sort.Slice(filtered, func(i, j int) bool {
-			return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
-		})
 	case TodoSortByPriority:
-		sort.Slice(filtered, func(i, j int) bool {
-			// Higher priority first (3=high, 1=low)
-			if filtered[i].Priority != filtered[j].Priority {
-				return filtered[i].Priority > filtered[j].Priority
-			}
-			// Same priority: sort by date descending
-			return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-		})
+		query.Sort = sqlite.TodoSortPriority
+	case TodoSortByDateAsc:
+		query.Sort = sqlite.TodoSortCreatedAsc
 	case TodoSortByTitle:
-		sort.Slice(filtered, func(i, j int) bool {
-			return strings.ToLower(filtered[i].Title) < strings.ToLower(filtered[j].Title)
-		})
+		query.Sort = sqlite.TodoSortTitle
 	case TodoSortByDueDate:
-		sort.Slice(filtered, func(i, j int) bool {
-			// Nil due dates go to the end
-			if filtered[i].DueDate == nil && filtered[j].DueDate == nil {
-				return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-			}
-			if filtered[i].DueDate == nil {
-				return false
-			}
-			if filtered[j].DueDate == nil {
-				return true
-			}
-			return filtered[i].DueDate.Before(*filtered[j].DueDate)
-		})
+		query.Sort = sqlite.TodoSortDueDate
+	default:
+		query.Sort = sqlite.TodoSortCreatedDesc
+	}
+	return query
+}
+
+// fetchTodos runs query. Unless showSnoozed is set, snoozed todos are
+// hidden until they wake up (Phase 6) and counted instead.
+func fetchTodos(ctx context.Context, store *sqlite.Store, query sqlite.TodoQuery, showSnoozed bool) ([]models.Todo, int, error) {
+	snoozed := 0
+	if !showSnoozed {
+		total, err := store.CountTodosContext(ctx, query)
+		if err != nil {
+			return nil, 0, err
+		}
+		query.ActiveAt = time.Now()
+		active, err := store.CountTodosContext(ctx, query)
+		if err != nil {
+			return nil, 0, err
+		}
+		snoozed = total - active
+	}
+
+	todos, err := store.QueryTodosContext(ctx, query)
+	if err != nil {
+		return nil, 0, err
 	}
+	return todos, snoozed, nil
+}
+
+// setTodos replaces the list items with todos.
+func (m *TodosListModel) setTodos(todos []models.Todo, snoozed int) {
+	m.snoozedCount = snoozed
 
-	items := make([]list.Item, 0, len(filtered))
-	for _, todo := range filtered {
-		items = append(items, TodoItem{todo: todo})
+	var items []list.Item
+	switch m.grouping {
+	case todoGroupProject:
+		items = groupTodosByProject(todos, m.collapsedGroups)
+	case todoGroupNote:
+		items = groupTodosByNote(todos, m.linkedNoteTitles(todos), m.collapsedGroups)
+	default:
+		items = make([]list.Item, 0, len(todos))
+		for _, todo := range todos {
+			items = append(items, TodoItem{todo: todo})
+		}
 	}
 
 	m.list.SetItems(items)
-	return nil
+	if m.showTable {
+		m.refreshTable()
+	}
+}
+
+// groupTodosByProject orders todos by project (unassigned last), keeping
+// the current sort within each project, and inserts a header before
+// each group. Todos of collapsed groups are left out.
+func groupTodosByProject(todos []models.Todo, collapsed map[string]bool) []list.Item {
+	sort.SliceStable(todos, func(i, j int) bool {
+		pi, pj := todos[i].Project, todos[j].Project
+		if (pi == "") != (pj == "") {
+			return pj == ""
+		}
+		return strings.ToLower(pi) < strings.ToLower(pj)
+	})
+
+	counts := make(map[string]int)
+	for _, todo := range todos {
+		counts[todo.Project]++
+	}
+
+	items := make([]list.Item, 0, len(todos)+len(counts))
+	for i, todo := range todos {
+		folded := collapsed[todoGroupProject.groupKey(todo)]
+		if i == 0 || todos[i-1].Project != todo.Project {
+			items = append(items, projectHeaderItem{name: todo.Project, count: counts[todo.Project], collapsed: folded})
+		}
+		if !folded {
+			items = append(items, TodoItem{todo: todo})
+		}
+	}
+	return items
+}
+
+// InputActive reports whether a text field has focus, so the app leaves
+// single-letter keys such as q and ? to the screen.
+func (m *TodosListModel) InputActive() bool {
+	return m.showFilter || m.showCreate || m.showProjectPicker || (m.showSnooze && m.snoozePicking) || m.retag.active ||
+		m.fieldEditor.open
+}
+
+// HelpSections returns every todos key, grouped for the help modal.
+func (m *TodosListModel) HelpSections() []components.HelpSection {
+	return components.TodosHelp
+}
+
+// FilterLabel describes the active project, tag and text filters, or ""
+// when there are none.
+func (m *TodosListModel) FilterLabel() string {
+	tags := make([]string, 0, len(m.selectedTags))
+	for tag := range m.selectedTags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	label := filterLabel(m.projectFilter, tags, m.filter)
+	if m.staleOnly {
+		label = strings.TrimSpace("stale " + label)
+	}
+	return label
+}
+
+// filterLabel joins a project, tags and filter text into a short label.
+func filterLabel(project string, tags []string, text string) string {
+	var parts []string
+	if project != "" {
+		parts = append(parts, "@"+project)
+	}
+	for _, tag := range tags {
+		parts = append(parts, "#"+tag)
+	}
+	if text != "" {
+		parts = append(parts, "\""+text+"\"")
+	}
+	return strings.Join(parts, " ")
+}
+
+// SetProjectFilter shows only todos in the given project ("" = all).
+func (m *TodosListModel) SetProjectFilter(project string) {
+	m.projectFilter = project
+	m.LoadTodos()
 }
 
 // Update handles messages for the todos screen.
@@ -336,6 +541,14 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case todosLoadedMsg:
+		if msg.id == m.loadID {
+			m.loadCancel = nil
+			if msg.err == nil {
+				m.setTodos(msg.todos, msg.snoozed)
+			}
+		}
+		return m, nil
 	case tea.KeyMsg:
 		// Handle help modal - any key closes it
 		if m.showHelp {
@@ -370,77 +583,30 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filterInput, cmd = m.filterInput.Update(msg)
 				// Search-as-you-type: update filter and reload on every keystroke
 				m.filter = m.filterInput.Value()
-				m.LoadTodos()
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, cmd, m.loadTodosCmd())
 				return m, tea.Batch(cmds...)
 			}
 		}
 
 		// Handle delete confirmation dialog
-		if m.confirmingDelete {
-			switch msg.String() {
-			case "y", "Y":
-				m.store.DeleteTodo(m.deleteTargetID)
-				m.confirmingDelete = false
-				m.deleteTargetID = 0
-				m.LoadTodos()
-				return m, nil
-			case "n", "N", "esc":
-				m.confirmingDelete = false
-				m.deleteTargetID = 0
-				return m, nil
-			}
-			return m, nil
+		if m.confirmDelete.IsOpen() {
+			return m, m.confirmDelete.Update(msg)
 		}
 
 		// Handle keys when in create/edit mode
 		if m.showCreate {
 			switch msg.String() {
-			case "tab", "shift+tab":
-				// Toggle focus between title and description
-				if m.titleInput.Focused() {
-					m.titleInput.Blur()
-					m.descInput.Focus()
-				} else {
-					m.descInput.Blur()
-					m.titleInput.Focus()
-				}
+			case "tab":
+				// Cycle focus: title -> estimate -> due -> description
+				m.cycleFormFocus(1)
+				return m, nil
+			case "shift+tab":
+				m.cycleFormFocus(-1)
 				return m, nil
 			case "enter":
-				// Only save if title input is focused (allow newlines in description)
-				if m.titleInput.Focused() {
-					title := strings.TrimSpace(m.titleInput.Value())
-					desc := strings.TrimSpace(m.descInput.Value())
-					if title != "" {
-						if m.editingID > 0 {
-							// Update existing todo - fetch to preserve other fields
-							existing, err := m.store.GetTodo(m.editingID)
-							if err != nil || existing == nil {
-								return m, nil
-							}
-							existing.Title = title
-							existing.Description = desc
-							if err := m.store.UpdateTodo(existing); err != nil {
-								return m, nil
-							}
-						} else {
-							// Create new todo
-							todo := &models.Todo{
-								Title:       title,
-								Description: desc,
-								Status:      models.TodoStatusPending,
-								Priority:    models.TodoPriorityMedium,
-							}
-							if err := m.store.CreateTodo(todo); err != nil {
-								return m, nil
-							}
-						}
-						m.showCreate = false
-						m.editingID = 0
-						m.titleInput.SetValue("")
-						m.descInput.SetValue("")
-						m.LoadTodos()
-					}
+				// Only save from single-line inputs (allow newlines in description)
+				if !m.descInput.Focused() {
+					m.saveForm()
 					return m, nil
 				}
 				// When description is focused, DON'T return - let Enter pass through
@@ -450,63 +616,144 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Check for cross-platform save shortcut
 			if keymap.IsModS(msg) {
 				// Alternative save shortcut
-				title := strings.TrimSpace(m.titleInput.Value())
-				desc := strings.TrimSpace(m.descInput.Value())
-				if title != "" {
-					if m.editingID > 0 {
-						// Update existing todo - fetch to preserve other fields
-						existing, err := m.store.GetTodo(m.editingID)
-						if err != nil || existing == nil {
-							return m, nil
-						}
-						existing.Title = title
-						existing.Description = desc
-						if err := m.store.UpdateTodo(existing); err != nil {
-							return m, nil
-						}
-					} else {
-						// Create new todo
-						todo := &models.Todo{
-							Title:       title,
-							Description: desc,
-							Status:      models.TodoStatusPending,
-							Priority:    models.TodoPriorityMedium,
-						}
-						if err := m.store.CreateTodo(todo); err != nil {
-							return m, nil
-						}
-					}
-					m.showCreate = false
-					m.editingID = 0
-					m.titleInput.SetValue("")
-					m.descInput.SetValue("")
-					m.LoadTodos()
-				}
+				m.saveForm()
 				return m, nil
 			}
 
 			if msg.String() == "esc" {
-				m.showCreate = false
-				m.editingID = 0
-				m.titleInput.SetValue("")
-				m.descInput.SetValue("")
+				m.resetForm()
 				return m, nil
 			}
 
 			// Update the focused input
 			var cmd tea.Cmd
-			if m.titleInput.Focused() {
+			switch {
+			case m.titleInput.Focused():
 				m.titleInput, cmd = m.titleInput.Update(msg)
-			} else {
+			case m.estimateInput.Focused():
+				m.estimateInput, cmd = m.estimateInput.Update(msg)
+			case m.dueInput.Focused():
+				m.dueInput, cmd = m.dueInput.Update(msg)
+			default:
 				m.descInput, cmd = m.descInput.Update(msg)
 			}
+			m.validateForm()
 			cmds = append(cmds, cmd)
 			return m, tea.Batch(cmds...)
 		}
 
+		// Handle the batch retag prompt (Phase 6)
+		if m.retag.active {
+			cmd, applied := m.retag.update(msg, m.store)
+			if applied {
+				m.LoadTodos()
+			}
+			return m, cmd
+		}
+
+		// Handle project picker modal (Phase 6)
+		if m.showProjectPicker {
+			options := m.projectPickerOptions()
+			switch msg.String() {
+			case "esc":
+				m.closeProjectPicker()
+				return m, nil
+			case "up", "ctrl+k":
+				if m.projectPickerIndex > 0 {
+					m.projectPickerIndex--
+				}
+				return m, nil
+			case "down", "ctrl+j":
+				if m.projectPickerIndex < len(options)-1 {
+					m.projectPickerIndex++
+				}
+				return m, nil
+			case "enter":
+				if m.projectPickerIndex < len(options) {
+					m.assignProject(options[m.projectPickerIndex].project)
+				}
+				m.closeProjectPicker()
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.projectInput, cmd = m.projectInput.Update(msg)
+			m.projectPickerIndex = 0
+			return m, cmd
+		}
+
+		// Handle snooze picker modal (Phase 6)
+		if m.showSnooze {
+			if m.snoozePicking {
+				switch msg.String() {
+				case "esc":
+					m.snoozePicking = false
+					m.snoozeErr = ""
+					m.snoozeDateInput.Blur()
+					return m, nil
+				case "enter":
+					until, err := models.ParseSnoozeDate(m.snoozeDateInput.Value(), time.Now())
+					if err != nil {
+						m.snoozeErr = err.Error()
+						return m, nil
+					}
+					m.snoozeTodo(&until)
+					m.closeSnoozePicker()
+					return m, nil
+				}
+				var cmd tea.Cmd
+				m.snoozeDateInput, cmd = m.snoozeDateInput.Update(msg)
+				m.snoozeErr = ""
+				return m, cmd
+			}
+
+			options := m.snoozeOptions(time.Now())
+			switch msg.String() {
+			case "esc", "z":
+				m.closeSnoozePicker()
+			case "up", "k":
+				if m.snoozeIndex > 0 {
+					m.snoozeIndex--
+				}
+			case "down", "j":
+				if m.snoozeIndex < len(options)-1 {
+					m.snoozeIndex++
+				}
+			case "enter":
+				opt := options[m.snoozeIndex]
+				if opt.pick {
+					m.snoozePicking = true
+					m.snoozeDateInput.SetValue("")
+					m.snoozeDateInput.Focus()
+					return m, nil
+				}
+				m.snoozeTodo(opt.until)
+				m.closeSnoozePicker()
+			}
+			return m, nil
+		}
+
 		// Handle preview mode keys first
 		if m.showPreview {
+			if m.fieldEditor.open && m.previewTodo != nil {
+				saved, cmd := m.fieldEditor.Update(m.store, "todo", m.previewTodo.ID, msg)
+				if saved {
+					m.previewFields = loadFields(m.store, "todo", m.previewTodo.ID)
+				}
+				return m, cmd
+			}
 			switch msg.String() {
+			case "F":
+				// Set a custom field (Phase 6)
+				if m.previewTodo != nil {
+					m.fieldEditor.Open()
+				}
+				return m, nil
+			case "y":
+				// Phase 10: Copy the todo's link
+				if m.previewTodo != nil {
+					return m, copyReference(todoReference(m.previewTodo))
+				}
+				return m, nil
 			case "esc", "v", "q":
 				m.showPreview = false
 				m.previewTodo = nil
@@ -515,11 +762,7 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Edit from preview
 				if m.previewTodo != nil {
 					m.showPreview = false
-					m.showCreate = true
-					m.editingID = m.previewTodo.ID
-					m.titleInput.SetValue(m.previewTodo.Title)
-					m.descInput.SetValue(m.previewTodo.Description)
-					m.titleInput.Focus()
+					m.openEditForm(*m.previewTodo)
 					m.previewTodo = nil
 				}
 				return m, nil
@@ -527,8 +770,7 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Delete from preview
 				if m.previewTodo != nil {
 					m.showPreview = false
-					m.confirmingDelete = true
-					m.deleteTargetID = m.previewTodo.ID
+					m.confirmTodoDelete(m.previewTodo.ID)
 					m.previewTodo = nil
 				}
 				return m, nil
@@ -536,8 +778,17 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Phase 9: Table view keys; the rest fall through to the list
+		if m.showTable {
+			if cmd, handled := m.handleTableKey(msg); handled {
+				return m, cmd
+			}
+		}
+
 		// Handle keys when viewing list - process BEFORE passing to list
 		switch msg.String() {
+		case "esc":
+			return m, goBack
 		case "/":
 			// Open filter input
 			m.showFilter = true
@@ -545,21 +796,24 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.filterInput.Focus()
 			return m, nil
 		case "f":
-			// Cycle through status filters: all -> pending -> in_progress -> completed -> all
-			switch m.statusFilter {
-			case "":
-				m.statusFilter = models.TodoStatusPending
-			case models.TodoStatusPending:
-				m.statusFilter = models.TodoStatusInProgress
-			case models.TodoStatusInProgress:
-				m.statusFilter = models.TodoStatusCompleted
-			case models.TodoStatusCompleted:
-				m.statusFilter = ""
-			default:
-				m.statusFilter = ""
-			}
+			// Cycle through status filters: all, then each status in
+			// workflow order (pending -> in_progress -> completed by
+			// default), then all again
+			m.statusFilter = nextStatusFilter(m.statusFilter)
 			m.LoadTodos()
 			return m, nil
+		case "x":
+			// Move the todo on to the next status of the workflow
+			if selected, ok := m.list.SelectedItem().(TodoItem); ok {
+				selected.todo.Status = workflow.Next(selected.todo.Status)
+				if err := m.store.UpdateTodo(&selected.todo); err != nil {
+					m.LoadTodos()
+					return m, toastCmd("Could not change the status: " + err.Error())
+				}
+				m.LoadTodos()
+				return m, toastCmd("Status: " + workflow.Get(selected.todo.Status).Label)
+			}
+			return m, nil
 		case "s":
 			// Phase 3: Cycle through sort modes
 			m.sortMode = (m.sortMode + 1) % 5 // 5 sort modes total
@@ -608,44 +862,117 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.LoadTodos()
 			return m, nil
+		case "P":
+			// Phase 6: Assign the selected todo to a project
+			if selected := m.GetSelectedTodo(); selected != nil {
+				m.openProjectPicker(selected)
+			}
+			return m, nil
+		case "b":
+			// Phase 6: Cycle grouping: none, project, linked note
+			m.grouping = m.grouping.next()
+			m.LoadTodos()
+			m.list.Select(0)
+			return m, nil
+		case "O":
+			// Phase 6: Open the projects overview
+			return m, func() tea.Msg { return OpenProjectsMsg{} }
+		case "z":
+			// Phase 6: Snooze the selected todo
+			if selected := m.GetSelectedTodo(); selected != nil {
+				m.openSnoozePicker(selected)
+			}
+			return m, nil
+		case "+":
+			// Phase 6: Push the due date back a day
+			return m, m.rescheduleSelected(1, false)
+		case "-":
+			// Phase 6: Pull the due date forward a day
+			return m, m.rescheduleSelected(-1, false)
+		case "w":
+			// Phase 6: Push the due date back a week
+			return m, m.rescheduleSelected(7, false)
+		case "0":
+			// Phase 6: Clear the due date
+			return m, m.rescheduleSelected(0, true)
+		case "D":
+			// Toggle compact/comfortable rows
+			return m, toggleListDensity(m.store, &m.list, "todos")
+		case "T":
+			// Phase 9: Switch to the table view
+			m.openTable()
+			return m, nil
+		case "*":
+			// Phase 6: Star or unstar the selected todo
+			if selected := m.GetSelectedTodo(); selected != nil {
+				cmd := toggleTodoStar(m.store, selected)
+				m.LoadTodos()
+				return m, cmd
+			}
+			return m, nil
+		case "#":
+			// Phase 6: Tag or untag every filtered todo
+			return m, m.openRetag()
+		case "a":
+			// Phase 6: Show only stale todos
+			if m.staleAfter > 0 {
+				m.staleOnly = !m.staleOnly
+				m.LoadTodos()
+				m.list.Select(0)
+			}
+			return m, nil
+		case "H":
+			// Phase 10: Pin the active filter to Home
+			return m, m.togglePin()
+		case "y":
+			// Phase 10: Copy the selected todo's link
+			if selected := m.GetSelectedTodo(); selected != nil {
+				return m, copyReference(todoReference(selected))
+			}
+			return m, nil
+		case "Z":
+			// Phase 6: Show or hide snoozed todos
+			m.showSnoozed = !m.showSnoozed
+			m.LoadTodos()
+			return m, nil
 		case "v":
 			// Phase 3: Toggle preview mode
-			if len(m.list.VisibleItems()) > 0 {
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(TodoItem); ok {
 					m.showPreview = true
 					m.previewTodo = &selected.todo
+					m.previewFields = loadFields(m.store, "todo", selected.todo.ID)
 				}
 			}
 			return m, nil
 		case "c":
+			m.resetForm()
 			m.showCreate = true
-			m.editingID = 0
-			m.titleInput.SetValue("")
-			m.descInput.SetValue("")
 			m.titleInput.Focus()
-			m.descInput.Blur()
 			return m, nil // Return early to prevent list from processing
 		case "e":
-			if len(m.list.VisibleItems()) > 0 {
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(TodoItem); ok {
-					m.showCreate = true
-					m.editingID = selected.todo.ID
-					m.titleInput.SetValue(selected.todo.Title)
-					m.descInput.SetValue(selected.todo.Description)
-					m.titleInput.Focus()
+					m.openEditForm(selected.todo)
 				}
 			}
 			return m, nil
 		case "d":
-			if len(m.list.VisibleItems()) > 0 {
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(TodoItem); ok {
-					m.confirmingDelete = true
-					m.deleteTargetID = selected.todo.ID
+					m.confirmTodoDelete(selected.todo.ID)
 				}
 			}
 			return m, nil
-		case " ":
-			if len(m.list.VisibleItems()) > 0 {
+		case " ", "enter":
+			// Phase 6: Collapse or expand the group under the cursor
+			if m.toggleGroup() {
+				return m, nil
+			}
+			if msg.String() == "enter" {
+				break
+			}
+			if len(m.list.Items()) > 0 {
 				if selected, ok := m.list.SelectedItem().(TodoItem); ok {
 					if selected.todo.Status == models.TodoStatusCompleted {
 						selected.todo.Status = models.TodoStatusPending
@@ -666,6 +993,9 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.statusFilter = ""
 			m.priorityFilter = -1
 			m.selectedTags = make(map[string]bool)
+			m.projectFilter = ""
+			m.showSnoozed = false
+			m.staleOnly = false
 			m.LoadTodos()
 			return m, nil
 		}
@@ -679,6 +1009,482 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// OpenCreateForNote opens the create form pre-linked to a note.
+// The title is pre-filled with the note title; saving sets the todo's
+// NoteID and records a "contains" link from the note to the new todo.
+func (m *TodosListModel) OpenCreateForNote(noteID int64, noteTitle string) {
+	m.showPreview = false
+	m.previewTodo = nil
+	m.resetForm()
+	m.showCreate = true
+	m.linkNoteID = noteID
+	m.linkNoteTitle = noteTitle
+	m.titleInput.SetValue(noteTitle)
+	m.titleInput.Focus()
+}
+
+// openEditForm opens the form pre-filled with an existing todo.
+func (m *TodosListModel) openEditForm(todo models.Todo) {
+	m.resetForm()
+	m.showCreate = true
+	m.editingID = todo.ID
+	m.titleInput.SetValue(todo.Title)
+	m.estimateInput.SetValue(models.FormatMinutes(todo.EstimateMinutes))
+	if todo.DueDate != nil {
+		m.dueInput.SetValue(models.FormatDueInput(*todo.DueDate))
+	}
+	m.descInput.SetValue(todo.Description)
+	m.titleInput.Focus()
+}
+
+// cycleFormFocus moves focus through title, estimate, due date and
+// description.
+func (m *TodosListModel) cycleFormFocus(delta int) {
+	current := 0
+	switch {
+	case m.estimateInput.Focused():
+		current = 1
+	case m.dueInput.Focused():
+		current = 2
+	case m.descInput.Focused():
+		current = 3
+	}
+	next := (current + delta + 4) % 4
+
+	m.titleInput.Blur()
+	m.estimateInput.Blur()
+	m.dueInput.Blur()
+	m.descInput.Blur()
+	switch next {
+	case 0:
+		m.titleInput.Focus()
+	case 1:
+		m.estimateInput.Focus()
+	case 2:
+		m.dueInput.Focus()
+	default:
+		m.descInput.Focus()
+	}
+}
+
+// Rules for the todo form's fields.
+var (
+	todoTitleRules    = []components.Rule{components.Required("Title"), components.MaxLength("Title", models.MaxTitleLength)}
+	todoEstimateRules = []components.Rule{components.Parses(func(value string) error {
+		_, err := models.ParseEstimate(value)
+		return err
+	})}
+	todoDueRules = []components.Rule{components.Parses(func(value string) error {
+		_, err := models.ParseDue(value, time.Now())
+		return err
+	})}
+	todoDescRules = []components.Rule{components.MaxLength("Description", models.MaxDescriptionLength)}
+)
+
+// validateForm checks the form's fields, setting their inline errors, and
+// reports whether they are valid.
+func (m *TodosListModel) validateForm() bool {
+	m.titleErr = components.Check(m.titleInput.Value(), m.saveAttempted, todoTitleRules...)
+	m.estimateErr = components.Check(m.estimateInput.Value(), m.saveAttempted, todoEstimateRules...)
+	m.dueErr = components.Check(m.dueInput.Value(), m.saveAttempted, todoDueRules...)
+	m.descErr = components.Check(m.descInput.Value(), m.saveAttempted, todoDescRules...)
+	return m.titleErr == "" && m.estimateErr == "" && m.dueErr == "" && m.descErr == ""
+}
+
+// saveForm persists the create/edit form. Returns false when nothing was
+// saved (invalid fields or store error) so the form stays open.
+func (m *TodosListModel) saveForm() bool {
+	m.saveAttempted = true
+	if !m.validateForm() {
+		return false
+	}
+	title := strings.TrimSpace(m.titleInput.Value())
+	desc := strings.TrimSpace(m.descInput.Value())
+	estimate, _ := models.ParseEstimate(m.estimateInput.Value())
+	due, _ := models.ParseDue(m.dueInput.Value(), time.Now())
+
+	var createdID int64
+	if m.editingID > 0 {
+		// Update existing todo - fetch to preserve other fields
+		existing, err := m.store.GetTodo(m.editingID)
+		if err != nil || existing == nil {
+			return false
+		}
+		existing.Title = title
+		existing.Description = desc
+		existing.EstimateMinutes = estimate
+		existing.DueDate = due
+		if err := m.store.UpdateTodo(existing); err != nil {
+			return false
+		}
+	} else {
+		// Create new todo
+		todo := &models.Todo{
+			Title:           title,
+			Description:     desc,
+			Status:          models.TodoStatusPending,
+			Priority:        models.TodoPriorityMedium,
+			EstimateMinutes: estimate,
+			DueDate:         due,
+		}
+		if m.linkNoteID > 0 {
+			noteID := m.linkNoteID
+			todo.NoteID = &noteID
+		}
+		if err := m.store.CreateTodo(todo); err != nil {
+			return false
+		}
+		if m.linkNoteID > 0 {
+			m.store.CreateLink(&models.Link{
+				SourceType: "note",
+				SourceID:   m.linkNoteID,
+				TargetType: "todo",
+				TargetID:   todo.ID,
+				LinkType:   models.LinkTypeContains,
+			})
+		}
+		createdID = todo.ID
+	}
+
+	m.resetForm()
+	m.LoadTodos()
+	if createdID > 0 {
+		// Follow the new todo, wherever the sort put it
+		m.SelectTodoByID(createdID)
+		if m.previewAfterCreate {
+			m.PreviewTodo(createdID)
+		}
+	}
+	return true
+}
+
+// resetForm closes the create/edit form and clears its inputs.
+func (m *TodosListModel) resetForm() {
+	m.showCreate = false
+	m.editingID = 0
+	m.linkNoteID = 0
+	m.linkNoteTitle = ""
+	m.titleErr = ""
+	m.estimateErr = ""
+	m.dueErr = ""
+	m.descErr = ""
+	m.saveAttempted = false
+	m.titleInput.SetValue("")
+	m.estimateInput.SetValue("")
+	m.dueInput.SetValue("")
+	m.descInput.SetValue("")
+	m.titleInput.Blur()
+	m.estimateInput.Blur()
+	m.dueInput.Blur()
+	m.descInput.Blur()
+}
+
+// projectOption is one row in the project picker.
+type projectOption struct {
+	label   string
+	project string // Value assigned on Enter ("" clears the project)
+}
+
+// projectPickerOptions lists projects matching the typed text, then offers
+// to create the typed name if it is new, then to clear the project.
+func (m *TodosListModel) projectPickerOptions() []projectOption {
+	query := strings.TrimSpace(m.projectInput.Value())
+	lower := strings.ToLower(query)
+
+	var options []projectOption
+	exact := false
+	for _, project := range m.allProjects {
+		if lower != "" && !strings.Contains(strings.ToLower(project), lower) {
+			continue
+		}
+		if strings.EqualFold(project, query) {
+			exact = true
+		}
+		options = append(options, projectOption{label: "📁 " + project, project: project})
+	}
+	if query != "" && !exact {
+		options = append(options, projectOption{label: fmt.Sprintf("+ Create %q", query), project: query})
+	}
+	options = append(options, projectOption{label: "∅ No project", project: ""})
+	return options
+}
+
+func (m *TodosListModel) openProjectPicker(todo *models.Todo) {
+	m.showProjectPicker = true
+	m.projectTargetID = todo.ID
+	m.projectInput.SetValue("")
+	m.projectInput.Focus()
+	m.projectPickerIndex = 0
+	// Start on the todo's current project when it has one
+	for i, opt := range m.projectPickerOptions() {
+		if opt.project == todo.Project && todo.Project != "" {
+			m.projectPickerIndex = i
+			break
+		}
+	}
+}
+
+func (m *TodosListModel) closeProjectPicker() {
+	m.showProjectPicker = false
+	m.projectTargetID = 0
+	m.projectInput.SetValue("")
+	m.projectInput.Blur()
+}
+
+// assignProject sets the picker's target todo to project and reloads.
+func (m *TodosListModel) assignProject(project string) {
+	todo, err := m.store.GetTodo(m.projectTargetID)
+	if err != nil || todo == nil {
+		return
+	}
+	todo.Project = project
+	if err := m.store.UpdateTodo(todo); err != nil {
+		return
+	}
+	m.LoadTodos()
+}
+
+// renderProjectPicker renders the project picker modal.
+func (m *TodosListModel) renderProjectPicker() string {
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+
+	normalStyle := lipgloss.NewStyle().
+		Foreground(styles.TextColor).
+		Padding(0, 1)
+
+	var lines []string
+	for i, opt := range m.projectPickerOptions() {
+		if i == m.projectPickerIndex {
+			lines = append(lines, selectedStyle.Render("▶ "+opt.label))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+opt.label))
+		}
+	}
+
+	m.helpBar.SetHints([]components.HelpHint{
+		{Key: "↑/↓", Description: "Navigate"},
+		{Key: "Enter", Description: "Assign", Primary: true},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.TitleStyle.Render("📁 Assign Project"),
+		styles.SubtitleStyle.Render("Projects group todos by outcome; #tags stay for contexts"),
+		"",
+		m.projectInput.View(),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines...),
+		"",
+		m.helpBar.View(),
+	)
+	return styles.PanelStyle.Render(content)
+}
+
+// snoozeOption is one entry in the snooze picker.
+type snoozeOption struct {
+	label string
+	until *time.Time // nil wakes the todo now
+	pick  bool       // Prompt for a custom date
+}
+
+// snoozeOptions lists the snooze presets. A todo that is already snoozed
+// also gets an option to wake it immediately.
+func (m *TodosListModel) snoozeOptions(now time.Time) []snoozeOption {
+	later := models.SnoozeLaterToday(now)
+	tomorrow := models.SnoozeTomorrow(now)
+	nextWeek := models.SnoozeNextWeek(now, datefmt.WeekStart())
+
+	options := []snoozeOption{
+		{label: "Later today (" + datefmt.Clock(later) + ")", until: &later},
+		{label: "Tomorrow (" + tomorrow.Format("Mon ") + datefmt.Clock(tomorrow) + ")", until: &tomorrow},
+		{label: "Next week (" + datefmt.Day(nextWeek) + ")", until: &nextWeek},
+		{label: "Pick date…", pick: true},
+	}
+	if m.snoozeTarget != nil && m.snoozeTarget.IsSnoozed(now) {
+		options = append(options, snoozeOption{label: "☀ Wake now"})
+	}
+	return options
+}
+
+// openSnoozePicker opens the picker for todo, read once from the store
+// since the list may be stale and the picker renders on every frame.
+func (m *TodosListModel) openSnoozePicker(todo *models.Todo) {
+	target, err := m.store.GetTodo(todo.ID)
+	if err != nil || target == nil {
+		return
+	}
+	m.showSnooze = true
+	m.snoozeTarget = target
+	m.snoozeIndex = 0
+	m.snoozePicking = false
+	m.snoozeErr = ""
+}
+
+func (m *TodosListModel) closeSnoozePicker() {
+	m.showSnooze = false
+	m.snoozeTarget = nil
+	m.snoozePicking = false
+	m.snoozeErr = ""
+	m.snoozeDateInput.SetValue("")
+	m.snoozeDateInput.Blur()
+}
+
+// snoozeTodo defers the picker's target todo until the given time
+// (nil clears the snooze) and reloads.
+func (m *TodosListModel) snoozeTodo(until *time.Time) {
+	if m.snoozeTarget == nil {
+		return
+	}
+	todo := *m.snoozeTarget
+	todo.DeferredUntil = until
+	if err := m.store.UpdateTodo(&todo); err != nil {
+		return
+	}
+	m.LoadTodos()
+}
+
+// rescheduleSelected shifts the selected todo's due date by days, or
+// clears it when clear is set, and persists right away. A todo without a
+// due date is shifted from today. The returned command toasts the new date.
+func (m *TodosListModel) rescheduleSelected(days int, clear bool) tea.Cmd {
+	selected := m.GetSelectedTodo()
+	if selected == nil {
+		return nil
+	}
+	todo := *selected
+	if clear {
+		if todo.DueDate == nil {
+			return nil
+		}
+		todo.DueDate = nil
+	} else {
+		base := startOfDay(time.Now())
+		if todo.DueDate != nil {
+			base = *todo.DueDate
+		}
+		due := base.AddDate(0, 0, days)
+		todo.DueDate = &due
+	}
+	if err := m.store.UpdateTodo(&todo); err != nil {
+		return nil
+	}
+	m.LoadTodos()
+	m.SelectTodoByID(todo.ID)
+
+	text := "📅 Due date cleared"
+	if todo.DueDate != nil {
+		text = "📅 Due " + datefmt.Day(*todo.DueDate)
+	}
+	return func() tea.Msg { return ToastMsg{Text: text} }
+}
+
+// SetPreviewAfterCreate sets whether saving a new todo opens its preview.
+// The new todo is selected either way.
+func (m *TodosListModel) SetPreviewAfterCreate(preview bool) {
+	m.previewAfterCreate = preview
+}
+
+// SelectTodoByID moves the cursor to the todo with id, if it is listed.
+func (m *TodosListModel) SelectTodoByID(id int64) {
+	for i, it := range m.list.Items() {
+		if item, ok := it.(TodoItem); ok && item.todo.ID == id {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
+// PreviewTodo opens the preview of the todo with id, whether or not it is
+// listed. It does nothing, and returns false, while the todo form is open
+// or when the todo does not exist.
+func (m *TodosListModel) PreviewTodo(id int64) bool {
+	if m.showCreate {
+		return false
+	}
+	todo, err := m.store.GetTodo(id)
+	if err != nil || todo == nil {
+		return false
+	}
+	m.showPreview = true
+	m.previewTodo = todo
+	m.previewFields = loadFields(m.store, "todo", todo.ID)
+	return true
+}
+
+// OpenedTodoID returns the todo being previewed or edited, or 0 when none
+// is (a new todo has no ID yet).
+func (m *TodosListModel) OpenedTodoID() int64 {
+	switch {
+	case m.showCreate:
+		return m.editingID
+	case m.showPreview && m.previewTodo != nil:
+		return m.previewTodo.ID
+	}
+	return 0
+}
+
+// renderSnoozePicker renders the snooze picker modal.
+func (m *TodosListModel) renderSnoozePicker() string {
+	if m.snoozePicking {
+		m.helpBar.SetHints([]components.HelpHint{
+			{Key: "Enter", Description: "Snooze", Primary: true},
+			{Key: "Esc", Description: "Back"},
+		})
+		content := lipgloss.JoinVertical(
+			lipgloss.Left,
+			styles.TitleStyle.Render("💤 Snooze Until"),
+			styles.SubtitleStyle.Render(fmt.Sprintf("The todo reappears at %d:00 on that day", models.SnoozeHour)),
+			"",
+			m.snoozeDateInput.View(),
+		)
+		if m.snoozeErr != "" {
+			content = lipgloss.JoinVertical(lipgloss.Left, content, components.FieldError(m.snoozeErr))
+		}
+		return styles.PanelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, content, "", m.helpBar.View()))
+	}
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(styles.SecondaryColor).
+		Bold(true).
+		Background(styles.SurfaceColor).
+		Padding(0, 1)
+
+	normalStyle := lipgloss.NewStyle().
+		Foreground(styles.TextColor).
+		Padding(0, 1)
+
+	var lines []string
+	for i, opt := range m.snoozeOptions(time.Now()) {
+		if i == m.snoozeIndex {
+			lines = append(lines, selectedStyle.Render("▶ "+opt.label))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+opt.label))
+		}
+	}
+
+	m.helpBar.SetHints([]components.HelpHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Snooze", Primary: true},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.TitleStyle.Render("💤 Snooze Todo"),
+		styles.SubtitleStyle.Render("Hide it from the list until it needs attention"),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, lines...),
+		"",
+		m.helpBar.View(),
+	)
+	return styles.PanelStyle.Render(content)
+}
+
 // View renders the todos screen.
 //
 // Phase 4: UX Overhaul
@@ -693,7 +1499,7 @@ func (m *TodosListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *TodosListModel) View() string {
 	// Phase 10: Help modal
 	if m.showHelp {
-		return styles.PanelStyle.Render(m.helpView())
+		return m.helpView()
 	}
 
 	// Phase 3: Preview mode
@@ -701,6 +1507,25 @@ func (m *TodosListModel) View() string {
 		return m.renderPreview()
 	}
 
+	// Phase 6: Batch retag prompt
+	if m.retag.active {
+		label := m.FilterLabel()
+		if label == "" {
+			label = "the current filters"
+		}
+		return m.retag.view(&m.helpBar, label)
+	}
+
+	// Phase 6: Project picker modal
+	if m.showProjectPicker {
+		return m.renderProjectPicker()
+	}
+
+	// Phase 6: Snooze picker modal
+	if m.showSnooze {
+		return m.renderSnoozePicker()
+	}
+
 	// Filter input mode
 	if m.showFilter {
 		filterHints := []components.HelpHint{
@@ -725,17 +1550,8 @@ func (m *TodosListModel) View() string {
 	}
 
 	// Delete confirmation dialog
-	if m.confirmingDelete {
-		m.helpBar.SetHints(components.ConfirmHints)
-		confirmDialog := lipgloss.JoinVertical(
-			lipgloss.Center,
-			styles.TitleStyle.Render("⚠️ Delete Todo?"),
-			"",
-			styles.SubtitleStyle.Render("This action cannot be undone."),
-			"",
-			m.helpBar.View(),
-		)
-		return styles.PanelStyle.Render(confirmDialog)
+	if m.confirmDelete.IsOpen() {
+		return m.confirmDelete.View()
 	}
 
 	if m.showCreate {
@@ -743,10 +1559,17 @@ func (m *TodosListModel) View() string {
 
 		// Show which field is focused
 		titleLabel := styles.SubtitleStyle.Render("Title")
+		estimateLabel := styles.SubtitleStyle.Render("Estimate")
+		dueFieldLabel := styles.SubtitleStyle.Render("Due")
 		descLabel := styles.SubtitleStyle.Render("Description (supports #tags)")
-		if m.titleInput.Focused() {
+		switch {
+		case m.titleInput.Focused():
 			titleLabel = styles.SelectedItemStyle.Render("▶ Title")
-		} else {
+		case m.estimateInput.Focused():
+			estimateLabel = styles.SelectedItemStyle.Render("▶ Estimate")
+		case m.dueInput.Focused():
+			dueFieldLabel = styles.SelectedItemStyle.Render("▶ Due")
+		default:
 			descLabel = styles.SelectedItemStyle.Render("▶ Description (supports #tags)")
 		}
 
@@ -756,25 +1579,46 @@ func (m *TodosListModel) View() string {
 			formTitle = "✅ Edit Todo"
 		}
 
+		formHeader := styles.TitleStyle.Render(formTitle)
+		if m.linkNoteID > 0 {
+			formHeader = lipgloss.JoinVertical(
+				lipgloss.Left,
+				formHeader,
+				styles.SubtitleStyle.Render("🔗 Linked to note: "+m.linkNoteTitle),
+			)
+		}
+
 		form := lipgloss.JoinVertical(
 			lipgloss.Left,
-			styles.TitleStyle.Render(formTitle),
+			formHeader,
 			"",
 			titleLabel,
 			m.titleInput.View(),
-			"",
+			components.FieldError(m.titleErr),
+			estimateLabel,
+			m.estimateInput.View(),
+			components.FieldError(m.estimateErr),
+			dueFieldLabel,
+			m.dueInput.View(),
+			components.FieldError(m.dueErr),
 			descLabel,
 			m.descInput.View(),
-			"",
+			components.FieldError(m.descErr),
 			m.helpBar.View(),
 		)
 		return styles.PanelStyle.Render(form)
 	}
 
-	// Update header with item count
-	m.header.SetItemCount(len(m.list.Items()))
+	// Update header with item count (group headers are not todos)
+	todoCount := 0
+	for _, item := range m.list.Items() {
+		if _, ok := item.(TodoItem); ok {
+			todoCount++
+		}
+	}
+	m.header.SetItemCount(todoCount)
 
-	// Update help hints (with platform-appropriate mod key)
+	// Platform-appropriate mod key for the reset hints
 	mod := keymap.ModKeyDisplay()
 
 	// Get current status filter display
@@ -788,6 +1632,8 @@ func (m *TodosListModel) View() string {
 		statusDesc = "Prog"
 	case models.TodoStatusCompleted:
 		statusDesc = "Done"
+	default:
+		statusDesc = workflow.Get(m.statusFilter).Label
 	}
 
 	// Get current priority filter display (Phase 3)
@@ -810,16 +1656,14 @@ func (m *TodosListModel) View() string {
 		break
 	}
 
-	listHints := []components.HelpHint{
-		{Key: "c", Description: "Create", Primary: true},
-		{Key: "e", Description: "Edit"},
-		{Key: "v", Description: "View"},
-		{Key: "Space", Description: "Toggle"},
-		{Key: "s", Description: m.sortMode.String()},
-		{Key: "f", Description: statusDesc},
-		{Key: "p", Description: priorityDesc},
-		{Key: "t", Description: tagDesc},
-		{Key: mod + "+H", Description: "Home"},
+	listHints := components.WithDescriptions(components.TodosListHints, map[string]string{
+		"s": m.sortMode.String(),
+		"f": statusDesc,
+		"p": priorityDesc,
+		"t": tagDesc,
+	})
+	if m.showTable {
+		listHints = components.TodosTableHints
 	}
 	m.helpBar.SetHints(listHints)
 
@@ -837,6 +1681,15 @@ func (m *TodosListModel) View() string {
 	if len(m.selectedTags) > 0 {
 		filterParts = append(filterParts, "tag:"+tagDesc)
 	}
+	if m.projectFilter != "" {
+		filterParts = append(filterParts, "project:"+m.projectFilter)
+	}
+	if m.showSnoozed {
+		filterParts = append(filterParts, "snoozed:shown")
+	}
+	if m.staleOnly {
+		filterParts = append(filterParts, "stale")
+	}
 
 	var filterStatus string
 	if len(filterParts) > 0 {
@@ -848,15 +1701,26 @@ func (m *TodosListModel) View() string {
 	}
 
 	// Sort indicator
+	sortLabel := "⬡ Sort: " + m.sortMode.String()
+	if m.grouping != todoGroupNone {
+		sortLabel += " • Grouped by " + m.grouping.String()
+	}
+	if m.snoozedCount > 0 {
+		sortLabel += fmt.Sprintf(" • 💤 %d snoozed [Z show]", m.snoozedCount)
+	}
 	sortIndicator := lipgloss.NewStyle().
 		Foreground(styles.SecondaryColor).
-		Render("⬡ Sort: " + m.sortMode.String())
+		Render(sortLabel)
 
 	// Empty state
 	if len(m.list.Items()) == 0 {
 		emptyMsg := "No todos yet. Add something to get done!"
-		if m.filter != "" || m.statusFilter != "" || m.priorityFilter >= 0 || len(m.selectedTags) > 0 {
+		if m.staleOnly {
+			emptyMsg = "No stale todos. Press [a] to show all todos."
+		} else if m.filter != "" || m.statusFilter != "" || m.priorityFilter >= 0 || len(m.selectedTags) > 0 || m.projectFilter != "" {
 			emptyMsg = "No todos match your filters. Press [" + mod + "+R] to reset."
+		} else if m.snoozedCount > 0 {
+			emptyMsg = fmt.Sprintf("All clear for now. %d snoozed todo(s) will return; press [Z] to show them.", m.snoozedCount)
 		}
 		emptyState := lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -871,24 +1735,26 @@ func (m *TodosListModel) View() string {
 		return styles.PanelStyle.Render(emptyState)
 	}
 
-	// Regular list view with header and help bar
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		m.header.View(),
-		sortIndicator,
-	)
+	// Regular list view: the list (or table) fills what the header, sort
+	// and filter lines and help bar leave
+	top := lipgloss.JoinVertical(lipgloss.Left, m.header.View(), sortIndicator)
 	if filterStatus != "" {
-		content = lipgloss.JoinVertical(lipgloss.Left, content, filterStatus)
+		top = lipgloss.JoinVertical(lipgloss.Left, top, filterStatus)
 	}
-	content = lipgloss.JoinVertical(
-		lipgloss.Left,
-		content,
-		"",
-		m.list.View(),
-		"",
-		m.helpBar.View(),
-	)
-	return content
+	top = lipgloss.JoinVertical(lipgloss.Left, top, "")
+	bottom := lipgloss.JoinVertical(lipgloss.Left, "", m.helpBar.View())
+	rows := components.FillHeight(m.height-components.AppChrome, minListRows, top, bottom)
+
+	var listView string
+	if m.showTable {
+		m.tableRows = rows
+		m.table.SetHeight(m.tableHeight())
+		listView = m.renderTable()
+	} else {
+		m.list.SetSize(m.width-4, rows)
+		listView = m.list.View()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, top, listView, bottom)
 }
 
 // renderPreview renders the full todo details in preview mode (Phase 3).
@@ -899,23 +1765,21 @@ func (m *TodosListModel) renderPreview() string {
 	}
 
 	// Preview hints
-	previewHints := []components.HelpHint{
-		{Key: "e", Description: "Edit", Primary: true},
-		{Key: "d", Description: "Delete"},
-		{Key: "Esc", Description: "Close"},
-	}
-	m.helpBar.SetHints(previewHints)
+	m.helpBar.SetHints(components.TodosPreviewHints)
 
 	// Status badge
 	var statusBadge string
 	statusStyle := lipgloss.NewStyle().Padding(0, 1).Bold(true)
 	switch todo.Status {
 	case models.TodoStatusPending:
-		statusBadge = statusStyle.Background(styles.CreamYellow).Foreground(lipgloss.Color("#000")).Render("PENDING")
+		statusBadge = statusStyle.Background(styles.CreamYellow).Foreground(styles.BackgroundColor).Render("PENDING")
 	case models.TodoStatusInProgress:
-		statusBadge = statusStyle.Background(styles.SecondaryColor).Foreground(lipgloss.Color("#000")).Render("IN PROGRESS")
+		statusBadge = statusStyle.Background(styles.SecondaryColor).Foreground(styles.BackgroundColor).Render("IN PROGRESS")
 	case models.TodoStatusCompleted:
-		statusBadge = statusStyle.Background(styles.SuccessColor).Foreground(lipgloss.Color("#000")).Render("COMPLETED")
+		statusBadge = statusStyle.Background(styles.SuccessColor).Foreground(styles.BackgroundColor).Render("COMPLETED")
+	default:
+		status := workflow.Get(todo.Status)
+		statusBadge = statusStyle.Background(statusColor(todo.Status)).Foreground(styles.BackgroundColor).Render(strings.ToUpper(status.Label))
 	}
 
 	// Priority badge
@@ -923,11 +1787,11 @@ func (m *TodosListModel) renderPreview() string {
 	priorityStyle := lipgloss.NewStyle().Padding(0, 1)
 	switch todo.Priority {
 	case models.TodoPriorityHigh:
-		priorityBadge = priorityStyle.Background(styles.ErrorColor).Foreground(lipgloss.Color("#fff")).Render("HIGH")
+		priorityBadge = priorityStyle.Background(styles.ErrorColor).Foreground(styles.BackgroundColor).Render("HIGH")
 	case models.TodoPriorityMedium:
-		priorityBadge = priorityStyle.Background(styles.CreamYellow).Foreground(lipgloss.Color("#000")).Render("MEDIUM")
+		priorityBadge = priorityStyle.Background(styles.CreamYellow).Foreground(styles.BackgroundColor).Render("MEDIUM")
 	case models.TodoPriorityLow:
-		priorityBadge = priorityStyle.Background(styles.MutedColor).Foreground(lipgloss.Color("#000")).Render("LOW")
+		priorityBadge = priorityStyle.Background(styles.MutedColor).Foreground(styles.BackgroundColor).Render("LOW")
 	}
 
 	// Tags
@@ -940,27 +1804,16 @@ func (m *TodosListModel) renderPreview() string {
 			Padding(0, 1)
 		tagStrs := make([]string, len(tags))
 		for i, tag := range tags {
-			tagStrs[i] = tagStyle.Render("#" + tag)
+			tagStrs[i] = styles.ColorTag(tagStyle, tag).Render(styles.TagText(tag))
 		}
 		tagsLine = strings.Join(tagStrs, " ")
 	}
 
 	// Dates
-	createdStr := todo.CreatedAt.Format("Jan 2, 2006 3:04 PM")
+	createdStr := datefmt.DateTime(todo.CreatedAt)
 	var dueStr string
 	if todo.DueDate != nil {
-		dueStr = todo.DueDate.Format("Jan 2, 2006")
-		// Add relative time
-		daysUntil := int(time.Until(*todo.DueDate).Hours() / 24)
-		if daysUntil < 0 {
-			dueStr += fmt.Sprintf(" (overdue by %d days)", -daysUntil)
-		} else if daysUntil == 0 {
-			dueStr += " (today!)"
-		} else if daysUntil == 1 {
-			dueStr += " (tomorrow)"
-		} else if daysUntil <= 7 {
-			dueStr += fmt.Sprintf(" (in %d days)", daysUntil)
-		}
+		dueStr = previewDue(*todo.DueDate, time.Now())
 	}
 
 	// Build preview content
@@ -986,6 +1839,11 @@ func (m *TodosListModel) renderPreview() string {
 		"",
 	)
 
+	if todo.Project != "" {
+		projectLine := lipgloss.NewStyle().Foreground(styles.SecondaryColor).Render("📁 " + todo.Project)
+		content = lipgloss.JoinVertical(lipgloss.Left, content, projectLine, "")
+	}
+
 	if tagsLine != "" {
 		content = lipgloss.JoinVertical(lipgloss.Left, content, tagsLine, "")
 	}
@@ -1008,6 +1866,9 @@ func (m *TodosListModel) renderPreview() string {
 		"",
 		labelStyle.Render("Created"),
 		styles.SubtitleStyle.Render(createdStr),
+		"",
+		labelStyle.Render("Link"),
+		styles.SubtitleStyle.Render(fmt.Sprintf("ID %d · %s", todo.ID, deeplink.URI(deeplink.KindTodo, todo.ID))),
 	)
 
 	if dueStr != "" {
@@ -1020,6 +1881,33 @@ func (m *TodosListModel) renderPreview() string {
 		)
 	}
 
+	if est := models.FormatMinutes(todo.EstimateMinutes); est != "" {
+		content = lipgloss.JoinVertical(
+			lipgloss.Left,
+			content,
+			"",
+			labelStyle.Render("Estimate"),
+			styles.SubtitleStyle.Render("⏱ "+est),
+		)
+	}
+
+	if todo.IsSnoozed(time.Now()) {
+		content = lipgloss.JoinVertical(
+			lipgloss.Left,
+			content,
+			"",
+			labelStyle.Render("Snoozed Until"),
+			styles.SubtitleStyle.Render("💤 "+datefmt.DayTime(*todo.DeferredUntil)),
+		)
+	}
+
+	if fields := renderFields(m.previewFields); fields != "" {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", fields)
+	}
+	if m.fieldEditor.open {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", m.fieldEditor.View())
+	}
+
 	content = lipgloss.JoinVertical(
 		lipgloss.Left,
 		content,
@@ -1045,14 +1933,36 @@ type TodoItem struct {
 	todo models.Todo
 }
 
+// todoItemKey keeps the selected todo selected when the list reloads.
+func todoItemKey(item list.Item) (int64, bool) {
+	t, ok := item.(TodoItem)
+	return t.todo.ID, ok
+}
+
+// todoStatusIcon returns the list indicator for a todo status: ○
+// pending, ◐ in progress, ✓ completed, or a custom status's icon.
+func todoStatusIcon(status models.TodoStatus) string {
+	return workflow.Get(status).Icon
+}
+
+// nextStatusFilter returns the status filter after current: each status
+// in workflow order, then "" for all.
+func nextStatusFilter(current models.TodoStatus) models.TodoStatus {
+	statuses := workflow.Statuses()
+	if current == "" {
+		return statuses[0].Name
+	}
+	for i, s := range statuses {
+		if s.Name == current && i+1 < len(statuses) {
+			return statuses[i+1].Name
+		}
+	}
+	return ""
+}
+
 func (t TodoItem) Title() string {
 	// Status indicator with color hint
-	status := "○"  // Pending (hollow circle)
-	if t.todo.Status == models.TodoStatusCompleted {
-		status = "✓" // Completed (checkmark)
-	} else if t.todo.Status == models.TodoStatusInProgress {
-		status = "◐" // In progress (half circle)
-	}
+	status := todoStatusIcon(t.todo.Status)
 
 	// Priority indicator
 	priority := ""
@@ -1065,7 +1975,7 @@ func (t TodoItem) Title() string {
 	// Due date indicator
 	dueIndicator := ""
 	if t.todo.DueDate != nil {
-		daysUntil := int(time.Until(*t.todo.DueDate).Hours() / 24)
+		_, daysUntil := dueLabel(*t.todo.DueDate, time.Now())
 		if daysUntil < 0 {
 			dueIndicator = " ⚠️" // Overdue
 		} else if daysUntil == 0 {
@@ -1075,12 +1985,21 @@ func (t TodoItem) Title() string {
 		}
 	}
 
-	return fmt.Sprintf("%s %s%s%s", status, t.todo.Title, priority, dueIndicator)
+	star := ""
+	if t.todo.Starred {
+		star = "★ "
+	}
+	return fmt.Sprintf("%s %s%s%s%s", status, star, t.todo.Title, priority, dueIndicator)
 }
 
 func (t TodoItem) Description() string {
 	parts := []string{}
 
+	// Project (Phase 6)
+	if t.todo.Project != "" {
+		parts = append(parts, "📁 "+t.todo.Project)
+	}
+
 	// Tags (Phase 3)
 	tags := extractTagsFromTodo(&t.todo)
 	if len(tags) > 0 {
@@ -1096,24 +2015,24 @@ func (t TodoItem) Description() string {
 		parts = append(parts, strings.Join(tagStrs, " "))
 	}
 
+	// Estimate (Phase 6)
+	if est := models.FormatMinutes(t.todo.EstimateMinutes); est != "" {
+		parts = append(parts, "⏱ "+est)
+	}
+
+	// Snooze (Phase 6), only visible when snoozed todos are shown
+	if t.todo.IsSnoozed(time.Now()) {
+		parts = append(parts, "💤 until "+datefmt.ShortDateTime(*t.todo.DeferredUntil))
+	}
+
 	// Due date (Phase 3)
 	if t.todo.DueDate != nil {
-		daysUntil := int(time.Until(*t.todo.DueDate).Hours() / 24)
-		var dueStr string
-		if daysUntil < 0 {
-			dueStr = fmt.Sprintf("Overdue %d days", -daysUntil)
-		} else if daysUntil == 0 {
-			dueStr = "Due today"
-		} else if daysUntil == 1 {
-			dueStr = "Due tomorrow"
-		} else if daysUntil <= 7 {
-			dueStr = fmt.Sprintf("Due in %d days", daysUntil)
-		} else {
-			dueStr = "Due " + t.todo.DueDate.Format("Jan 2")
-		}
+		dueStr, _ := dueLabel(*t.todo.DueDate, time.Now())
 		parts = append(parts, dueStr)
 	}
 
+	parts = append(parts, "edited "+datefmt.Age(t.todo.UpdatedAt))
+
 	// Description preview
 	if t.todo.Description != "" {
 		// Remove hashtags from preview (already shown separately)
@@ -1127,57 +2046,96 @@ func (t TodoItem) Description() string {
 		}
 	}
 
-	if len(parts) == 0 {
-		return "No description"
+	return strings.Join(parts, " • ")
+}
+
+// previewDue writes a due date for the preview with its distance from
+// now: a countdown to a due time ("due in 3h 20m"), or calendar days.
+func previewDue(due, now time.Time) string {
+	if models.DueHasTime(due) {
+		// Phase 6: a precise countdown to a due time
+		return datefmt.DateTime(due) + " (" + models.FormatCountdown(due, now) + ")"
 	}
+	text := datefmt.Date(due)
+	switch daysUntil := models.DaysUntil(due, now); {
+	case daysUntil < 0:
+		text += fmt.Sprintf(" (overdue by %d days)", -daysUntil)
+	case daysUntil == 0:
+		text += " (today!)"
+	case daysUntil == 1:
+		text += " (tomorrow)"
+	case daysUntil <= 7:
+		text += fmt.Sprintf(" (in %d days)", daysUntil)
+	}
+	return text
+}
 
-	return strings.Join(parts, " • ")
+// dueLabel describes a due date relative to now ("Due today", "Overdue 3
+// days") and returns the calendar days until it, negative when overdue.
+// A due time within a day either way is counted down ("Due in 3h 20m").
+func dueLabel(due, now time.Time) (string, int) {
+	until := due.Sub(now)
+	daysUntil := models.DaysUntil(due, now)
+	timed := models.DueHasTime(due)
+	if timed && until > -24*time.Hour && until < 24*time.Hour {
+		countdown := models.FormatCountdown(due, now)
+		if until < 0 {
+			daysUntil = min(daysUntil, -1)
+		}
+		return strings.ToUpper(countdown[:1]) + countdown[1:], daysUntil
+	}
+	switch {
+	case daysUntil < 0:
+		return fmt.Sprintf("Overdue %d days", -daysUntil), daysUntil
+	case daysUntil == 0:
+		return "Due today", daysUntil
+	case daysUntil == 1 && timed:
+		return "Due tomorrow " + datefmt.Clock(due), daysUntil
+	case daysUntil == 1:
+		return "Due tomorrow", daysUntil
+	case daysUntil <= 7:
+		return fmt.Sprintf("Due in %d days", daysUntil), daysUntil
+	case timed:
+		return "Due " + datefmt.ShortDateTime(due), daysUntil
+	default:
+		return "Due " + datefmt.Short(due), daysUntil
+	}
 }
 
 func (t TodoItem) FilterValue() string {
 	return t.todo.Title + " " + t.todo.Description
 }
 
+// projectHeaderItem is a group heading shown when the list is grouped by
+// project. It is not a TodoItem, so todo actions ignore it.
+type projectHeaderItem struct {
+	name      string
+	count     int
+	collapsed bool
+}
+
+func (h projectHeaderItem) Title() string {
+	name := h.name
+	if name == "" {
+		name = "No project"
+	}
+	return fmt.Sprintf("%s 📁 %s (%d)", groupArrow(h.collapsed), strings.ToUpper(name), h.count)
+}
+
+func (h projectHeaderItem) Description() string { return "" }
+
+func (h projectHeaderItem) FilterValue() string { return "" }
+
 // helpView renders the help modal for the todos screen.
 func (m *TodosListModel) helpView() string {
-	title := styles.TitleStyle.Render("✅ TODOS - Help")
-
-	helpText := `Manage your tasks with flexible sorting, filtering, and tagging.
-
-` + styles.SelectedItemStyle.Render("List Navigation:") + `
-• ` + styles.NeonStyle.Render("j/k") + ` or Arrow Keys: Move selection up/down
-• ` + styles.NeonStyle.Render("Space") + `: Toggle todo completion status
-• ` + styles.NeonStyle.Render("v") + `: View full todo details (preview mode)
-• ` + styles.NeonStyle.Render("c") + `: Create new todo
-• ` + styles.NeonStyle.Render("e") + `: Edit selected todo
-• ` + styles.NeonStyle.Render("d") + `: Delete selected todo
-
-` + styles.SelectedItemStyle.Render("Sorting & Filtering:") + `
-• ` + styles.NeonStyle.Render("s") + `: Cycle sort mode (Date↓ → Priority → Date↑ → A-Z → Due Date)
-• ` + styles.NeonStyle.Render("f") + `: Cycle status filter (All → Pending → In Progress → Completed)
-• ` + styles.NeonStyle.Render("p") + `: Cycle priority filter (All → High → Medium → Low)
-• ` + styles.NeonStyle.Render("t") + `: Cycle tag filter
-• ` + styles.NeonStyle.Render("/") + `: Open search filter
-• ` + styles.NeonStyle.Render("Ctrl+R") + `: Reset all filters
-
-` + styles.SelectedItemStyle.Render("In Create/Edit Mode:") + `
-• ` + styles.NeonStyle.Render("Tab") + `: Switch between title and description fields
-• ` + styles.NeonStyle.Render("Ctrl+S") + ` or Enter (in title): Save todo
-• ` + styles.NeonStyle.Render("Esc") + `: Cancel editing
-
-` + styles.SelectedItemStyle.Render("Tips:") + `
-• Use #hashtags in title or description to add tags
-• Priority indicators: 🔴 high, 🟢 low
-• Due date indicators: ⚠️ overdue, 📅 today, ⏰ soon`
-
-	help := styles.HelpStyle.Render("Press any key to close")
-
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		title,
-		"",
-		helpText,
-		"",
-		help,
-	)
+	modal := components.NewHelpModal("Todos - Keyboard Shortcuts", m.HelpSections())
+	modal.SetSize(m.width, m.height)
+
+	tipStyle := lipgloss.NewStyle().Foreground(styles.MutedColor)
+	tips := tipStyle.Render(`• Use #hashtags in title or description to add tags
+• Each row's stripe shows its priority: pink high, yellow medium, cyan low
+• Due dates turn pink when overdue and yellow when due within 3 days
+• Estimates accept 30, 45m, 1h30m or 1.5h and show as ⏱`)
+
+	return lipgloss.JoinVertical(lipgloss.Left, modal.View(), tips)
 }
@@ -0,0 +1,197 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Todo grouping (Phase 6: Projects).
+//
+// b cycles the todos list through no grouping, grouping by project and
+// grouping by linked note, so the work behind a note ("📝 Thesis — 4
+// open") reads at a glance. Enter or Space on a group header collapses or
+// expands the group; collapsed groups stay collapsed until expanded.
+
+// todoGrouping is how the todos list is grouped.
+type todoGrouping int
+
+const (
+	todoGroupNone todoGrouping = iota
+	todoGroupProject
+	todoGroupNote
+)
+
+// String names the grouping for the sort indicator.
+func (g todoGrouping) String() string {
+	switch g {
+	case todoGroupProject:
+		return "project"
+	case todoGroupNote:
+		return "linked note"
+	}
+	return "none"
+}
+
+// next returns the grouping g cycles to.
+func (g todoGrouping) next() todoGrouping {
+	return (g + 1) % 3
+}
+
+// groupKey identifies a group in collapsedGroups.
+func (g todoGrouping) groupKey(todo models.Todo) string {
+	if g == todoGroupNote {
+		if todo.NoteID == nil {
+			return "note:"
+		}
+		return fmt.Sprintf("note:%d", *todo.NoteID)
+	}
+	return "project:" + todo.Project
+}
+
+// noteHeaderItem heads the todos linked to one note when the list is
+// grouped by linked note. It is not a TodoItem, so todo actions ignore it.
+type noteHeaderItem struct {
+	key       string
+	title     string // "" for todos without a linked note
+	open      int
+	done      int
+	collapsed bool
+}
+
+func (h noteHeaderItem) Title() string {
+	title := "No linked note"
+	if h.title != "" {
+		title = h.title
+	}
+	counts := fmt.Sprintf("%d open", h.open)
+	if h.done > 0 {
+		counts += fmt.Sprintf(", %d done", h.done)
+	}
+	return fmt.Sprintf("%s 📝 %s — %s", groupArrow(h.collapsed), title, counts)
+}
+
+func (h noteHeaderItem) Description() string { return "" }
+
+func (h noteHeaderItem) FilterValue() string { return "" }
+
+// groupArrow shows whether a group is collapsed.
+func groupArrow(collapsed bool) string {
+	if collapsed {
+		return "▸"
+	}
+	return "▾"
+}
+
+// groupTodosByNote orders todos by the title of their linked note
+// (unlinked last), keeping the current sort within each note, and inserts
+// a header before each group. titles maps note IDs to titles; todos of
+// collapsed groups are left out.
+func groupTodosByNote(todos []models.Todo, titles map[int64]string, collapsed map[string]bool) []list.Item {
+	title := func(todo models.Todo) string {
+		if todo.NoteID == nil {
+			return ""
+		}
+		if t, ok := titles[*todo.NoteID]; ok {
+			return t
+		}
+		return "Deleted note"
+	}
+	sort.SliceStable(todos, func(i, j int) bool {
+		ti, tj := title(todos[i]), title(todos[j])
+		if (ti == "") != (tj == "") {
+			return tj == ""
+		}
+		if !strings.EqualFold(ti, tj) {
+			return strings.ToLower(ti) < strings.ToLower(tj)
+		}
+		// Notes sharing a title stay apart
+		return noteIDOf(todos[i]) < noteIDOf(todos[j])
+	})
+
+	var items []list.Item
+	for i := 0; i < len(todos); {
+		key := todoGroupNote.groupKey(todos[i])
+		header := noteHeaderItem{key: key, title: title(todos[i]), collapsed: collapsed[key]}
+		j := i
+		for ; j < len(todos) && todoGroupNote.groupKey(todos[j]) == key; j++ {
+			if todos[j].Status == models.TodoStatusCompleted {
+				header.done++
+			} else {
+				header.open++
+			}
+		}
+		items = append(items, header)
+		if !header.collapsed {
+			for _, todo := range todos[i:j] {
+				items = append(items, TodoItem{todo: todo})
+			}
+		}
+		i = j
+	}
+	return items
+}
+
+// noteIDOf returns the todo's linked note ID, or 0.
+func noteIDOf(todo models.Todo) int64 {
+	if todo.NoteID == nil {
+		return 0
+	}
+	return *todo.NoteID
+}
+
+// linkedNoteTitles looks up the titles of the notes todos link to. Notes
+// that no longer exist are left out.
+func (m *TodosListModel) linkedNoteTitles(todos []models.Todo) map[int64]string {
+	titles := make(map[int64]string)
+	for _, todo := range todos {
+		if todo.NoteID == nil {
+			continue
+		}
+		id := *todo.NoteID
+		if _, seen := titles[id]; seen {
+			continue
+		}
+		if note, err := m.store.GetNote(id); err == nil && note != nil {
+			titles[id] = note.Title
+		}
+	}
+	return titles
+}
+
+// groupHeaderKey returns the collapsedGroups key of a group header item,
+// or "" for a todo.
+func groupHeaderKey(item list.Item) string {
+	switch item := item.(type) {
+	case projectHeaderItem:
+		return "project:" + item.name
+	case noteHeaderItem:
+		return item.key
+	}
+	return ""
+}
+
+// toggleGroup collapses or expands the group under the cursor and keeps
+// its header selected. It reports whether a header was selected.
+func (m *TodosListModel) toggleGroup() bool {
+	key := groupHeaderKey(m.list.SelectedItem())
+	if key == "" {
+		return false
+	}
+	if m.collapsedGroups == nil {
+		m.collapsedGroups = make(map[string]bool)
+	}
+	m.collapsedGroups[key] = !m.collapsedGroups[key]
+	m.LoadTodos()
+	for i, item := range m.list.Items() {
+		if groupHeaderKey(item) == key {
+			m.list.Select(i)
+			break
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func TestTodosGroupByLinkedNote(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	thesis := &models.Note{Title: "Thesis"}
+	if err := m.store.CreateNote(thesis); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	for _, todo := range []*models.Todo{
+		{Title: "Write chapter 2", Status: models.TodoStatusPending, NoteID: &thesis.ID},
+		{Title: "Book the defense", Status: models.TodoStatusPending, NoteID: &thesis.ID},
+		{Title: "Outline", Status: models.TodoStatusCompleted, NoteID: &thesis.ID},
+		{Title: "Buy milk", Status: models.TodoStatusPending},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+
+	// b cycles: project, then linked note
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if m.grouping != todoGroupNote {
+		t.Fatalf("grouping = %v, want linked note", m.grouping)
+	}
+	items := m.list.Items()
+	if len(items) != 6 {
+		t.Fatalf("expected 2 headers + 4 todos, got %d items", len(items))
+	}
+	if got := items[0].(noteHeaderItem).Title(); got != "▾ 📝 Thesis — 2 open, 1 done" {
+		t.Errorf("first header = %q", got)
+	}
+	if got := items[4].(noteHeaderItem).Title(); got != "▾ 📝 No linked note — 1 open" {
+		t.Errorf("last header = %q", got)
+	}
+
+	// Enter on a header collapses its group and keeps it selected
+	m.list.Select(0)
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	items = m.list.Items()
+	if len(items) != 3 {
+		t.Fatalf("expected Thesis collapsed to its header, got %d items", len(items))
+	}
+	if header, ok := m.list.SelectedItem().(noteHeaderItem); !ok || !header.collapsed {
+		t.Fatalf("expected the collapsed header selected, got %#v", m.list.SelectedItem())
+	}
+
+	// Space expands it again rather than toggling a todo
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if len(m.list.Items()) != 6 {
+		t.Fatalf("expected Thesis expanded, got %d items", len(m.list.Items()))
+	}
+
+	// A third b turns grouping off
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if len(m.list.Items()) != 4 {
+		t.Fatalf("expected 4 ungrouped todos, got %d items", len(m.list.Items()))
+	}
+
+	// g stays the list's jump to the top
+	m.list.Select(3)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if m.list.Index() != 0 || m.grouping != todoGroupNone {
+		t.Fatalf("g: index = %d, grouping = %v; want the top, ungrouped", m.list.Index(), m.grouping)
+	}
+}
@@ -0,0 +1,368 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/datefmt"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+	"github.com/Jericoz-JC/flowState-CLI/internal/workflow"
+)
+
+// Table view for todos (Phase 9: Component Library).
+//
+// T switches the todos list between cards and a table with one row per
+// todo: title, status, priority, due, tags and age. The table shows the
+// same todos as the list (filters apply) and keeps the list's selection in
+// step, so edit, toggle, delete and the other list keys act on the
+// highlighted row.
+//
+// Keyboard Shortcuts (table view):
+//   - 1-6: Sort by that column; pressing it again reverses the order
+//   - ←/→ or h/l: Scroll the columns after Title sideways
+//   - T/Esc: Back to the card list
+
+// todoColumn identifies a todo table column.
+type todoColumn int
+
+const (
+	todoColTitle todoColumn = iota
+	todoColStatus
+	todoColPriority
+	todoColDue
+	todoColTags
+	todoColAge
+	todoColCount
+
+	todoColNone todoColumn = -1 // Keep the list's sort order
+)
+
+// todoColumns are the table columns in display order. Title takes the
+// width left over by the others, but at least minTitleWidth.
+var todoColumns = [todoColCount]struct {
+	title string
+	width int
+}{
+	{"Title", 0},
+	{"Status", 11},
+	{"Priority", 10},
+	{"Due", 10},
+	{"Tags", 18},
+	{"Age", 7},
+}
+
+// minTitleWidth is the narrowest the Title column gets before other
+// columns scroll out of view.
+const minTitleWidth = 24
+
+// tableCellPadding is the horizontal padding the table adds per cell.
+const tableCellPadding = 2
+
+// newTodoTable creates the table with navigation keys that do not clash
+// with the list's single-letter actions.
+func newTodoTable() table.Model {
+	km := table.KeyMap{
+		LineUp:     key.NewBinding(key.WithKeys("up", "k")),
+		LineDown:   key.NewBinding(key.WithKeys("down", "j")),
+		PageUp:     key.NewBinding(key.WithKeys("pgup")),
+		PageDown:   key.NewBinding(key.WithKeys("pgdown")),
+		GotoTop:    key.NewBinding(key.WithKeys("home", "g")),
+		GotoBottom: key.NewBinding(key.WithKeys("end", "G")),
+	}
+	s := table.DefaultStyles()
+	s.Header = s.Header.Foreground(styles.PrimaryColor).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		BorderForeground(styles.BorderColor)
+	s.Selected = s.Selected.Foreground(styles.AccentColor)
+	return table.New(table.WithKeyMap(km), table.WithStyles(s), table.WithFocused(true))
+}
+
+// openTable switches to the table view, keeping the selected todo.
+func (m *TodosListModel) openTable() {
+	m.showTable = true
+	m.tableScroll = 0
+	m.refreshTable()
+}
+
+// refreshTable rebuilds the table rows from the listed todos, sorted by
+// the table's sort column, and keeps the selected todo highlighted.
+func (m *TodosListModel) refreshTable() {
+	var selectedID int64
+	if selected := m.GetSelectedTodo(); selected != nil {
+		selectedID = selected.ID
+	}
+
+	todos := make([]models.Todo, 0, len(m.list.Items()))
+	for _, item := range m.list.Items() {
+		if ti, ok := item.(TodoItem); ok {
+			todos = append(todos, ti.todo)
+		}
+	}
+	if m.tableSort != todoColNone {
+		sortTodosByColumn(todos, m.tableSort, m.tableSortDesc)
+	}
+	m.tableTodos = todos
+
+	now := time.Now()
+	rows := make([]table.Row, len(todos))
+	cursor := 0
+	for i, todo := range todos {
+		rows[i] = todoTableRow(todo, now)
+		if todo.ID == selectedID {
+			cursor = i
+		}
+	}
+	m.layoutTable()
+	m.table.SetRows(rows)
+	m.table.SetCursor(cursor)
+	m.syncTableSelection()
+}
+
+// layoutTable sizes the columns for the current width and scroll offset.
+// Columns scrolled past, or that do not fit, get width 0 and are hidden.
+func (m *TodosListModel) layoutTable() {
+	avail := m.width - 4
+	used, full := 0, false
+	cols := make([]table.Column, todoColCount)
+	for c := todoColStatus; c < todoColCount; c++ {
+		cols[c] = table.Column{Title: m.columnTitle(c)}
+		if int(c)-1 < m.tableScroll || full {
+			continue
+		}
+		w := todoColumns[c].width + tableCellPadding
+		if used+w > avail-minTitleWidth-tableCellPadding {
+			full = true
+			continue
+		}
+		cols[c].Width = todoColumns[c].width
+		used += w
+	}
+	cols[todoColTitle] = table.Column{Title: m.columnTitle(todoColTitle), Width: avail - used - tableCellPadding}
+	m.table.SetColumns(cols)
+	m.table.SetWidth(avail)
+	m.table.SetHeight(m.tableHeight())
+}
+
+// tableHeight is the table's height within tableRows, less a row for the
+// scroll hint when columns are hidden.
+func (m *TodosListModel) tableHeight() int {
+	height := m.tableRows
+	if left, right := m.hiddenColumns(); left || right {
+		height--
+	}
+	return max(height, minListRows)
+}
+
+// columnTitle returns the header for column c with its number key and,
+// when the table is sorted by it, the direction.
+func (m *TodosListModel) columnTitle(c todoColumn) string {
+	title := fmt.Sprintf("%d %s", c+1, todoColumns[c].title)
+	if c == m.tableSort {
+		if m.tableSortDesc {
+			return title + " ▼"
+		}
+		return title + " ▲"
+	}
+	return title
+}
+
+// hiddenColumns reports whether columns are scrolled out on the left or
+// cut off on the right.
+func (m *TodosListModel) hiddenColumns() (left, right bool) {
+	cols := m.table.Columns()
+	for c := todoColStatus; c < todoColumn(len(cols)); c++ {
+		if cols[c].Width > 0 {
+			continue
+		}
+		if int(c)-1 < m.tableScroll {
+			left = true
+		} else {
+			right = true
+		}
+	}
+	return left, right
+}
+
+// syncTableSelection selects the highlighted row's todo in the list, so
+// list actions apply to it.
+func (m *TodosListModel) syncTableSelection() {
+	if c := m.table.Cursor(); c >= 0 && c < len(m.tableTodos) {
+		m.SelectTodoByID(m.tableTodos[c].ID)
+	}
+}
+
+// handleTableKey handles keys specific to the table view. It reports
+// whether the key was handled; other keys fall through to the list.
+func (m *TodosListModel) handleTableKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "T", "esc":
+		m.showTable = false
+		return nil, true
+	case "1", "2", "3", "4", "5", "6":
+		c := todoColumn(msg.String()[0] - '1')
+		if c == m.tableSort {
+			m.tableSortDesc = !m.tableSortDesc
+		} else {
+			m.tableSort, m.tableSortDesc = c, false
+		}
+		m.refreshTable()
+		return nil, true
+	case "left", "h":
+		if m.tableScroll > 0 {
+			m.tableScroll--
+			m.layoutTable()
+		}
+		return nil, true
+	case "right", "l":
+		if _, right := m.hiddenColumns(); right {
+			m.tableScroll++
+			m.layoutTable()
+		}
+		return nil, true
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "g", "end", "G":
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		m.syncTableSelection()
+		return cmd, true
+	}
+	return nil, false
+}
+
+// renderTable renders the table with a scroll hint when columns are hidden.
+func (m *TodosListModel) renderTable() string {
+	view := m.table.View()
+	left, right := m.hiddenColumns()
+	if !left && !right {
+		return view
+	}
+	hint := "columns: "
+	if left {
+		hint += "← more "
+	}
+	if right {
+		hint += "more →"
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, view,
+		lipgloss.NewStyle().Foreground(styles.MutedColor).Render(strings.TrimSpace(hint)))
+}
+
+// todoTableRow renders a todo's cells.
+func todoTableRow(todo models.Todo, now time.Time) table.Row {
+	status := map[models.TodoStatus]string{
+		models.TodoStatusPending:    "○ Pending",
+		models.TodoStatusInProgress: "◐ Doing",
+		models.TodoStatusCompleted:  "✓ Done",
+	}[todo.Status]
+	if status == "" {
+		s := workflow.Get(todo.Status)
+		status = s.Icon + " " + s.Label
+	}
+	priority := map[models.TodoPriority]string{
+		models.TodoPriorityHigh:   "High",
+		models.TodoPriorityMedium: "Medium",
+		models.TodoPriorityLow:    "Low",
+	}[todo.Priority]
+
+	due := ""
+	if todo.DueDate != nil {
+		due = datefmt.Short(*todo.DueDate)
+		overdueFrom := startOfDay(now)
+		if models.DueHasTime(*todo.DueDate) {
+			due = datefmt.ShortDateTime(*todo.DueDate)
+			overdueFrom = now
+		}
+		if todo.DueDate.Before(overdueFrom) && todo.Status != models.TodoStatusCompleted {
+			due = "⚠ " + due
+		}
+	}
+
+	tags := extractTagsFromTodo(&todo)
+	for i, tag := range tags {
+		tags[i] = "#" + tag
+	}
+
+	title := todo.Title
+	if todo.Starred {
+		title = "★ " + title
+	}
+	return table.Row{title, status, priority, due, strings.Join(tags, " "), formatAge(now.Sub(todo.CreatedAt))}
+}
+
+// formatAge renders how long ago something was created: 5m, 3h, 4d, 2w,
+// 6mo or 1y.
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 14*24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d < 60*24*time.Hour:
+		return fmt.Sprintf("%dw", int(d.Hours()/(24*7)))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%dmo", int(d.Hours()/(24*30)))
+	default:
+		return fmt.Sprintf("%dy", int(d.Hours()/(24*365)))
+	}
+}
+
+// todoStatusRank orders statuses as the workflow does: pending, in
+// progress, completed unless todo_statuses says otherwise.
+func todoStatusRank(s models.TodoStatus) int {
+	return workflow.Rank(s)
+}
+
+// sortTodosByColumn sorts todos by column c. The ascending order is the
+// natural one for each column: title A-Z, pending first, high priority
+// first, soonest due first, tags A-Z, newest first. Todos without a due
+// date or tags stay last in either direction.
+func sortTodosByColumn(todos []models.Todo, c todoColumn, desc bool) {
+	missing := func(t *models.Todo) bool {
+		switch c {
+		case todoColDue:
+			return t.DueDate == nil
+		case todoColTags:
+			return len(extractTagsFromTodo(t)) == 0
+		}
+		return false
+	}
+	compare := func(a, b *models.Todo) int {
+		switch c {
+		case todoColTitle:
+			return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case todoColStatus:
+			return todoStatusRank(a.Status) - todoStatusRank(b.Status)
+		case todoColPriority:
+			return int(b.Priority) - int(a.Priority)
+		case todoColDue:
+			return models.CompareDue(*a.DueDate, *b.DueDate)
+		case todoColTags:
+			return strings.Compare(strings.Join(extractTagsFromTodo(a), " "), strings.Join(extractTagsFromTodo(b), " "))
+		case todoColAge:
+			return b.CreatedAt.Compare(a.CreatedAt)
+		}
+		return 0
+	}
+
+	sort.SliceStable(todos, func(i, j int) bool {
+		a, b := &todos[i], &todos[j]
+		if ma, mb := missing(a), missing(b); ma || mb {
+			return !ma && mb
+		}
+		cmp := compare(a, b)
+		if desc {
+			cmp = -cmp
+		}
+		return cmp < 0
+	})
+}
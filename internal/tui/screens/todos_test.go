@@ -2,11 +2,14 @@ package screens
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
 	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
 )
 
@@ -169,19 +172,719 @@ func TestTodosTabSwitchesFocus(t *testing.T) {
 	// Press Tab
 	m.Update(tea.KeyMsg{Type: tea.KeyTab})
 
-	// Description should now be focused
+	// Estimate should now be focused
 	if m.titleInput.Focused() {
 		t.Fatalf("expected title to NOT be focused after Tab")
 	}
-	if !m.descInput.Focused() {
-		t.Fatalf("expected description to be focused after Tab")
+	if !m.estimateInput.Focused() {
+		t.Fatalf("expected estimate to be focused after Tab")
+	}
+
+	// Press Tab again
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	// Due date should now be focused
+	if !m.dueInput.Focused() {
+		t.Fatalf("expected due date to be focused after second Tab")
 	}
 
 	// Press Tab again
 	m.Update(tea.KeyMsg{Type: tea.KeyTab})
 
+	// Description should now be focused
+	if !m.descInput.Focused() {
+		t.Fatalf("expected description to be focused after third Tab")
+	}
+
+	// Press Tab a fourth time
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
 	// Title should be focused again
 	if !m.titleInput.Focused() {
-		t.Fatalf("expected title to be focused after second Tab")
+		t.Fatalf("expected title to be focused after fourth Tab")
+	}
+}
+
+func TestTodosEstimateSaved(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.titleInput.SetValue("Write report")
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	// Invalid estimate keeps the form open with an error
+	m.estimateInput.SetValue("soon")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showCreate || m.estimateErr == "" {
+		t.Fatalf("expected form to stay open with an error for invalid estimate")
+	}
+
+	m.estimateInput.SetValue("1h30m")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showCreate {
+		t.Fatalf("expected form to close after saving")
+	}
+
+	todos, _ := m.store.ListTodos()
+	if len(todos) != 1 || todos[0].EstimateMinutes != 90 {
+		t.Fatalf("expected one todo with a 90 minute estimate, got %+v", todos)
+	}
+	if !strings.Contains(TodoItem{todo: todos[0]}.Description(), "1h30m") {
+		t.Fatalf("expected list description to show estimate")
+	}
+}
+
+func TestTodosDueTimeSaved(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.titleInput.SetValue("Send invoice")
+
+	m.dueInput.SetValue("tomorrow at 5")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showCreate || m.dueErr == "" {
+		t.Fatalf("expected form to stay open with an error for an invalid due date")
+	}
+
+	m.dueInput.SetValue("tomorrow 5:30pm")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showCreate {
+		t.Fatalf("expected form to close after saving, got error %q", m.dueErr)
+	}
+
+	todos, _ := m.store.ListTodos()
+	if len(todos) != 1 || todos[0].DueDate == nil {
+		t.Fatalf("expected one todo with a due date, got %+v", todos)
+	}
+	due := *todos[0].DueDate
+	want := startOfDay(time.Now()).AddDate(0, 0, 1).Add(17*time.Hour + 30*time.Minute)
+	if !due.Equal(want) {
+		t.Fatalf("due = %v, want %v", due, want)
+	}
+
+	m.PreviewTodo(todos[0].ID)
+	if view := m.View(); !strings.Contains(view, "(due in ") {
+		t.Fatalf("expected the preview to count down to the due time, got:\n%s", view)
+	}
+
+	// The edit form shows the time so it survives a save
+	m.openEditForm(todos[0])
+	if got := m.dueInput.Value(); got != want.Format("2006-01-02 15:04") {
+		t.Fatalf("edit form due = %q", got)
+	}
+}
+
+func TestTodosDueCountdown(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 10, 11, 40, 0, 0, time.Local)
+	tests := []struct {
+		due  time.Time
+		want string
+	}{
+		{now.Add(3*time.Hour + 20*time.Minute), "due in 3h 20m"},
+		{now.Add(2*24*time.Hour + 4*time.Hour + 10*time.Minute), "due in 2d 4h"},
+		{now.Add(2*24*time.Hour + 5*time.Minute), "due in 2d"},
+		{now.Add(-45 * time.Minute), "overdue by 45m"},
+		{now.Add(20 * time.Second), "due in <1m"},
+	}
+	for _, tt := range tests {
+		if got := models.FormatCountdown(tt.due, now); got != tt.want {
+			t.Errorf("FormatCountdown(%v) = %q, want %q", tt.due, got, tt.want)
+		}
+	}
+
+	for input, want := range map[string]time.Time{
+		"17:00":             time.Date(2026, 3, 10, 17, 0, 0, 0, time.Local),
+		"today 5pm":         time.Date(2026, 3, 10, 17, 0, 0, 0, time.Local),
+		"+2":                time.Date(2026, 3, 12, 0, 0, 0, 0, time.Local),
+		"2026-04-01 9:05am": time.Date(2026, 4, 1, 9, 5, 0, 0, time.Local),
+	} {
+		got, err := models.ParseDue(input, now)
+		if err != nil || got == nil || !got.Equal(want) {
+			t.Errorf("ParseDue(%q) = %v, %v; want %v", input, got, err, want)
+		}
+	}
+}
+
+func TestTodosDueDayBoundaries(t *testing.T) {
+	t.Parallel()
+
+	day := func(d, hour, minute int) time.Time {
+		return time.Date(2026, 3, d, hour, minute, 0, 0, time.Local)
+	}
+	lateEvening := day(10, 23, 30)
+	tests := []struct {
+		name    string
+		due     time.Time
+		now     time.Time
+		label   string
+		days    int
+		preview string
+	}{
+		{"tomorrow, under a day away", day(11, 0, 0), lateEvening, "Due tomorrow", 1, "(tomorrow)"},
+		{"today, late in the day", day(10, 0, 0), lateEvening, "Due today", 0, "(today!)"},
+		{"yesterday, just after midnight", day(9, 0, 0), day(10, 0, 5), "Overdue 1 days", -1, "(overdue by 1 days)"},
+		{"three days ago, early morning", day(7, 0, 0), day(10, 6, 0), "Overdue 3 days", -3, "(overdue by 3 days)"},
+		{"in two days, late in the day", day(12, 0, 0), lateEvening, "Due in 2 days", 2, "(in 2 days)"},
+		{"tomorrow morning with a time", day(11, 9, 0), day(10, 8, 0), "Due tomorrow", 1, "(due in 1d 1h)"},
+		{"tomorrow morning within a day", day(11, 7, 0), lateEvening, "Due in 7h 30m", 1, "(due in 7h 30m)"},
+		{"earlier today with a time", day(10, 9, 0), day(10, 9, 45), "Overdue by 45m", -1, "(overdue by 45m)"},
+	}
+	for _, tt := range tests {
+		label, days := dueLabel(tt.due, tt.now)
+		if !strings.HasPrefix(label, tt.label) || days != tt.days {
+			t.Errorf("%s: dueLabel() = %q, %d; want %q, %d", tt.name, label, days, tt.label, tt.days)
+		}
+		if got := previewDue(tt.due, tt.now); !strings.HasSuffix(got, tt.preview) {
+			t.Errorf("%s: previewDue() = %q, want it to end in %q", tt.name, got, tt.preview)
+		}
+	}
+
+	// A day is a date, not 24 hours, across a DST change as well
+	if loc, err := time.LoadLocation("America/New_York"); err == nil {
+		now := time.Date(2026, 3, 7, 12, 0, 0, 0, loc)
+		if got := models.DaysUntil(time.Date(2026, 3, 9, 0, 0, 0, 0, loc), now); got != 2 {
+			t.Errorf("DaysUntil() across DST = %d, want 2", got)
+		}
+	}
+}
+
+func TestTodosOpenCreateForNoteLinksNote(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+
+	note := &models.Note{Title: "Launch plan", Body: "Ship it"}
+	if err := m.store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	m.OpenCreateForNote(note.ID, note.Title)
+	if !m.showCreate {
+		t.Fatalf("expected create form to be open")
+	}
+	if got := m.titleInput.Value(); got != "Launch plan" {
+		t.Fatalf("expected title pre-filled with note title, got %q", got)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	todos, _ := m.store.ListTodosForNote(note.ID)
+	if len(todos) != 1 {
+		t.Fatalf("expected 1 todo linked by note_id, got %d", len(todos))
+	}
+
+	links, _ := m.store.GetLinksForItem("note", note.ID)
+	found := false
+	for _, l := range links {
+		if l.TargetType == "todo" && l.TargetID == todos[0].ID && l.LinkType == models.LinkTypeContains {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected contains link from note to todo, got %+v", links)
+	}
+	if m.linkNoteID != 0 {
+		t.Fatalf("expected pending note link to be cleared after save")
+	}
+}
+
+func TestTodosProjectPickerAndGrouping(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, todo := range []*models.Todo{
+		{Title: "Pack boxes", Status: models.TodoStatusPending, Project: "Move"},
+		{Title: "Buy milk", Status: models.TodoStatusPending},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+
+	// Select "Buy milk" and create a new project for it via the picker
+	for i, item := range m.list.Items() {
+		if ti, ok := item.(TodoItem); ok && ti.todo.Title == "Buy milk" {
+			m.list.Select(i)
+		}
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	if !m.showProjectPicker {
+		t.Fatalf("expected project picker to open")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Errands")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showProjectPicker {
+		t.Fatalf("expected picker to close after Enter")
+	}
+
+	todos, _ := m.store.ListTodos()
+	for _, todo := range todos {
+		if todo.Title == "Buy milk" && todo.Project != "Errands" {
+			t.Fatalf("expected Buy milk in project Errands, got %q", todo.Project)
+		}
+	}
+
+	// Grouping inserts one header per project
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	headers := 0
+	for _, item := range m.list.Items() {
+		if _, ok := item.(projectHeaderItem); ok {
+			headers++
+		}
+	}
+	if headers != 2 {
+		t.Fatalf("expected 2 project headers, got %d", headers)
+	}
+
+	// Project filter narrows the list
+	m.SetProjectFilter("Move")
+	if len(m.list.Items()) != 2 { // header + 1 todo
+		t.Fatalf("expected only Move todos, got %d items", len(m.list.Items()))
+	}
+}
+
+func TestTodosSnoozeHidesUntilWake(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, todo := range []*models.Todo{
+		{Title: "Renew passport", Status: models.TodoStatusPending},
+		{Title: "Buy milk", Status: models.TodoStatusPending},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+
+	for i, item := range m.list.Items() {
+		if ti, ok := item.(TodoItem); ok && ti.todo.Title == "Renew passport" {
+			m.list.Select(i)
+		}
+	}
+
+	// z opens the picker; j selects "Tomorrow"
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+	if !m.showSnooze {
+		t.Fatalf("expected snooze picker to open")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showSnooze {
+		t.Fatalf("expected picker to close after Enter")
+	}
+
+	if len(m.list.Items()) != 1 || m.snoozedCount != 1 {
+		t.Fatalf("expected snoozed todo to be hidden, items = %d snoozed = %d", len(m.list.Items()), m.snoozedCount)
+	}
+	if !strings.Contains(m.View(), "1 snoozed") {
+		t.Fatalf("expected snoozed count in view")
+	}
+
+	// Z shows snoozed todos again
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Z'}})
+	if len(m.list.Items()) != 2 {
+		t.Fatalf("expected snoozed todo to be shown, got %d items", len(m.list.Items()))
+	}
+
+	// The picker offers to wake a snoozed todo, from the todo it loaded
+	// on opening rather than a read per frame
+	for i, item := range m.list.Items() {
+		if ti, ok := item.(TodoItem); ok && ti.todo.Title == "Renew passport" {
+			m.list.Select(i)
+		}
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+	if m.snoozeTarget == nil || m.snoozeTarget.DeferredUntil == nil || !strings.Contains(m.View(), "Wake now") {
+		t.Fatalf("expected the picker to offer Wake now for the snoozed todo, target = %+v", m.snoozeTarget)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// A snooze in the past no longer hides the todo
+	todos, _ := m.store.ListTodos()
+	for _, todo := range todos {
+		if todo.DeferredUntil != nil {
+			past := time.Now().Add(-time.Minute)
+			todo.DeferredUntil = &past
+			m.store.UpdateTodo(&todo)
+		}
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Z'}})
+	if len(m.list.Items()) != 2 || m.snoozedCount != 0 {
+		t.Fatalf("expected woken todo to be visible, items = %d", len(m.list.Items()))
+	}
+}
+
+func TestTodosQuickReschedule(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	todo := &models.Todo{Title: "File taxes", Status: models.TodoStatusPending}
+	if err := m.store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	m.LoadTodos()
+
+	press := func(key rune) string {
+		t.Helper()
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		if cmd == nil {
+			t.Fatalf("%q: expected a toast command", key)
+		}
+		toast, ok := cmd().(ToastMsg)
+		if !ok {
+			t.Fatalf("%q: expected ToastMsg, got %T", key, cmd())
+		}
+		return toast.Text
+	}
+	due := func() *time.Time {
+		t.Helper()
+		got, err := m.store.GetTodo(todo.ID)
+		if err != nil {
+			t.Fatalf("GetTodo() err = %v", err)
+		}
+		return got.DueDate
+	}
+
+	// Without a due date, + starts from today
+	tomorrow := startOfDay(time.Now()).AddDate(0, 0, 1)
+	if text := press('+'); !strings.Contains(text, tomorrow.Format("Mon Jan 2")) {
+		t.Fatalf("toast = %q, want tomorrow's date", text)
+	}
+	if d := due(); d == nil || !d.Equal(tomorrow) {
+		t.Fatalf("due = %v, want %v", d, tomorrow)
+	}
+
+	press('w')
+	if d := due(); d == nil || !d.Equal(tomorrow.AddDate(0, 0, 7)) {
+		t.Fatalf("after w due = %v, want a week later", d)
+	}
+
+	press('-')
+	if d := due(); d == nil || !d.Equal(tomorrow.AddDate(0, 0, 6)) {
+		t.Fatalf("after - due = %v, want a day earlier", d)
+	}
+
+	if text := press('0'); text != "📅 Due date cleared" {
+		t.Fatalf("toast = %q", text)
+	}
+	if d := due(); d != nil {
+		t.Fatalf("after 0 due = %v, want nil", d)
+	}
+}
+
+func TestTodosDensityToggle(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	if err := m.store.CreateTodo(&models.Todo{Title: "Pay rent", Description: "before the 5th"}); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	m.SetListDensity(false)
+	m.LoadTodos()
+	if !strings.Contains(m.View(), "before the 5th") {
+		t.Fatalf("expected comfortable rows to show the description")
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if toast, ok := cmd().(ToastMsg); !ok || toast.Text != "Compact rows" {
+		t.Fatalf("expected a compact rows toast, got %#v", cmd())
+	}
+	if view := m.View(); strings.Contains(view, "before the 5th") || !strings.Contains(view, "Pay rent") {
+		t.Fatalf("expected compact rows with titles only:\n%s", view)
+	}
+
+	// The toggle is stored and wins over the configured default
+	reopened := NewTodosListModel(m.store)
+	reopened.SetSize(100, 40)
+	reopened.SetListDensity(false)
+	reopened.LoadTodos()
+	if strings.Contains(reopened.View(), "before the 5th") {
+		t.Fatalf("expected the stored compact density to be restored")
+	}
+}
+
+func TestTodosTableView(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	soon := time.Now().AddDate(0, 0, 2)
+	for _, todo := range []*models.Todo{
+		{Title: "Buy milk", Priority: models.TodoPriorityLow, Status: models.TodoStatusPending},
+		{Title: "Ship release #work", Priority: models.TodoPriorityHigh, Status: models.TodoStatusPending, DueDate: &soon},
+		{Title: "Answer email", Priority: models.TodoPriorityMedium, Status: models.TodoStatusPending},
+	} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+
+	press := func(keys ...string) {
+		for _, k := range keys {
+			switch k {
+			case "right":
+				m.Update(tea.KeyMsg{Type: tea.KeyRight})
+			case "down":
+				m.Update(tea.KeyMsg{Type: tea.KeyDown})
+			default:
+				m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+			}
+		}
+	}
+	titles := func() string {
+		var out []string
+		for _, row := range m.table.Rows() {
+			out = append(out, row[0])
+		}
+		return strings.Join(out, ", ")
+	}
+
+	press("T")
+	if !m.showTable {
+		t.Fatalf("expected T to open the table view")
+	}
+	view := m.View()
+	for _, want := range []string{"Title", "Status", "Priority", "Due", "Tags", "Age", "#work"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected table to show %q:\n%s", want, view)
+		}
+	}
+
+	// 3 sorts by priority (high first); again reverses
+	press("3")
+	if got := titles(); got != "Ship release #work, Answer email, Buy milk" {
+		t.Fatalf("priority order = %q", got)
+	}
+	press("3")
+	if got := titles(); got != "Buy milk, Answer email, Ship release #work" {
+		t.Fatalf("reversed priority order = %q", got)
+	}
+	// 1 sorts by title
+	press("1")
+	if got := titles(); got != "Answer email, Buy milk, Ship release #work" {
+		t.Fatalf("title order = %q", got)
+	}
+
+	// List actions apply to the highlighted row
+	press("down", " ")
+	todos, _ := m.store.ListTodos()
+	for _, todo := range todos {
+		if (todo.Status == models.TodoStatusCompleted) != (todo.Title == "Buy milk") {
+			t.Fatalf("expected only Buy milk to be completed, %q is %s", todo.Title, todo.Status)
+		}
+	}
+	if got := titles(); got != "Answer email, Buy milk, Ship release #work" || m.table.Cursor() != 1 {
+		t.Fatalf("expected the table to keep its sort and cursor after a reload, got %q at %d", got, m.table.Cursor())
+	}
+
+	// Narrow terminals scroll the columns after Title
+	m.SetSize(60, 40)
+	if _, right := m.hiddenColumns(); !right {
+		t.Fatalf("expected columns to be cut off at 60 columns")
+	}
+	press("right")
+	if left, _ := m.hiddenColumns(); !left || strings.Contains(m.View(), "Status") {
+		t.Fatalf("expected the Status column to scroll out:\n%s", m.View())
+	}
+
+	press("T")
+	if m.showTable {
+		t.Fatalf("expected T to return to the card list")
+	}
+}
+
+func TestTodosFormValidation(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if strings.Contains(m.View(), "is required") {
+		t.Fatalf("expected a new form to open without errors")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.showCreate || !strings.Contains(m.View(), "Title is required") {
+		t.Fatalf("expected an empty title to keep the form open with an error, got:\n%s", m.View())
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if m.titleErr != "" {
+		t.Errorf("expected the error to clear once the title is filled in, got %q", m.titleErr)
+	}
+
+	// Length limits show as the field is edited
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(strings.Repeat("y", models.MaxTitleLength))})
+	if !strings.Contains(m.titleErr, "too long") {
+		t.Fatalf("expected a too-long title error, got %q", m.titleErr)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if todos, _ := m.store.ListTodos(); !m.showCreate || len(todos) != 0 {
+		t.Errorf("expected a too-long title not to save")
+	}
+}
+
+func TestTodosSelectionSurvivesReload(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, title := range []string{"First", "Second", "Third"} {
+		if err := m.store.CreateTodo(&models.Todo{Title: title, Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium}); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+	m.list.Select(1)
+	selected := m.GetSelectedTodo().ID
+
+	// A new todo reloads the list with one more row
+	if err := m.store.CreateTodo(&models.Todo{Title: "Fourth", Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium}); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	m.LoadTodos()
+	if got := m.GetSelectedTodo(); got == nil || got.ID != selected {
+		t.Errorf("expected todo %d to stay selected after a reload, got %+v", selected, got)
+	}
+
+	// Deleting it selects the todo that takes its place
+	index := m.list.Index()
+	m.store.DeleteTodo(selected)
+	m.LoadTodos()
+	if got := m.GetSelectedTodo(); got == nil || m.list.Index() != min(index, len(m.list.Items())-1) {
+		t.Errorf("expected the neighbor at row %d to be selected after a delete, got row %d", index, m.list.Index())
+	}
+}
+
+func TestTodosSelectionFollowsCreate(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	for _, title := range []string{"First", "Second", "Third"} {
+		if err := m.store.CreateTodo(&models.Todo{Title: title, Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium}); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	m.LoadTodos()
+	m.list.Select(2)
+	m.SetPreviewAfterCreate(true)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Fresh")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.GetSelectedTodo(); got == nil || got.Title != "Fresh" {
+		t.Fatalf("expected the new todo to be selected, got %+v", got)
+	}
+	if !m.showPreview || m.previewTodo == nil || m.previewTodo.Title != "Fresh" {
+		t.Errorf("expected the new todo's preview to open")
+	}
+}
+
+func TestTodosStatusCycleAndFilter(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	todo := &models.Todo{Title: "Review PR", Status: models.TodoStatusPending, Priority: models.TodoPriorityMedium}
+	if err := m.store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+	m.LoadTodos()
+
+	for _, want := range []models.TodoStatus{models.TodoStatusInProgress, models.TodoStatusCompleted, models.TodoStatusPending} {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+		got, err := m.store.GetTodo(todo.ID)
+		if err != nil || got.Status != want {
+			t.Fatalf("after x, status = %q (err %v), want %q", got.Status, err, want)
+		}
+	}
+
+	var filters []models.TodoStatus
+	for range 4 {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+		filters = append(filters, m.statusFilter)
+	}
+	want := []models.TodoStatus{models.TodoStatusPending, models.TodoStatusInProgress, models.TodoStatusCompleted, ""}
+	for i := range want {
+		if filters[i] != want[i] {
+			t.Errorf("status filters = %q, want %q", filters, want)
+			break
+		}
+	}
+}
+
+func TestTodosStatusCycleFailure(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := sqlite.New(&config.Config{DbPath: dbPath})
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	todo := &models.Todo{Title: "Review PR", Status: models.TodoStatusPending}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	// A read-only store refuses the update
+	ro, err := sqlite.New(&config.Config{DbPath: dbPath, ReadOnly: true})
+	if err != nil {
+		t.Fatalf("sqlite.New(ReadOnly) err = %v", err)
+	}
+	t.Cleanup(func() { _ = ro.Close() })
+	m := NewTodosListModel(ro)
+	m.SetSize(100, 40)
+	m.LoadTodos()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if cmd == nil {
+		t.Fatal("expected a toast command")
+	}
+	if toast, ok := cmd().(ToastMsg); !ok || !strings.HasPrefix(toast.Text, "Could not change the status") {
+		t.Fatalf("toast = %#v, want a failure", cmd())
+	}
+	if got := m.GetSelectedTodo(); got == nil || got.Status != models.TodoStatusPending {
+		t.Fatalf("selected todo = %+v, want it still pending", got)
+	}
+}
+
+func TestTodosCustomFields(t *testing.T) {
+	t.Parallel()
+
+	m := newTestTodosModel(t)
+	m.SetSize(100, 40)
+	bug := &models.Todo{Title: "Fix login", Status: models.TodoStatusPending}
+	other := &models.Todo{Title: "Fix signup", Status: models.TodoStatusPending}
+	for _, todo := range []*models.Todo{other, bug} {
+		if err := m.store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	if !m.PreviewTodo(bug.ID) {
+		t.Fatal("PreviewTodo() = false")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	for _, r := range "Client: Acme" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || m.fieldEditor.open {
+		t.Fatalf("expected Enter to save the field and close the prompt")
+	}
+	if view := m.View(); !containsString(view, "Fields") || !containsString(view, "client: Acme") {
+		t.Errorf("expected the field in the preview, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m.filter = "fix client:acme"
+	m.LoadTodos()
+	if items := m.list.Items(); len(items) != 1 || items[0].(TodoItem).todo.ID != bug.ID {
+		t.Errorf("filter client:acme = %d items, want only the bug", len(items))
 	}
 }
@@ -0,0 +1,122 @@
+package screens
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// Writing mode (Phase 4: UX Overhaul).
+//
+// F8 in the note editor hides everything but the body: no title, labels,
+// help bar or status bar. The text is wrapped to a centered column and
+// scrolls like a typewriter, keeping the cursor's row in the middle of
+// the screen. The textarea still handles every key; only its view is
+// replaced. Esc leaves writing mode before it cancels the edit.
+
+// writingWidth is the widest the writing column gets.
+const writingWidth = 72
+
+// toggleWriting turns writing mode on or off, moving focus to the body.
+func (m *NotesListModel) toggleWriting() {
+	m.writing = !m.writing
+	if m.writing {
+		m.titleInput.Blur()
+		m.bodyInput.Focus()
+		m.editPreview = false
+	}
+}
+
+// Writing reports whether the editor is in writing mode, so the app can
+// drop its own chrome around it.
+func (m *NotesListModel) Writing() bool {
+	return m.showCreate && m.writing && !m.editPreview && !m.showTagPicker
+}
+
+// renderWriting renders the body alone, typewriter style, with the
+// editor's popup (see editorPopup) under it when one is open.
+func (m *NotesListModel) renderWriting() string {
+	width := min(writingWidth, max(m.width-4, 10))
+
+	popup := m.editorPopup()
+
+	// Leave a line for toasts, such as a save confirmation
+	height := m.height - 1
+	if popup != "" {
+		height -= lipgloss.Height(popup) + 1
+	}
+	height = max(height, minBodyHeight)
+
+	line, col := m.bodyInput.Cursor()
+	rows, cursorRow, cursorCol := typewriterRows(m.bodyInput.Value(), line, col, width)
+
+	cursorStyle := lipgloss.NewStyle().Reverse(true)
+	textStyle := lipgloss.NewStyle().Foreground(styles.TextColor).Width(width + 1)
+	middle := height / 2
+	lines := make([]string, height)
+	for i := range lines {
+		r := cursorRow - middle + i
+		switch {
+		case r < 0 || r >= len(rows):
+			lines[i] = textStyle.Render("")
+		case r == cursorRow:
+			runes := []rune(rows[r])
+			at := " "
+			rest := ""
+			if cursorCol < len(runes) {
+				at = string(runes[cursorCol])
+				rest = string(runes[cursorCol+1:])
+			}
+			lines[i] = textStyle.Render(string(runes[:cursorCol]) + cursorStyle.Render(at) + rest)
+		default:
+			lines[i] = textStyle.Render(rows[r])
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	if popup != "" {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, "", popup)
+	}
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, content)
+}
+
+// typewriterRows word-wraps text to width and finds the cursor, given as
+// a line and rune column of text, among the wrapped rows. Rows keep their
+// trailing spaces so columns map straight back to the text.
+func typewriterRows(text string, line, col, width int) (rows []string, cursorRow, cursorCol int) {
+	for i, l := range strings.Split(text, "\n") {
+		runes := []rune(l)
+		starts := wrapStarts(runes, width)
+		for k, start := range starts {
+			end := len(runes)
+			if k+1 < len(starts) {
+				end = starts[k+1]
+			}
+			if i == line && col >= start && (col < end || k == len(starts)-1) {
+				cursorRow, cursorCol = len(rows), min(col-start, end-start)
+			}
+			rows = append(rows, string(runes[start:end]))
+		}
+	}
+	return rows, cursorRow, cursorCol
+}
+
+// wrapStarts returns where each wrapped row of a line starts. Rows break
+// after the last space that fits, or mid-word when a word is too long.
+func wrapStarts(runes []rune, width int) []int {
+	starts := []int{0}
+	for start := 0; len(runes)-start > width; {
+		next := start + width
+		for i := next; i > start; i-- {
+			if runes[i-1] == ' ' {
+				next = i
+				break
+			}
+		}
+		starts = append(starts, next)
+		start = next
+	}
+	return starts
+}
@@ -0,0 +1,71 @@
+package screens
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTypewriterRows(t *testing.T) {
+	t.Parallel()
+
+	text := "the quick brown fox\nsupercalifragilistic\n"
+	tests := []struct {
+		name      string
+		line, col int
+		row, rcol int
+	}{
+		{"start", 0, 0, 0, 0},
+		{"wrapped word", 0, 12, 1, 2},
+		{"end of line", 0, 19, 1, 9},
+		{"long word", 1, 12, 3, 2},
+		{"empty last line", 2, 0, 4, 0},
+	}
+	for _, tt := range tests {
+		rows, row, col := typewriterRows(text, tt.line, tt.col, 10)
+		want := []string{"the quick ", "brown fox", "supercalif", "ragilistic", ""}
+		if !reflect.DeepEqual(rows, want) {
+			t.Fatalf("rows = %q, want %q", rows, want)
+		}
+		if row != tt.row || col != tt.rcol {
+			t.Errorf("%s: cursor = (%d, %d), want (%d, %d)", tt.name, row, col, tt.row, tt.rcol)
+		}
+	}
+}
+
+func TestNotesWritingMode(t *testing.T) {
+	t.Parallel()
+
+	m := newTestNotesModel(t)
+	update := func(msg tea.Msg) {
+		mm, _ := m.Update(msg)
+		m = *mm.(*NotesListModel)
+	}
+
+	update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m.titleInput.SetValue("Draft title")
+	m.bodyInput.SetValue(strings.Repeat("line\n", 60) + "last line")
+
+	update(tea.KeyMsg{Type: tea.KeyF8})
+	if !m.Writing() || !m.bodyInput.Focused() {
+		t.Fatal("expected F8 to enter writing mode with the body focused")
+	}
+
+	view := m.View()
+	if strings.Contains(view, "Draft title") || strings.Contains(view, "Save") {
+		t.Error("writing mode should hide the title and help bar")
+	}
+	// The cursor is on the last line, which sits in the middle row.
+	lines := strings.Split(view, "\n")
+	if got := lines[(m.height-1)/2]; !strings.Contains(got, "last lin") {
+		t.Errorf("middle row = %q, want the cursor's line", got)
+	}
+
+	// Esc leaves writing mode but keeps the edit open.
+	update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Writing() || !m.showCreate {
+		t.Fatalf("Esc should leave writing mode only, writing=%v showCreate=%v", m.Writing(), m.showCreate)
+	}
+}
@@ -0,0 +1,36 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Accessibility (Phase 4).
+//
+// high_contrast swaps the ARCHWAVE palette for HighContrastPalette.
+// reduced_motion turns off effects that move or change on their own:
+// blinking cursors, spinners, gradient text, and feedback or pickers that
+// vanish on a timer. Toasts then stay until the next key.
+
+var reducedMotion bool
+
+// UseHighContrast switches between the high-contrast palette and the
+// ARCHWAVE one.
+func UseHighContrast(on bool) {
+	if !on {
+		UsePalette(ArchwavePalette)
+		return
+	}
+	// Ask the terminal for its background now; once Bubble Tea reads
+	// input the reply would be taken for key presses
+	lipgloss.HasDarkBackground()
+	UsePalette(HighContrastPalette)
+}
+
+// SetReducedMotion turns reduced motion on or off.
+func SetReducedMotion(on bool) {
+	reducedMotion = on
+}
+
+// ReducedMotion reports whether effects that move or change on their own
+// are off.
+func ReducedMotion() bool {
+	return reducedMotion
+}
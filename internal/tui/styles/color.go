@@ -0,0 +1,52 @@
+package styles
+
+import (
+	"io"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Color profiles (Phase 4: Robustness).
+//
+// The ARCHWAVE palette is hex, and rounding pastel hex to the nearest
+// 256 or 16 color turns lavender into grey and the deep purple background
+// into black-on-black. Each palette color therefore carries a hand-picked
+// xterm-256 index and ANSI color, chosen for the terminal's profile:
+// truecolor, 256, 16 or none. The profile comes from termenv detection,
+// which honors NO_COLOR and CLICOLOR_FORCE; --no-color turns color off
+// regardless.
+
+// paletteColor returns a palette color given as truecolor hex, xterm-256
+// index and ANSI (0-15) color.
+func paletteColor(hex, ansi256, ansi string) lipgloss.CompleteColor {
+	return lipgloss.CompleteColor{TrueColor: hex, ANSI256: ansi256, ANSI: ansi}
+}
+
+// DetectColorProfile returns the color profile to render to out with:
+// no color when noColor is set, otherwise what the terminal and the
+// environment support.
+func DetectColorProfile(out io.Writer, noColor bool) termenv.Profile {
+	if noColor {
+		return termenv.Ascii
+	}
+	return termenv.NewOutput(out).EnvColorProfile()
+}
+
+// SetColorProfile renders all styles with profile.
+func SetColorProfile(profile termenv.Profile) {
+	lipgloss.SetColorProfile(profile)
+}
+
+// ColorProfileName names a profile for diagnostics.
+func ColorProfileName(profile termenv.Profile) string {
+	switch profile {
+	case termenv.TrueColor:
+		return "truecolor"
+	case termenv.ANSI256:
+		return "256"
+	case termenv.ANSI:
+		return "16"
+	}
+	return "none"
+}
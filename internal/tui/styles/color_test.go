@@ -0,0 +1,76 @@
+package styles
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestPaletteDegradesPerProfile(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	tests := []struct {
+		profile termenv.Profile
+		want    string
+	}{
+		{termenv.TrueColor, "\x1b[38;2;"},
+		{termenv.ANSI256, "\x1b[38;5;183m"},
+		{termenv.ANSI, "\x1b[95m"},
+	}
+	for _, tt := range tests {
+		t.Run(ColorProfileName(tt.profile), func(t *testing.T) {
+			SetColorProfile(tt.profile)
+			got := lipgloss.NewStyle().Foreground(PrimaryColor).Render("x")
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("PrimaryColor rendered %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	SetColorProfile(termenv.Ascii)
+	if got := TitleStyle.Render("x"); strings.Contains(got, "\x1b[") {
+		t.Errorf("TitleStyle with no color rendered %q, want no escapes", got)
+	}
+}
+
+func TestPaletteHasEveryProfile(t *testing.T) {
+	complete := func(c lipgloss.CompleteColor) bool {
+		return c.TrueColor != "" && c.ANSI256 != "" && c.ANSI != ""
+	}
+	for name, p := range map[string]Palette{"archwave": ArchwavePalette, "high contrast": HighContrastPalette} {
+		for _, c := range p.colors() {
+			ok := false
+			switch c := c.(type) {
+			case lipgloss.CompleteColor:
+				ok = complete(c)
+			case lipgloss.CompleteAdaptiveColor:
+				ok = complete(c.Light) && complete(c.Dark)
+			}
+			if !ok {
+				t.Errorf("%s color %+v is missing a profile", name, c)
+			}
+		}
+	}
+}
+
+func TestDetectColorProfile(t *testing.T) {
+	var out bytes.Buffer
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR_FORCE", "1")
+	if got := DetectColorProfile(&out, false); got != termenv.ANSI {
+		t.Errorf("with CLICOLOR_FORCE got %s, want 16", ColorProfileName(got))
+	}
+	if got := DetectColorProfile(&out, true); got != termenv.Ascii {
+		t.Errorf("with --no-color got %s, want none", ColorProfileName(got))
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if got := DetectColorProfile(&out, false); got != termenv.Ascii {
+		t.Errorf("with NO_COLOR got %s, want none", ColorProfileName(got))
+	}
+}
@@ -0,0 +1,114 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Palettes (Phase 4: Accessibility).
+//
+// The ARCHWAVE palette is the default look: pastel pinks, purples and
+// cyans on deep purple-black. The high-contrast palette trades the pastels
+// for saturated colors on pure black, or dark colors on pure white when
+// the terminal has a light background, so text stays readable for low
+// vision and in bright rooms. Each color is given as truecolor hex,
+// xterm-256 index and ANSI color (see color.go).
+
+// Palette is a full set of theme colors.
+type Palette struct {
+	Primary, Secondary, Accent      lipgloss.TerminalColor
+	Success, Warning, Error, Timer  lipgloss.TerminalColor
+	Background, Surface, Border     lipgloss.TerminalColor
+	Text, Muted, Highlight          lipgloss.TerminalColor
+	NeonPink, PaleAqua, CreamYellow lipgloss.TerminalColor
+	Periwinkle, PalePink            lipgloss.TerminalColor
+}
+
+// ArchwavePalette is the default vaporwave palette.
+var ArchwavePalette = Palette{
+	// Primary colors - vaporwave spectrum
+	Primary:   paletteColor("#d4a5ff", "183", "13"), // Soft lavender
+	Secondary: paletteColor("#5ffbf1", "87", "14"),  // Neon cyan
+	Accent:    paletteColor("#ff6ec7", "205", "5"),  // Hot pink
+
+	// Semantic colors
+	Success: paletteColor("#8ffef4", "123", "14"), // Light cyan
+	Warning: paletteColor("#f9f871", "227", "11"), // Pale yellow
+	Error:   paletteColor("#ff9adc", "218", "9"),  // Soft pink
+	Timer:   paletteColor("#ff6ec7", "205", "13"), // Hot pink for timer
+
+	// Background colors - deep purple-black
+	Background: paletteColor("#1a0d2e", "234", "0"), // Deep purple-black
+	Surface:    paletteColor("#2d1b4e", "236", "8"), // Dark purple
+	Border:     paletteColor("#543a6e", "60", "8"),  // Muted purple
+
+	// Text colors
+	Text:      paletteColor("#fef6ff", "255", "15"), // Off-white pink
+	Muted:     paletteColor("#b8c1ff", "147", "7"),  // Pale blue
+	Highlight: paletteColor("#ffffff", "231", "15"), // Pure white for highlights
+
+	// Additional ARCHWAVE colors
+	NeonPink:    paletteColor("#f4a5ff", "219", "13"), // Soft neon pink
+	PaleAqua:    paletteColor("#8ffef4", "123", "14"), // Pale aqua
+	CreamYellow: paletteColor("#fbf9a5", "229", "11"), // Cream yellow
+	Periwinkle:  paletteColor("#8b9aff", "111", "12"), // Periwinkle blue
+	PalePink:    paletteColor("#ffc8ff", "225", "13"), // Pale pink
+}
+
+// HighContrastPalette keeps every text color at a contrast ratio of 7:1
+// or more against the background and 4.5:1 against the surface of
+// selected rows and badges (WCAG AAA and AA), on dark and light terminals
+// alike.
+var HighContrastPalette = Palette{
+	Primary:   contrastColor(paletteColor("#5f0087", "54", "5"), paletteColor("#ffd700", "220", "11")),
+	Secondary: contrastColor(paletteColor("#005f87", "24", "4"), paletteColor("#00ffff", "51", "14")),
+	Accent:    contrastColor(paletteColor("#870087", "90", "5"), paletteColor("#ff87ff", "213", "13")),
+
+	Success: contrastColor(paletteColor("#005f00", "22", "2"), paletteColor("#00ff00", "46", "10")),
+	Warning: contrastColor(paletteColor("#5f3700", "58", "3"), paletteColor("#ffff00", "226", "11")),
+	Error:   contrastColor(paletteColor("#af0000", "124", "1"), paletteColor("#ff8787", "210", "9")),
+	Timer:   contrastColor(paletteColor("#870087", "90", "5"), paletteColor("#ff87ff", "213", "13")),
+
+	Background: contrastColor(paletteColor("#ffffff", "231", "15"), paletteColor("#000000", "16", "0")),
+	Surface:    contrastColor(paletteColor("#e4e4e4", "254", "7"), paletteColor("#303030", "236", "8")),
+	Border:     contrastColor(paletteColor("#000000", "16", "0"), paletteColor("#ffffff", "231", "15")),
+
+	Text:      contrastColor(paletteColor("#000000", "16", "0"), paletteColor("#ffffff", "231", "15")),
+	Muted:     contrastColor(paletteColor("#303030", "236", "8"), paletteColor("#d0d0d0", "252", "7")),
+	Highlight: contrastColor(paletteColor("#000000", "16", "0"), paletteColor("#ffffff", "231", "15")),
+
+	NeonPink:    contrastColor(paletteColor("#870087", "90", "5"), paletteColor("#ffafff", "219", "13")),
+	PaleAqua:    contrastColor(paletteColor("#005f5f", "23", "6"), paletteColor("#87ffff", "123", "14")),
+	CreamYellow: contrastColor(paletteColor("#5f3700", "58", "3"), paletteColor("#ffff87", "228", "11")),
+	Periwinkle:  contrastColor(paletteColor("#00005f", "17", "4"), paletteColor("#afd7ff", "153", "12")),
+	PalePink:    contrastColor(paletteColor("#87005f", "89", "5"), paletteColor("#ffd7ff", "225", "13")),
+}
+
+// contrastColor returns a color that is light on light backgrounds and
+// dark on dark ones.
+func contrastColor(light, dark lipgloss.CompleteColor) lipgloss.CompleteAdaptiveColor {
+	return lipgloss.CompleteAdaptiveColor{Light: light, Dark: dark}
+}
+
+// colors lists the palette's colors.
+func (p Palette) colors() []lipgloss.TerminalColor {
+	return []lipgloss.TerminalColor{
+		p.Primary, p.Secondary, p.Accent,
+		p.Success, p.Warning, p.Error, p.Timer,
+		p.Background, p.Surface, p.Border,
+		p.Text, p.Muted, p.Highlight,
+		p.NeonPink, p.PaleAqua, p.CreamYellow, p.Periwinkle, p.PalePink,
+	}
+}
+
+// UsePalette makes p the palette of every style.
+func UsePalette(p Palette) {
+	PrimaryColor, SecondaryColor, AccentColor = p.Primary, p.Secondary, p.Accent
+	SuccessColor, WarningColor, ErrorColor, TimerColor = p.Success, p.Warning, p.Error, p.Timer
+	BackgroundColor, SurfaceColor, BorderColor = p.Background, p.Surface, p.Border
+	TextColor, MutedColor, HighlightColor = p.Text, p.Muted, p.Highlight
+	NeonPink, PaleAqua, CreamYellow = p.NeonPink, p.PaleAqua, p.CreamYellow
+	Periwinkle, PalePink = p.Periwinkle, p.PalePink
+	buildStyles()
+}
+
+func init() {
+	UsePalette(ArchwavePalette)
+}
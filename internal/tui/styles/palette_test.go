@@ -0,0 +1,101 @@
+package styles
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// contrastRatio returns the WCAG contrast ratio of two "#rrggbb" colors.
+func contrastRatio(t *testing.T, a, b string) float64 {
+	t.Helper()
+	luminance := func(hex string) float64 {
+		var rgb [3]float64
+		for i := range rgb {
+			v, err := strconv.ParseUint(hex[1+2*i:3+2*i], 16, 8)
+			if err != nil {
+				t.Fatalf("bad color %q", hex)
+			}
+			c := float64(v) / 255
+			if c <= 0.03928 {
+				rgb[i] = c / 12.92
+			} else {
+				rgb[i] = math.Pow((c+0.055)/1.055, 2.4)
+			}
+		}
+		return 0.2126*rgb[0] + 0.7152*rgb[1] + 0.0722*rgb[2]
+	}
+	la, lb := luminance(a), luminance(b)
+	return (math.Max(la, lb) + 0.05) / (math.Min(la, lb) + 0.05)
+}
+
+func TestHighContrastPaletteContrast(t *testing.T) {
+	p := HighContrastPalette
+	text := map[string]lipgloss.TerminalColor{
+		"primary": p.Primary, "secondary": p.Secondary, "accent": p.Accent,
+		"success": p.Success, "warning": p.Warning, "error": p.Error, "timer": p.Timer,
+		"text": p.Text, "muted": p.Muted, "highlight": p.Highlight,
+		"neon pink": p.NeonPink, "pale aqua": p.PaleAqua, "cream yellow": p.CreamYellow,
+		"periwinkle": p.Periwinkle, "pale pink": p.PalePink,
+	}
+	bg := p.Background.(lipgloss.CompleteAdaptiveColor)
+	surfaceColor := p.Surface.(lipgloss.CompleteAdaptiveColor)
+	for name, c := range text {
+		c := c.(lipgloss.CompleteAdaptiveColor)
+		for _, side := range []struct {
+			name            string
+			fg, bg, surface lipgloss.CompleteColor
+		}{
+			{"light", c.Light, bg.Light, surfaceColor.Light},
+			{"dark", c.Dark, bg.Dark, surfaceColor.Dark},
+		} {
+			if r := contrastRatio(t, side.fg.TrueColor, side.bg.TrueColor); r < 7 {
+				t.Errorf("%s on %s background: contrast %.1f, want >= 7", name, side.name, r)
+			}
+			if r := contrastRatio(t, side.fg.TrueColor, side.surface.TrueColor); r < 4.5 {
+				t.Errorf("%s on %s surface: contrast %.1f, want >= 4.5", name, side.name, r)
+			}
+		}
+	}
+}
+
+func TestUseHighContrastRebuildsStyles(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prev)
+		UseHighContrast(false)
+	})
+
+	before := TitleStyle.Render("x")
+	UseHighContrast(true)
+	if PrimaryColor != HighContrastPalette.Primary {
+		t.Fatalf("PrimaryColor = %v, want the high-contrast primary", PrimaryColor)
+	}
+	if after := TitleStyle.Render("x"); after == before {
+		t.Errorf("TitleStyle still renders %q after UseHighContrast(true)", after)
+	}
+
+	UseHighContrast(false)
+	if got := TitleStyle.Render("x"); got != before {
+		t.Errorf("TitleStyle renders %q after UseHighContrast(false), want %q", got, before)
+	}
+}
+
+func TestGradientTextReducedMotion(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prev)
+		SetReducedMotion(false)
+	})
+
+	SetReducedMotion(true)
+	want := lipgloss.NewStyle().Foreground(PrimaryColor).Render("flow")
+	if got := GradientText("flow", PrimaryColor, SecondaryColor, AccentColor); got != want {
+		t.Errorf("GradientText with reduced motion = %q, want one color %q", got, want)
+	}
+}
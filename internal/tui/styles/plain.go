@@ -0,0 +1,173 @@
+package styles
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Plain output (Phase 4: Accessibility).
+//
+// Screen readers read the terminal cell by cell, so border art comes out
+// as "box drawings double horizontal" and emoji as their Unicode names.
+// With plain output on, the app passes every frame through Plain: box
+// drawing becomes blank space, bars become # and -, banner letters spaced
+// out for effect ("F O C U S") close up, and symbols become word labels in
+// parentheses, e.g. "📁 launch" reads "(project) launch". The logo, the
+// big focus timer and the session dots are drawn as text.
+
+var plainOutput bool
+
+// SetPlainOutput turns plain output on or off.
+func SetPlainOutput(on bool) {
+	plainOutput = on
+}
+
+// PlainOutput reports whether plain output is on.
+func PlainOutput() bool {
+	return plainOutput
+}
+
+// plainSymbols are the words symbols are read as. An empty word drops a
+// symbol that only decorates, or that the text beside it already says.
+var plainSymbols = map[rune]string{
+	// Item kinds
+	'📝': "(note)",
+	'✅': "(todo)",
+	'📁': "(project)",
+	'📓': "(notebook)",
+	'🏷': "(tag)",
+	'🔗': "(link)",
+	'👻': "(placeholder)",
+	'📌': "(pinned)",
+
+	// Status
+	'○': "(pending)",
+	'◐': "(doing)",
+	'✓': "(done)",
+	'✗': "(cancelled)",
+	'✕': "(cancelled)",
+	'●': "(running)",
+	'◉': "(marked)",
+	'☐': "(open)",
+	'★': "(starred)",
+	'⭐': "(starred)",
+	'☆': "(unstarred)",
+	'💤': "(snoozed)",
+	'🔴': "(high priority)",
+	'🟢': "(low priority)",
+	'⚠': "(warning)",
+	'⏱': "(estimate)",
+	'📅': "(due)",
+	'⏰': "(alarm)",
+	'⏲': "(timers)",
+	'🔒': "(read-only)",
+	'▲': "(ascending)",
+	'▼': "(descending)",
+
+	// Markers and keys
+	'▶': ">",
+	'▸': ">",
+	'▾': "v",
+	'↑': "Up",
+	'↓': "Down",
+	'←': "Left",
+	'→': "Right",
+	'⌘': "Cmd",
+	'【': "[",
+	'】': "]",
+	'•': "-",
+	'·': "-",
+	'～': "~",
+	'▌': " ",
+
+	// Decoration, and icons beside words that say the same
+	'✦': "", '✨': "", '🔥': "", '◈': "", '⬡': "", '☀': "", '⚡': "",
+	'🎯': "", '📊': "", '📚': "", '📋': "", '📎': "", '∅': "", '⌛': "",
+	'🍅': "", '☕': "", '⏸': "", '🧠': "", '🗓': "", '📥': "", '🔍': "", '🔎': "",
+	'🩺': "", '🧹': "", '🏁': "", '🧩': "", '🛟': "",
+
+	// Invisible joiners left over from dropped emoji
+	'\ufe0f': "", // Emoji presentation selector
+	'\u200d': "", // Zero-width joiner
+}
+
+// spacedLetters matches banner text spaced out a letter at a time, with
+// three spaces between words.
+var spacedLetters = regexp.MustCompile(`\b[A-Z](?:(?: |   )[A-Z]){2,}\b`)
+
+// Plain rewrites a rendered frame for screen readers, leaving its ANSI
+// styling in place.
+func Plain(s string) string {
+	var b strings.Builder
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\x1b')
+		if i < 0 {
+			b.WriteString(plainText(s))
+			break
+		}
+		b.WriteString(plainText(s[:i]))
+		n := escapeLen(s[i:])
+		b.WriteString(s[i : i+n])
+		s = s[i+n:]
+	}
+	return b.String()
+}
+
+// escapeLen returns the length of the escape sequence s starts with: a
+// CSI sequence (ESC [ … final byte), an OSC sequence (ESC ] … BEL or
+// ESC \), or a two-byte escape.
+func escapeLen(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+	return 2
+}
+
+// plainText rewrites text without escape sequences.
+func plainText(s string) string {
+	s = strings.NewReplacer(" • ", ", ", " · ", ", ").Replace(s)
+	var b strings.Builder
+	for _, r := range s {
+		if word, ok := plainSymbols[r]; ok {
+			b.WriteString(word)
+			continue
+		}
+		switch {
+		case r >= 0x2500 && r <= 0x257f: // Box drawing
+			b.WriteByte(' ')
+		case r == '█' || r == '▓' || r == '▒' || r == '▰':
+			b.WriteByte('#')
+		case r == '░' || r == '▱':
+			b.WriteByte('-')
+		case r >= 0x2800 && r <= 0x28ff: // Braille spinner frames
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return spacedLetters.ReplaceAllStringFunc(b.String(), func(m string) string {
+		words := strings.Split(m, "   ")
+		for i, w := range words {
+			words[i] = strings.ReplaceAll(w, " ", "")
+		}
+		return strings.Join(words, " ")
+	})
+}
@@ -0,0 +1,30 @@
+package styles
+
+import "testing"
+
+func TestPlain(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"box drawing", "╔══╗\n║ab║\n╚══╝", "    \n ab \n    "},
+		{"banner letters", "║  🍅  F O C U S  ║", "     FOCUS   "},
+		{"words between banner gaps", "R E A D Y   T O   F O C U S", "READY TO FOCUS"},
+		{"short capitals stay", "A B testing", "A B testing"},
+		{"item icons", "📁 launch • 💤 Mar 9", "(project) launch, (snoozed) Mar 9"},
+		{"emoji selector", "⚠️ Delete Todo?", "(warning) Delete Todo?"},
+		{"selection and keys", "▶ Notes  [↑/↓] Move", "> Notes  [Up/Down] Move"},
+		{"decoration dropped", "✦ Saved ✨", " Saved "},
+		{"bars", "▓▓▓░░", "###--"},
+		{"ansi kept", "\x1b[1m★\x1b[0m Star", "\x1b[1m(starred)\x1b[0m Star"},
+		{"osc kept", "\x1b]2;flow 🍅\a📝", "\x1b]2;flow 🍅\a(note)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Plain(tt.in); got != tt.want {
+				t.Errorf("Plain(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
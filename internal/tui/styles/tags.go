@@ -0,0 +1,40 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Tag looks (Phase 6: Organization).
+//
+// Tags can be given a color and an icon on the Tags screen. The app
+// passes them to SetTagLooks at startup and after each change; FormatTag
+// and the tag pills in the lists and previews draw tags through TagText
+// and ColorTag so every tag looks the same wherever it appears.
+
+// TagLook is how one tag is drawn.
+type TagLook struct {
+	Color string // Hex or ANSI color; "" for the theme's
+	Icon  string // Shown before the #, e.g. "🔥"
+}
+
+var tagLooks map[string]TagLook
+
+// SetTagLooks sets the looks of styled tags, by tag.
+func SetTagLooks(looks map[string]TagLook) {
+	tagLooks = looks
+}
+
+// TagText returns "#tag", with the tag's icon in front when it has one.
+func TagText(tag string) string {
+	if icon := tagLooks[tag].Icon; icon != "" {
+		return icon + " #" + tag
+	}
+	return "#" + tag
+}
+
+// ColorTag returns style with the tag's color as its foreground, or style
+// unchanged when the tag has no color.
+func ColorTag(style lipgloss.Style, tag string) lipgloss.Style {
+	if color := tagLooks[tag].Color; color != "" {
+		return style.Foreground(lipgloss.Color(color))
+	}
+	return style
+}
@@ -0,0 +1,24 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatTagLooks(t *testing.T) {
+	SetTagLooks(map[string]TagLook{"urgent": {Color: "#ff5f87", Icon: "🔥"}})
+	defer SetTagLooks(nil)
+
+	if got := FormatTag("urgent"); !strings.Contains(got, "🔥 #urgent") {
+		t.Errorf("FormatTag(urgent) = %q, want the icon before the tag", got)
+	}
+	if got := TagText("work"); got != "#work" {
+		t.Errorf("TagText(work) = %q, want #work", got)
+	}
+	if ColorTag(TagStyle, "urgent").GetForeground() == TagStyle.GetForeground() {
+		t.Errorf("expected urgent to get its own color")
+	}
+	if ColorTag(TagStyle, "work").GetForeground() != TagStyle.GetForeground() {
+		t.Errorf("expected work to keep the theme's color")
+	}
+}
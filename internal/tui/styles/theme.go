@@ -1,6 +1,7 @@
 package styles
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -39,276 +40,327 @@ const LogoASCIISmall = `
 // LogoMinWidth is the minimum terminal width for full ASCII logo
 const LogoMinWidth = 72
 
-// ARCHWAVE vaporwave color palette - pastel pinks, purples, and cyans
+// Palette colors, set from the active palette by UsePalette (see
+// palette.go).
 var (
-	// Primary colors - vaporwave spectrum
-	PrimaryColor   = lipgloss.Color("#d4a5ff") // Soft lavender
-	SecondaryColor = lipgloss.Color("#5ffbf1") // Neon cyan
-	AccentColor    = lipgloss.Color("#ff6ec7") // Hot pink
+	// Primary colors
+	PrimaryColor, SecondaryColor, AccentColor lipgloss.TerminalColor
 
 	// Semantic colors
-	SuccessColor = lipgloss.Color("#8ffef4") // Light cyan
-	WarningColor = lipgloss.Color("#f9f871") // Pale yellow
-	ErrorColor   = lipgloss.Color("#ff9adc") // Soft pink
-	TimerColor   = lipgloss.Color("#ff6ec7") // Hot pink for timer
+	SuccessColor, WarningColor, ErrorColor, TimerColor lipgloss.TerminalColor
 
-	// Background colors - deep purple-black
-	BackgroundColor = lipgloss.Color("#1a0d2e") // Deep purple-black
-	SurfaceColor    = lipgloss.Color("#2d1b4e") // Dark purple
-	BorderColor     = lipgloss.Color("#543a6e") // Muted purple
+	// Background colors
+	BackgroundColor, SurfaceColor, BorderColor lipgloss.TerminalColor
 
 	// Text colors
-	TextColor      = lipgloss.Color("#fef6ff") // Off-white pink
-	MutedColor     = lipgloss.Color("#b8c1ff") // Pale blue
-	HighlightColor = lipgloss.Color("#ffffff") // Pure white for highlights
+	TextColor, MutedColor, HighlightColor lipgloss.TerminalColor
 
-	// Additional ARCHWAVE colors
-	NeonPink    = lipgloss.Color("#f4a5ff") // Soft neon pink
-	PaleAqua    = lipgloss.Color("#8ffef4") // Pale aqua
-	CreamYellow = lipgloss.Color("#fbf9a5") // Cream yellow
-	Periwinkle  = lipgloss.Color("#8b9aff") // Periwinkle blue
-	PalePink    = lipgloss.Color("#ffc8ff") // Pale pink
+	// Additional colors
+	NeonPink, PaleAqua, CreamYellow, Periwinkle, PalePink lipgloss.TerminalColor
+)
+
+// Shared styles, built from the palette by buildStyles.
+var (
+	LogoStyle              lipgloss.Style
+	TitleStyle             lipgloss.Style
+	SubtitleStyle          lipgloss.Style
+	MenuItemStyle          lipgloss.Style
+	MenuItemActiveStyle    lipgloss.Style
+	SelectedItemStyle      lipgloss.Style
+	StatusBarStyle         lipgloss.Style
+	TimerStyle             lipgloss.Style
+	TimerActiveStyle       lipgloss.Style
+	ContainerStyle         lipgloss.Style
+	PanelStyle             lipgloss.Style
+	PanelActiveStyle       lipgloss.Style
+	BorderStyle            lipgloss.Style
+	NeonStyle              lipgloss.Style
+	RetroBoxStyle          lipgloss.Style
+	TagStyle               lipgloss.Style
+	ProgressBarStyle       lipgloss.Style
+	ProgressBarFilledStyle lipgloss.Style
+	InputStyle             lipgloss.Style
+	InputFocusedStyle      lipgloss.Style
+	HelpStyle              lipgloss.Style
+	KeyStyle               lipgloss.Style
+	DescStyle              lipgloss.Style
+	SuccessStyle           lipgloss.Style
+	ErrorStyle             lipgloss.Style
+	WarningStyle           lipgloss.Style
+	DividerStyle           lipgloss.Style
+	CardStyle              lipgloss.Style
+	CardActiveStyle        lipgloss.Style
+	BadgeStyle             lipgloss.Style
+	BadgeSuccessStyle      lipgloss.Style
+	BadgeWarningStyle      lipgloss.Style
+	BadgeErrorStyle        lipgloss.Style
+	BadgeInfoStyle         lipgloss.Style
+	SectionHeaderStyle     lipgloss.Style
+	CardMutedStyle         lipgloss.Style
+	HighlightBoxStyle      lipgloss.Style
+	EmptyStateStyle        lipgloss.Style
+	CountBadgeStyle        lipgloss.Style
+	LinkStyle              lipgloss.Style
+	CodeStyle              lipgloss.Style
+	CodeBlockStyle         lipgloss.Style
+	CodeKeywordStyle       lipgloss.Style
+	CodeStringStyle        lipgloss.Style
+	CodeKeyStyle           lipgloss.Style
+	CodeNumberStyle        lipgloss.Style
+	CodeCommentStyle       lipgloss.Style
+)
 
+// buildStyles builds the shared styles from the current palette colors.
+func buildStyles() {
 	// Logo style with gradient effect
 	LogoStyle = lipgloss.NewStyle().
-			Foreground(PrimaryColor).
-			Bold(true)
+		Foreground(PrimaryColor).
+		Bold(true)
 
 	// Title style - larger, more prominent
 	TitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(PrimaryColor).
-			MarginBottom(1).
-			Padding(0, 1)
+		Bold(true).
+		Foreground(PrimaryColor).
+		MarginBottom(1).
+		Padding(0, 1)
 
 	// Subtitle style
 	SubtitleStyle = lipgloss.NewStyle().
-			Foreground(MutedColor).
-			Italic(true).
-			MarginBottom(2)
+		Foreground(MutedColor).
+		Italic(true).
+		MarginBottom(2)
 
 	// Menu item styles
 	MenuItemStyle = lipgloss.NewStyle().
-			Foreground(TextColor).
-			Padding(0, 2).
-			MarginLeft(2)
+		Foreground(TextColor).
+		Padding(0, 2).
+		MarginLeft(2)
 
 	MenuItemActiveStyle = lipgloss.NewStyle().
-				Foreground(SecondaryColor).
-				Bold(true).
-				Padding(0, 2).
-				MarginLeft(2)
+		Foreground(SecondaryColor).
+		Bold(true).
+		Padding(0, 2).
+		MarginLeft(2)
 
 	// Selected/active item style
 	SelectedItemStyle = lipgloss.NewStyle().
-				Foreground(SecondaryColor).
-				Bold(true).
-				Background(SurfaceColor).
-				Padding(0, 1)
+		Foreground(SecondaryColor).
+		Bold(true).
+		Background(SurfaceColor).
+		Padding(0, 1)
 
 	// Status bar - more prominent with accent
 	StatusBarStyle = lipgloss.NewStyle().
-			Background(SurfaceColor).
-			Foreground(MutedColor).
-			Padding(0, 2).
-			MarginTop(1)
+		Background(SurfaceColor).
+		Foreground(MutedColor).
+		Padding(0, 2).
+		MarginTop(1)
 
 	// Timer display style
 	TimerStyle = lipgloss.NewStyle().
-			Foreground(TimerColor).
-			Bold(true).
-			Padding(1, 4)
+		Foreground(TimerColor).
+		Bold(true).
+		Padding(1, 4)
 
 	TimerActiveStyle = lipgloss.NewStyle().
-				Foreground(SuccessColor).
-				Bold(true).
-				Padding(1, 4)
+		Foreground(SuccessColor).
+		Bold(true).
+		Padding(1, 4)
 
 	// Container with border
 	ContainerStyle = lipgloss.NewStyle().
-			Background(BackgroundColor).
-			Padding(1, 2)
+		Background(BackgroundColor).
+		Padding(1, 2)
 
 	// Panel with double border (vaporwave aesthetic)
 	PanelStyle = lipgloss.NewStyle().
-			Border(lipgloss.DoubleBorder()).
-			BorderForeground(BorderColor).
-			Padding(1, 2)
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(BorderColor).
+		Padding(1, 2)
 
 	// Highlighted panel (for focused elements)
 	PanelActiveStyle = lipgloss.NewStyle().
-				Border(lipgloss.DoubleBorder()).
-				BorderForeground(AccentColor).
-				Padding(1, 2)
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(AccentColor).
+		Padding(1, 2)
 
 	// Border style for sections
 	BorderStyle = lipgloss.NewStyle().
-			Border(lipgloss.DoubleBorder()).
-			BorderForeground(BorderColor)
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(BorderColor)
 
 	// Neon glow style for important elements
 	NeonStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Bold(true)
+		Foreground(SecondaryColor).
+		Bold(true)
 
 	// Retro box style with hot pink border
 	RetroBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.DoubleBorder()).
-			BorderForeground(AccentColor).
-			Padding(1, 2)
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(AccentColor).
+		Padding(1, 2)
 
 	// Tag style - pill-like appearance
 	TagStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Background(SurfaceColor).
-			Padding(0, 1).
-			MarginRight(1)
+		Foreground(SecondaryColor).
+		Background(SurfaceColor).
+		Padding(0, 1).
+		MarginRight(1)
 
 	// Progress bar style
 	ProgressBarStyle = lipgloss.NewStyle().
-				Foreground(PrimaryColor)
+		Foreground(PrimaryColor)
 
 	ProgressBarFilledStyle = lipgloss.NewStyle().
-				Foreground(SuccessColor)
+		Foreground(SuccessColor)
 
 	// Input field styles
 	InputStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(BorderColor).
-			Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(BorderColor).
+		Padding(0, 1)
 
 	InputFocusedStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(AccentColor).
-				Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(AccentColor).
+		Padding(0, 1)
 
 	// Help text style
 	HelpStyle = lipgloss.NewStyle().
-			Foreground(MutedColor).
-			Italic(true).
-			MarginTop(1)
+		Foreground(MutedColor).
+		Italic(true).
+		MarginTop(1)
 
 	// Keyboard shortcut style
 	KeyStyle = lipgloss.NewStyle().
-			Foreground(AccentColor).
-			Bold(true)
+		Foreground(AccentColor).
+		Bold(true)
 
 	// Description/label in help
 	DescStyle = lipgloss.NewStyle().
-			Foreground(MutedColor)
+		Foreground(MutedColor)
 
 	// Success message style
 	SuccessStyle = lipgloss.NewStyle().
-			Foreground(SuccessColor).
-			Bold(true)
+		Foreground(SuccessColor).
+		Bold(true)
 
 	// Error message style
 	ErrorStyle = lipgloss.NewStyle().
-			Foreground(ErrorColor).
-			Bold(true)
+		Foreground(ErrorColor).
+		Bold(true)
 
 	// Warning message style
 	WarningStyle = lipgloss.NewStyle().
-			Foreground(WarningColor)
+		Foreground(WarningColor)
 
 	// Divider line
 	DividerStyle = lipgloss.NewStyle().
-			Foreground(BorderColor)
+		Foreground(BorderColor)
 
 	// Card styles for list items (enhanced visual hierarchy)
 	CardStyle = lipgloss.NewStyle().
-			Background(SurfaceColor).
-			Padding(0, 1).
-			MarginBottom(1)
+		Background(SurfaceColor).
+		Padding(0, 1).
+		MarginBottom(1)
 
 	CardActiveStyle = lipgloss.NewStyle().
-			Background(SurfaceColor).
-			BorderLeft(true).
-			BorderStyle(lipgloss.ThickBorder()).
-			BorderForeground(AccentColor).
-			Padding(0, 1).
-			MarginBottom(1)
+		Background(SurfaceColor).
+		BorderLeft(true).
+		BorderStyle(lipgloss.ThickBorder()).
+		BorderForeground(AccentColor).
+		Padding(0, 1).
+		MarginBottom(1)
 
 	// Badge styles for status indicators
 	BadgeStyle = lipgloss.NewStyle().
-			Foreground(TextColor).
-			Background(BorderColor).
-			Padding(0, 1)
+		Foreground(TextColor).
+		Background(BorderColor).
+		Padding(0, 1)
 
 	BadgeSuccessStyle = lipgloss.NewStyle().
-				Foreground(BackgroundColor).
-				Background(SuccessColor).
-				Bold(true).
-				Padding(0, 1)
+		Foreground(BackgroundColor).
+		Background(SuccessColor).
+		Bold(true).
+		Padding(0, 1)
 
 	BadgeWarningStyle = lipgloss.NewStyle().
-				Foreground(BackgroundColor).
-				Background(WarningColor).
-				Bold(true).
-				Padding(0, 1)
+		Foreground(BackgroundColor).
+		Background(WarningColor).
+		Bold(true).
+		Padding(0, 1)
 
 	BadgeErrorStyle = lipgloss.NewStyle().
-			Foreground(BackgroundColor).
-			Background(ErrorColor).
-			Bold(true).
-			Padding(0, 1)
+		Foreground(BackgroundColor).
+		Background(ErrorColor).
+		Bold(true).
+		Padding(0, 1)
 
 	BadgeInfoStyle = lipgloss.NewStyle().
-			Foreground(BackgroundColor).
-			Background(SecondaryColor).
-			Bold(true).
-			Padding(0, 1)
+		Foreground(BackgroundColor).
+		Background(SecondaryColor).
+		Bold(true).
+		Padding(0, 1)
 
 	// Section header style with decorative line
 	SectionHeaderStyle = lipgloss.NewStyle().
-				Foreground(PrimaryColor).
-				Bold(true).
-				BorderBottom(true).
-				BorderStyle(lipgloss.NormalBorder()).
-				BorderForeground(BorderColor).
-				MarginBottom(1).
-				PaddingBottom(0)
+		Foreground(PrimaryColor).
+		Bold(true).
+		BorderBottom(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(BorderColor).
+		MarginBottom(1).
+		PaddingBottom(0)
 
 	// Muted card for completed/inactive items
 	CardMutedStyle = lipgloss.NewStyle().
-			Foreground(MutedColor).
-			Background(BackgroundColor).
-			Padding(0, 1).
-			MarginBottom(1)
+		Foreground(MutedColor).
+		Background(BackgroundColor).
+		Padding(0, 1).
+		MarginBottom(1)
 
 	// Highlight box for important messages
 	HighlightBoxStyle = lipgloss.NewStyle().
-				Foreground(TextColor).
-				Background(SurfaceColor).
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(AccentColor).
-				Padding(1, 2)
+		Foreground(TextColor).
+		Background(SurfaceColor).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(AccentColor).
+		Padding(1, 2)
 
 	// Empty state style
 	EmptyStateStyle = lipgloss.NewStyle().
-			Foreground(MutedColor).
-			Align(lipgloss.Center).
-			Italic(true).
-			Padding(2, 4)
+		Foreground(MutedColor).
+		Align(lipgloss.Center).
+		Italic(true).
+		Padding(2, 4)
 
 	// Count badge (for item counts in headers)
 	CountBadgeStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Background(SurfaceColor).
-			Padding(0, 1).
-			Bold(true)
+		Foreground(SecondaryColor).
+		Background(SurfaceColor).
+		Padding(0, 1).
+		Bold(true)
 
 	// Inline link style
 	LinkStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Underline(true)
+		Foreground(SecondaryColor).
+		Underline(true)
 
 	// Code/monospace style
 	CodeStyle = lipgloss.NewStyle().
-			Foreground(PaleAqua).
-			Background(SurfaceColor).
-			Padding(0, 1)
-)
+		Foreground(PaleAqua).
+		Background(SurfaceColor).
+		Padding(0, 1)
+
+	// Code block token styles. Each carries the code background so a line
+	// stays one solid block after the per-token ANSI resets.
+	CodeBlockStyle = lipgloss.NewStyle().Foreground(PaleAqua).Background(SurfaceColor)
+	CodeKeywordStyle = CodeBlockStyle.Foreground(PrimaryColor).Bold(true)
+	CodeStringStyle = CodeBlockStyle.Foreground(CreamYellow)
+	CodeKeyStyle = CodeBlockStyle.Foreground(SecondaryColor)
+	CodeNumberStyle = CodeBlockStyle.Foreground(AccentColor)
+	CodeCommentStyle = CodeBlockStyle.Foreground(MutedColor).Italic(true)
+}
 
 // Helper function to create a full-screen container
 func Screen(width, height int) lipgloss.Style {
@@ -362,10 +414,13 @@ func VaporwaveProgressBar(progress float64, width int) string {
 }
 
 // GradientText applies alternating colors to text for a gradient-like effect
-func GradientText(text string, colors ...lipgloss.Color) string {
+func GradientText(text string, colors ...lipgloss.TerminalColor) string {
 	if len(colors) == 0 || len(text) == 0 {
 		return text
 	}
+	if ReducedMotion() {
+		return lipgloss.NewStyle().Foreground(colors[0]).Render(text)
+	}
 
 	var result strings.Builder
 	runes := []rune(text)
@@ -436,9 +491,10 @@ func EmptyState(message string) string {
 	return EmptyStateStyle.Render(DecoStar + " " + message + " " + DecoStar)
 }
 
-// FormatTag renders a tag with proper styling
+// FormatTag renders a tag with proper styling, in its color and with
+// its icon when set (see SetTagLooks)
 func FormatTag(tag string) string {
-	return TagStyle.Render("#" + tag)
+	return ColorTag(TagStyle, tag).Render(TagText(tag))
 }
 
 // FormatTags renders multiple tags with proper styling
@@ -540,10 +596,13 @@ var asciiDigits = map[rune][]string{
 
 // RenderASCIITime renders a time string (e.g., "25:00") as large ASCII art
 // Returns a slice of strings, one per line
-func RenderASCIITime(timeStr string, color lipgloss.Color) string {
+func RenderASCIITime(timeStr string, color lipgloss.TerminalColor) string {
 	lines := make([]string, 5)
 
 	style := lipgloss.NewStyle().Foreground(color).Bold(true)
+	if PlainOutput() {
+		return style.Render(timeStr)
+	}
 
 	for _, char := range timeStr {
 		digit, ok := asciiDigits[char]
@@ -567,6 +626,18 @@ func RenderASCIITime(timeStr string, color lipgloss.Color) string {
 	return result.String()
 }
 
+// RenderCompactTime renders a time string in a small rounded box, the
+// fallback for terminals too narrow for RenderASCIITime.
+func RenderCompactTime(timeStr string, color lipgloss.TerminalColor) string {
+	return lipgloss.NewStyle().
+		Foreground(color).
+		Bold(true).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(0, 2).
+		Render(timeStr)
+}
+
 // ASCII art for session mode indicators
 const (
 	WorkModeASCII = `
@@ -714,6 +785,9 @@ func SessionCountIndicator(count, max int) string {
 	if max <= 0 {
 		max = 8
 	}
+	if PlainOutput() {
+		return lipgloss.NewStyle().Foreground(SuccessColor).Render(fmt.Sprintf("%d of %d", min(count, max), max))
+	}
 
 	var result strings.Builder
 	completedStyle := lipgloss.NewStyle().Foreground(SuccessColor)
@@ -735,7 +809,7 @@ func SessionCountIndicator(count, max int) string {
 
 // GlowBorder wraps content in a neon-glow styled border
 // Creates a vaporwave aesthetic with the specified glow color
-func GlowBorder(content string, glowColor lipgloss.Color) string {
+func GlowBorder(content string, glowColor lipgloss.TerminalColor) string {
 	// Create the glow effect using a colored double border
 	glowStyle := lipgloss.NewStyle().
 		Border(lipgloss.DoubleBorder()).
@@ -41,6 +41,18 @@ func TestRenderASCIITimeContainsDigitArt(t *testing.T) {
 	}
 }
 
+func TestRenderCompactTime(t *testing.T) {
+	result := RenderCompactTime("25:00", PrimaryColor)
+
+	lines := strings.Split(result, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+	if !strings.Contains(lines[1], "25:00") {
+		t.Errorf("expected the time on the middle line, got %q", lines[1])
+	}
+}
+
 func TestRenderProgressRing(t *testing.T) {
 	tests := []struct {
 		name     string
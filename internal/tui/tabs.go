@@ -0,0 +1,247 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
+)
+
+// Tabbed workspaces (Phase 10: Navigation).
+//
+// Each tab has its own notes, todos, search, mind map, planner, projects,
+// inbox and starred screens plus its own navigation history, so two tabs can show
+// differently filtered views of the same notes. Alt+1..9 switches tabs;
+// the number after the last tab opens a new one on the current screen,
+// unfiltered. Alt+W closes the current tab. The focus timer, quick capture
+// and link modal are shared by all tabs.
+
+// maxTabs is how many tabs Alt+1..9 can reach.
+const maxTabs = 9
+
+// workspace is one tab's screens, current screen and history.
+type workspace struct {
+	screen       Screen
+	notes        *screens.NotesListModel
+	todos        *screens.TodosListModel
+	search       *screens.SearchModel
+	mindMap      *screens.MindMapModel
+	planner      *screens.PlannerModel
+	projects     *screens.ProjectsModel
+	inbox        *screens.InboxModel
+	starred      *screens.StarredModel
+	backStack    []navEntry
+	forwardStack []navEntry
+}
+
+// newWorkspace creates a tab's screens, configured from config.json and
+// starting on screen.
+func (m *Model) newWorkspace(screen Screen) workspace {
+	cfg := m.config
+
+	notesScreen := screens.NewNotesListModel(m.store)
+	if m.spell != nil {
+		notesScreen.SetSpellChecker(m.spell)
+	}
+	notesScreen.SetListDensity(cfg.CompactList("notes"))
+	notesScreen.SetLineNumbers(cfg.EditorLineNumbers)
+	if !cfg.SafeMode {
+		notesScreen.SetShareCommand(cfg.ShareCommand)
+		notesScreen.SetSummarizeCommand(cfg.SummarizeCommand)
+	}
+	notesScreen.SetStaleAfter(cfg.StaleNoteAge())
+	notesScreen.SetPreviewAfterCreate(cfg.PreviewAfterCreate)
+	if cfg.SuggestTags() && !cfg.ReadOnly && !cfg.SafeMode {
+		notesScreen.SetTagSuggester(m.semantic)
+	}
+	todosScreen := screens.NewTodosListModel(m.store)
+	todosScreen.SetListDensity(cfg.CompactList("todos"))
+	todosScreen.SetStaleAfter(cfg.StaleTodoAge())
+	todosScreen.SetPreviewAfterCreate(cfg.PreviewAfterCreate)
+	searchScreen := screens.NewSearchModel(m.store, m.semantic)
+	mindMapScreen := screens.NewMindMapModel(m.store)
+	plannerScreen := screens.NewPlannerModel(m.store)
+	plannerScreen.SetCapacity(cfg.DailyCapacityMinutes())
+	projectsScreen := screens.NewProjectsModel(m.store)
+	inboxScreen := screens.NewInboxModel(m.store)
+	starredScreen := screens.NewStarredModel(m.store)
+
+	return workspace{
+		screen:   screen,
+		notes:    &notesScreen,
+		todos:    &todosScreen,
+		search:   &searchScreen,
+		mindMap:  &mindMapScreen,
+		planner:  &plannerScreen,
+		projects: &projectsScreen,
+		inbox:    &inboxScreen,
+		starred:  &starredScreen,
+	}
+}
+
+// saveTab stores the active tab's state back into tabs.
+func (m *Model) saveTab() {
+	m.tabs[m.activeTab] = m.workspace()
+}
+
+// workspace returns the active tab's current state.
+func (m *Model) workspace() workspace {
+	return workspace{
+		screen:       m.currentScreen,
+		notes:        m.notesScreen,
+		todos:        m.todosScreen,
+		search:       m.searchScreen,
+		mindMap:      m.mindMapScreen,
+		planner:      m.plannerScreen,
+		projects:     m.projectsScreen,
+		inbox:        m.inboxScreen,
+		starred:      m.starredScreen,
+		backStack:    m.backStack,
+		forwardStack: m.forwardStack,
+	}
+}
+
+// useTab makes tab i active, without reloading its screens.
+func (m *Model) useTab(i int) {
+	w := m.tabs[i]
+	m.activeTab = i
+	m.currentScreen = w.screen
+	m.notesScreen = w.notes
+	m.todosScreen = w.todos
+	m.searchScreen = w.search
+	m.mindMapScreen = w.mindMap
+	m.plannerScreen = w.planner
+	m.projectsScreen = w.projects
+	m.inboxScreen = w.inbox
+	m.starredScreen = w.starred
+	m.backStack = w.backStack
+	m.forwardStack = w.forwardStack
+	if m.width > 0 {
+		m.SetSize(m.width, m.height)
+	}
+}
+
+// switchTab shows tab i (0-based), opening a new tab when i is just past
+// the last one. Other tabs may have changed the data, so the tab's screen
+// is reloaded with its selection kept.
+func (m *Model) switchTab(i int) string {
+	switch {
+	case i == m.activeTab:
+		return ""
+	case i < len(m.tabs):
+		m.saveTab()
+		m.useTab(i)
+		m.restore(m.here())
+		return ""
+	case i == len(m.tabs):
+		m.saveTab()
+		m.tabs = append(m.tabs, m.newWorkspace(m.currentScreen))
+		m.useTab(i)
+		m.enterScreen(m.currentScreen)
+		return fmt.Sprintf("Opened tab %d", i+1)
+	default:
+		return fmt.Sprintf("No tab %d — Alt+%d opens a new one", i+1, len(m.tabs)+1)
+	}
+}
+
+// closeTab closes the active tab and shows the one before it. The last
+// tab cannot be closed.
+func (m *Model) closeTab() string {
+	if len(m.tabs) == 1 {
+		return "Only one tab open"
+	}
+	closed := m.activeTab
+	m.tabs = append(m.tabs[:closed], m.tabs[closed+1:]...)
+	next := closed - 1
+	if next < 0 {
+		next = 0
+	}
+	m.useTab(next)
+	m.restore(m.here())
+	return fmt.Sprintf("Closed tab %d", closed+1)
+}
+
+// tabToast shows text, when there is any, as a toast.
+func (m *Model) tabToast(text string) tea.Cmd {
+	if text == "" {
+		return nil
+	}
+	return m.showToast(text)
+}
+
+// tabKey returns the tab index for Alt+1..Alt+9, or -1 for other keys.
+func tabKey(key string) int {
+	if len(key) == len("alt+1") && strings.HasPrefix(key, "alt+") && key[4] >= '1' && key[4] <= '9' {
+		return int(key[4] - '1')
+	}
+	return -1
+}
+
+// tabStrip renders the tab list for the status bar, e.g.
+// "1 Notes #work  [2 Notes #home]", or "" with a single tab.
+func (m *Model) tabStrip() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+	labels := make([]string, len(m.tabs))
+	for i, w := range m.tabs {
+		if i == m.activeTab {
+			w = m.workspace()
+		}
+		label := fmt.Sprintf("%d %s", i+1, screenTitle(w.screen))
+		filter := ""
+		switch w.screen {
+		case ScreenNotes:
+			filter = w.notes.FilterLabel()
+		case ScreenTodos:
+			filter = w.todos.FilterLabel()
+		}
+		if filter != "" {
+			label += " " + filter
+		}
+		if i == m.activeTab {
+			label = "[" + label + "]"
+		}
+		labels[i] = label
+	}
+	return strings.Join(labels, "  ")
+}
+
+// screenTitle names screen as the status bar does.
+func screenTitle(screen Screen) string {
+	switch screen {
+	case ScreenNotes:
+		return "Notes"
+	case ScreenTodos:
+		return "Todos"
+	case ScreenFocus:
+		return "Focus"
+	case ScreenSearch:
+		return "Search"
+	case ScreenMindMap:
+		return "Mind Map"
+	case ScreenPlanner:
+		return "Planner"
+	case ScreenProjects:
+		return "Projects"
+	case ScreenInbox:
+		return "Inbox"
+	case ScreenStarred:
+		return "Starred"
+	case ScreenDiagnostics:
+		return "Diagnostics"
+	case ScreenDone:
+		return "Done"
+	case ScreenHabits:
+		return "Habits"
+	case ScreenPlugins:
+		return "Plugins"
+	case ScreenTags:
+		return "Tags"
+	case ScreenModel:
+		return "Model"
+	}
+	return "Home"
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Terminal title (Phase 10: Navigation).
+//
+// The terminal's title shows the current screen and, while a focus
+// session runs, its remaining time ("flowState — 18:42 🍅 · Notes"), so
+// the countdown stays visible from another tab or window. The title is
+// set with an OSC escape sequence only when it changes, at most once a
+// second while the timer ticks. terminal_title: false turns it off.
+
+// appTitle starts every terminal title.
+const appTitle = "flowState"
+
+// terminalTitle returns the title for the current screen and focus timer.
+func (m *Model) terminalTitle() string {
+	title := appTitle + " — "
+	if m.focusScreen != nil {
+		if status := m.focusScreen.TitleStatus(); status != "" {
+			title += status + " · "
+		}
+	}
+	return title + screenTitle(m.currentScreen)
+}
+
+// titleCmd sets the terminal title when it has changed since it was last
+// set, and returns nil otherwise.
+func (m *Model) titleCmd() tea.Cmd {
+	if m.config != nil && !m.config.TerminalTitle() {
+		return nil
+	}
+	title := m.terminalTitle()
+	if title == m.windowTitle {
+		return nil
+	}
+	m.windowTitle = title
+	return tea.SetWindowTitle(title)
+}
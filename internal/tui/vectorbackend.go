@@ -0,0 +1,49 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/search"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/qdrant"
+)
+
+// Vector backend (Phase 5: Semantic Search).
+//
+// By default ("memory") semantic search keeps its vectors in a
+// qdrant.VectorStore saved to vectors.idx in the data directory, so a
+// restart loads them rather than sending every vector again, and
+// ann_search can search it through the HNSW graph. vector_backend
+// "qdrant" keeps and searches them in the Qdrant server at qdrant_url
+// instead. When the backend cannot be used, the app searches the
+// database as usual and says why in a toast. A read-only attach searches
+// the database too: the vector file belongs to the instance that owns
+// it.
+
+// openVectorBackend returns the backend cfg selects, nil to search the
+// database, and a toast to show when the configured one cannot be used.
+func openVectorBackend(cfg *config.Config) (search.VectorBackend, string) {
+	switch backend := strings.ToLower(strings.TrimSpace(cfg.VectorBackend)); backend {
+	case "", "memory":
+		if cfg.ReadOnly {
+			return nil, ""
+		}
+		store, err := qdrant.New(cfg)
+		if err != nil {
+			return nil, err.Error() + "; searching the database"
+		}
+		return store, ""
+	case "qdrant":
+		client, err := qdrant.NewClient(cfg)
+		if err != nil {
+			return nil, "vector_backend: " + err.Error() + "; searching locally"
+		}
+		if !client.IsAvailable() {
+			return nil, fmt.Sprintf("Qdrant at %s is unreachable; searching locally", cfg.QdrantUrl)
+		}
+		return client, ""
+	default:
+		return nil, fmt.Sprintf("vector_backend: unknown backend %q; searching locally", cfg.VectorBackend)
+	}
+}
@@ -0,0 +1,191 @@
+// Package workflow holds the todo statuses a user works through.
+//
+// Phase 2: Todos - Custom statuses
+//   - The built-in statuses are pending, in_progress and completed;
+//     todo_statuses adds more, e.g. "waiting", "blocked" or "review"
+//   - The order of todo_statuses is the workflow order: the status
+//     filter, the x key that moves a todo on and the table's status sort
+//     follow it. A built-in status may be listed to place it or give it
+//     a label, icon or color; pending is otherwise first and in_progress
+//     and completed last
+//   - A status with "cycle": false is skipped by x, for statuses set on
+//     purpose such as "blocked"; it can still be filtered on
+//   - Statuses are stored as their names, so todos keep a status that is
+//     later removed from the config; it shows as its name
+//   - Only completed counts as done: every other status is open
+//
+// The app calls Set once at startup; screens look statuses up through
+// the package functions, like datefmt.
+//
+// Usage:
+//
+//	w, err := workflow.New(cfg.TodoStatuses)
+//	workflow.Set(w)
+//	next := workflow.Next(todo.Status)
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+// Status is one status of the workflow.
+type Status struct {
+	Name  models.TodoStatus
+	Label string // e.g. "Waiting"
+	Icon  string // List indicator, e.g. "⏳"
+	Color string // Hex or ANSI color; "" for the theme's
+	Cycle bool   // Reached by the x key
+}
+
+// Workflow is the ordered list of statuses.
+type Workflow struct {
+	statuses []Status
+}
+
+// builtin are the statuses every workflow has, in their default order.
+var builtin = []Status{
+	{Name: models.TodoStatusPending, Label: "Pending", Icon: "○", Cycle: true},
+	{Name: models.TodoStatusInProgress, Label: "In progress", Icon: "◐", Cycle: true},
+	{Name: models.TodoStatusCompleted, Label: "Completed", Icon: "✓", Cycle: true},
+}
+
+// namePattern is what a status name may look like, so it reads well in
+// filters and pinned filter labels.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// New builds the workflow from todo_statuses. Invalid or repeated
+// entries are left out and reported in the error; the workflow is usable
+// either way.
+func New(custom []config.TodoStatus) (Workflow, error) {
+	var w Workflow
+	var errs []string
+	seen := map[models.TodoStatus]bool{}
+	for _, c := range custom {
+		name := models.TodoStatus(strings.ToLower(strings.TrimSpace(c.Name)))
+		switch {
+		case !namePattern.MatchString(string(name)):
+			errs = append(errs, fmt.Sprintf("invalid todo status %q (use lowercase letters, digits and _)", c.Name))
+			continue
+		case seen[name]:
+			errs = append(errs, fmt.Sprintf("todo status %q is listed twice", name))
+			continue
+		}
+		seen[name] = true
+
+		s := Status{Name: name, Label: humanize(string(name)), Icon: "◇", Cycle: true}
+		for _, b := range builtin {
+			if b.Name == name {
+				s = b
+			}
+		}
+		if label := strings.TrimSpace(c.Label); label != "" {
+			s.Label = label
+		}
+		if icon := strings.TrimSpace(c.Icon); icon != "" {
+			s.Icon = icon
+		}
+		s.Color = strings.TrimSpace(c.Color)
+		if c.Cycle != nil {
+			s.Cycle = *c.Cycle
+		}
+		w.statuses = append(w.statuses, s)
+	}
+
+	// Built-ins not listed: pending first, the others last
+	if !seen[models.TodoStatusPending] {
+		w.statuses = append([]Status{builtin[0]}, w.statuses...)
+	}
+	for _, b := range builtin[1:] {
+		if !seen[b.Name] {
+			w.statuses = append(w.statuses, b)
+		}
+	}
+	if len(errs) > 0 {
+		return w, errors.New(strings.Join(errs, "; "))
+	}
+	return w, nil
+}
+
+// humanize turns a status name into a label: "needs_review" becomes
+// "Needs review".
+func humanize(name string) string {
+	label := strings.ReplaceAll(name, "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// Statuses returns the statuses in workflow order.
+func (w Workflow) Statuses() []Status {
+	return w.statuses
+}
+
+// Get returns the named status. A status missing from the workflow, left
+// over from an earlier config, is labeled with its name.
+func (w Workflow) Get(name models.TodoStatus) Status {
+	for _, s := range w.statuses {
+		if s.Name == name {
+			return s
+		}
+	}
+	if name == "" {
+		return builtin[0]
+	}
+	return Status{Name: name, Label: string(name), Icon: "◇"}
+}
+
+// Rank returns the position of the named status in the workflow; unknown
+// statuses sort last.
+func (w Workflow) Rank(name models.TodoStatus) int {
+	for i, s := range w.statuses {
+		if s.Name == name {
+			return i
+		}
+	}
+	return len(w.statuses)
+}
+
+// Next returns the status after name among those reached by cycling,
+// wrapping from the last to the first. An unknown status moves to the
+// first.
+func (w Workflow) Next(name models.TodoStatus) models.TodoStatus {
+	var cycle []models.TodoStatus
+	for _, s := range w.statuses {
+		if s.Cycle {
+			cycle = append(cycle, s.Name)
+		}
+	}
+	if len(cycle) == 0 {
+		return name
+	}
+	for i, s := range cycle {
+		if s == name {
+			return cycle[(i+1)%len(cycle)]
+		}
+	}
+	return cycle[0]
+}
+
+var current, _ = New(nil)
+
+// Set makes w the workflow used by the package functions.
+func Set(w Workflow) {
+	current = w
+}
+
+// Statuses returns the configured statuses in workflow order.
+func Statuses() []Status { return current.Statuses() }
+
+// Get returns the named status of the configured workflow.
+func Get(name models.TodoStatus) Status { return current.Get(name) }
+
+// Rank returns the position of the named status in the configured
+// workflow.
+func Rank(name models.TodoStatus) int { return current.Rank(name) }
+
+// Next returns the status the x key moves a todo in name on to.
+func Next(name models.TodoStatus) models.TodoStatus { return current.Next(name) }
@@ -0,0 +1,95 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+)
+
+func names(w Workflow) []models.TodoStatus {
+	var out []models.TodoStatus
+	for _, s := range w.Statuses() {
+		out = append(out, s.Name)
+	}
+	return out
+}
+
+func equal(a, b []models.TodoStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewDefault(t *testing.T) {
+	w, err := New(nil)
+	if err != nil {
+		t.Fatalf("New(nil) err = %v", err)
+	}
+	want := []models.TodoStatus{models.TodoStatusPending, models.TodoStatusInProgress, models.TodoStatusCompleted}
+	if got := names(w); !equal(got, want) {
+		t.Errorf("statuses = %q, want %q", got, want)
+	}
+	if w.Next(models.TodoStatusCompleted) != models.TodoStatusPending {
+		t.Errorf("expected completed to cycle back to pending")
+	}
+}
+
+func TestNewCustom(t *testing.T) {
+	off := false
+	w, err := New([]config.TodoStatus{
+		{Name: "waiting", Icon: "⏳"},
+		{Name: "In_Progress", Label: "Doing"},
+		{Name: "blocked", Color: "#ff5f87", Cycle: &off},
+		{Name: "needs_review"},
+		{Name: "bad name"},
+		{Name: "waiting"},
+	})
+	if err == nil || !strings.Contains(err.Error(), `"bad name"`) || !strings.Contains(err.Error(), `"waiting" is listed twice`) {
+		t.Errorf("New() err = %v, want the bad and repeated entries", err)
+	}
+
+	want := []models.TodoStatus{"pending", "waiting", "in_progress", "blocked", "needs_review", "completed"}
+	if got := names(w); !equal(got, want) {
+		t.Fatalf("statuses = %q, want %q", got, want)
+	}
+
+	if s := w.Get("in_progress"); s.Label != "Doing" || s.Icon != "◐" {
+		t.Errorf("in_progress = %+v, want the built-in icon with the new label", s)
+	}
+	if s := w.Get("needs_review"); s.Label != "Needs review" || !s.Cycle {
+		t.Errorf("needs_review = %+v, want a humanized label", s)
+	}
+	if s := w.Get("blocked"); s.Color != "#ff5f87" || s.Cycle {
+		t.Errorf("blocked = %+v", s)
+	}
+	if s := w.Get("archived"); s.Label != "archived" {
+		t.Errorf("an unknown status should show its name, got %+v", s)
+	}
+
+	// x skips blocked
+	var cycle []models.TodoStatus
+	status := models.TodoStatusPending
+	for range 6 {
+		status = w.Next(status)
+		cycle = append(cycle, status)
+	}
+	wantCycle := []models.TodoStatus{"waiting", "in_progress", "needs_review", "completed", "pending", "waiting"}
+	if !equal(cycle, wantCycle) {
+		t.Errorf("cycle = %q, want %q", cycle, wantCycle)
+	}
+	if w.Next("blocked") != "pending" {
+		t.Errorf("a status outside the cycle should move to the first")
+	}
+
+	if w.Rank("waiting") != 1 || w.Rank("completed") != 5 || w.Rank("archived") != 6 {
+		t.Errorf("ranks = %d, %d, %d", w.Rank("waiting"), w.Rank("completed"), w.Rank("archived"))
+	}
+}
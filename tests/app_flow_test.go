@@ -0,0 +1,696 @@
+package tests
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/deeplink"
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/qdrant"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	app "github.com/Jericoz-JC/flowState-CLI/internal/tui"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/styles"
+)
+
+// TestAppGlobalShortcuts checks every global shortcut reaches its screen
+// from every other screen, so a screen cannot swallow navigation keys.
+func TestAppGlobalShortcuts(t *testing.T) {
+	shortcuts := []struct {
+		key    tea.KeyType
+		status string
+	}{
+		{tea.KeyCtrlN, "Notes"},
+		{tea.KeyCtrlT, "Todos"},
+		{tea.KeyCtrlF, "Focus"},
+		{tea.KeyCtrlUnderscore, "Search"},
+		{tea.KeyCtrlG, "Mind Map"},
+		{tea.KeyCtrlP, "Planner"},
+		{tea.KeyCtrlO, "Inbox"},
+		{tea.KeyCtrlH, "Home"},
+	}
+
+	d := newAppDriver(t, 120, 40)
+	for _, from := range shortcuts {
+		for _, to := range shortcuts {
+			d.Press(from.key)
+			d.RequireView(from.status + " |")
+			d.Press(to.key)
+			if !strings.Contains(d.View(), to.status+" |") {
+				t.Errorf("%s -> %s: shortcut did not switch screens", from.status, to.status)
+			}
+		}
+	}
+
+	// Quick capture opens over any screen and saves into the inbox
+	d.Press(tea.KeyCtrlT, tea.KeyCtrlX)
+	d.RequireView("Quick Capture |")
+	d.Type("Call the bank")
+	d.Press(tea.KeyCtrlS)
+	d.Press(tea.KeyCtrlO)
+	d.RequireView("Inbox |", "Call the bank")
+
+	d.Press(tea.KeyCtrlH)
+	d.Type("q")
+	d.RequireQuit()
+}
+
+// TestAppSideTimerToast checks a focus side timer finishing on another
+// screen still reaches the focus screen and shows a toast.
+func TestAppSideTimerToast(t *testing.T) {
+	d := newAppDriver(t, 100, 30)
+
+	d.Press(tea.KeyCtrlF)
+	d.Type("t")
+	d.Type("40m tea")
+	d.Press(tea.KeyEnter)
+	d.RequireView("tea", "40:00")
+
+	d.Press(tea.KeyCtrlN)
+	d.RequireView("Notes |")
+	d.Tick(screens.FocusTimerDoneMsg{ID: 1, Label: "tea"}, 1)
+	d.RequireView("Notes |", "tea — time's up")
+
+	d.Press(tea.KeyCtrlF)
+	if strings.Contains(d.View(), "40:00") {
+		t.Errorf("expected the finished timer to be gone from the focus screen")
+	}
+}
+
+func TestAppTodoRescheduleToast(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+
+	d.Press(tea.KeyCtrlT)
+	d.Type("c")
+	d.Type("Renew passport")
+	d.Press(tea.KeyCtrlS)
+	d.RequireView("Renew passport")
+
+	d.Type("w")
+	week := time.Now().AddDate(0, 0, 7)
+	d.RequireView("Todos |", "Due "+week.Format("Mon Jan 2"))
+}
+
+func TestAppOpenDeepLink(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	store := d.Store()
+	var todos []*models.Todo
+	for _, title := range []string{"Write report", "Call dentist"} {
+		todo := &models.Todo{Title: title, Status: models.TodoStatusPending}
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+		todos = append(todos, todo)
+	}
+	note := &models.Note{Title: "Linked note", Body: "body"}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	open := func(target deeplink.Target) (err error) {
+		d.Do(func(m *app.Model) { err = m.Open(target) })
+		return err
+	}
+	target, err := deeplink.Parse("flowstate://todo/" + strconv.FormatInt(todos[0].ID, 10))
+	if err != nil {
+		t.Fatalf("Parse() err = %v", err)
+	}
+	if err := open(target); err != nil {
+		t.Fatalf("Open(%s) err = %v", target, err)
+	}
+	d.Type("v")
+	d.RequireView("Todos |", "Write report", target.URI())
+
+	if err := open(deeplink.Target{Kind: deeplink.KindNote, ID: note.ID}); err != nil {
+		t.Fatalf("Open(note) err = %v", err)
+	}
+	d.Type("p")
+	d.RequireView("Notes |", "Linked note", deeplink.URI(deeplink.KindNote, note.ID))
+
+	if err := open(deeplink.Target{Kind: deeplink.KindNote, ID: 999}); err == nil {
+		t.Errorf("expected an error opening a missing note")
+	}
+}
+
+func TestAppPerScreenHelpModal(t *testing.T) {
+	d := newAppDriver(t, 160, 45)
+
+	d.Press(tea.KeyCtrlT)
+	d.Type("?")
+	d.RequireView("Todos - Keyboard Shortcuts", "Cycle tag filter", "Group by project", "Sort by column", "Quick Capture")
+	d.Type("?")
+
+	d.Press(tea.KeyCtrlN)
+	d.Type("?")
+	d.RequireView("Notes - Keyboard Shortcuts", "Pick tags to filter by", "Spelling suggestions", "Quick Capture")
+	d.Press(tea.KeyEsc)
+
+	d.Press(tea.KeyCtrlH)
+	d.Type("?")
+	d.RequireView("Keyboard Shortcuts", "Week Planner")
+}
+
+func TestAppHomeCounts(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	d.RequireView("Notes (0)", "Todos (0 open)", "Focus (0 today)", "Inbox (0)")
+
+	store := d.Store()
+	today := time.Now()
+	for _, todo := range []*models.Todo{
+		{Title: "Due now", Status: models.TodoStatusPending, DueDate: &today},
+		{Title: "Someday", Status: models.TodoStatusPending},
+		{Title: "Done", Status: models.TodoStatusCompleted, DueDate: &today},
+	} {
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+	for _, note := range []*models.Note{
+		{Title: "Idea", Body: "#inbox", Tags: []string{"inbox"}},
+		{Title: "Plan", Body: "body"},
+	} {
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	session := &models.FocusSession{StartTime: today, Duration: 25 * 60, Status: models.SessionStatusCompleted}
+	if err := store.CreateSession(session); err != nil {
+		t.Fatalf("CreateSession() err = %v", err)
+	}
+
+	// Counts refresh when Home is opened
+	d.Do(func(*app.Model) { d.cfg.DailyFocusGoalMinutes = 100 })
+	d.Press(tea.KeyCtrlT, tea.KeyCtrlH)
+	d.RequireView("Notes (2)", "Todos (1 due today)", "Focus (1/4 sessions)", "Inbox (1)")
+}
+
+func TestAppNavigationHistory(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	store := d.Store()
+	for _, title := range []string{"First note", "Second note"} {
+		if err := store.CreateNote(&models.Note{Title: title, Body: title + " body"}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		time.Sleep(2 * time.Millisecond) // distinct updated_at
+	}
+
+	// Notes → older note's preview (second in the list) → new linked todo
+	d.Press(tea.KeyCtrlN)
+	d.Type("j")
+	d.Type("p")
+	d.RequireView("First note body")
+	d.Type("T")
+	d.RequireView("Todos |")
+	d.Type("Follow up")
+	d.Press(tea.KeyCtrlS)
+	d.RequireView("Todos |", "Follow up")
+
+	// Esc unwinds to the note's preview, now listing the new todo
+	d.Press(tea.KeyEsc)
+	d.RequireView("Notes |", "First note body", "[T] New Todo", "Follow up")
+	d.Press(tea.KeyEsc)
+	d.RequireView("Notes |", "▶ just now First note")
+
+	alt := func(k tea.KeyType) { d.Send(tea.KeyMsg{Type: k, Alt: true}) }
+	alt(tea.KeyRight)
+	d.RequireView("Todos |")
+	alt(tea.KeyLeft)
+	d.RequireView("Notes |")
+
+	// Back past the first screen lands Home
+	d.Press(tea.KeyEsc)
+	d.RequireView("Capture and organize")
+	d.Press(tea.KeyEsc)
+	d.RequireView("Capture and organize")
+}
+
+func TestAppTabs(t *testing.T) {
+	d := newAppDriver(t, 140, 40)
+	store := d.Store()
+	for _, title := range []string{"Work standup", "Home groceries"} {
+		if err := store.CreateNote(&models.Note{Title: title}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	tab := func(r rune) { d.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}, Alt: true}) }
+
+	// Tab 1: notes filtered to "standup"
+	d.Press(tea.KeyCtrlN)
+	d.Type("/standup")
+	d.Press(tea.KeyEnter)
+	d.RequireView("Work standup")
+	d.RequireNoView("Home groceries")
+
+	// Alt+2 opens a second, unfiltered notes tab
+	tab('2')
+	d.RequireView("Opened tab 2", `1 Notes "standup"  [2 Notes]`, "Work standup", "Home groceries")
+	d.Type("/groceries")
+	d.Press(tea.KeyEnter)
+	d.RequireNoView("Work standup")
+
+	// Each tab keeps its own filter
+	tab('1')
+	d.RequireView(`[1 Notes "standup"]`, "Work standup")
+	d.RequireNoView("Home groceries")
+	tab('2')
+	d.RequireView(`[2 Notes "groceries"]`, "Home groceries")
+	d.RequireNoView("Work standup")
+
+	tab('5')
+	d.RequireView("No tab 5")
+
+	// Closing tab 2 returns to tab 1 and hides the tab strip
+	d.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}, Alt: true})
+	d.RequireView("Closed tab 2", "Work standup")
+	d.RequireNoView("[1 Notes")
+}
+
+func TestAppCtrlFFindsInNoteEditor(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+
+	d.Press(tea.KeyCtrlN)
+	d.Type("c")
+	d.Press(tea.KeyCtrlF)
+	d.RequireView("Notes |", "Find:", "Replace All")
+
+	// Outside the editor Ctrl+F still opens Focus
+	d.Press(tea.KeyEsc, tea.KeyEsc)
+	d.Press(tea.KeyCtrlF)
+	d.RequireView("Focus |")
+}
+
+func TestAppStarred(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	store := d.Store()
+	if err := store.CreateNote(&models.Note{Title: "Launch plan"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	if err := store.CreateTodo(&models.Todo{Title: "Book venue", Status: models.TodoStatusPending}); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	// * stars the selected note and todo; both show up on Starred
+	d.Press(tea.KeyCtrlN)
+	d.Type("*")
+	d.RequireView(`Starred "Launch plan"`, "★")
+	d.Press(tea.KeyCtrlT)
+	d.Type("*")
+	d.Press(tea.KeyCtrlH)
+	d.RequireView("Starred (2)")
+
+	d.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}, Alt: true})
+	d.RequireView("Starred |", "Launch plan", "Book venue", "2 items")
+
+	// Enter opens the selected item on its own screen
+	d.Press(tea.KeyEnter)
+	d.RequireView("Todos |", "Book venue")
+	d.Press(tea.KeyEsc)
+	d.RequireView("Starred |")
+
+	// * unstars it
+	d.Type("*")
+	d.RequireView(`Unstarred "Book venue"`, "1 item")
+}
+
+func TestAppTerminalTitle(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	d.Press(tea.KeyCtrlN)
+	d.RequireTitle("flowState — Notes")
+
+	// A running session shows its countdown, and keeps ticking off-screen.
+	// Titles are set by commands that may run in any order, so each
+	// change is awaited before the next key.
+	d.Press(tea.KeyCtrlF)
+	d.RequireTitle("flowState — Focus")
+	d.Type("s")
+	d.RequireTitle("flowState — 25:00 🍅 · Focus")
+	d.Press(tea.KeyCtrlT)
+	d.RequireTitle("flowState — 25:00 🍅 · Todos")
+	d.Tick(screens.FocusTickMsg(time.Now()), 90)
+	d.Press(tea.KeyCtrlN)
+	d.RequireTitle("flowState — 23:30 🍅 · Notes")
+}
+
+func TestAppQuickSwitcher(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	altR := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}, Alt: true}
+
+	d.Send(altR)
+	d.RequireView("No recently opened notes or todos")
+
+	store := d.Store()
+	for _, title := range []string{"Alpha note", "Beta note"} {
+		if err := store.CreateNote(&models.Note{Title: title, Body: title + " body"}); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+	}
+	if err := store.CreateTodo(&models.Todo{Title: "Gamma todo", Status: models.TodoStatusPending}); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	// Preview both notes (newest is listed first), then the todo
+	d.Press(tea.KeyCtrlN)
+	d.Type("p")
+	d.Press(tea.KeyEsc)
+	d.Type("j")
+	d.Type("p")
+	d.Press(tea.KeyEsc)
+	d.Press(tea.KeyCtrlT)
+	d.Type("v")
+
+	// The overlay lists them newest first, with the previous one selected
+	d.Send(altR)
+	d.RequireView("Recent", "1 ☐ Gamma todo", "▶ 2 📝 Alpha note", "3 📝 Beta note")
+	d.Send(altR)
+	d.RequireView("▶ 3 📝 Beta note")
+	d.Press(tea.KeyUp)
+	d.Press(tea.KeyEnter)
+	d.RequireView("Notes |", "Alpha note body")
+
+	// Opening an item moves it to the front
+	d.Send(altR)
+	d.RequireView("1 📝 Alpha note", "▶ 2 ☐ Gamma todo")
+	d.Type("3")
+	d.RequireView("Notes |", "Beta note body")
+}
+
+func TestAppQuickSwitcherWideTitle(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+	altR := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}, Alt: true}
+
+	// Each character is two cells wide, so the row overflows the overlay
+	// well before its rune count does
+	title := strings.Repeat("漢字", 20)
+	if err := d.Store().CreateNote(&models.Note{Title: title, Body: "wide body"}); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+	d.Press(tea.KeyCtrlN)
+	d.Type("p")
+	d.Press(tea.KeyEsc)
+
+	d.Send(altR)
+	d.RequireView("1 📝 漢字漢字", "…")
+	if strings.Contains(d.View(), title) {
+		t.Fatalf("expected the title to be truncated, got:\n%s", d.View())
+	}
+}
+
+func TestAppPinnedFilters(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+
+	store := d.Store()
+	todos := []*models.Todo{
+		{Title: "Ship the release", Priority: models.TodoPriorityHigh},
+		{Title: "Fix the login bug", Priority: models.TodoPriorityHigh},
+		{Title: "Water the plants", Priority: models.TodoPriorityLow},
+	}
+	for _, todo := range todos {
+		todo.Status = models.TodoStatusPending
+		if err := store.CreateTodo(todo); err != nil {
+			t.Fatalf("CreateTodo() err = %v", err)
+		}
+	}
+
+	// Nothing to pin until the list is filtered
+	d.Press(tea.KeyCtrlT)
+	d.Type("H")
+	d.RequireView("Filter the list first")
+
+	// p cycles to high priority; H pins it
+	d.Type("p")
+	d.Type("H")
+	d.RequireView("Pinned priority:high to Home (key 1)")
+
+	d.Press(tea.KeyCtrlH)
+	d.RequireView("📌 Pinned", "[1] ✅ priority:high (2)")
+
+	// 1 opens the todos screen with the filter restored
+	d.Type("1")
+	d.RequireView("Todos", "Ship the release", "Fix the login bug")
+	if strings.Contains(d.View(), "Water the plants") {
+		t.Fatalf("pinned filter shows a low priority todo:\n%s", d.View())
+	}
+
+	// H on the same filter unpins it
+	d.Type("H")
+	d.RequireView("Unpinned priority:high from Home")
+	d.Press(tea.KeyCtrlH)
+	if strings.Contains(d.View(), "📌 Pinned") {
+		t.Fatalf("Home still lists the unpinned filter:\n%s", d.View())
+	}
+}
+
+func TestAppAccessibility(t *testing.T) {
+	t.Cleanup(func() {
+		styles.UseHighContrast(false)
+		styles.SetReducedMotion(false)
+	})
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.HighContrast = true
+		cfg.ReducedMotion = true
+	})
+	if styles.PrimaryColor != styles.HighContrastPalette.Primary {
+		t.Fatalf("high_contrast did not switch the palette")
+	}
+
+	// With reduced motion a toast stays until the next key
+	d.Send(screens.ToastMsg{Text: "Saved the note"})
+	d.RequireView("Saved the note")
+	d.Press(tea.KeyCtrlN)
+	if strings.Contains(d.View(), "Saved the note") {
+		t.Fatalf("toast outlived the next key:\n%s", d.View())
+	}
+}
+
+func TestAppPlainOutput(t *testing.T) {
+	t.Cleanup(func() { styles.SetPlainOutput(false) })
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.PlainOutput = true
+	})
+
+	store := d.Store()
+	todo := &models.Todo{Title: "Ship the release", Status: models.TodoStatusPending, Project: "launch"}
+	if err := store.CreateTodo(todo); err != nil {
+		t.Fatalf("CreateTodo() err = %v", err)
+	}
+
+	d.Press(tea.KeyCtrlH)
+	d.RequireView("flowState")
+	d.Press(tea.KeyCtrlT)
+	d.RequireView("(low priority) (pending) Ship the release", "(project) launch")
+	d.Press(tea.KeyCtrlF)
+	d.RequireView("25:00")
+
+	d.Send(screens.ToastMsg{Text: "Saved"})
+	d.RequireView("Notice: Saved")
+	for _, screen := range []tea.KeyType{tea.KeyCtrlH, tea.KeyCtrlT, tea.KeyCtrlF} {
+		d.Press(screen)
+		if i := strings.IndexFunc(d.View(), func(r rune) bool {
+			return r >= 0x2500 && r <= 0x259f || r >= 0x1f300
+		}); i >= 0 {
+			t.Fatalf("plain output has art or emoji %q:\n%s", []rune(d.View()[i:])[0], d.View())
+		}
+	}
+}
+
+func TestAppHomeGoalWideTitle(t *testing.T) {
+	d := newAppDriver(t, 120, 40)
+
+	// 40 cells wide, past the 32-cell title column, in only 20 runes
+	title := strings.Repeat("漢字", 10)
+	if err := d.Store().CreateGoal(&models.Goal{Title: title, Kind: models.GoalNotes, Target: 5, Period: models.GoalWeek}); err != nil {
+		t.Fatalf("CreateGoal() err = %v", err)
+	}
+	d.Press(tea.KeyCtrlH)
+	d.RequireView("🎯 Goals", "0/5")
+	for _, line := range strings.Split(d.View(), "\n") {
+		if strings.Contains(line, "漢字") && !strings.Contains(line, "…") {
+			t.Fatalf("expected the goal title truncated on one line, got:\n%s", d.View())
+		}
+	}
+}
+
+func TestAppSafeMode(t *testing.T) {
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.SafeMode = true
+		// A directory cannot be read as a word list; normally that stops
+		// the app from starting
+		cfg.SpellCheck = true
+		cfg.DictionaryPath = t.TempDir()
+	})
+	d.RequireView("SAFE MODE", "Safe mode: indexing, plugins, hooks and Home extras are off")
+
+	store := d.Store()
+	if err := store.CreateGoal(&models.Goal{Title: "Write daily", Kind: models.GoalNotes, Target: 5, Period: models.GoalWeek}); err != nil {
+		t.Fatalf("CreateGoal() err = %v", err)
+	}
+	if err := store.CreatePin(&models.Pin{Screen: "todos", Label: "priority:high"}); err != nil {
+		t.Fatalf("CreatePin() err = %v", err)
+	}
+	note := &models.Note{Title: "Still reachable", Body: "body"}
+	if err := store.CreateNote(note); err != nil {
+		t.Fatalf("CreateNote() err = %v", err)
+	}
+
+	// Home leaves out goals and pins; the data itself is all there
+	d.Press(tea.KeyCtrlH)
+	if view := d.View(); strings.Contains(view, "Write daily") || strings.Contains(view, "📌 Pinned") {
+		t.Fatalf("safe mode Home shows goals or pins:\n%s", view)
+	}
+	d.Press(tea.KeyCtrlN)
+	d.RequireView("SAFE MODE", "Still reachable")
+
+	// Opening search would index the new note otherwise
+	d.Press(tea.KeyCtrlUnderscore)
+	d.RequireView("Search |")
+	if _, indexed, _ := store.GetNoteEmbedding(note.ID); indexed {
+		t.Fatalf("safe mode indexed a note")
+	}
+}
+
+func TestAppModelFirstRun(t *testing.T) {
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.EmbeddingsEnabled = true
+	})
+	d.RequireView("Semantic Model", "Welcome!", "Not downloaded")
+
+	// Skipping it lands on Home, which keeps a way back
+	d.Press(tea.KeyEsc)
+	d.RequireView("Model", "not downloaded")
+	if offered, ok, err := d.Store().GetSetting("model.offered"); err != nil || !ok || offered == "" {
+		t.Fatalf("model.offered = %q, %v, %v; want it recorded", offered, ok, err)
+	}
+	d.Type("m")
+	d.RequireView("Semantic Model", "Not downloaded")
+	if strings.Contains(d.View(), "Welcome!") {
+		t.Fatalf("the Model screen still shows the first-run offer:\n%s", d.View())
+	}
+}
+
+func TestAppModelChangeRebuildsIndex(t *testing.T) {
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		// An index built by the default model before embedding_model changed
+		store, err := sqlite.New(cfg)
+		if err != nil {
+			t.Fatalf("sqlite.New() err = %v", err)
+		}
+		note := &models.Note{Title: "Garden", Body: "plant tomatoes"}
+		if err := store.CreateNote(note); err != nil {
+			t.Fatalf("CreateNote() err = %v", err)
+		}
+		if err := store.UpsertNoteEmbedding(note.ID, make([]float32, 384)); err != nil {
+			t.Fatalf("UpsertNoteEmbedding() err = %v", err)
+		}
+		store.Close()
+		cfg.EmbeddingModel = "bge-base-en-v1.5"
+	})
+	d.RequireView("Rebuild the search index?", "1 note from all-MiniLM-L6-v2 (384 dimensions)", "bge-base-en-v1.5 (768 dimensions)")
+	if index, _ := d.Store().EmbeddingIndex(); index.Model != "all-MiniLM-L6-v2" || index.Vectors != 1 {
+		t.Fatalf("startup touched the old index before asking: %+v", index)
+	}
+
+	// Declining keeps the old vectors and leaves search off
+	d.Type("n")
+	d.RequireView("Semantic search is off until the index is rebuilt")
+	d.Press(tea.KeyCtrlUnderscore)
+	d.Type("tomatoes")
+	d.Press(tea.KeyEnter)
+	d.RequireView("rebuild the search index")
+
+	d.Press(tea.KeyCtrlH)
+	d.Type("m")
+	d.RequireView("1 note from all-MiniLM-L6-v2 (384 dimensions); r rebuilds it for bge-base-en-v1.5")
+	d.Type("r")
+	d.Type("y")
+	d.RequireView("Search index rebuilt with bge-base-en-v1.5")
+	index, err := d.Store().EmbeddingIndex()
+	if err != nil || index.Model != "bge-base-en-v1.5" || index.Dimensions != 768 || index.Vectors != 1 {
+		t.Fatalf("index after the rebuild = %+v, %v", index, err)
+	}
+}
+
+func TestAppVectorBackendFallsBack(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	d := newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.VectorBackend = "qdrant"
+		cfg.QdrantUrl = server.URL
+	})
+	d.RequireView("is unreachable; searching locally")
+
+	d = newAppDriverWith(t, 120, 40, func(cfg *config.Config) {
+		cfg.VectorBackend = "pinecone"
+	})
+	d.RequireView(`vector_backend: unknown backend "pinecone"; searching locally`)
+}
+
+// TestAppVectorIndexSurvivesRestart checks the built-in vector store is
+// saved to the data directory and loaded on the next start, which then
+// neither embeds the unchanged notes again nor resends their vectors.
+func TestAppVectorIndexSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	configure := func(cfg *config.Config) {
+		cfg.DbPath = filepath.Join(dir, "test.db")
+		cfg.DataDir = dir
+	}
+
+	d := newAppDriverWith(t, 120, 40, configure)
+	d.Press(tea.KeyCtrlN)
+	d.Type("c")
+	d.Type("Restart note")
+	d.Press(tea.KeyTab)
+	d.Type("vectors kept on disk")
+	d.Press(tea.KeyCtrlS)
+	d.Press(tea.KeyCtrlUnderscore) // Opening Search indexes the new note
+	d.RequireView("Search |")
+	d.WaitForMsg("app.indexedMsg")
+	if err := d.Close(); err != nil {
+		t.Fatalf("Close() err = %v", err)
+	}
+
+	index := qdrant.IndexPath(&config.Config{DataDir: dir})
+	before, err := os.Stat(index)
+	if err != nil || before.Size() == 0 {
+		t.Fatalf("vector index after the first run: %v, %v", before, err)
+	}
+	// Date the stored vector into the future: embedding the note again
+	// would overwrite it
+	db, err := sql.Open("sqlite", filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("UPDATE note_vectors SET updated_at = '2999-01-01 00:00:00'"); err != nil {
+		t.Fatalf("date vectors: %v", err)
+	}
+	db.Close()
+
+	d = newAppDriverWith(t, 120, 40, configure)
+	after, err := os.Stat(index)
+	if err != nil || after.Size() != before.Size() {
+		t.Errorf("vector index grew on restart: %d → %v bytes, err %v", before.Size(), after, err)
+	}
+	times, err := d.Store().NoteEmbeddingTimes()
+	if err != nil || len(times) != 1 {
+		t.Fatalf("NoteEmbeddingTimes() = %v, %v; want one vector", times, err)
+	}
+	for _, at := range times {
+		if at.Year() != 2999 {
+			t.Errorf("vector stored at %v, want it not embedded again", at)
+		}
+	}
+
+	d.Press(tea.KeyCtrlUnderscore)
+	d.Type("vectors kept on disk")
+	d.Press(tea.KeyEnter)
+	d.RequireView("Restart note")
+}
@@ -0,0 +1,350 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/config"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	app "github.com/Jericoz-JC/flowState-CLI/internal/tui"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
+)
+
+// settleTimeout is how long the driver waits for the app to handle a
+// message or to show something before the test fails.
+const settleTimeout = 5 * time.Second
+
+var (
+	ansiPattern  = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+	titlePattern = regexp.MustCompile("\x1b\\]2;([^\x07]*)\x07")
+)
+
+// Messages the harness model handles itself rather than passing on.
+type (
+	// syncMsg is closed once every message sent before it was handled.
+	syncMsg chan struct{}
+	// doMsg runs fn on the app inside the program's event loop.
+	doMsg struct {
+		fn   func(*app.Model)
+		done chan struct{}
+	}
+	// fastForwardMsg delivers msg n times, discarding the commands the
+	// app returns, so a timer's messages can be sent without the timer
+	// re-arming itself each time.
+	fastForwardMsg struct {
+		msg tea.Msg
+		n   int
+	}
+)
+
+// harnessModel wraps the app for teatest. It keeps the last rendered
+// view for assertions, records the types of the messages the app
+// handled, and owns the focus clock: the app's own once-a-second
+// FocusTickMsg is dropped, so a countdown only moves when a test
+// fast-forwards it.
+type harnessModel struct {
+	app *app.Model
+
+	mu   sync.Mutex
+	view string
+	seen map[string]int
+}
+
+func (h *harnessModel) Init() tea.Cmd {
+	return h.app.Init()
+}
+
+func (h *harnessModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case syncMsg:
+		close(msg)
+		return h, nil
+	case doMsg:
+		msg.fn(h.app)
+		close(msg.done)
+		return h, nil
+	case fastForwardMsg:
+		for i := 0; i < msg.n; i++ {
+			h.app.Update(msg.msg)
+		}
+		return h, nil
+	case screens.FocusTickMsg:
+		return h, nil
+	}
+	_, cmd := h.app.Update(msg)
+	h.mu.Lock()
+	h.seen[fmt.Sprintf("%T", msg)]++
+	h.mu.Unlock()
+	return h, cmd
+}
+
+func (h *harnessModel) View() string {
+	view := h.app.View()
+	h.mu.Lock()
+	h.view = view
+	h.mu.Unlock()
+	return view
+}
+
+// newTestApp creates an app backed by a fresh database, with configure
+// applied to the config first, wrapped for teatest. The app is closed
+// when the test ends.
+func newTestApp(t *testing.T, configure func(*config.Config)) (*harnessModel, *config.Config) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	cfg := &config.Config{
+		DbPath:          filepath.Join(tmpDir, "test.db"),
+		ModelPath:       filepath.Join(tmpDir, "models"),
+		WorkHoursPerDay: config.DefaultWorkHoursPerDay,
+	}
+	if configure != nil {
+		configure(cfg)
+	}
+	m, err := app.New(cfg)
+	if err != nil {
+		t.Fatalf("app.New() err = %v", err)
+	}
+	t.Cleanup(func() { _ = m.Close() })
+	return &harnessModel{app: m, seen: map[string]int{}}, cfg
+}
+
+// appDriver runs a full app.Model under a real tea.Program (teatest).
+// Each message it sends is handled before the call returns; the commands
+// it starts run on their own, so assertions on what they change wait
+// for it with RequireView, RequireTitle or WaitForMsg.
+type appDriver struct {
+	t   *testing.T
+	tm  *teatest.TestModel
+	h   *harnessModel
+	cfg *config.Config
+
+	out      strings.Builder // Program output read so far
+	finished chan struct{}   // Closed when the program has exited
+	closed   bool
+}
+
+// newAppDriver creates an app backed by a fresh database and sizes it.
+func newAppDriver(t *testing.T, width, height int) *appDriver {
+	t.Helper()
+	return newAppDriverWith(t, width, height, nil)
+}
+
+// newAppDriverWith is newAppDriver with configure applied to the config
+// before the app is created.
+func newAppDriverWith(t *testing.T, width, height int, configure func(*config.Config)) *appDriver {
+	t.Helper()
+
+	h, cfg := newTestApp(t, configure)
+	d := &appDriver{t: t, h: h, cfg: cfg, finished: make(chan struct{})}
+	d.tm = teatest.NewTestModel(t, h, teatest.WithInitialTermSize(width, height))
+	go func() {
+		d.tm.WaitFinished(t)
+		close(d.finished)
+	}()
+	// Runs before the app is closed (cleanups run last-in first-out)
+	t.Cleanup(d.stop)
+	d.sync()
+	return d
+}
+
+// Send delivers msg and waits until the app has handled it.
+func (d *appDriver) Send(msg tea.Msg) {
+	d.t.Helper()
+	if d.closed {
+		d.t.Fatalf("Send(%T) after the app quit", msg)
+	}
+	d.tm.Send(msg)
+	d.sync()
+}
+
+// Press sends a special key such as tea.KeyEnter or tea.KeyCtrlN.
+func (d *appDriver) Press(keys ...tea.KeyType) {
+	d.t.Helper()
+	for _, k := range keys {
+		d.Send(tea.KeyMsg{Type: k})
+	}
+}
+
+// Type sends s one rune at a time, as typing would.
+func (d *appDriver) Type(s string) {
+	d.t.Helper()
+	for _, r := range s {
+		if r == ' ' {
+			d.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+			continue
+		}
+		d.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+// Tick delivers msg n times, discarding the commands the app returns.
+// Use it to fast-forward timers rather than wait for them.
+func (d *appDriver) Tick(msg tea.Msg, n int) {
+	d.t.Helper()
+	d.Send(fastForwardMsg{msg: msg, n: n})
+}
+
+// Do runs fn on the app inside the program's event loop, for calls such
+// as Open that the command line makes before the program starts.
+func (d *appDriver) Do(fn func(*app.Model)) {
+	d.t.Helper()
+	done := make(chan struct{})
+	d.tm.Send(doMsg{fn: fn, done: done})
+	d.wait(done, "Do()")
+}
+
+// View returns the last rendered screen with ANSI escape sequences
+// removed.
+func (d *appDriver) View() string {
+	d.h.mu.Lock()
+	defer d.h.mu.Unlock()
+	return ansiPattern.ReplaceAllString(d.h.view, "")
+}
+
+// RequireView waits until the screen shows every want string, failing
+// the test if it does not within settleTimeout.
+func (d *appDriver) RequireView(want ...string) {
+	d.t.Helper()
+	shows := func() bool {
+		view := d.View()
+		for _, w := range want {
+			if !strings.Contains(view, w) {
+				return false
+			}
+		}
+		return true
+	}
+	if !d.eventually(shows) {
+		d.t.Fatalf("expected screen to contain %q, got:\n%s", want, d.View())
+	}
+}
+
+// RequireNoView waits until the screen no longer shows unwanted.
+func (d *appDriver) RequireNoView(unwanted string) {
+	d.t.Helper()
+	if !d.eventually(func() bool { return !strings.Contains(d.View(), unwanted) }) {
+		d.t.Fatalf("expected screen not to contain %q, got:\n%s", unwanted, d.View())
+	}
+}
+
+// RequireTitle waits until the terminal title the app last set is want.
+func (d *appDriver) RequireTitle(want string) {
+	d.t.Helper()
+	if !d.eventually(func() bool { return d.title() == want }) {
+		d.t.Fatalf("title = %q, want %q", d.title(), want)
+	}
+}
+
+// WaitForMsg waits until the app has handled a message whose type, as
+// printed by %T, is name (e.g. "app.indexedMsg"), to know a background
+// command finished.
+func (d *appDriver) WaitForMsg(name string) {
+	d.t.Helper()
+	handled := func() bool {
+		d.h.mu.Lock()
+		defer d.h.mu.Unlock()
+		return d.h.seen[name] > 0
+	}
+	if !d.eventually(handled) {
+		d.t.Fatalf("the app did not handle a %s within %v", name, settleTimeout)
+	}
+}
+
+// RequireQuit waits for the app to quit.
+func (d *appDriver) RequireQuit() {
+	d.t.Helper()
+	select {
+	case <-d.finished:
+		d.closed = true
+	case <-time.After(settleTimeout):
+		d.t.Fatalf("expected the app to quit")
+	}
+}
+
+// Close quits the program and closes the app, as the command line does
+// on exit.
+func (d *appDriver) Close() error {
+	d.t.Helper()
+	d.stop()
+	return d.h.app.Close()
+}
+
+// Store opens a second connection to the app's database for assertions.
+func (d *appDriver) Store() *sqlite.Store {
+	d.t.Helper()
+	store, err := sqlite.New(d.cfg)
+	if err != nil {
+		d.t.Fatalf("sqlite.New() err = %v", err)
+	}
+	d.t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+// sync waits until the app has handled every message sent so far.
+func (d *appDriver) sync() {
+	d.t.Helper()
+	done := make(syncMsg)
+	d.tm.Send(done)
+	d.wait(done, "a message")
+}
+
+// wait fails the test unless done is closed within settleTimeout. A
+// program that quits meanwhile never closes it; the next Send fails.
+func (d *appDriver) wait(done chan struct{}, what string) {
+	d.t.Helper()
+	select {
+	case <-done:
+	case <-d.finished:
+		d.closed = true
+	case <-time.After(settleTimeout):
+		d.t.Fatalf("the app did not handle %s within %v", what, settleTimeout)
+	}
+}
+
+// eventually polls cond until it holds or settleTimeout passes.
+func (d *appDriver) eventually(cond func() bool) bool {
+	deadline := time.Now().Add(settleTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return true
+}
+
+// title returns the terminal title the app last set, from the program
+// output.
+func (d *appDriver) title() string {
+	data, _ := io.ReadAll(d.tm.Output())
+	d.out.Write(data)
+	matches := titlePattern.FindAllStringSubmatch(d.out.String(), -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[len(matches)-1][1]
+}
+
+// stop quits the program, if it is still running, and waits for it.
+func (d *appDriver) stop() {
+	if d.closed {
+		return
+	}
+	d.closed = true
+	_ = d.tm.Quit()
+	select {
+	case <-d.finished:
+	case <-time.After(settleTimeout):
+		d.t.Errorf("the app did not quit within %v", settleTimeout)
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+
+	"github.com/Jericoz-JC/flowState-CLI/internal/models"
+	"github.com/Jericoz-JC/flowState-CLI/internal/storage/sqlite"
+	"github.com/Jericoz-JC/flowState-CLI/internal/tui/screens"
+)
+
+// TestAppFlowNoteTodoFocusSearch runs the whole app under a real
+// tea.Program through a typical session: write a note, create a todo
+// linked to it, run a (fast-forwarded) focus session, then find the note
+// again with search and quit.
+func TestAppFlowNoteTodoFocusSearch(t *testing.T) {
+	h, cfg := newTestApp(t, nil)
+	tm := teatest.NewTestModel(t, h, teatest.WithInitialTermSize(120, 40))
+	waitForOutput(t, tm, "Ready |")
+	store, err := sqlite.New(cfg)
+	if err != nil {
+		t.Fatalf("sqlite.New() err = %v", err)
+	}
+	defer store.Close()
+
+	// Create a note
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlN})
+	waitForOutput(t, tm, "Notes |")
+	tm.Type("c")
+	typeText(tm, "Harness note")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	typeText(tm, "quarterly planning for #harness")
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlS})
+	waitForOutput(t, tm, "1 item")
+
+	notes, err := store.ListNotes()
+	if err != nil || len(notes) != 1 {
+		t.Fatalf("ListNotes() = %v, %v; want the new note", notes, err)
+	}
+	note := notes[0]
+	if len(note.Tags) != 1 || note.Tags[0] != "harness" {
+		t.Errorf("note tags = %v, want [harness]", note.Tags)
+	}
+
+	// T opens the todo form pre-linked to the selected note
+	tm.Type("T")
+	waitForOutput(t, tm, "Todos |")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	waitForOutput(t, tm, "edited just now")
+	todos, err := store.ListTodosForNote(note.ID)
+	if err != nil || len(todos) != 1 || todos[0].Title != "Harness note" {
+		t.Fatalf("ListTodosForNote() = %v, %v; want one linked todo", todos, err)
+	}
+
+	// Pick the shortest work duration and run the session to completion
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlF})
+	waitForOutput(t, tm, "Focus |")
+	tm.Type("d")
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("s")
+	tick := screens.FocusTickMsg(time.Now())
+	tm.Send(fastForwardMsg{msg: tick, n: screens.WorkDurations[0] * 60})
+	waitForOutput(t, tm, "B R E A K")
+	sessions, err := store.ListSessions()
+	if err != nil || len(sessions) != 1 || sessions[0].Status != models.SessionStatusCompleted {
+		t.Fatalf("ListSessions() = %v, %v; want one completed session", sessions, err)
+	}
+	if sessions[0].Duration != screens.WorkDurations[0]*60 {
+		t.Errorf("session duration = %ds, want %ds", sessions[0].Duration, screens.WorkDurations[0]*60)
+	}
+	tm.Send(fastForwardMsg{msg: tick, n: screens.BreakDurations[0] * 60})
+
+	// Ctrl+/ arrives from terminals as ctrl+_
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlUnderscore})
+	waitForOutput(t, tm, "Search |")
+	typeText(tm, "quarterly planning")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	waitForOutput(t, tm, "Harness note")
+
+	// Opening the result jumps back to the note
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	waitForOutput(t, tm, "Notes |")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+	final := tm.FinalModel(t, teatest.WithFinalTimeout(settleTimeout)).(*harnessModel)
+	if view := final.app.View(); !strings.Contains(view, "Notes |") || !strings.Contains(view, "Harness note") {
+		t.Errorf("final screen is not the note:\n%s", view)
+	}
+}
+
+// waitForOutput waits until the program has printed want.
+func waitForOutput(t *testing.T, tm *teatest.TestModel, want string) {
+	t.Helper()
+	teatest.WaitFor(t, tm.Output(), func(out []byte) bool {
+		return bytes.Contains(out, []byte(want))
+	}, teatest.WithDuration(settleTimeout), teatest.WithCheckInterval(10*time.Millisecond))
+}
+
+// typeText sends s as key presses, spaces as the space key as a terminal
+// would (TestModel.Type sends them as runes).
+func typeText(tm *teatest.TestModel, s string) {
+	for _, word := range strings.SplitAfter(s, " ") {
+		if w := strings.TrimSuffix(word, " "); w != "" {
+			tm.Type(w)
+		}
+		if strings.HasSuffix(word, " ") {
+			tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+		}
+	}
+}